@@ -25,7 +25,10 @@ import (
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	uc_wallet "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/wallet"
 	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
@@ -82,10 +85,15 @@ func doRunGenerateWallets() {
 	walletRepo := repo.NewWalletRepo(cfg, logger, dbClient)
 	accountRepo := repo.NewAccountRepo(cfg, logger, dbClient)
 	mempoolTxRepo := repo.NewMempoolTransactionRepo(cfg, logger, dbClient)
+	mempoolTxStatusRepo := repo.NewMempoolTransactionStatusRepo(cfg, logger, redisCacheProvider)
+	txReceiptRepo := repo.NewTransactionReceiptRepo(cfg, logger, dbClient)
 	blockchainStateRepo := repo.NewBlockchainStateRepo(cfg, logger, dbClient)
 	tokRepo := repo.NewTokenRepo(cfg, logger, dbClient)
 	gbdRepo := repo.NewGenesisBlockDataRepo(cfg, logger, dbClient)
 	bdRepo := repo.NewBlockDataRepo(cfg, logger, dbClient)
+	validatorSetRepo := repo.NewValidatorSetRepo(cfg, logger, dbClient)
+	accountStateDeltaRepo := repo.NewAccountStateDeltaRepo(cfg, logger, dbClient)
+	tokenStateDeltaRepo := repo.NewTokenStateDeltaRepo(cfg, logger, dbClient)
 
 	// Use-cases
 	openHDWalletFromMnemonicUseCase := uc_walletutil.NewOpenHDWalletFromMnemonicUseCase(
@@ -133,6 +141,16 @@ func doRunGenerateWallets() {
 		logger,
 		mempoolTxRepo,
 	)
+	mempoolTransactionStatusUpsertUseCase := uc_mempooltx.NewMempoolTransactionStatusUpsertUseCase(
+		cfg,
+		logger,
+		mempoolTxStatusRepo,
+	)
+	upsertTransactionReceiptUseCase := uc_txreceipt.NewUpsertTransactionReceiptUseCase(
+		cfg,
+		logger,
+		txReceiptRepo,
+	)
 	getBlockchainStateUseCase := uc_blockchainstate.NewGetBlockchainStateUseCase(
 		cfg,
 		logger,
@@ -190,6 +208,31 @@ func doRunGenerateWallets() {
 	)
 
 	// Create PoA consensus mechanism service
+	listValidatorSetUseCase := uc_validatorset.NewListValidatorSetUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertValidatorSetMemberUseCase := uc_validatorset.NewUpsertValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	deleteValidatorSetMemberUseCase := uc_validatorset.NewDeleteValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertAccountStateDeltaUseCase := uc_statedelta.NewUpsertAccountStateDeltaUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	upsertTokenStateDeltaUseCase := uc_statedelta.NewUpsertTokenStateDeltaUseCase(
+		cfg,
+		logger,
+		tokenStateDeltaRepo,
+	)
 	proofOfAuthorityConsensusMechanismService := sv_poa.NewProofOfAuthorityConsensusMechanismService(
 		cfg,
 		logger,
@@ -210,6 +253,13 @@ func doRunGenerateWallets() {
 		proofOfWorkUseCase,
 		upsertBlockDataUseCase,
 		blockchainStatePublishUseCase,
+		mempoolTransactionStatusUpsertUseCase,
+		upsertTransactionReceiptUseCase,
+		listValidatorSetUseCase,
+		upsertValidatorSetMemberUseCase,
+		deleteValidatorSetMemberUseCase,
+		upsertAccountStateDeltaUseCase,
+		upsertTokenStateDeltaUseCase,
 	)
 
 	createAccountService := s_account.NewCreateAccountService(
@@ -8,6 +8,7 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/authority/credentials"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/authority/genesis"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/authority/tokens"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/authority/validators"
 )
 
 func AuthorityCmd() *cobra.Command {
@@ -24,6 +25,7 @@ func AuthorityCmd() *cobra.Command {
 	cmd.AddCommand(coins.CoinsCmd())
 	cmd.AddCommand(tokens.TokensCmd())
 	cmd.AddCommand(genesis.NewGenesistCmd())
+	cmd.AddCommand(validators.ValidatorsCmd())
 
 	return cmd
 }
@@ -20,7 +20,10 @@ import (
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
@@ -93,10 +96,15 @@ func doRunTransferCoinsCommand() {
 	// ------ Repository ------
 	accountRepo := repo.NewAccountRepo(cfg, logger, dbClient)
 	mempoolTxRepo := repo.NewMempoolTransactionRepo(cfg, logger, dbClient)
+	mempoolTxStatusRepo := repo.NewMempoolTransactionStatusRepo(cfg, logger, redisCacheProvider)
+	txReceiptRepo := repo.NewTransactionReceiptRepo(cfg, logger, dbClient)
 	blockchainStateRepo := repo.NewBlockchainStateRepo(cfg, logger, dbClient)
 	tokRepo := repo.NewTokenRepo(cfg, logger, dbClient)
 	gbdRepo := repo.NewGenesisBlockDataRepo(cfg, logger, dbClient)
 	bdRepo := repo.NewBlockDataRepo(cfg, logger, dbClient)
+	validatorSetRepo := repo.NewValidatorSetRepo(cfg, logger, dbClient)
+	accountStateDeltaRepo := repo.NewAccountStateDeltaRepo(cfg, logger, dbClient)
+	tokenStateDeltaRepo := repo.NewTokenStateDeltaRepo(cfg, logger, dbClient)
 
 	// ------ Use-case ------
 	// Wallet
@@ -134,6 +142,16 @@ func doRunTransferCoinsCommand() {
 		logger,
 		mempoolTxRepo,
 	)
+	mempoolTransactionStatusUpsertUseCase := uc_mempooltx.NewMempoolTransactionStatusUpsertUseCase(
+		cfg,
+		logger,
+		mempoolTxStatusRepo,
+	)
+	upsertTransactionReceiptUseCase := uc_txreceipt.NewUpsertTransactionReceiptUseCase(
+		cfg,
+		logger,
+		txReceiptRepo,
+	)
 
 	// Include all other necessary use cases for PoA service
 	getBlockchainStateUseCase := uc_blockchainstate.NewGetBlockchainStateUseCase(
@@ -185,6 +203,31 @@ func doRunTransferCoinsCommand() {
 		redisCacheProvider,
 	)
 
+	listValidatorSetUseCase := uc_validatorset.NewListValidatorSetUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertValidatorSetMemberUseCase := uc_validatorset.NewUpsertValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	deleteValidatorSetMemberUseCase := uc_validatorset.NewDeleteValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertAccountStateDeltaUseCase := uc_statedelta.NewUpsertAccountStateDeltaUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	upsertTokenStateDeltaUseCase := uc_statedelta.NewUpsertTokenStateDeltaUseCase(
+		cfg,
+		logger,
+		tokenStateDeltaRepo,
+	)
 	// ------ Service ------
 	// Create PoA service
 	getProofOfAuthorityPrivateKeyService := sv_poa.NewGetProofOfAuthorityPrivateKeyService(
@@ -214,6 +257,13 @@ func doRunTransferCoinsCommand() {
 		proofOfWorkUseCase,
 		upsertBlockDataUseCase,
 		blockchainStatePublishUseCase,
+		mempoolTransactionStatusUpsertUseCase,
+		upsertTransactionReceiptUseCase,
+		listValidatorSetUseCase,
+		upsertValidatorSetMemberUseCase,
+		deleteValidatorSetMemberUseCase,
+		upsertAccountStateDeltaUseCase,
+		upsertTokenStateDeltaUseCase,
 	)
 
 	// Coin Transfer service now also takes the PoA service
@@ -20,6 +20,7 @@ import (
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/logger"
@@ -55,6 +56,7 @@ func doRunNewAccount() {
 	tokRepo := repo.NewTokenRepo(cfg, logger, dbClient)
 	gbdRepo := repo.NewGenesisBlockDataRepo(cfg, logger, dbClient)
 	bdRepo := repo.NewBlockDataRepo(cfg, logger, dbClient)
+	validatorSetRepo := repo.NewValidatorSetRepo(cfg, logger, dbClient)
 
 	// ------ Use-case ------
 	// Wallet Util
@@ -131,6 +133,11 @@ func doRunNewAccount() {
 		logger,
 	)
 
+	upsertValidatorSetMemberUseCase := uc_validatorset.NewUpsertValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
 	// ------ Service ------
 	getProofOfAuthorityPrivateKeyService := sv_poa.NewGetProofOfAuthorityPrivateKeyService(
 		cfg,
@@ -151,6 +158,7 @@ func doRunNewAccount() {
 		upsertBlockDataUseCase,
 		upsertBlockchainStateUseCase,
 		getBlockchainStateUseCase,
+		upsertValidatorSetMemberUseCase,
 	)
 
 	////
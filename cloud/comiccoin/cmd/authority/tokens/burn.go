@@ -20,7 +20,10 @@ import (
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
@@ -65,7 +68,12 @@ func doRunBurnToken() {
 	tokRepo := repo.NewTokenRepo(cfg, logger, dbClient)
 	gbdRepo := repo.NewGenesisBlockDataRepo(cfg, logger, dbClient)
 	bdRepo := repo.NewBlockDataRepo(cfg, logger, dbClient)
+	validatorSetRepo := repo.NewValidatorSetRepo(cfg, logger, dbClient)
+	accountStateDeltaRepo := repo.NewAccountStateDeltaRepo(cfg, logger, dbClient)
+	tokenStateDeltaRepo := repo.NewTokenStateDeltaRepo(cfg, logger, dbClient)
 	mempoolTxRepo := repo.NewMempoolTransactionRepo(cfg, logger, dbClient)
+	mempoolTxStatusRepo := repo.NewMempoolTransactionStatusRepo(cfg, logger, redisCacheProvider)
+	txReceiptRepo := repo.NewTransactionReceiptRepo(cfg, logger, dbClient)
 
 	// ------ Use-case ------
 	// Wallet Utils
@@ -161,7 +169,42 @@ func doRunBurnToken() {
 		logger,
 		mempoolTxRepo,
 	)
+	mempoolTransactionStatusUpsertUseCase := uc_mempooltx.NewMempoolTransactionStatusUpsertUseCase(
+		cfg,
+		logger,
+		mempoolTxStatusRepo,
+	)
+	upsertTransactionReceiptUseCase := uc_txreceipt.NewUpsertTransactionReceiptUseCase(
+		cfg,
+		logger,
+		txReceiptRepo,
+	)
 
+	listValidatorSetUseCase := uc_validatorset.NewListValidatorSetUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertValidatorSetMemberUseCase := uc_validatorset.NewUpsertValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	deleteValidatorSetMemberUseCase := uc_validatorset.NewDeleteValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertAccountStateDeltaUseCase := uc_statedelta.NewUpsertAccountStateDeltaUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	upsertTokenStateDeltaUseCase := uc_statedelta.NewUpsertTokenStateDeltaUseCase(
+		cfg,
+		logger,
+		tokenStateDeltaRepo,
+	)
 	// ------ Service ------
 	// Create PoA service for private key access
 	getProofOfAuthorityPrivateKeyService := sv_poa.NewGetProofOfAuthorityPrivateKeyService(
@@ -191,6 +234,13 @@ func doRunBurnToken() {
 		proofOfWorkUseCase,
 		upsertBlockDataUseCase,
 		blockchainStatePublishUseCase,
+		mempoolTransactionStatusUpsertUseCase,
+		upsertTransactionReceiptUseCase,
+		listValidatorSetUseCase,
+		upsertValidatorSetMemberUseCase,
+		deleteValidatorSetMemberUseCase,
+		upsertAccountStateDeltaUseCase,
+		upsertTokenStateDeltaUseCase,
 	)
 
 	// Token Burn service with direct PoA submission
@@ -205,6 +255,7 @@ func doRunBurnToken() {
 		getBlockDataUseCase,
 		getTokenUseCase,
 		mempoolTransactionCreateUseCase,
+		getAccountUseCase,
 		proofOfAuthorityConsensusMechanismService, // Add the PoA service
 	)
 
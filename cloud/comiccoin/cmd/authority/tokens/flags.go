@@ -4,6 +4,9 @@ var (
 	flagTokenID          string
 	flagTokenMetadataURI string
 
+	flagTokenCreatorAddress     string
+	flagTokenRoyaltyBasisPoints uint16
+
 	flagTransferRecipientAddress string
 	flagTransferTokenID          string
 
@@ -5,20 +5,29 @@ import (
 	"errors"
 	"log"
 	"log/slog"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/repo"
+	sv_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/account"
 	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
 	sv_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/token"
 	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
 	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	uc_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collection"
+	uc_collectionedition "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collectionedition"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
@@ -38,6 +47,8 @@ func MintTokenCmd() *cobra.Command {
 
 	cmd.Flags().StringVar(&flagTokenMetadataURI, "metadata-uri", "", "The location of this tokens metadata file.")
 	cmd.MarkFlagRequired("metadata-uri")
+	cmd.Flags().StringVar(&flagTokenCreatorAddress, "creator-address", "", "The creator whom is paid a royalty on every transfer of this token made with a coin payment.")
+	cmd.Flags().Uint16Var(&flagTokenRoyaltyBasisPoints, "royalty-basis-points", 0, "The royalty paid to the creator, one basis point is 0.01% of the coin payment.")
 
 	return cmd
 }
@@ -61,7 +72,14 @@ func doRunMintToken() {
 	tokRepo := repo.NewTokenRepo(cfg, logger, dbClient)
 	gbdRepo := repo.NewGenesisBlockDataRepo(cfg, logger, dbClient)
 	bdRepo := repo.NewBlockDataRepo(cfg, logger, dbClient)
+	validatorSetRepo := repo.NewValidatorSetRepo(cfg, logger, dbClient)
+	accountStateDeltaRepo := repo.NewAccountStateDeltaRepo(cfg, logger, dbClient)
+	tokenStateDeltaRepo := repo.NewTokenStateDeltaRepo(cfg, logger, dbClient)
 	mempoolTxRepo := repo.NewMempoolTransactionRepo(cfg, logger, dbClient)
+	mempoolTxStatusRepo := repo.NewMempoolTransactionStatusRepo(cfg, logger, cachep)
+	txReceiptRepo := repo.NewTransactionReceiptRepo(cfg, logger, dbClient)
+	collectionRepo := repo.NewCollectionRepo(cfg, logger, dbClient)
+	collectionEditionRepo := repo.NewCollectionEditionRepo(cfg, logger, dbClient)
 
 	// ------ Use-case ------
 	// Wallet Utils
@@ -162,6 +180,63 @@ func doRunMintToken() {
 		logger,
 		mempoolTxRepo,
 	)
+	mempoolTransactionListByFromAddressUseCase := uc_mempooltx.NewMempoolTransactionListByFromAddressUseCase(
+		cfg,
+		logger,
+		mempoolTxRepo,
+	)
+	mempoolTransactionStatusUpsertUseCase := uc_mempooltx.NewMempoolTransactionStatusUpsertUseCase(
+		cfg,
+		logger,
+		mempoolTxStatusRepo,
+	)
+	upsertTransactionReceiptUseCase := uc_txreceipt.NewUpsertTransactionReceiptUseCase(
+		cfg,
+		logger,
+		txReceiptRepo,
+	)
+
+	listValidatorSetUseCase := uc_validatorset.NewListValidatorSetUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertValidatorSetMemberUseCase := uc_validatorset.NewUpsertValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	deleteValidatorSetMemberUseCase := uc_validatorset.NewDeleteValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertAccountStateDeltaUseCase := uc_statedelta.NewUpsertAccountStateDeltaUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	upsertTokenStateDeltaUseCase := uc_statedelta.NewUpsertTokenStateDeltaUseCase(
+		cfg,
+		logger,
+		tokenStateDeltaRepo,
+	)
+	// Collection
+	getCollectionUseCase := uc_collection.NewGetCollectionUseCase(
+		cfg,
+		logger,
+		collectionRepo,
+	)
+	upsertCollectionUseCase := uc_collection.NewUpsertCollectionUseCase(
+		cfg,
+		logger,
+		collectionRepo,
+	)
+	upsertCollectionEditionUseCase := uc_collectionedition.NewUpsertCollectionEditionUseCase(
+		cfg,
+		logger,
+		collectionEditionRepo,
+	)
 
 	// ------ Service ------
 	// Create PoA service for private key access
@@ -171,6 +246,12 @@ func doRunMintToken() {
 		privateKeyFromHDWalletUseCase,
 	)
 
+	getAccountNextNonceService := sv_account.NewGetAccountNextNonceService(
+		logger,
+		getAccountUseCase,
+		mempoolTransactionListByFromAddressUseCase,
+	)
+
 	// Create PoA consensus mechanism service
 	proofOfAuthorityConsensusMechanismService := sv_poa.NewProofOfAuthorityConsensusMechanismService(
 		cfg,
@@ -192,6 +273,13 @@ func doRunMintToken() {
 		proofOfWorkUseCase,
 		upsertBlockDataUseCase,
 		blockchainStatePublishUseCase,
+		mempoolTransactionStatusUpsertUseCase,
+		upsertTransactionReceiptUseCase,
+		listValidatorSetUseCase,
+		upsertValidatorSetMemberUseCase,
+		deleteValidatorSetMemberUseCase,
+		upsertAccountStateDeltaUseCase,
+		upsertTokenStateDeltaUseCase,
 	)
 
 	// Token Mint service with direct PoA submission
@@ -206,12 +294,21 @@ func doRunMintToken() {
 		getLatestTokenIDUseCase,
 		getBlockDataUseCase,
 		mempoolTransactionCreateUseCase,
+		getAccountNextNonceService,
 		proofOfAuthorityConsensusMechanismService, // Add the PoA service
+		getCollectionUseCase,
+		upsertCollectionUseCase,
+		upsertCollectionEditionUseCase,
 	)
 
 	// Execution
 	ctx := context.Background()
-	newTokID, err := tokenMintService.Execute(ctx, cfg.Blockchain.ProofOfAuthorityAccountAddress, flagTokenMetadataURI)
+	var royalty *domain.TokenRoyalty
+	if flagTokenCreatorAddress != "" {
+		creatorAddress := common.HexToAddress(strings.ToLower(flagTokenCreatorAddress))
+		royalty = &domain.TokenRoyalty{Creator: &creatorAddress, BasisPoints: flagTokenRoyaltyBasisPoints}
+	}
+	newTokID, err := tokenMintService.Execute(ctx, cfg.Blockchain.ProofOfAuthorityAccountAddress, flagTokenMetadataURI, royalty)
 	if err != nil {
 		logger.Error("Failed executing",
 			slog.Any("error", err))
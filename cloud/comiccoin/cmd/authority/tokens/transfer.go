@@ -14,10 +14,14 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/repo"
 	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
 	sv_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/token"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
 	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
@@ -60,7 +64,13 @@ func doRunTransferToken() {
 	blockchainStateRepo := repo.NewBlockchainStateRepo(cfg, logger, dbClient)
 	tokRepo := repo.NewTokenRepo(cfg, logger, dbClient)
 	bdRepo := repo.NewBlockDataRepo(cfg, logger, dbClient)
+	validatorSetRepo := repo.NewValidatorSetRepo(cfg, logger, dbClient)
+	accountStateDeltaRepo := repo.NewAccountStateDeltaRepo(cfg, logger, dbClient)
+	tokenStateDeltaRepo := repo.NewTokenStateDeltaRepo(cfg, logger, dbClient)
 	mempoolTxRepo := repo.NewMempoolTransactionRepo(cfg, logger, dbClient)
+	mempoolTxStatusRepo := repo.NewMempoolTransactionStatusRepo(cfg, logger, redisCacheProvider)
+	txReceiptRepo := repo.NewTransactionReceiptRepo(cfg, logger, dbClient)
+	accountRepo := repo.NewAccountRepo(cfg, logger, dbClient)
 
 	// ------ Use-case ------
 	privateKeyFromHDWalletUseCase := uc_walletutil.NewPrivateKeyFromHDWalletUseCase(
@@ -93,16 +103,56 @@ func doRunTransferToken() {
 		logger,
 		mempoolTxRepo,
 	)
+	getAccountUseCase := uc_account.NewGetAccountUseCase(
+		cfg,
+		logger,
+		accountRepo,
+	)
 	mempoolTransactionDeleteByIDUseCase := uc_mempooltx.NewMempoolTransactionDeleteByIDUseCase(
 		cfg,
 		logger,
 		mempoolTxRepo,
 	)
+	mempoolTransactionStatusUpsertUseCase := uc_mempooltx.NewMempoolTransactionStatusUpsertUseCase(
+		cfg,
+		logger,
+		mempoolTxStatusRepo,
+	)
+	upsertTransactionReceiptUseCase := uc_txreceipt.NewUpsertTransactionReceiptUseCase(
+		cfg,
+		logger,
+		txReceiptRepo,
+	)
 	blockchainStatePublishUseCase := uc_blockchainstate.NewBlockchainStatePublishUseCase(
 		logger,
 		redisCacheProvider,
 	)
 
+	listValidatorSetUseCase := uc_validatorset.NewListValidatorSetUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertValidatorSetMemberUseCase := uc_validatorset.NewUpsertValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	deleteValidatorSetMemberUseCase := uc_validatorset.NewDeleteValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertAccountStateDeltaUseCase := uc_statedelta.NewUpsertAccountStateDeltaUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	upsertTokenStateDeltaUseCase := uc_statedelta.NewUpsertTokenStateDeltaUseCase(
+		cfg,
+		logger,
+		tokenStateDeltaRepo,
+	)
 	// ------ Service ------
 	// Create PoA service
 	getProofOfAuthorityPrivateKeyService := sv_poa.NewGetProofOfAuthorityPrivateKeyService(
@@ -123,7 +173,7 @@ func doRunTransferToken() {
 		upsertBlockchainStateUseCase,
 		nil, // getGenesisBlockDataUseCase - not needed for transfer
 		getBlockDataUseCase,
-		nil, // getAccountUseCase - not needed for transfer
+		getAccountUseCase,
 		nil, // getAccountsHashStateUseCase - not needed for transfer
 		nil, // upsertAccountUseCase - not needed for transfer
 		getTokenUseCase,
@@ -132,6 +182,13 @@ func doRunTransferToken() {
 		nil, // proofOfWorkUseCase - not needed for transfer
 		nil, // upsertBlockDataUseCase - not needed for transfer
 		blockchainStatePublishUseCase,
+		mempoolTransactionStatusUpsertUseCase,
+		upsertTransactionReceiptUseCase,
+		listValidatorSetUseCase,
+		upsertValidatorSetMemberUseCase,
+		deleteValidatorSetMemberUseCase,
+		upsertAccountStateDeltaUseCase,
+		upsertTokenStateDeltaUseCase,
 	)
 
 	// Token Transfer service with PoA service
@@ -146,6 +203,7 @@ func doRunTransferToken() {
 		getBlockDataUseCase,
 		getTokenUseCase,
 		mempoolTransactionCreateUseCase,
+		getAccountUseCase,
 		proofOfAuthorityConsensusMechanismService, // Add the PoA service
 	)
 
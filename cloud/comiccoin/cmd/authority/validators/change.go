@@ -0,0 +1,133 @@
+package validators
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/repo"
+	sv_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/validatorset"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/logger"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodb"
+)
+
+// Command line argument flags
+var (
+	flagValidatorID        string
+	flagValidatorPublicKey string
+)
+
+func AddValidatorCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "add",
+		Short: "Submit a transaction to the ComicCoin blockchain network to authorise a new validator to seal blocks",
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunChangeValidatorSetCommand(domain.ValidatorSetChangeActionAdd)
+		},
+	}
+
+	cmd.Flags().StringVar(&flagValidatorID, "id", "", "The name of the validator")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringVar(&flagValidatorPublicKey, "public-key", "", "The hex encoded uncompressed public key of the validator")
+	cmd.MarkFlagRequired("public-key")
+
+	return cmd
+}
+
+func RemoveValidatorCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "remove",
+		Short: "Submit a transaction to the ComicCoin blockchain network to revoke a validator from sealing blocks",
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunChangeValidatorSetCommand(domain.ValidatorSetChangeActionRemove)
+		},
+	}
+
+	cmd.Flags().StringVar(&flagValidatorID, "id", "", "The name of the validator")
+	cmd.Flags().StringVar(&flagValidatorPublicKey, "public-key", "", "The hex encoded uncompressed public key of the validator")
+	cmd.MarkFlagRequired("public-key")
+
+	return cmd
+}
+
+func doRunChangeValidatorSetCommand(action string) {
+	//
+	// Load up dependencies.
+	//
+
+	// ------ Common ------
+	logger := logger.NewProvider()
+	cfg := config.NewProvider()
+	dbClient := mongodb.NewProvider(cfg, logger)
+	keystore := hdkeystore.NewAdapter()
+
+	// ------ Repository ------
+	accountRepo := repo.NewAccountRepo(cfg, logger, dbClient)
+	mempoolTxRepo := repo.NewMempoolTransactionRepo(cfg, logger, dbClient)
+
+	// ------ Use-case ------
+	privateKeyFromHDWalletUseCase := uc_walletutil.NewPrivateKeyFromHDWalletUseCase(
+		cfg,
+		logger,
+		keystore,
+	)
+	getAccountUseCase := uc_account.NewGetAccountUseCase(
+		cfg,
+		logger,
+		accountRepo,
+	)
+	mempoolTransactionCreateUseCase := uc_mempooltx.NewMempoolTransactionCreateUseCase(
+		cfg,
+		logger,
+		mempoolTxRepo,
+	)
+
+	// ------ Service ------
+	validatorSetChangeService := sv_validatorset.NewValidatorSetChangeService(
+		cfg,
+		logger,
+		getAccountUseCase,
+		privateKeyFromHDWalletUseCase,
+		mempoolTransactionCreateUseCase,
+	)
+
+	//
+	// Execute.
+	//
+
+	publicKeyBytes, err := hexutil.Decode(flagValidatorPublicKey)
+	if err != nil {
+		log.Fatalf("Failed decoding public key: %v\n", err)
+	}
+
+	validator := &domain.Validator{
+		ID:             flagValidatorID,
+		PublicKeyBytes: publicKeyBytes,
+	}
+
+	if err := validatorSetChangeService.Execute(
+		context.Background(),
+		cfg.Blockchain.ProofOfAuthorityAccountAddress,
+		cfg.Blockchain.ProofOfAuthorityWalletMnemonic,
+		cfg.Blockchain.ProofOfAuthorityWalletPath,
+		action,
+		validator,
+	); err != nil {
+		logger.Error("Failed changing validator set",
+			slog.Any("error", err))
+		log.Fatalf("Failed changing validator set: %v\n", err)
+	}
+
+	logger.Debug("Validator set change submitted",
+		slog.String("action", action),
+		slog.String("validator_id", flagValidatorID))
+}
@@ -0,0 +1,19 @@
+package validators
+
+import "github.com/spf13/cobra"
+
+func ValidatorsCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "validators",
+		Short: "Execute commands related to the validator set",
+		Run: func(cmd *cobra.Command, args []string) {
+			// Do nothing...
+		},
+	}
+
+	// Attach our sub-commands for `validators`
+	cmd.AddCommand(AddValidatorCmd())
+	cmd.AddCommand(RemoveValidatorCmd())
+
+	return cmd
+}
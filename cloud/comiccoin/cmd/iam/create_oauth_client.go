@@ -0,0 +1,100 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/iam/create_oauth_client.go
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/logger"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	oauth_mongodb "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/mongodb"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodb"
+)
+
+var (
+	flagOAuthClientName        string
+	flagOAuthClientRedirectURI string
+	flagOAuthClientPublic      bool
+)
+
+func CreateOAuthClientCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "create-oauth-client",
+		Short: "Register a third-party app which can \"Log in with ComicCoin\"",
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunCreateOAuthClient()
+		},
+	}
+
+	cmd.Flags().StringVar(&flagOAuthClientName, "name", "", "The app name shown to users when asked for consent")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVar(&flagOAuthClientRedirectURI, "redirect-uri", "", "The URI users are sent back to after authorizing the app")
+	cmd.MarkFlagRequired("redirect-uri")
+	cmd.Flags().BoolVar(&flagOAuthClientPublic, "public", false, "The app cannot keep a secret (mobile or browser app) and must use PKCE")
+
+	return cmd
+}
+
+func doRunCreateOAuthClient() {
+	// Common
+	logger := logger.NewProvider()
+	cfg := config.NewProvider()
+	passp := password.NewProvider()
+
+	// Validate inputs
+	u, err := url.Parse(flagOAuthClientRedirectURI)
+	if err != nil || u.Scheme == "" || u.Host == "" || u.Fragment != "" {
+		log.Fatal("--redirect-uri must be an absolute URI without a fragment")
+	}
+	if u.Scheme != "https" && u.Hostname() != "localhost" && u.Hostname() != "127.0.0.1" {
+		log.Fatal("--redirect-uri must use https unless it points to localhost")
+	}
+
+	dbClient := mongodb.NewProvider(cfg, logger)
+	clientService := oauth_mongodb.NewMongoClientService(logger, dbClient, cfg.DB.IAMName)
+
+	// Generate the credentials
+	clientID, err := passp.GenerateSecureRandomString(16)
+	if err != nil {
+		log.Fatalf("Failed to generate client id: %v\n", err)
+	}
+	var clientSecret string
+	if !flagOAuthClientPublic {
+		clientSecret, err = passp.GenerateSecureRandomString(32)
+		if err != nil {
+			log.Fatalf("Failed to generate client secret: %v\n", err)
+		}
+	}
+
+	client := &oauth.Client{
+		ID:          clientID,
+		Secret:      clientSecret,
+		Name:        flagOAuthClientName,
+		RedirectURI: flagOAuthClientRedirectURI,
+		Public:      flagOAuthClientPublic,
+		CreatedAt:   time.Now(),
+	}
+	if err := clientService.CreateClient(context.Background(), client); err != nil {
+		logger.Error("Failed to create oauth client", slog.Any("error", err))
+		log.Fatalf("Failed to create oauth client: %v\n", err)
+	}
+
+	fmt.Printf("\n===== OAuth Client Created =====\n")
+	fmt.Printf("Name:          %s\n", client.Name)
+	fmt.Printf("Client ID:     %s\n", client.ID)
+	if client.Public {
+		fmt.Printf("Client Secret: (none, public client must use PKCE)\n")
+	} else {
+		fmt.Printf("Client Secret: %s\n", clientSecret)
+		fmt.Println("\nSave the client secret now, it is stored hashed and cannot be shown again.")
+	}
+	fmt.Printf("Redirect URI:  %s\n", client.RedirectURI)
+}
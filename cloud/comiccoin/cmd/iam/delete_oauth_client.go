@@ -0,0 +1,54 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/iam/delete_oauth_client.go
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/logger"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	oauth_mongodb "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/mongodb"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodb"
+)
+
+var flagDeleteOAuthClientID string
+
+func DeleteOAuthClientCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "delete-oauth-client",
+		Short: "Remove a third-party app, it can no longer get or refresh tokens",
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunDeleteOAuthClient()
+		},
+	}
+
+	cmd.Flags().StringVar(&flagDeleteOAuthClientID, "client-id", "", "The client ID of the app to remove")
+	cmd.MarkFlagRequired("client-id")
+
+	return cmd
+}
+
+func doRunDeleteOAuthClient() {
+	// Common
+	logger := logger.NewProvider()
+	cfg := config.NewProvider()
+	dbClient := mongodb.NewProvider(cfg, logger)
+	clientService := oauth_mongodb.NewMongoClientService(logger, dbClient, cfg.DB.IAMName)
+
+	if err := clientService.DeleteClient(context.Background(), flagDeleteOAuthClientID); err != nil {
+		if err == oauth.ErrInvalidClient {
+			log.Fatalf("OAuth client does not exist: %s\n", flagDeleteOAuthClientID)
+		}
+		logger.Error("Failed to delete oauth client", slog.Any("error", err))
+		log.Fatalf("Failed to delete oauth client: %v\n", err)
+	}
+
+	// Tokens already issued fail introspection and refresh since the
+	// client no longer authenticates.
+	fmt.Printf("OAuth client %s deleted\n", flagDeleteOAuthClientID)
+}
@@ -22,6 +22,11 @@ func IAMCmd() *cobra.Command {
 	cmd.AddCommand(VerifyUserEmailCmd())
 	cmd.AddCommand(DeleteUserCmd())
 	cmd.AddCommand(VerifyProfileCmd())
+	cmd.AddCommand(GetListLockedUsersCmd())
+	cmd.AddCommand(UnlockUserCmd())
+	cmd.AddCommand(CreateOAuthClientCmd())
+	cmd.AddCommand(GetListOAuthClientsCmd())
+	cmd.AddCommand(DeleteOAuthClientCmd())
 
 	return cmd
 }
@@ -0,0 +1,90 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/iam/list_locked_users.go
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/logger"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodb"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+)
+
+func GetListLockedUsersCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "list-locked-users",
+		Short: "List users locked after too many failed login attempts",
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunListLockedUsers()
+		},
+	}
+
+	return cmd
+}
+
+func doRunListLockedUsers() {
+	// Common
+	logger := logger.NewProvider()
+	cfg := config.NewProvider()
+	dbClient := mongodb.NewProvider(cfg, logger)
+
+	// Context
+	ctx := context.Background()
+
+	// Get the user collection
+	userCollection := dbClient.Database(cfg.DB.IAMName).Collection("users")
+
+	// Most recently locked users first
+	filter := bson.M{"status": user.UserStatusLocked}
+	findOptions := options.Find().SetSort(bson.D{{Key: "locked_at", Value: -1}})
+
+	// Execute the query
+	cursor, err := userCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		logger.Error("Failed to query locked users", slog.Any("error", err))
+		log.Fatalf("Failed to query locked users: %v\n", err)
+	}
+	defer cursor.Close(ctx)
+
+	// Decode the results
+	var users []*user.User
+	if err = cursor.All(ctx, &users); err != nil {
+		logger.Error("Failed to decode locked users", slog.Any("error", err))
+		log.Fatalf("Failed to decode locked users: %v\n", err)
+	}
+
+	// Display results
+	fmt.Printf("\n===== Locked Users =====\n")
+	fmt.Printf("Total locked users: %d\n\n", len(users))
+
+	// Display user table header
+	fmt.Printf("%-24s | %-30s | %-30s | %-20s\n", "ID", "Name", "Email", "Locked At")
+	fmt.Println(strings.Repeat("-", 112))
+
+	// Display users
+	for _, u := range users {
+		lockedAtStr := "Unknown"
+		if !u.LockedAt.IsZero() {
+			lockedAtStr = u.LockedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%-24s | %-30s | %-30s | %-20s\n",
+			u.ID.Hex(),
+			truncateString(u.Name, 30),
+			truncateString(u.Email, 30),
+			lockedAtStr,
+		)
+	}
+
+	fmt.Println(strings.Repeat("-", 112))
+	if len(users) > 0 {
+		fmt.Println("\nUnlock a user: go run main.go iam unlock-user --email=<email>")
+	}
+}
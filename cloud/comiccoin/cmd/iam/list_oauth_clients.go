@@ -0,0 +1,59 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/iam/list_oauth_clients.go
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/logger"
+	oauth_mongodb "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/mongodb"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodb"
+)
+
+func GetListOAuthClientsCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "list-oauth-clients",
+		Short: "List third-party apps registered for \"Log in with ComicCoin\"",
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunListOAuthClients()
+		},
+	}
+
+	return cmd
+}
+
+func doRunListOAuthClients() {
+	// Common
+	logger := logger.NewProvider()
+	cfg := config.NewProvider()
+	dbClient := mongodb.NewProvider(cfg, logger)
+	clientService := oauth_mongodb.NewMongoClientService(logger, dbClient, cfg.DB.IAMName)
+
+	clients, err := clientService.ListClients(context.Background())
+	if err != nil {
+		logger.Error("Failed to list oauth clients", slog.Any("error", err))
+		log.Fatalf("Failed to list oauth clients: %v\n", err)
+	}
+
+	// Display results
+	fmt.Printf("\n===== OAuth Clients =====\n")
+	fmt.Printf("Total clients: %d\n\n", len(clients))
+
+	fmt.Printf("%-32s | %-24s | %-6s | %-40s\n", "Client ID", "Name", "Public", "Redirect URI")
+	fmt.Println(strings.Repeat("-", 112))
+	for _, c := range clients {
+		fmt.Printf("%-32s | %-24s | %-6t | %-40s\n",
+			c.ID,
+			truncateString(c.Name, 24),
+			c.Public,
+			truncateString(c.RedirectURI, 40),
+		)
+	}
+	fmt.Println(strings.Repeat("-", 112))
+}
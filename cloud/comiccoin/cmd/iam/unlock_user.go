@@ -0,0 +1,152 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/cmd/iam/unlock_user.go
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/logger"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodb"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	r_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/user"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+var (
+	flagUnlockUserID    string
+	flagUnlockUserEmail string
+)
+
+func UnlockUserCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "unlock-user",
+		Short: "Unlock a user locked after too many failed login attempts",
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunUnlockUser()
+		},
+	}
+
+	// Register flags, one of them is required
+	cmd.Flags().StringVar(&flagUnlockUserID, "id", "", "The ID of the user to unlock")
+	cmd.Flags().StringVar(&flagUnlockUserEmail, "email", "", "The email address of the user to unlock")
+
+	return cmd
+}
+
+func doRunUnlockUser() {
+	// Common
+	logger := logger.NewProvider()
+	cfg := config.NewProvider()
+
+	// Validate inputs
+	if flagUnlockUserID == "" && flagUnlockUserEmail == "" {
+		log.Fatal("Either --id or --email must be provided")
+	}
+	var userID primitive.ObjectID
+	if flagUnlockUserID != "" {
+		id, err := primitive.ObjectIDFromHex(flagUnlockUserID)
+		if err != nil {
+			log.Fatalf("Invalid user ID format: %v\n", err)
+		}
+		userID = id
+	}
+
+	dbClient := mongodb.NewProvider(cfg, logger)
+
+	// Repository
+	userRepo := r_user.NewRepository(cfg, logger, dbClient)
+
+	// Use-case
+	userGetByIDUseCase := uc_user.NewUserGetByIDUseCase(
+		cfg,
+		logger,
+		userRepo,
+	)
+	userGetByEmailUseCase := uc_user.NewUserGetByEmailUseCase(
+		cfg,
+		logger,
+		userRepo,
+	)
+	userUpdateUseCase := uc_user.NewUserUpdateUseCase(
+		cfg,
+		logger,
+		userRepo,
+	)
+
+	// Context
+	ctx := context.Background()
+
+	// Start the transaction
+	session, err := dbClient.StartSession()
+	if err != nil {
+		logger.Error("start session error",
+			slog.Any("error", err))
+		log.Fatalf("Failed executing: %v\n", err)
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx mongo.SessionContext) (interface{}, error) {
+		// Find the user by ID or email
+		var foundUser *user.User
+		if flagUnlockUserID != "" {
+			foundUser, err = userGetByIDUseCase.Execute(sessCtx, userID)
+		} else {
+			foundUser, err = userGetByEmailUseCase.Execute(sessCtx, flagUnlockUserEmail)
+		}
+		if err != nil {
+			logger.Error("failed to get user", slog.Any("error", err))
+			return nil, err
+		}
+
+		if foundUser == nil {
+			return nil, fmt.Errorf("user not found")
+		}
+
+		// Check if user is locked
+		if foundUser.Status != user.UserStatusLocked {
+			return nil, fmt.Errorf("user with email %s is not locked", foundUser.Email)
+		}
+
+		// Update the user to be active
+		foundUser.Status = user.UserStatusActive
+		foundUser.UnlockCode = ""
+		foundUser.UnlockCodeExpiry = time.Time{}
+		foundUser.LockedAt = time.Time{}
+		foundUser.ModifiedAt = time.Now()
+
+		err = userUpdateUseCase.Execute(sessCtx, foundUser)
+		if err != nil {
+			logger.Error("failed to update user", slog.Any("error", err))
+			return nil, err
+		}
+
+		return foundUser, nil
+	}
+
+	// Execute the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		logger.Error("transaction failed", slog.Any("error", err))
+		log.Fatalf("Failed to unlock user: %v\n", err)
+	}
+
+	// Get the user from the result
+	u := result.(*user.User)
+
+	// Display success message
+	fmt.Printf("\nUser unlocked successfully!\n")
+	fmt.Printf("ID: %s\n", u.ID.Hex())
+	fmt.Printf("Email: %s\n", u.Email)
+	fmt.Printf("Name: %s\n", u.Name)
+	fmt.Printf("Modified At: %s\n", u.ModifiedAt.Format(time.RFC3339))
+	fmt.Printf("\nThe user can now log in again.\n")
+}
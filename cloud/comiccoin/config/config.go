@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -20,6 +21,7 @@ type Configuration struct {
 	NFTStore            NFTStorageConfig
 	PublicFaucetEmailer PublicFaucetMailgunConfig
 	IAMEmailer          IAMMailgunConfig
+	IAM                 IAMConfig
 }
 
 type CacheConf struct {
@@ -45,12 +47,26 @@ type BlockchainConfig struct {
 	// TransPerBlock is the maximum number of transactions that can be included in a block.
 	TransPerBlock uint16 `json:"trans_per_block"`
 
+	// MaxBlockInterval is the longest amount of time a pending transaction
+	// will wait in the mempool before the authority seals a block, even if
+	// the block has not reached `TransPerBlock` transactions.
+	MaxBlockInterval time.Duration `json:"max_block_interval"`
+
+	// StateSnapshotInterval is how often the authority exports a signed
+	// snapshot of every account and token so new nodes can fast-sync.
+	StateSnapshotInterval time.Duration `json:"state_snapshot_interval"`
+
 	// Difficulty represents how difficult it should be to solve the work problem.
 	Difficulty uint16 `json:"difficulty"`
 
 	// ComicCoin: Fee that must be paid for every transaction. This value is provided by the authority.
 	TransactionFee uint64 `bson:"transaction_fee" json:"transaction_fee"`
 
+	// FeeMarketActivationHeight is the first block number which accepts
+	// transactions with their own fee, from then on `TransactionFee` is the
+	// minimum fee. Zero means the fee market is not active.
+	FeeMarketActivationHeight uint64 `json:"fee_market_activation_height"`
+
 	// (Only set by PoA node)
 	ProofOfAuthorityAccountAddress *common.Address
 	ProofOfAuthorityWalletMnemonic *sstring.SecureString
@@ -98,6 +114,21 @@ type IAMMailgunConfig struct {
 	BackendDomain    string
 }
 
+// IAMConfig represents the security settings of the identity and access
+// management module.
+type IAMConfig struct {
+	// LoginMaxFailedAttempts is the number of failed logins after which the
+	// account gets locked until the user follows the emailed unlock link.
+	LoginMaxFailedAttempts int
+
+	// LoginMaxFailedAttemptsPerIP is the number of failed logins after which
+	// all logins from the same IP address are rejected.
+	LoginMaxFailedAttemptsPerIP int
+
+	// LoginFailedAttemptsWindow is how long failed logins are remembered.
+	LoginFailedAttemptsWindow time.Duration
+}
+
 func NewProvider() *Configuration {
 	var c Configuration
 
@@ -116,9 +147,12 @@ func NewProvider() *Configuration {
 	c.Blockchain.ChainID = uint16(chainID)
 	transPerBlock, _ := strconv.ParseUint(getEnv("COMICCOIN_BLOCKCHAIN_TRANS_PER_BLOCK", true), 10, 16)
 	c.Blockchain.TransPerBlock = uint16(transPerBlock)
+	c.Blockchain.MaxBlockInterval = getDurationEnv("COMICCOIN_BLOCKCHAIN_MAX_BLOCK_INTERVAL", false, 5*time.Second)
+	c.Blockchain.StateSnapshotInterval = getDurationEnv("COMICCOIN_BLOCKCHAIN_STATE_SNAPSHOT_INTERVAL", false, 1*time.Hour)
 	difficulty, _ := strconv.ParseUint(getEnv("COMICCOIN_BLOCKCHAIN_DIFFICULTY", true), 10, 16)
 	c.Blockchain.Difficulty = uint16(difficulty)
 	c.Blockchain.TransactionFee, _ = strconv.ParseUint(getEnv("COMICCOIN_BLOCKCHAIN_TRANSACTION_FEE", true), 10, 64)
+	c.Blockchain.FeeMarketActivationHeight, _ = strconv.ParseUint(getEnv("COMICCOIN_BLOCKCHAIN_FEE_MARKET_ACTIVATION_HEIGHT", false), 10, 64)
 	proofOfAuthorityAccountAddress := getEnv("COMICCOIN_BLOCKCHAIN_PROOF_OF_AUTHORITY_ACCOUNT_ADDRESS", false)
 	if proofOfAuthorityAccountAddress != "" {
 		address := common.HexToAddress(proofOfAuthorityAccountAddress)
@@ -173,6 +207,11 @@ func NewProvider() *Configuration {
 	c.IAMEmailer.FrontendDomain = getEnv("COMICCOIN_IAM_MAILGUN_FRONTEND_DOMAIN", true)
 	c.IAMEmailer.BackendDomain = getEnv("COMICCOIN_IAM_MAILGUN_BACKEND_DOMAIN", true)
 
+	// Login throttling section.
+	c.IAM.LoginMaxFailedAttempts = getIntEnv("COMICCOIN_IAM_LOGIN_MAX_FAILED_ATTEMPTS", false, 5)
+	c.IAM.LoginMaxFailedAttemptsPerIP = getIntEnv("COMICCOIN_IAM_LOGIN_MAX_FAILED_ATTEMPTS_PER_IP", false, 20)
+	c.IAM.LoginFailedAttemptsWindow = getDurationEnv("COMICCOIN_IAM_LOGIN_FAILED_ATTEMPTS_WINDOW", false, 15*time.Minute)
+
 	return &c
 }
 
@@ -250,3 +289,27 @@ func getUint64Env(key string, required bool) uint64 {
 	}
 	return valueUint64
 }
+
+func getIntEnv(key string, required bool, defaultValue int) int {
+	valueStr := getEnv(key, required)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Fatalf("Invalid int value for environment variable %s", key)
+	}
+	return value
+}
+
+func getDurationEnv(key string, required bool, defaultValue time.Duration) time.Duration {
+	valueStr := getEnv(key, required)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := time.ParseDuration(valueStr)
+	if err != nil {
+		log.Fatalf("Invalid duration value for environment variable %s", key)
+	}
+	return value
+}
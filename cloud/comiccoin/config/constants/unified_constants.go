@@ -21,6 +21,7 @@ const (
 	SessionUserStoreName
 	SessionUserStoreLevel
 	SessionUserStoreTimezone
+	SessionUserAgent
 )
 
 const (
@@ -7,6 +7,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fxamacker/cbor/v2"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
 )
 
 // Account struct represents an entity in our blockchain whom has transfered
@@ -50,6 +52,18 @@ type Account struct {
 	// 3. The transaction is sent to the network and verified by the nodes.
 	// 4. If the transaction is valid, the nonce value is incremented again and stored in the account's state.
 	//
+	// The authority enforces this strictly: a transaction is only accepted if
+	// its nonce is exactly equal to the account nonce plus one, anything lower
+	// is rejected as a replay (see `ErrTransactionNonceReplayed`) and anything
+	// higher is rejected as a gap (see `ErrTransactionNonceGap`). Only the
+	// sender's nonce is incremented, receiving coins or tokens does not change
+	// the nonce of the receiving account. This only applies to blocks with a
+	// canonical header, see `BlockHeader.HasStrictAccountNonces`, the legacy
+	// blocks also incremented the nonce of the receiver and of the proof of
+	// authority account and are still replayed that way. No migration of the
+	// saved accounts is needed as the next nonce is always derived from the
+	// saved nonce, however high the legacy blocks left it.
+	//
 	// By including the Nonce field in the Account struct, the blockchain can
 	// keep track of the nonce value for each account and prevent replay attacks.
 	//
@@ -79,10 +93,15 @@ type AccountRepository interface {
 	// DeleteByID deletes an account by its ID.
 	DeleteByAddress(ctx context.Context, addr *common.Address) error
 
-	// HashStateByChainID returns a hash based on the contents of the accounts and
-	// their balances. This is added to each block and checked by peers.
+	// HashStateByChainID returns the root of the accounts trie, which commits
+	// to the contents of the accounts and their balances. This is added to
+	// each block and checked by peers.
 	HashStateByChainID(ctx context.Context, chainID uint16) (string, error)
 
+	// ProveByAddress returns the proof of the account, or of its absence,
+	// against the root of the accounts trie.
+	ProveByAddress(ctx context.Context, chainID uint16, addr *common.Address) (*smt.Proof, error)
+
 	OpenTransaction() error
 	CommitTransaction() error
 	DiscardTransaction()
@@ -101,6 +120,27 @@ func (acc *Account) SetNonce(n *big.Int) {
 	acc.NonceBytes = n.Bytes()
 }
 
+// NextNonce returns the nonce which the next transaction sent from this
+// account must use.
+func (acc *Account) NextNonce() *big.Int {
+	nonce := acc.GetNonce()
+	return nonce.Add(nonce, big.NewInt(1))
+}
+
+// ValidateTransactionNonce verifies the transaction nonce is the next nonce
+// for this account, returning `ErrTransactionNonceReplayed` if the nonce was
+// already used or `ErrTransactionNonceGap` if the nonce skips ahead.
+func (acc *Account) ValidateTransactionNonce(tx *Transaction) error {
+	switch tx.GetNonce().Cmp(acc.NextNonce()) {
+	case -1:
+		return ErrTransactionNonceReplayed
+	case 1:
+		return ErrTransactionNonceGap
+	default:
+		return nil
+	}
+}
+
 // Serialize serializes the account into a byte slice.
 // This method uses the cbor library to marshal the account into a byte slice.
 func (b *Account) Serialize() ([]byte, error) {
@@ -131,3 +171,27 @@ func NewAccountFromDeserialize(data []byte) (*Account, error) {
 	}
 	return account, nil
 }
+
+// HashAccountsState returns the root of the accounts trie built from scratch
+// from the accounts. This is the value stored in the block header
+// `StateRoot` field and checked by peers.
+//
+// DEVELOPERS NOTE:
+// The Authority keeps the accounts trie up-to-date incrementally in the
+// `AccountRepository`, this function is only used to verify a full set of
+// accounts, like a state snapshot, against a block header.
+func HashAccountsState(accounts []*Account) (string, error) {
+	leaves := make([]smt.Leaf, 0, len(accounts))
+	for _, account := range accounts {
+		leafHash, err := StateTrieAccountLeafHash(account)
+		if err != nil {
+			return "", err
+		}
+		leaves = append(leaves, smt.Leaf{Key: StateTrieAccountKey(account.Address), LeafHash: leafHash})
+	}
+	root, err := smt.Root(leaves)
+	if err != nil {
+		return "", err
+	}
+	return StateTrieRootHex(root), nil
+}
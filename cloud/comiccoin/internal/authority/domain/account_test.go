@@ -54,6 +54,40 @@ func TestAccount(t *testing.T) {
 		}
 	})
 
+	t.Run("NextNonce", func(t *testing.T) {
+		acc := &Account{NonceBytes: big.NewInt(41).Bytes()}
+		if acc.NextNonce().Cmp(big.NewInt(42)) != 0 {
+			t.Errorf("expected next nonce to be 42, got %v", acc.NextNonce())
+		}
+		if acc.GetNonce().Cmp(big.NewInt(41)) != 0 {
+			t.Errorf("expected NextNonce to not modify the account nonce, got %v", acc.GetNonce())
+		}
+
+		empty := &Account{}
+		if empty.NextNonce().Cmp(big.NewInt(1)) != 0 {
+			t.Errorf("expected next nonce of new account to be 1, got %v", empty.NextNonce())
+		}
+	})
+
+	t.Run("ValidateTransactionNonce", func(t *testing.T) {
+		acc := &Account{NonceBytes: big.NewInt(5).Bytes()}
+		tests := []struct {
+			nonce    int64
+			expected error
+		}{
+			{4, ErrTransactionNonceReplayed},
+			{5, ErrTransactionNonceReplayed},
+			{6, nil},
+			{7, ErrTransactionNonceGap},
+		}
+		for _, tt := range tests {
+			tx := &Transaction{NonceBytes: big.NewInt(tt.nonce).Bytes()}
+			if err := acc.ValidateTransactionNonce(tx); err != tt.expected {
+				t.Errorf("expected ValidateTransactionNonce to return %v, got %v for nonce %v", tt.expected, err, tt.nonce)
+			}
+		}
+	})
+
 	t.Run("Serialize", func(t *testing.T) {
 		addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
 		acc := &Account{
@@ -9,6 +9,10 @@ import (
 	"github.com/fxamacker/cbor/v2"
 )
 
+// BlockDataRangeMaxSize is the maximum number of blocks which can be
+// downloaded from the Authority in a single header number range request.
+const BlockDataRangeMaxSize = 100
+
 // BlockData represents the data that can be serialized to disk and over the network.
 // It contains the hash of the block, the block header, and the list of transactions in the block.
 type BlockData struct {
@@ -50,9 +54,23 @@ type BlockDataRepository interface {
 
 	GetByTransactionNonce(ctx context.Context, txNonce *big.Int) (*BlockData, error)
 
+	// GetByTransactionFromAddressAndNonce gets the block data which contains
+	// the transaction sent from the address with the nonce. Because nonces are
+	// only unique per account, this is the exact lookup for a transaction.
+	GetByTransactionFromAddressAndNonce(ctx context.Context, from *common.Address, txNonce *big.Int) (*BlockData, error)
+
 	// ListByChainID lists all block data in the repository for the particular chain.
 	ListByChainID(ctx context.Context, chainID uint16) ([]*BlockData, error)
 
+	// ListInHeaderNumberRangeForChainID lists the block data with a header
+	// number between `from` and `to` (inclusive) for the particular chain,
+	// ordered by header number.
+	ListInHeaderNumberRangeForChainID(ctx context.Context, chainID uint16, from, to *big.Int) ([]*BlockData, error)
+
+	// ListByTokenIDForChainID lists the block data which contain a token
+	// transaction for the token ID of the particular chain.
+	ListByTokenIDForChainID(ctx context.Context, chainID uint16, tokenID *big.Int) ([]*BlockData, error)
+
 	// DeleteByHash deletes a block data by its hash.
 	// It takes a hash and returns an error if one occurs.
 	DeleteByHash(ctx context.Context, hash string) error
@@ -74,6 +92,14 @@ type BlockDataRepository interface {
 
 	ListLatestBlockTransactions(ctx context.Context, limit int64) ([]*BlockTransaction, error)
 
+	// ListExplorerTransactionsByFilter lists a page of the transactions
+	// matching the filter, ordered from the newest to the oldest.
+	ListExplorerTransactionsByFilter(ctx context.Context, filter *ExplorerTransactionFilter) (*ExplorerTransactionListResult, error)
+
+	// GetExplorerAccountActivityByAddress summarizes the transactions sent
+	// from or to the address.
+	GetExplorerAccountActivityByAddress(ctx context.Context, chainID uint16, address *common.Address) (*ExplorerAccountActivity, error)
+
 	OpenTransaction() error
 	CommitTransaction() error
 	DiscardTransaction()
@@ -6,6 +6,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fxamacker/cbor/v2"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
 )
 
 // Known Issue:
@@ -41,8 +43,14 @@ type BlockHeader struct {
 	LatestTokenIDBytes  []byte `bson:"latest_token_id_bytes" json:"latest_token_id_bytes"` // ComicCoin: The latest token that the blockchain points to.
 	LatestTokenIDString string `bson:"-" json:"latest_token_id_string"`                    // Read-only response in string format - will not be saved in database, only returned via API.
 	TokensRoot          string `bson:"tokens_root" json:"tokens_root"`                     // ComicCoin: Represents the hash of all the tokens and their owners.
+
+	Version uint8 `bson:"version,omitempty" json:"version,omitempty"` // ComicCoin: The signing version, see `signature.VersionCanonical`; zero is the legacy JSON signing.
 }
 
+// BlockHeaderVersion is the signing version of the block headers created by
+// this build.
+const BlockHeaderVersion = signature.VersionCanonical
+
 func (bh *BlockHeader) GetNumber() *big.Int {
 	return new(big.Int).SetBytes(bh.NumberBytes)
 }
@@ -82,6 +90,48 @@ func (bh *BlockHeader) SeLatestTokenID(n *big.Int) {
 	bh.LatestTokenIDBytes = n.Bytes()
 }
 
+// HasStrictAccountNonces returns true if the account nonces of the block only
+// count the transactions sent from each account. Blocks with a legacy header
+// were sealed when the nonce of an existing receiver of coins and the nonce of
+// the proof of authority account were also incremented for every transaction,
+// those blocks must keep being replayed with the legacy rules so the balances
+// and state roots of the existing blockchain stay the same.
+func (bh *BlockHeader) HasStrictAccountNonces() bool {
+	return bh.Version != signature.VersionLegacy
+}
+
+// SigningVersion returns the signing version of the block header.
+func (bh *BlockHeader) SigningVersion() uint8 {
+	return bh.Version
+}
+
+// CanonicalFields returns the fields of the block header in the order they
+// are signed for its signing version. The read-only `_string`
+// fields are never signed.
+//
+//	[version, chain_id, number, prev_block_hash, timestamp, difficulty,
+//	 beneficiary, transaction_fee, state_root, trans_root, nonce,
+//	 latest_token_id, tokens_root]
+func (bh *BlockHeader) CanonicalFields() ([]any, error) {
+	if bh.Version != signature.VersionCanonical {
+		return nil, fmt.Errorf("%w: %d", signature.ErrUnsupportedVersion, bh.Version)
+	}
+	return []any{
+		bh.ChainID,
+		bh.GetNumber().Bytes(),
+		bh.PrevBlockHash,
+		bh.TimeStamp,
+		bh.Difficulty,
+		bh.Beneficiary.Bytes(),
+		bh.TransactionFee,
+		bh.StateRoot,
+		bh.TransRoot,
+		bh.GetNonce().Bytes(),
+		bh.GetLatestTokenID().Bytes(),
+		bh.TokensRoot,
+	}, nil
+}
+
 // Serialize serializes a block header into a byte array.
 // It returns the serialized byte array and an error if one occurs.
 func (b *BlockHeader) Serialize() ([]byte, error) {
@@ -3,8 +3,10 @@ package domain
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/fxamacker/cbor/v2"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
@@ -16,6 +18,62 @@ type BlockTransaction struct {
 	SignedTransaction
 	TimeStamp uint64 `bson:"timestamp" json:"timestamp"` // Ethereum: The time the transaction was received.
 	Fee       uint64 `bson:"fee" json:"fee"`             // ComicCoin: Fee paid for this transaction to the ComicCoin authority.
+
+	// The royalty paid to the creator of the token out of the coin payment
+	// of a token transfer, the recipient is credited the rest. Empty for
+	// every other transaction so their hash does not change.
+	RoyaltyRecipient *common.Address `bson:"royalty_recipient,omitempty" json:"royalty_recipient,omitempty"`
+	Royalty          uint64          `bson:"royalty,omitempty" json:"royalty,omitempty"`
+}
+
+// DEVELOPERS NOTE:
+// The `Fee` of the block transaction shadows the `Fee` which the sender
+// signed in the embedded `Transaction` since both use the `fee` key, so the
+// signed fee would be dropped when the block is downloaded or stored and
+// the signature could not be verified anymore. Therefore the signed fee is
+// encoded under its own `signed_fee` key, which is left out when empty so
+// the hash of the transactions without their own fee does not change.
+
+// blockTransaction has the fields of the block transaction without its
+// encoding methods.
+type blockTransaction BlockTransaction
+
+// blockTransactionEncoding is the JSON and CBOR form of a block transaction.
+type blockTransactionEncoding struct {
+	blockTransaction
+	SignedFee uint64 `json:"signed_fee,omitempty"`
+}
+
+// MarshalJSON encodes the block transaction including its signed fee.
+func (tx BlockTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(blockTransactionEncoding{blockTransaction(tx), tx.Transaction.Fee})
+}
+
+// UnmarshalJSON decodes the block transaction including its signed fee.
+func (tx *BlockTransaction) UnmarshalJSON(data []byte) error {
+	var enc blockTransactionEncoding
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return err
+	}
+	*tx = BlockTransaction(enc.blockTransaction)
+	tx.Transaction.Fee = enc.SignedFee
+	return nil
+}
+
+// MarshalCBOR encodes the block transaction including its signed fee.
+func (tx BlockTransaction) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(blockTransactionEncoding{blockTransaction(tx), tx.Transaction.Fee})
+}
+
+// UnmarshalCBOR decodes the block transaction including its signed fee.
+func (tx *BlockTransaction) UnmarshalCBOR(data []byte) error {
+	var enc blockTransactionEncoding
+	if err := cbor.Unmarshal(data, &enc); err != nil {
+		return err
+	}
+	*tx = BlockTransaction(enc.blockTransaction)
+	tx.Transaction.Fee = enc.SignedFee
+	return nil
 }
 
 func (dto *BlockTransaction) Serialize() ([]byte, error) {
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/merkle"
+)
+
+// BlockTransactionProof represents the merkle inclusion proof of a single
+// transaction. Light clients (like wallets) can use it to prove a transaction
+// was included in a block signed by the proof of authority without having to
+// download every block.
+type BlockTransactionProof struct {
+	// BlockHash is the unique hash of the block which contains the transaction.
+	BlockHash string `json:"block_hash"`
+
+	// Header is the header of the block, the `TransRoot` field is the merkle
+	// root which the proof must resolve to.
+	Header *BlockHeader `json:"header"`
+
+	// The signature of the block's header which was applied by the
+	// proof-of-authority validator.
+	HeaderSignatureBytes []byte `json:"header_signature_bytes"`
+
+	// The proof-of-authority validator whom signed the block header.
+	Validator *Validator `json:"validator"`
+
+	// Transaction is the transaction being proven.
+	Transaction *BlockTransaction `json:"transaction"`
+
+	// TransactionIndex is the position of the transaction inside `BlockData.Trans`.
+	TransactionIndex uint64 `json:"transaction_index"`
+
+	// MerklePath is the list of sibling hashes from the transaction up to
+	// the merkle root, see `merkle.Tree.Proof`.
+	MerklePath [][]byte `json:"merkle_path"`
+
+	// MerklePathOrder indicates for every hash in the merkle path whether it
+	// is concatenated first (0) or second (1).
+	MerklePathOrder []int64 `json:"merkle_path_order"`
+}
+
+// NewBlockTransactionProof rebuilds the merkle tree of the block exactly as
+// it was when the block was sealed, makes sure it still resolves to the
+// signed root and returns the proof of the transaction at the index.
+func NewBlockTransactionProof(blockData *BlockData, txIndex int) (*BlockTransactionProof, error) {
+	if txIndex < 0 || txIndex >= len(blockData.Trans) {
+		return nil, fmt.Errorf("transaction index %v out of range for block %v", txIndex, blockData.Hash)
+	}
+
+	trans := make([]BlockTransaction, 0, len(blockData.Trans))
+	for _, blockTx := range blockData.Trans {
+		trans = append(trans, blockTx.WithoutJSONStrings())
+	}
+
+	tree, err := merkle.NewTree(trans)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating merkle tree: %v", err)
+	}
+	if tree.RootHex() != blockData.Header.TransRoot {
+		return nil, fmt.Errorf("merkle root mismatch for block %v: got %v but header has %v", blockData.Hash, tree.RootHex(), blockData.Header.TransRoot)
+	}
+
+	merklePath, merklePathOrder, err := tree.Proof(trans[txIndex])
+	if err != nil {
+		return nil, fmt.Errorf("failed creating merkle proof: %v", err)
+	}
+
+	return &BlockTransactionProof{
+		BlockHash:            blockData.Hash,
+		Header:               blockData.Header,
+		HeaderSignatureBytes: blockData.HeaderSignatureBytes,
+		Validator:            blockData.Validator,
+		Transaction:          &trans[txIndex],
+		TransactionIndex:     uint64(txIndex),
+		MerklePath:           merklePath,
+		MerklePathOrder:      merklePathOrder,
+	}, nil
+}
+
+// Verify checks the transaction hashes up the merkle path to the transaction
+// root of the block header and that the block header was signed by the
+// validator. Callers must still check the validator is the one they trust.
+func (p *BlockTransactionProof) Verify() error {
+	if p.Header == nil {
+		return errors.New("proof is missing block header")
+	}
+	if p.Transaction == nil {
+		return errors.New("proof is missing transaction")
+	}
+	if p.Validator == nil {
+		return errors.New("proof is missing validator")
+	}
+	if len(p.MerklePath) != len(p.MerklePathOrder) {
+		return errors.New("proof merkle path and order are different lengths")
+	}
+
+	// Step 1: Hash the transaction up the merkle path.
+	hash, err := p.Transaction.WithoutJSONStrings().Hash()
+	if err != nil {
+		return fmt.Errorf("failed hashing transaction: %v", err)
+	}
+	for i, sibling := range p.MerklePath {
+		var data []byte
+		switch p.MerklePathOrder[i] {
+		case 0:
+			data = append(append([]byte{}, sibling...), hash...)
+		case 1:
+			data = append(append([]byte{}, hash...), sibling...)
+		default:
+			return fmt.Errorf("invalid merkle path order: %v", p.MerklePathOrder[i])
+		}
+		sum := sha256.Sum256(data)
+		hash = sum[:]
+	}
+
+	// Step 2: Compare against the merkle root of the block.
+	transRoot, err := hexutil.Decode(p.Header.TransRoot)
+	if err != nil {
+		return fmt.Errorf("failed decoding transaction root: %v", err)
+	}
+	if !bytes.Equal(hash, transRoot) {
+		return errors.New("transaction is not included in block")
+	}
+
+	// Step 3: Verify the validator signed the block header.
+	header := p.Header.WithoutJSONStrings()
+	if !p.Validator.Verify(p.HeaderSignatureBytes, header) {
+		return errors.New("block header signature is invalid")
+	}
+	return nil
+}
+
+// WithoutJSONStrings returns a copy of the block header without the
+// read-only `_string` fields, this is the form which was signed.
+func (bh *BlockHeader) WithoutJSONStrings() *BlockHeader {
+	header := *bh
+	header.NumberString = ""
+	header.NonceString = ""
+	header.LatestTokenIDString = ""
+	return &header
+}
+
+// WithoutJSONStrings returns a copy of the block transaction without the
+// read-only `_string` fields, this is the form which was hashed into the
+// merkle tree.
+func (tx BlockTransaction) WithoutJSONStrings() BlockTransaction {
+	tx.NonceString = ""
+	tx.DataString = ""
+	tx.TokenIDString = ""
+	tx.TokenNonceString = ""
+	return tx
+}
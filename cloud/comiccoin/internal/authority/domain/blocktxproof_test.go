@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/merkle"
+)
+
+func TestBlockTransactionProofVerify(t *testing.T) {
+	validatorKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	validator := &Validator{
+		ID:             "test",
+		PublicKeyBytes: crypto.FromECDSAPub(&validatorKey.PublicKey),
+	}
+
+	accountKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	from := crypto.PubkeyToAddress(accountKey.PublicKey)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	trans := make([]BlockTransaction, 0, 3)
+	for i := int64(1); i <= 3; i++ {
+		tx := Transaction{
+			ChainID:    1,
+			NonceBytes: big.NewInt(i).Bytes(),
+			From:       &from,
+			To:         &to,
+			Value:      10,
+			Type:       TransactionTypeCoin,
+		}
+		stx, err := tx.Sign(accountKey)
+		if err != nil {
+			t.Fatalf("failed signing transaction: %v", err)
+		}
+		trans = append(trans, BlockTransaction{SignedTransaction: stx, Fee: 1})
+	}
+
+	tree, err := merkle.NewTree(trans)
+	if err != nil {
+		t.Fatalf("failed creating merkle tree: %v", err)
+	}
+	header := &BlockHeader{
+		ChainID:     1,
+		NumberBytes: big.NewInt(1).Bytes(),
+		TransRoot:   tree.RootHex(),
+	}
+	headerSig, err := validator.Sign(validatorKey, header)
+	if err != nil {
+		t.Fatalf("failed signing header: %v", err)
+	}
+
+	newProof := func(t *testing.T, index int) *BlockTransactionProof {
+		path, order, err := tree.Proof(trans[index])
+		if err != nil {
+			t.Fatalf("failed creating merkle proof: %v", err)
+		}
+		tx := trans[index]
+		return &BlockTransactionProof{
+			Header:               header,
+			HeaderSignatureBytes: headerSig,
+			Validator:            validator,
+			Transaction:          &tx,
+			TransactionIndex:     uint64(index),
+			MerklePath:           path,
+			MerklePathOrder:      order,
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		for i := range trans {
+			if err := newProof(t, i).Verify(); err != nil {
+				t.Errorf("expected proof for transaction %d to verify, got error %v", i, err)
+			}
+		}
+	})
+
+	t.Run("IgnoresReadOnlyStrings", func(t *testing.T) {
+		proof := newProof(t, 0)
+		proof.Transaction.NonceString = "1"
+		proof.Header = header.WithoutJSONStrings()
+		proof.Header.NumberString = "1"
+		if err := proof.Verify(); err != nil {
+			t.Errorf("expected proof to verify, got error %v", err)
+		}
+	})
+
+	t.Run("TamperedTransaction", func(t *testing.T) {
+		proof := newProof(t, 1)
+		proof.Transaction.Value = 1000
+		if err := proof.Verify(); err == nil {
+			t.Error("expected tampered transaction to fail verification")
+		}
+	})
+
+	t.Run("WrongValidator", func(t *testing.T) {
+		otherKey, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("failed generating key: %v", err)
+		}
+		proof := newProof(t, 2)
+		proof.Validator = &Validator{ID: "other", PublicKeyBytes: crypto.FromECDSAPub(&otherKey.PublicKey)}
+		if err := proof.Verify(); err == nil {
+			t.Error("expected proof signed by another validator to fail verification")
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrCollectionSupplyExceeded is returned when minting editions would take a
+// collection over its maximum supply.
+var ErrCollectionSupplyExceeded = errors.New("collection supply exceeded")
+
+// Collection groups the tokens which were minted as editions of the same
+// work, for example every copy of a print run of a comic. Editions are
+// numbered from one in the order they were minted and a collection can never
+// have more editions than its maximum supply.
+type Collection struct {
+	// The unique identifier for this blockchain that we are managing the state for.
+	ChainID uint16 `bson:"chain_id" json:"chain_id"`
+
+	IDBytes []byte          `bson:"id_bytes" json:"id_bytes"`
+	Creator *common.Address `bson:"creator" json:"creator"` // The publisher whom created the collection.
+	Name    string          `bson:"name" json:"name"`
+
+	// The metadata shared by every edition, used by editions which were
+	// minted without their own metadata.
+	BaseMetadataURI string `bson:"base_metadata_uri" json:"base_metadata_uri"`
+
+	MaxSupply uint64 `bson:"max_supply" json:"max_supply"`
+	Supply    uint64 `bson:"supply" json:"supply"` // The number of editions minted so far.
+
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// CollectionEdition records which edition of a collection a token is, for
+// example "copy 12 of 500".
+type CollectionEdition struct {
+	ChainID           uint16 `bson:"chain_id" json:"chain_id"`
+	CollectionIDBytes []byte `bson:"collection_id_bytes" json:"collection_id_bytes"`
+	TokenIDBytes      []byte `bson:"token_id_bytes" json:"token_id_bytes"`
+	Edition           uint64 `bson:"edition" json:"edition"`
+	MaxSupply         uint64 `bson:"max_supply" json:"max_supply"`
+	MetadataURI       string `bson:"metadata_uri" json:"metadata_uri"` // The metadata the edition was minted with.
+}
+
+// CollectionToken is a token of a collection together with its edition.
+type CollectionToken struct {
+	Edition   uint64 `json:"edition"`
+	MaxSupply uint64 `json:"max_supply"`
+	*Token
+}
+
+// CollectionRepository interface defines the methods for interacting with the collection repository.
+type CollectionRepository interface {
+	// Upsert inserts or updates a collection in the repository.
+	Upsert(ctx context.Context, col *Collection) error
+
+	// GetByID retrieves a collection by its ID.
+	GetByID(ctx context.Context, id *big.Int) (*Collection, error)
+
+	// GetLatestIDByChainID returns the ID of the most recently created
+	// collection or zero if there are no collections.
+	GetLatestIDByChainID(ctx context.Context, chainID uint16) (*big.Int, error)
+
+	// ListByCreator retrieves all the collections created by the address.
+	ListByCreator(ctx context.Context, creator *common.Address) ([]*Collection, error)
+}
+
+// CollectionEditionRepository interface defines the methods for interacting with the collection edition repository.
+type CollectionEditionRepository interface {
+	// Upsert inserts or updates a collection edition in the repository.
+	Upsert(ctx context.Context, edition *CollectionEdition) error
+
+	// ListByCollectionID retrieves all the editions of the collection ordered by edition number.
+	ListByCollectionID(ctx context.Context, collectionID *big.Int) ([]*CollectionEdition, error)
+}
+
+func (col *Collection) GetID() *big.Int {
+	return new(big.Int).SetBytes(col.IDBytes)
+}
+
+func (e *CollectionEdition) GetCollectionID() *big.Int {
+	return new(big.Int).SetBytes(e.CollectionIDBytes)
+}
+
+func (e *CollectionEdition) GetTokenID() *big.Int {
+	return new(big.Int).SetBytes(e.TokenIDBytes)
+}
+
+// NextEditions returns the edition numbers of the next `n` editions of the
+// collection without changing its supply, or `ErrCollectionSupplyExceeded`
+// if minting them would exceed the maximum supply.
+func (col *Collection) NextEditions(n uint64) ([]uint64, error) {
+	if n == 0 {
+		return nil, fmt.Errorf("cannot mint zero editions")
+	}
+	if col.Supply > col.MaxSupply || n > col.MaxSupply-col.Supply {
+		return nil, fmt.Errorf("%w: %v editions requested but only %v of %v remain", ErrCollectionSupplyExceeded, n, col.MaxSupply-min(col.Supply, col.MaxSupply), col.MaxSupply)
+	}
+	editions := make([]uint64, 0, n)
+	for i := uint64(1); i <= n; i++ {
+		editions = append(editions, col.Supply+i)
+	}
+	return editions, nil
+}
+
+// EditionMetadataURI returns the metadata URI to mint an edition with, the
+// edition's own metadata takes precedence over the collection's base metadata.
+func (col *Collection) EditionMetadataURI(metadataURI string) string {
+	if metadataURI != "" {
+		return metadataURI
+	}
+	return col.BaseMetadataURI
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCollectionNextEditions(t *testing.T) {
+	col := &Collection{MaxSupply: 5, Supply: 2}
+
+	editions, err := col.NextEditions(3)
+	if err != nil {
+		t.Fatalf("expected editions, got %v", err)
+	}
+	if !reflect.DeepEqual(editions, []uint64{3, 4, 5}) {
+		t.Fatalf("unexpected editions: %v", editions)
+	}
+	if col.Supply != 2 {
+		t.Fatalf("supply must not change, got %v", col.Supply)
+	}
+
+	if _, err := col.NextEditions(4); !errors.Is(err, ErrCollectionSupplyExceeded) {
+		t.Fatalf("expected supply exceeded, got %v", err)
+	}
+	if _, err := col.NextEditions(0); err == nil {
+		t.Fatal("expected error for zero editions")
+	}
+
+	col.Supply = col.MaxSupply
+	if _, err := col.NextEditions(1); !errors.Is(err, ErrCollectionSupplyExceeded) {
+		t.Fatalf("expected supply exceeded, got %v", err)
+	}
+}
+
+func TestCollectionEditionMetadataURI(t *testing.T) {
+	col := &Collection{BaseMetadataURI: "ipfs://base"}
+	if got := col.EditionMetadataURI(""); got != "ipfs://base" {
+		t.Fatalf("expected base metadata, got %v", got)
+	}
+	if got := col.EditionMetadataURI("ipfs://copy"); got != "ipfs://copy" {
+		t.Fatalf("expected edition metadata, got %v", got)
+	}
+}
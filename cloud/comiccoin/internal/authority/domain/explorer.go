@@ -0,0 +1,310 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The explorer is the read-only view of the blockchain for our public
+// explorer. Every list is paginated with an opaque cursor: the response
+// returns a `next_cursor` which is passed back as `cursor` to get the next
+// page, an empty `next_cursor` means there are no more results.
+
+const (
+	// ExplorerDefaultPageSize is the number of results returned when the
+	// caller does not ask for a page size.
+	ExplorerDefaultPageSize = 25
+
+	// ExplorerMaxPageSize is the maximum number of results of a page.
+	ExplorerMaxPageSize = 100
+)
+
+// ErrInvalidExplorerCursor is returned when the cursor was not returned by
+// the explorer.
+var ErrInvalidExplorerCursor = errors.New("invalid cursor")
+
+// ExplorerPageSize returns the page size to use for the requested limit.
+func ExplorerPageSize(limit int64) int64 {
+	if limit <= 0 {
+		return ExplorerDefaultPageSize
+	}
+	return min(limit, ExplorerMaxPageSize)
+}
+
+// ExplorerBlock is the summary of a block in the list of blocks, the
+// transactions are not included to keep the list small.
+type ExplorerBlock struct {
+	Hash             string         `json:"hash"`
+	NumberString     string         `json:"number_string"`
+	PrevBlockHash    string         `json:"prev_block_hash"`
+	TimeStamp        uint64         `json:"timestamp"`
+	Beneficiary      common.Address `json:"beneficiary"`
+	TransactionFee   uint64         `json:"transaction_fee"`
+	TransactionCount int            `json:"transaction_count"`
+	FeesCollected    uint64         `json:"fees_collected"`
+	StateRoot        string         `json:"state_root"`
+	TransRoot        string         `json:"trans_root"`
+	TokensRoot       string         `json:"tokens_root"`
+}
+
+// NewExplorerBlock returns the summary of the block.
+func NewExplorerBlock(blockData *BlockData) *ExplorerBlock {
+	block := &ExplorerBlock{
+		Hash:             blockData.Hash,
+		NumberString:     blockData.Header.GetNumber().String(),
+		PrevBlockHash:    blockData.Header.PrevBlockHash,
+		TimeStamp:        blockData.Header.TimeStamp,
+		Beneficiary:      blockData.Header.Beneficiary,
+		TransactionFee:   blockData.Header.TransactionFee,
+		TransactionCount: len(blockData.Trans),
+		StateRoot:        blockData.Header.StateRoot,
+		TransRoot:        blockData.Header.TransRoot,
+		TokensRoot:       blockData.Header.TokensRoot,
+	}
+	for _, blockTx := range blockData.Trans {
+		block.FeesCollected += blockTx.Fee
+	}
+	return block
+}
+
+// ExplorerBlockListResult is a page of blocks, newest first.
+type ExplorerBlockListResult struct {
+	Blocks     []*ExplorerBlock `json:"blocks"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+// ExplorerTransaction is a block transaction together with the block it was
+// sealed in.
+type ExplorerTransaction struct {
+	BlockTransaction  `bson:",inline"`
+	BlockHash         string `bson:"block_hash" json:"block_hash"`
+	BlockNumberBytes  []byte `bson:"block_number_bytes" json:"-"`
+	BlockNumberString string `bson:"-" json:"block_number_string"`
+	BlockTimeStamp    uint64 `bson:"block_timestamp" json:"block_timestamp"`
+	TransactionIndex  int64  `bson:"transaction_index" json:"transaction_index"`
+}
+
+// MarshalJSON encodes the block transaction together with the fields of its
+// block, this is needed since the encoding of the embedded block transaction
+// would otherwise be used for the whole explorer transaction.
+func (tx ExplorerTransaction) MarshalJSON() ([]byte, error) {
+	blockTx, err := json.Marshal(tx.BlockTransaction)
+	if err != nil {
+		return nil, err
+	}
+	block, err := json.Marshal(struct {
+		BlockHash         string `json:"block_hash"`
+		BlockNumberString string `json:"block_number_string"`
+		BlockTimeStamp    uint64 `json:"block_timestamp"`
+		TransactionIndex  int64  `json:"transaction_index"`
+	}{tx.BlockHash, tx.BlockNumberString, tx.BlockTimeStamp, tx.TransactionIndex})
+	if err != nil {
+		return nil, err
+	}
+	// Merge both JSON objects into one.
+	return append(append(blockTx[:len(blockTx)-1], ','), block[1:]...), nil
+}
+
+// GetBlockNumber returns the number of the block the transaction is in.
+func (tx *ExplorerTransaction) GetBlockNumber() *big.Int {
+	return new(big.Int).SetBytes(tx.BlockNumberBytes)
+}
+
+// ExplorerTransactionFilter are the filters of the list of transactions,
+// every filter left empty is ignored.
+type ExplorerTransactionFilter struct {
+	ChainID uint16
+
+	// Type is either `coin`, `token` or `validator`.
+	Type string
+
+	// Address matches the transactions sent from or to the address.
+	Address *common.Address
+
+	// TokenID matches the transactions of the token.
+	TokenID *big.Int
+
+	// TimeStampStart and TimeStampEnd are the inclusive range, in unix
+	// milliseconds, of the time the transactions were received.
+	TimeStampStart uint64
+	TimeStampEnd   uint64
+
+	// Cursor is the position after which to continue listing.
+	Cursor *ExplorerTransactionCursor
+
+	Limit int64
+}
+
+// ExplorerTransactionCursor is the position of a transaction in the list of
+// transactions, which is ordered from the newest block to the oldest and
+// from the last transaction in a block to the first.
+type ExplorerTransactionCursor struct {
+	BlockTimeStamp   uint64
+	BlockHash        string
+	TransactionIndex int64
+}
+
+// Encode returns the opaque string of the cursor.
+func (c *ExplorerTransactionCursor) Encode() string {
+	raw := fmt.Sprintf("%d:%s:%d", c.BlockTimeStamp, c.BlockHash, c.TransactionIndex)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeExplorerTransactionCursor returns the cursor of the opaque string.
+func DecodeExplorerTransactionCursor(s string) (*ExplorerTransactionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidExplorerCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || parts[1] == "" {
+		return nil, ErrInvalidExplorerCursor
+	}
+	timestamp, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidExplorerCursor
+	}
+	index, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || index < 0 {
+		return nil, ErrInvalidExplorerCursor
+	}
+	return &ExplorerTransactionCursor{timestamp, parts[1], index}, nil
+}
+
+// ExplorerTransactionListResult is a page of transactions, newest first.
+type ExplorerTransactionListResult struct {
+	Transactions []*ExplorerTransaction `json:"transactions"`
+	NextCursor   string                 `json:"next_cursor,omitempty"`
+}
+
+// ExplorerActivity is when an account sent or received a transaction.
+type ExplorerActivity struct {
+	BlockHash         string `bson:"block_hash" json:"block_hash"`
+	BlockNumberBytes  []byte `bson:"block_number_bytes" json:"-"`
+	BlockNumberString string `bson:"-" json:"block_number_string"`
+	TimeStamp         uint64 `bson:"timestamp" json:"timestamp"`
+}
+
+// ExplorerAccountActivity summarizes the transactions of an account.
+type ExplorerAccountActivity struct {
+	TransactionCount uint64            `bson:"transaction_count" json:"transaction_count"`
+	FirstActivity    *ExplorerActivity `bson:"first_activity" json:"first_activity,omitempty"`
+	LastActivity     *ExplorerActivity `bson:"last_activity" json:"last_activity,omitempty"`
+}
+
+// ExplorerAccount is the summary of an account.
+type ExplorerAccount struct {
+	ChainID     uint16          `json:"chain_id"`
+	Address     *common.Address `json:"address"`
+	Balance     uint64          `json:"balance"`
+	NonceString string          `json:"nonce_string"`
+	ExplorerAccountActivity
+}
+
+// ExplorerRichListEntry is an account in the list of the top holders.
+type ExplorerRichListEntry struct {
+	Rank    int64           `json:"rank"`
+	Address *common.Address `json:"address"`
+	Balance uint64          `json:"balance"`
+
+	// Share is the balance of the account compared to the total supply,
+	// from 0 to 1.
+	Share float64 `json:"share"`
+}
+
+// ExplorerRichListResult is a page of the top holders, highest balance first.
+type ExplorerRichListResult struct {
+	Accounts   []*ExplorerRichListEntry `json:"accounts"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+// RankAccountsByBalance returns the page of the accounts with a balance
+// ordered by balance, highest first, and by address for equal balances so
+// the ranking is stable between pages. The cursor is the rank after which to
+// continue listing.
+func RankAccountsByBalance(accounts []*Account, cursor string, limit int64) (*ExplorerRichListResult, error) {
+	var offset int64
+	if cursor != "" {
+		n, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || n < 0 {
+			return nil, ErrInvalidExplorerCursor
+		}
+		offset = n
+	}
+	limit = ExplorerPageSize(limit)
+
+	holders := make([]*Account, 0, len(accounts))
+	var totalSupply uint64
+	for _, account := range accounts {
+		if account.Balance > 0 && account.Address != nil {
+			holders = append(holders, account)
+			totalSupply += account.Balance
+		}
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		if holders[i].Balance != holders[j].Balance {
+			return holders[i].Balance > holders[j].Balance
+		}
+		return strings.ToLower(holders[i].Address.Hex()) < strings.ToLower(holders[j].Address.Hex())
+	})
+
+	result := &ExplorerRichListResult{Accounts: make([]*ExplorerRichListEntry, 0)}
+	for i := offset; i < int64(len(holders)) && i < offset+limit; i++ {
+		result.Accounts = append(result.Accounts, &ExplorerRichListEntry{
+			Rank:    i + 1,
+			Address: holders[i].Address,
+			Balance: holders[i].Balance,
+			Share:   float64(holders[i].Balance) / float64(totalSupply),
+		})
+	}
+	if offset+limit < int64(len(holders)) {
+		result.NextCursor = strconv.FormatInt(offset+limit, 10)
+	}
+	return result, nil
+}
+
+// ExplorerSupply are the statistics of the coins in circulation.
+type ExplorerSupply struct {
+	ChainID uint16 `json:"chain_id"`
+
+	// TotalSupply is the sum of the balance of every account.
+	TotalSupply uint64 `json:"total_supply"`
+
+	// AuthorityBalance is the balance held by the proof of authority account,
+	// which collects the transaction fees.
+	AuthorityBalance uint64 `json:"authority_balance"`
+
+	// CirculatingSupply is the total supply not held by the authority.
+	CirculatingSupply uint64 `json:"circulating_supply"`
+
+	AccountsCount uint64 `json:"accounts_count"`
+	HoldersCount  uint64 `json:"holders_count"`
+
+	LatestBlockNumberString string `json:"latest_block_number_string"`
+	LatestTokenIDString     string `json:"latest_token_id_string"`
+}
+
+// NewExplorerSupply returns the supply statistics of the accounts.
+func NewExplorerSupply(accounts []*Account, authorityAddress *common.Address) *ExplorerSupply {
+	supply := &ExplorerSupply{}
+	for _, account := range accounts {
+		supply.AccountsCount++
+		supply.TotalSupply += account.Balance
+		if account.Balance > 0 {
+			supply.HoldersCount++
+		}
+		if authorityAddress != nil && account.Address != nil && *account.Address == *authorityAddress {
+			supply.AuthorityBalance += account.Balance
+		}
+	}
+	supply.CirculatingSupply = supply.TotalSupply - supply.AuthorityBalance
+	return supply
+}
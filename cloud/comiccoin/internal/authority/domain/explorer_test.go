@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestExplorerTransactionCursor(t *testing.T) {
+	cursor := &ExplorerTransactionCursor{BlockTimeStamp: 1700000000000, BlockHash: "0xabc", TransactionIndex: 3}
+	decoded, err := DecodeExplorerTransactionCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("failed decoding cursor: %v", err)
+	}
+	if *decoded != *cursor {
+		t.Fatalf("expected %+v, got %+v", cursor, decoded)
+	}
+
+	for _, invalid := range []string{"", "not-base64!", cursor.Encode()[:4]} {
+		if _, err := DecodeExplorerTransactionCursor(invalid); !errors.Is(err, ErrInvalidExplorerCursor) {
+			t.Fatalf("expected cursor %q to be invalid, got %v", invalid, err)
+		}
+	}
+}
+
+func TestRankAccountsByBalance(t *testing.T) {
+	newAccount := func(hex string, balance uint64) *Account {
+		address := common.HexToAddress(hex)
+		return &Account{Address: &address, Balance: balance}
+	}
+	accounts := []*Account{
+		newAccount("0x3", 10),
+		newAccount("0x1", 50),
+		newAccount("0x4", 0), // Accounts without coins are not ranked.
+		newAccount("0x2", 10),
+		newAccount("0x5", 30),
+	}
+
+	first, err := RankAccountsByBalance(accounts, "", 2)
+	if err != nil {
+		t.Fatalf("failed ranking accounts: %v", err)
+	}
+	if len(first.Accounts) != 2 || first.Accounts[0].Balance != 50 || first.Accounts[1].Balance != 30 {
+		t.Fatalf("unexpected first page: %+v", first.Accounts)
+	}
+	if first.Accounts[0].Share != 0.5 {
+		t.Fatalf("expected share 0.5, got %v", first.Accounts[0].Share)
+	}
+	if first.NextCursor == "" {
+		t.Fatal("expected a next cursor")
+	}
+
+	second, err := RankAccountsByBalance(accounts, first.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("failed ranking accounts: %v", err)
+	}
+	if len(second.Accounts) != 2 || second.Accounts[0].Rank != 3 || *second.Accounts[0].Address != common.HexToAddress("0x2") {
+		t.Fatalf("unexpected second page: %+v", second.Accounts)
+	}
+	if second.NextCursor != "" {
+		t.Fatalf("expected no next cursor, got %v", second.NextCursor)
+	}
+
+	if _, err := RankAccountsByBalance(accounts, "-1", 2); !errors.Is(err, ErrInvalidExplorerCursor) {
+		t.Fatalf("expected invalid cursor error, got %v", err)
+	}
+}
+
+func TestNewExplorerSupply(t *testing.T) {
+	authority := common.HexToAddress("0x1")
+	holder := common.HexToAddress("0x2")
+	empty := common.HexToAddress("0x3")
+	supply := NewExplorerSupply([]*Account{
+		{Address: &authority, Balance: 70},
+		{Address: &holder, Balance: 30},
+		{Address: &empty},
+	}, &authority)
+
+	if supply.TotalSupply != 100 || supply.AuthorityBalance != 70 || supply.CirculatingSupply != 30 {
+		t.Fatalf("unexpected supply: %+v", supply)
+	}
+	if supply.AccountsCount != 3 || supply.HoldersCount != 2 {
+		t.Fatalf("unexpected counts: %+v", supply)
+	}
+}
+
+func TestExplorerTransactionMarshalJSON(t *testing.T) {
+	tx := &ExplorerTransaction{
+		BlockTransaction:  BlockTransaction{SignedTransaction: SignedTransaction{Transaction: Transaction{Type: TransactionTypeCoin, Value: 10, Fee: 2}}, Fee: 2},
+		BlockHash:         "0xabc",
+		BlockNumberString: "7",
+		BlockTimeStamp:    1700000000000,
+		TransactionIndex:  3,
+	}
+	data, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatalf("failed encoding: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("failed decoding: %v", err)
+	}
+	for key, expected := range map[string]any{"block_hash": "0xabc", "block_number_string": "7", "transaction_index": float64(3), "type": "coin", "fee": float64(2), "signed_fee": float64(2)} {
+		if fields[key] != expected {
+			t.Fatalf("expected %v to be %v, got %v", key, expected, fields[key])
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// The fee market lets every transaction carry its own `Fee` which is paid to
+// the authority on top of the value, the authority seals the transactions
+// with the highest fees first. Transactions without a fee keep the legacy
+// rule where the block `TransactionFee` is taken out of the value, this way
+// every block sealed before the fee market was activated still validates.
+
+// ErrTransactionFeeTooLow is returned when a transaction pays less than the
+// minimum fee of the fee market.
+var ErrTransactionFeeTooLow = errors.New("transaction fee is less than the minimum fee")
+
+// ErrFeeMarketNotActive is returned when a transaction carries its own fee
+// before the fee market was activated.
+var ErrFeeMarketNotActive = errors.New("transaction fee is not accepted until the fee market is active")
+
+// IsFeeMarketActive returns true if the block number accepts transactions
+// with their own fee. An activation height of zero means never.
+func IsFeeMarketActive(activationHeight uint64, blockNumber *big.Int) bool {
+	if activationHeight == 0 || blockNumber == nil {
+		return false
+	}
+	return blockNumber.Cmp(new(big.Int).SetUint64(activationHeight)) >= 0
+}
+
+// SplitFees returns the amount taken from the sender, the amount given to the
+// recipient and the fee collected by the authority for the transaction. The
+// `legacyFee` is the `TransactionFee` of the block, it is only used for
+// transactions without their own fee. For a swap the buyer pays the seller,
+// see `Payer` and `Payee`.
+func (tx *Transaction) SplitFees(legacyFee uint64) (debit, credit, fee uint64) {
+	switch tx.Type {
+	case TransactionTypeCoin, TransactionTypeSwap:
+		if tx.Fee > 0 {
+			return tx.Value + tx.Fee, tx.Value, tx.Fee
+		}
+		return tx.Value, tx.Value - legacyFee, legacyFee
+	case TransactionTypeToken:
+		if tx.Fee > 0 {
+			// The value is a coin payment to the recipient made together
+			// with the token, see `Token.SplitRoyalty`.
+			return tx.Value + tx.Fee, tx.Value, tx.Fee
+		}
+		// Note: The value of a legacy token transaction was always the fee.
+		return tx.Value, 0, tx.Value
+	default:
+		return 0, 0, 0
+	}
+}
+
+// ValidateFee verifies the fee of the transaction follows the rules of the
+// block it is being sealed in.
+func (tx *Transaction) ValidateFee(feeMarketActive bool, minimumFee uint64) error {
+	if !tx.PaysFee() {
+		if tx.Fee != 0 {
+			return fmt.Errorf("transaction type %v does not pay a fee", tx.Type)
+		}
+		return nil
+	}
+	if tx.Fee == 0 {
+		// Legacy rule: the fee is taken out of the value.
+		if (tx.Type == TransactionTypeCoin || tx.Type == TransactionTypeSwap) && tx.Value < minimumFee {
+			return fmt.Errorf("%w: value %v does not cover the fee %v", ErrTransactionFeeTooLow, tx.Value, minimumFee)
+		}
+		return nil
+	}
+	if !feeMarketActive {
+		return ErrFeeMarketNotActive
+	}
+	if tx.Fee < minimumFee {
+		return fmt.Errorf("%w: got %v but minimum is %v", ErrTransactionFeeTooLow, tx.Fee, minimumFee)
+	}
+	if tx.Value > math.MaxUint64-tx.Fee {
+		return errors.New("transaction value and fee overflow")
+	}
+	return nil
+}
+
+// PaysFee returns true if the transaction type pays a fee to the authority.
+func (tx *Transaction) PaysFee() bool {
+	return tx.Type == TransactionTypeCoin || tx.Type == TransactionTypeToken || tx.Type == TransactionTypeSwap
+}
+
+// SortMempoolTransactionsByFee orders the transactions so the ones paying the
+// highest fee come first while the transactions of every sender keep their
+// nonce order, otherwise a sender's later transaction could be sealed before
+// their earlier one and be rejected. Transactions with the same fee keep
+// the order they were received in.
+func SortMempoolTransactionsByFee(txs []*MempoolTransaction, legacyFee uint64) []*MempoolTransaction {
+	// Group the transactions by sender in nonce order.
+	queues := make(map[string][]*MempoolTransaction)
+	senders := make([]string, 0)
+	for _, tx := range txs {
+		sender := ""
+		if tx.From != nil {
+			sender = strings.ToLower(tx.From.Hex())
+		}
+		if _, ok := queues[sender]; !ok {
+			senders = append(senders, sender)
+		}
+		queues[sender] = append(queues[sender], tx)
+	}
+	for _, sender := range senders {
+		queue := queues[sender]
+		sort.SliceStable(queue, func(i, j int) bool {
+			return queue[i].GetNonce().Cmp(queue[j].GetNonce()) < 0
+		})
+	}
+
+	// Repeatedly pick the highest paying transaction at the front of the
+	// sender queues, ties go to the transaction received first.
+	position := make(map[*MempoolTransaction]int, len(txs))
+	for i, tx := range txs {
+		position[tx] = i
+	}
+	sorted := make([]*MempoolTransaction, 0, len(txs))
+	for len(sorted) < len(txs) {
+		var best string
+		var bestTx *MempoolTransaction
+		var bestFee uint64
+		for _, sender := range senders {
+			queue := queues[sender]
+			if len(queue) == 0 {
+				continue
+			}
+			_, _, fee := queue[0].SplitFees(legacyFee)
+			if bestTx == nil || fee > bestFee || (fee == bestFee && position[queue[0]] < position[bestTx]) {
+				best, bestTx, bestFee = sender, queue[0], fee
+			}
+		}
+		sorted = append(sorted, bestTx)
+		queues[best] = queues[best][1:]
+	}
+	return sorted
+}
+
+// FeeEstimate is the fee the authority suggests for the next transactions
+// based on how full the recent blocks were.
+type FeeEstimate struct {
+	ChainID uint16 `json:"chain_id"`
+
+	// FeeMarketActive is true if transactions may carry their own fee.
+	FeeMarketActive bool `json:"fee_market_active"`
+
+	// FeeMarketActivationHeight is the block number from which transactions
+	// may carry their own fee, zero means never.
+	FeeMarketActivationHeight uint64 `json:"fee_market_activation_height"`
+
+	// MinimumFee is the lowest fee the authority accepts.
+	MinimumFee uint64 `json:"minimum_fee"`
+
+	// SuggestedFee is the fee which should get the transaction sealed in
+	// one of the next blocks.
+	SuggestedFee uint64 `json:"suggested_fee"`
+
+	// FastFee is the fee which should get the transaction sealed in the
+	// next block.
+	FastFee uint64 `json:"fast_fee"`
+
+	// BlockUtilization is how full the recent blocks and the mempool are
+	// compared to the maximum transactions per block, from 0 to 1.
+	BlockUtilization float64 `json:"block_utilization"`
+
+	// BlocksSampled is the number of recent blocks the estimate is based on.
+	BlocksSampled int `json:"blocks_sampled"`
+}
+
+// FeeEstimateCongestionThreshold is the block utilization above which the
+// minimum fee is no longer enough to be sealed quickly.
+const FeeEstimateCongestionThreshold = 0.5
+
+// EstimateFee suggests a fee from the fees paid in the recent blocks and the
+// number of transactions waiting in the mempool.
+func EstimateFee(recentBlocks []*BlockData, pendingTxs int, transPerBlock uint16, minimumFee uint64) *FeeEstimate {
+	estimate := &FeeEstimate{
+		MinimumFee:    minimumFee,
+		SuggestedFee:  minimumFee,
+		FastFee:       minimumFee,
+		BlocksSampled: len(recentBlocks),
+	}
+	if transPerBlock == 0 {
+		transPerBlock = 1
+	}
+
+	// Measure how full the blocks are, the mempool counts as the next block
+	// so a backlog raises the estimate before it shows up in the blocks.
+	fees := make([]uint64, 0)
+	var used float64
+	for _, blockData := range recentBlocks {
+		used += math.Min(1, float64(len(blockData.Trans))/float64(transPerBlock))
+		for _, blockTx := range blockData.Trans {
+			if blockTx.PaysFee() {
+				fees = append(fees, blockTx.Fee)
+			}
+		}
+	}
+	used += math.Min(1, float64(pendingTxs)/float64(transPerBlock))
+	estimate.BlockUtilization = used / float64(len(recentBlocks)+1)
+
+	if estimate.BlockUtilization < FeeEstimateCongestionThreshold || len(fees) == 0 {
+		return estimate
+	}
+	sort.Slice(fees, func(i, j int) bool { return fees[i] < fees[j] })
+	percentile := func(p float64) uint64 {
+		return fees[int(math.Ceil(p*float64(len(fees))))-1]
+	}
+	estimate.SuggestedFee = max(minimumFee, percentile(0.5))
+	estimate.FastFee = max(estimate.SuggestedFee, percentile(0.9))
+	return estimate
+}
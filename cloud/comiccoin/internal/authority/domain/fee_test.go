@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fxamacker/cbor/v2"
+)
+
+func TestIsFeeMarketActive(t *testing.T) {
+	if IsFeeMarketActive(0, big.NewInt(100)) {
+		t.Fatal("expected zero activation height to never activate")
+	}
+	if IsFeeMarketActive(10, big.NewInt(9)) {
+		t.Fatal("expected block before activation height to be inactive")
+	}
+	if !IsFeeMarketActive(10, big.NewInt(10)) {
+		t.Fatal("expected block at activation height to be active")
+	}
+}
+
+func TestTransactionSplitFees(t *testing.T) {
+	tests := []struct {
+		name                string
+		tx                  Transaction
+		debit, credit, fees uint64
+	}{
+		{"legacy coin", Transaction{Type: TransactionTypeCoin, Value: 10}, 10, 9, 1},
+		{"coin with fee", Transaction{Type: TransactionTypeCoin, Value: 10, Fee: 3}, 13, 10, 3},
+		{"legacy token", Transaction{Type: TransactionTypeToken, Value: 1}, 1, 0, 1},
+		{"token with fee", Transaction{Type: TransactionTypeToken, Fee: 2}, 2, 0, 2},
+		{"token with payment", Transaction{Type: TransactionTypeToken, Value: 10, Fee: 2}, 12, 10, 2},
+		{"swap with fee", Transaction{Type: TransactionTypeSwap, Value: 10, Fee: 2}, 12, 10, 2},
+		{"validator", Transaction{Type: TransactionTypeValidator}, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			debit, credit, fee := tt.tx.SplitFees(1)
+			if debit != tt.debit || credit != tt.credit || fee != tt.fees {
+				t.Fatalf("expected %v/%v/%v, got %v/%v/%v", tt.debit, tt.credit, tt.fees, debit, credit, fee)
+			}
+		})
+	}
+}
+
+func TestTransactionValidateFee(t *testing.T) {
+	legacy := Transaction{Type: TransactionTypeCoin, Value: 10}
+	if err := legacy.ValidateFee(true, 2); err != nil {
+		t.Fatalf("expected legacy transaction to stay valid: %v", err)
+	}
+	withFee := Transaction{Type: TransactionTypeCoin, Value: 10, Fee: 2}
+	if err := withFee.ValidateFee(false, 2); !errors.Is(err, ErrFeeMarketNotActive) {
+		t.Fatalf("expected fee before activation to fail, got %v", err)
+	}
+	if err := withFee.ValidateFee(true, 2); err != nil {
+		t.Fatalf("expected fee at minimum to be valid: %v", err)
+	}
+	if err := withFee.ValidateFee(true, 3); !errors.Is(err, ErrTransactionFeeTooLow) {
+		t.Fatalf("expected fee below minimum to fail, got %v", err)
+	}
+}
+
+func TestSortMempoolTransactionsByFee(t *testing.T) {
+	alice := common.HexToAddress("0x1")
+	bob := common.HexToAddress("0x2")
+	newTx := func(from common.Address, nonce int64, fee uint64) *MempoolTransaction {
+		return &MempoolTransaction{SignedTransaction: SignedTransaction{Transaction: Transaction{
+			From:       &from,
+			NonceBytes: big.NewInt(nonce).Bytes(),
+			Type:       TransactionTypeCoin,
+			Value:      10,
+			Fee:        fee,
+		}}}
+	}
+	a1 := newTx(alice, 1, 1)
+	a2 := newTx(alice, 2, 9)
+	b1 := newTx(bob, 1, 5)
+	b2 := newTx(bob, 2, 0) // Legacy transaction pays the legacy fee.
+
+	sorted := SortMempoolTransactionsByFee([]*MempoolTransaction{a2, b2, a1, b1}, 2)
+	expected := []*MempoolTransaction{b1, b2, a1, a2}
+	for i := range expected {
+		if sorted[i] != expected[i] {
+			t.Fatalf("unexpected order at %d: nonce %v fee %v", i, sorted[i].GetNonce(), sorted[i].Fee)
+		}
+	}
+}
+
+func TestEstimateFee(t *testing.T) {
+	newBlock := func(fees ...uint64) *BlockData {
+		trans := make([]BlockTransaction, 0, len(fees))
+		for _, fee := range fees {
+			trans = append(trans, BlockTransaction{SignedTransaction: SignedTransaction{Transaction: Transaction{Type: TransactionTypeCoin}}, Fee: fee})
+		}
+		return &BlockData{Trans: trans}
+	}
+
+	quiet := EstimateFee([]*BlockData{newBlock(9)}, 0, 4, 1)
+	if quiet.SuggestedFee != 1 || quiet.FastFee != 1 {
+		t.Fatalf("expected minimum fee when blocks are empty, got %+v", quiet)
+	}
+
+	busy := EstimateFee([]*BlockData{newBlock(1, 2, 3, 4), newBlock(5, 6, 7, 8)}, 4, 4, 2)
+	if busy.BlockUtilization != 1 {
+		t.Fatalf("expected full utilization, got %v", busy.BlockUtilization)
+	}
+	if busy.SuggestedFee != 4 || busy.FastFee != 8 {
+		t.Fatalf("expected suggested 4 and fast 8, got %+v", busy)
+	}
+}
+
+func TestBlockTransactionEncodingKeepsSignedFee(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	from := crypto.PubkeyToAddress(privateKey.PublicKey)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tx := Transaction{
+		ChainID:    1,
+		NonceBytes: big.NewInt(1).Bytes(),
+		From:       &from,
+		To:         &to,
+		Value:      10,
+		Type:       TransactionTypeCoin,
+		Version:    TransactionVersionFee,
+		Fee:        3,
+	}
+	stx, err := tx.Sign(privateKey)
+	if err != nil {
+		t.Fatalf("failed signing transaction: %v", err)
+	}
+	blockTx := BlockTransaction{SignedTransaction: stx, TimeStamp: 1, Fee: 3}
+
+	jsonBytes, err := json.Marshal(blockTx)
+	if err != nil {
+		t.Fatalf("failed encoding json: %v", err)
+	}
+	fromJSON := BlockTransaction{}
+	if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+		t.Fatalf("failed decoding json: %v", err)
+	}
+	cborBytes, err := cbor.Marshal(blockTx)
+	if err != nil {
+		t.Fatalf("failed encoding cbor: %v", err)
+	}
+	fromCBOR := BlockTransaction{}
+	if err := cbor.Unmarshal(cborBytes, &fromCBOR); err != nil {
+		t.Fatalf("failed decoding cbor: %v", err)
+	}
+
+	for name, decoded := range map[string]BlockTransaction{"json": fromJSON, "cbor": fromCBOR} {
+		if decoded.Transaction.Fee != 3 || decoded.Fee != 3 || decoded.TimeStamp != 1 {
+			t.Fatalf("%v: expected fees to be kept, got %+v", name, decoded)
+		}
+		if err := decoded.Validate(1, false); err != nil {
+			t.Fatalf("%v: expected decoded transaction to validate: %v", name, err)
+		}
+	}
+
+	// Transactions without their own fee keep their legacy encoding so the
+	// hash of the blocks sealed before stays the same.
+	legacy := BlockTransaction{SignedTransaction: SignedTransaction{Transaction: Transaction{Type: TransactionTypeCoin, Value: 10}}, Fee: 1}
+	type legacyBlockTransaction BlockTransaction
+	legacyBytes, err := json.Marshal(legacyBlockTransaction(legacy))
+	if err != nil {
+		t.Fatalf("failed encoding json: %v", err)
+	}
+	legacyEncoded, err := json.Marshal(legacy)
+	if err != nil {
+		t.Fatalf("failed encoding json: %v", err)
+	}
+	if string(legacyEncoded) != string(legacyBytes) {
+		t.Fatalf("expected legacy encoding %s, got %s", legacyBytes, legacyEncoded)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
@@ -68,6 +69,9 @@ type MempoolTransactionRepository interface {
 	// ListAll retrieves all mempool transactions in the repository.
 	ListByChainID(ctx context.Context, chainID uint16) ([]*MempoolTransaction, error)
 
+	// ListByFromAddress retrieves all mempool transactions sent from the particular address.
+	ListByFromAddress(ctx context.Context, addr *common.Address) ([]*MempoolTransaction, error)
+
 	// DeleteByChainID deletes all mempool transactions in the repository for the particular chainID.
 	DeleteByChainID(ctx context.Context, chainID uint16) error
 
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// MempoolTransactionStatusPending indicates the transaction was accepted
+	// into our mempool and is waiting to be sealed in a block.
+	MempoolTransactionStatusPending = "pending"
+
+	// MempoolTransactionStatusConfirmed indicates the transaction was sealed
+	// in a block on the blockchain.
+	MempoolTransactionStatusConfirmed = "confirmed"
+
+	// MempoolTransactionStatusRejected indicates the transaction failed
+	// verification by the consensus mechanism and was removed from the mempool.
+	MempoolTransactionStatusRejected = "rejected"
+)
+
+// MempoolTransactionStatus represents the processing status of a transaction
+// which was submitted to the authority's mempool. Submissions are acknowledged
+// right away with the mempool transaction ID which callers can use to poll or
+// stream the final outcome after the block producer has processed it.
+type MempoolTransactionStatus struct {
+	// The mempool transaction ID returned to the caller on submission.
+	ID primitive.ObjectID `json:"id"`
+
+	// The unique hash of the signed transaction which can be used to look up
+	// the transaction receipt, see `SignedTransaction.TransactionHash`.
+	TransactionHash string `json:"transaction_hash,omitempty"`
+
+	// The current status, either `pending`, `confirmed` or `rejected`.
+	Status string `json:"status"`
+
+	// The reason the transaction was rejected (if rejected).
+	Error string `json:"error,omitempty"`
+
+	// The block number the transaction was sealed in (if confirmed).
+	BlockNumberString string `json:"block_number_string,omitempty"`
+
+	// The hash of the block the transaction was sealed in (if confirmed).
+	BlockHash string `json:"block_hash,omitempty"`
+
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+// IsFinal returns true if the transaction will not change status anymore.
+func (s *MempoolTransactionStatus) IsFinal() bool {
+	return s.Status == MempoolTransactionStatusConfirmed || s.Status == MempoolTransactionStatusRejected
+}
+
+// MempoolTransactionStatusRepository interface defines the methods for
+// keeping track of the status of submitted mempool transactions.
+type MempoolTransactionStatusRepository interface {
+	// Upsert inserts or updates the status of a mempool transaction.
+	Upsert(ctx context.Context, status *MempoolTransactionStatus) error
+
+	// GetByID retrieves the status of a mempool transaction by its ID.
+	GetByID(ctx context.Context, id primitive.ObjectID) (*MempoolTransactionStatus, error)
+}
+
+// Serialize serializes the mempool transaction status into a byte slice.
+func (s *MempoolTransactionStatus) Serialize() ([]byte, error) {
+	dataBytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize mempool transaction status: %v", err)
+	}
+	return dataBytes, nil
+}
+
+// NewMempoolTransactionStatusFromDeserialize deserializes a mempool
+// transaction status from a byte slice.
+func NewMempoolTransactionStatusFromDeserialize(data []byte) (*MempoolTransactionStatus, error) {
+	// Defensive code: If the input data is empty, return a nil deserialization result.
+	if data == nil {
+		return nil, nil
+	}
+
+	status := &MempoolTransactionStatus{}
+	if err := json.Unmarshal(data, status); err != nil {
+		return nil, fmt.Errorf("failed to deserialize mempool transaction status: %v", err)
+	}
+	return status, nil
+}
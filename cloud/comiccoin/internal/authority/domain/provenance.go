@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+)
+
+// ErrTokenProvenanceInvalid is returned when a token provenance document does
+// not match the token transactions it was built from.
+var ErrTokenProvenanceInvalid = errors.New("token provenance is invalid")
+
+// TokenProvenanceOwner is a single owner in the chain of custody of a token.
+// The disposal fields are empty for the current owner.
+type TokenProvenanceOwner struct {
+	Owner                     *common.Address `json:"owner"`
+	AcquiredBlockHash         string          `json:"acquired_block_hash"`
+	AcquiredBlockNumberString string          `json:"acquired_block_number_string"`
+	AcquiredBlockTimeStamp    uint64          `json:"acquired_block_timestamp"`
+	DisposedBlockHash         string          `json:"disposed_block_hash,omitempty"`
+	DisposedBlockNumberString string          `json:"disposed_block_number_string,omitempty"`
+	DisposedBlockTimeStamp    uint64          `json:"disposed_block_timestamp,omitempty"`
+}
+
+// TokenProvenance is the chain of custody of a token: the block it was minted
+// in, every owner with the blocks they acquired and disposed of the token in
+// and the block it was burned in, if any.
+//
+// Every token transaction is included with its merkle inclusion proof (in
+// token nonce order) so the document can be verified offline against the
+// block headers, the validator also signs the document so it can be shared
+// as-is, see `TokenProvenanceCommitment`.
+type TokenProvenance struct {
+	ChainID       uint16 `json:"chain_id"`
+	TokenIDBytes  []byte `json:"token_id_bytes"`
+	TokenIDString string `json:"token_id_string"`
+
+	// The metadata URI of the token as of the latest transaction.
+	MetadataURI string `json:"metadata_uri"`
+
+	MintBlockHash         string `json:"mint_block_hash"`
+	MintBlockNumberString string `json:"mint_block_number_string"`
+
+	Owners []*TokenProvenanceOwner `json:"owners"`
+
+	Burned                bool   `json:"burned"`
+	BurnBlockHash         string `json:"burn_block_hash,omitempty"`
+	BurnBlockNumberString string `json:"burn_block_number_string,omitempty"`
+
+	// Transactions are the proofs of every token transaction, ordered by
+	// token nonce starting with the mint.
+	Transactions []*BlockTransactionProof `json:"transactions"`
+
+	// The hash of the transactions in this document, see
+	// `HashTokenProvenanceContents`.
+	ContentHash string `json:"content_hash"`
+
+	// The signature of the `TokenProvenanceCommitment` which was applied by
+	// the proof-of-authority validator.
+	SignatureBytes []byte     `json:"signature_bytes"`
+	Validator      *Validator `json:"validator"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TokenProvenanceCommitment is the part of the token provenance which is
+// signed by the validator, the transactions are committed to by their hash.
+type TokenProvenanceCommitment struct {
+	ChainID      uint16 `json:"chain_id"`
+	TokenIDBytes []byte `json:"token_id_bytes"`
+	ContentHash  string `json:"content_hash"`
+}
+
+func (p *TokenProvenance) GetTokenID() *big.Int {
+	return new(big.Int).SetBytes(p.TokenIDBytes)
+}
+
+// Commitment returns the part of the provenance which is signed by the
+// validator.
+func (p *TokenProvenance) Commitment() *TokenProvenanceCommitment {
+	return &TokenProvenanceCommitment{
+		ChainID:      p.ChainID,
+		TokenIDBytes: p.TokenIDBytes,
+		ContentHash:  p.ContentHash,
+	}
+}
+
+// NewTokenProvenance returns the unsigned provenance of the token from the
+// proofs of its transactions. The proofs are sorted by token nonce and must
+// form an unbroken chain of custody: a mint followed by transfers sent by the
+// previous owner, optionally ending with a burn.
+func NewTokenProvenance(chainID uint16, tokenID *big.Int, proofs []*BlockTransactionProof) (*TokenProvenance, error) {
+	sorted := make([]*BlockTransactionProof, len(proofs))
+	copy(sorted, proofs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Transaction.GetTokenNonce().Cmp(sorted[j].Transaction.GetTokenNonce()) < 0
+	})
+
+	p := &TokenProvenance{
+		ChainID:       chainID,
+		TokenIDBytes:  tokenID.Bytes(),
+		TokenIDString: tokenID.String(),
+		Transactions:  sorted,
+	}
+	if err := p.applyTransactions(); err != nil {
+		return nil, err
+	}
+	contentHash, err := HashTokenProvenanceContents(sorted)
+	if err != nil {
+		return nil, err
+	}
+	p.ContentHash = contentHash
+	return p, nil
+}
+
+// applyTransactions walks the transactions and sets the mint, owners and
+// burn of the provenance.
+func (p *TokenProvenance) applyTransactions() error {
+	if len(p.Transactions) == 0 {
+		return fmt.Errorf("%w: token has no transactions", ErrTokenProvenanceInvalid)
+	}
+
+	p.Owners = make([]*TokenProvenanceOwner, 0, len(p.Transactions))
+	p.Burned = false
+	var previousNonce *big.Int
+	for i, proof := range p.Transactions {
+		if proof == nil || proof.Transaction == nil || proof.Header == nil {
+			return fmt.Errorf("%w: transaction %v is missing", ErrTokenProvenanceInvalid, i)
+		}
+		tx := proof.Transaction
+		if !tx.MovesToken() || tx.ChainID != p.ChainID || proof.Header.ChainID != p.ChainID {
+			return fmt.Errorf("%w: transaction %v is not a token transaction of chain %v", ErrTokenProvenanceInvalid, i, p.ChainID)
+		}
+		if tx.GetTokenID().Cmp(p.GetTokenID()) != 0 {
+			return fmt.Errorf("%w: transaction %v is for token %v", ErrTokenProvenanceInvalid, i, tx.GetTokenID())
+		}
+		if p.Burned {
+			return fmt.Errorf("%w: transaction %v happened after the burn", ErrTokenProvenanceInvalid, i)
+		}
+
+		tokenNonce := tx.GetTokenNonce()
+		event := TokenStateDeltaEvent(&tx.Transaction)
+		if i == 0 {
+			if event != TokenStateDeltaEventMint {
+				return fmt.Errorf("%w: first transaction is not the mint", ErrTokenProvenanceInvalid)
+			}
+			p.MintBlockHash = proof.BlockHash
+			p.MintBlockNumberString = proof.Header.GetNumber().String()
+		} else {
+			if tokenNonce.Cmp(previousNonce) <= 0 {
+				return fmt.Errorf("%w: token nonce %v does not increase", ErrTokenProvenanceInvalid, tokenNonce)
+			}
+			current := p.Owners[len(p.Owners)-1]
+			if tx.From == nil || current.Owner == nil || *tx.From != *current.Owner {
+				return fmt.Errorf("%w: token nonce %v was not sent by the owner", ErrTokenProvenanceInvalid, tokenNonce)
+			}
+			current.DisposedBlockHash = proof.BlockHash
+			current.DisposedBlockNumberString = proof.Header.GetNumber().String()
+			current.DisposedBlockTimeStamp = proof.Header.TimeStamp
+		}
+		previousNonce = tokenNonce
+		p.MetadataURI = tx.TokenMetadataURI
+
+		if event == TokenStateDeltaEventBurn {
+			p.Burned = true
+			p.BurnBlockHash = proof.BlockHash
+			p.BurnBlockNumberString = proof.Header.GetNumber().String()
+			continue
+		}
+		p.Owners = append(p.Owners, &TokenProvenanceOwner{
+			Owner:                     tx.To,
+			AcquiredBlockHash:         proof.BlockHash,
+			AcquiredBlockNumberString: proof.Header.GetNumber().String(),
+			AcquiredBlockTimeStamp:    proof.Header.TimeStamp,
+		})
+	}
+	return nil
+}
+
+// HashTokenProvenanceContents returns the hash of the block hash, index and
+// hash of every transaction in the order given.
+func HashTokenProvenanceContents(proofs []*BlockTransactionProof) (string, error) {
+	contentBytes := make([]byte, 0)
+	for _, proof := range proofs {
+		txHash, err := proof.Transaction.WithoutJSONStrings().Hash()
+		if err != nil {
+			return "", err
+		}
+		contentBytes = append(contentBytes, []byte(proof.BlockHash)...)
+		contentBytes = append(contentBytes, new(big.Int).SetUint64(proof.TransactionIndex).Bytes()...)
+		contentBytes = append(contentBytes, txHash...)
+	}
+	return signature.Hash(contentBytes), nil
+}
+
+// Verify checks every transaction is included in its signed block, that the
+// mint, owners and burn match the transactions and that the document was
+// signed by the validator. Callers must still check the blocks are part of
+// the blockchain they trust.
+func (p *TokenProvenance) Verify(validator *Validator) error {
+	if validator == nil {
+		return fmt.Errorf("%w: missing validator", ErrTokenProvenanceInvalid)
+	}
+	if !validator.Verify(p.SignatureBytes, p.Commitment()) {
+		return fmt.Errorf("%w: signature is invalid", ErrTokenProvenanceInvalid)
+	}
+	for i, proof := range p.Transactions {
+		if proof == nil {
+			return fmt.Errorf("%w: transaction %v is missing", ErrTokenProvenanceInvalid, i)
+		}
+		if err := proof.Verify(); err != nil {
+			return fmt.Errorf("%w: transaction %v: %v", ErrTokenProvenanceInvalid, i, err)
+		}
+	}
+
+	contentHash, err := HashTokenProvenanceContents(p.Transactions)
+	if err != nil {
+		return err
+	}
+	if contentHash != p.ContentHash {
+		return fmt.Errorf("%w: content hash does not match, got %v, exp %v", ErrTokenProvenanceInvalid, contentHash, p.ContentHash)
+	}
+
+	expected, err := NewTokenProvenance(p.ChainID, p.GetTokenID(), p.Transactions)
+	if err != nil {
+		return err
+	}
+	if expected.ContentHash != p.ContentHash {
+		return fmt.Errorf("%w: transactions are not in token nonce order", ErrTokenProvenanceInvalid)
+	}
+	if expected.TokenIDString != p.TokenIDString ||
+		expected.MetadataURI != p.MetadataURI ||
+		expected.MintBlockHash != p.MintBlockHash ||
+		expected.MintBlockNumberString != p.MintBlockNumberString ||
+		expected.Burned != p.Burned ||
+		expected.BurnBlockHash != p.BurnBlockHash ||
+		expected.BurnBlockNumberString != p.BurnBlockNumberString ||
+		signature.Hash(expected.Owners) != signature.Hash(p.Owners) {
+		return fmt.Errorf("%w: chain of custody does not match the transactions", ErrTokenProvenanceInvalid)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/merkle"
+)
+
+func TestTokenProvenance(t *testing.T) {
+	validatorKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	validator := &Validator{
+		ID:             "test",
+		PublicKeyBytes: crypto.FromECDSAPub(&validatorKey.PublicKey),
+	}
+	authority := common.HexToAddress("0x1")
+	alice := common.HexToAddress("0x2")
+	bob := common.HexToAddress("0x3")
+	tokenID := big.NewInt(7)
+
+	newProof := func(t *testing.T, number int64, tx Transaction) *BlockTransactionProof {
+		tx.ChainID = 1
+		tx.Type = TransactionTypeToken
+		tx.TokenIDBytes = tokenID.Bytes()
+		other := Transaction{ChainID: 1, Type: TransactionTypeCoin, NonceBytes: big.NewInt(number).Bytes(), From: &authority, To: &bob, Value: 1}
+		trans := []BlockTransaction{
+			{SignedTransaction: SignedTransaction{Transaction: other}},
+			{SignedTransaction: SignedTransaction{Transaction: tx}},
+		}
+		tree, err := merkle.NewTree(trans)
+		if err != nil {
+			t.Fatalf("failed creating merkle tree: %v", err)
+		}
+		header := &BlockHeader{ChainID: 1, NumberBytes: big.NewInt(number).Bytes(), TimeStamp: uint64(number), TransRoot: tree.RootHex()}
+		headerSig, err := validator.Sign(validatorKey, header)
+		if err != nil {
+			t.Fatalf("failed signing header: %v", err)
+		}
+		proof, err := NewBlockTransactionProof(&BlockData{Hash: header.GetNumber().String(), Header: header, HeaderSignatureBytes: headerSig, Validator: validator, Trans: trans}, 1)
+		if err != nil {
+			t.Fatalf("failed creating proof: %v", err)
+		}
+		return proof
+	}
+
+	mint := newProof(t, 1, Transaction{From: &authority, To: &alice, TokenMetadataURI: "ipfs://a"})
+	transfer := newProof(t, 2, Transaction{From: &alice, To: &bob, TokenMetadataURI: "ipfs://a", TokenNonceBytes: big.NewInt(1).Bytes()})
+	burn := newProof(t, 3, Transaction{From: &bob, To: &TokenBurnAddress, TokenMetadataURI: "ipfs://a", TokenNonceBytes: big.NewInt(2).Bytes()})
+
+	newSignedProvenance := func(t *testing.T, proofs ...*BlockTransactionProof) *TokenProvenance {
+		p, err := NewTokenProvenance(1, tokenID, proofs)
+		if err != nil {
+			t.Fatalf("failed creating provenance: %v", err)
+		}
+		p.Validator = validator
+		p.SignatureBytes, err = validator.Sign(validatorKey, p.Commitment())
+		if err != nil {
+			t.Fatalf("failed signing provenance: %v", err)
+		}
+		return p
+	}
+
+	t.Run("ChainOfCustody", func(t *testing.T) {
+		// The proofs are sorted by token nonce.
+		p := newSignedProvenance(t, burn, mint, transfer)
+		if p.MintBlockHash != "1" || !p.Burned || p.BurnBlockHash != "3" {
+			t.Fatalf("unexpected mint or burn: %+v", p)
+		}
+		if len(p.Owners) != 2 {
+			t.Fatalf("expected 2 owners, got %v", len(p.Owners))
+		}
+		if *p.Owners[0].Owner != alice || p.Owners[0].AcquiredBlockHash != "1" || p.Owners[0].DisposedBlockHash != "2" {
+			t.Fatalf("unexpected first owner: %+v", p.Owners[0])
+		}
+		if *p.Owners[1].Owner != bob || p.Owners[1].AcquiredBlockHash != "2" || p.Owners[1].DisposedBlockHash != "3" {
+			t.Fatalf("unexpected second owner: %+v", p.Owners[1])
+		}
+		if err := p.Verify(validator); err != nil {
+			t.Fatalf("expected valid provenance, got %v", err)
+		}
+	})
+
+	t.Run("CurrentOwner", func(t *testing.T) {
+		p := newSignedProvenance(t, mint, transfer)
+		if p.Burned || len(p.Owners) != 2 || p.Owners[1].DisposedBlockHash != "" {
+			t.Fatalf("unexpected provenance: %+v", p)
+		}
+		if err := p.Verify(validator); err != nil {
+			t.Fatalf("expected valid provenance, got %v", err)
+		}
+	})
+
+	t.Run("MissingMint", func(t *testing.T) {
+		if _, err := NewTokenProvenance(1, tokenID, []*BlockTransactionProof{transfer}); !errors.Is(err, ErrTokenProvenanceInvalid) {
+			t.Fatalf("expected invalid provenance, got %v", err)
+		}
+	})
+
+	t.Run("NotSentByOwner", func(t *testing.T) {
+		// Bob never owned the token before the burn.
+		if _, err := NewTokenProvenance(1, tokenID, []*BlockTransactionProof{mint, burn}); !errors.Is(err, ErrTokenProvenanceInvalid) {
+			t.Fatalf("expected invalid provenance, got %v", err)
+		}
+	})
+
+	t.Run("TamperedOwner", func(t *testing.T) {
+		p := newSignedProvenance(t, mint, transfer)
+		p.Owners[1].Owner = &authority
+		if err := p.Verify(validator); !errors.Is(err, ErrTokenProvenanceInvalid) {
+			t.Fatalf("expected invalid provenance, got %v", err)
+		}
+	})
+
+	t.Run("DroppedTransaction", func(t *testing.T) {
+		p := newSignedProvenance(t, mint, transfer, burn)
+		p.Transactions = p.Transactions[:2]
+		if err := p.Verify(validator); !errors.Is(err, ErrTokenProvenanceInvalid) {
+			t.Fatalf("expected invalid provenance, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxRoyaltyBasisPoints is the royalty of a creator which takes the whole
+// payment, one basis point is 0.01%.
+const MaxRoyaltyBasisPoints uint16 = 10000
+
+// ErrTokenRoyaltyInvalid is returned when the royalty of a token mint
+// transaction is malformed.
+var ErrTokenRoyaltyInvalid = errors.New("token royalty is invalid")
+
+// TokenRoyalty is the payload stored in the `Data` field of a token mint
+// transaction. It records the creator of the token and the share of every
+// coin payment made on a transfer of the token which is paid to the creator.
+type TokenRoyalty struct {
+	Creator     *common.Address `json:"creator"`
+	BasisPoints uint16          `json:"basis_points"`
+}
+
+// Serialize serializes the token royalty into the transaction `Data`.
+func (r *TokenRoyalty) Serialize() ([]byte, error) {
+	dataBytes, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize token royalty: %v", err)
+	}
+	return dataBytes, nil
+}
+
+// Validate verifies the token royalty is well formed.
+func (r *TokenRoyalty) Validate() error {
+	if r.Creator == nil {
+		return fmt.Errorf("%w: missing creator", ErrTokenRoyaltyInvalid)
+	}
+	if r.BasisPoints > MaxRoyaltyBasisPoints {
+		return fmt.Errorf("%w: basis points %v exceed %v", ErrTokenRoyaltyInvalid, r.BasisPoints, MaxRoyaltyBasisPoints)
+	}
+	return nil
+}
+
+// NewTokenRoyaltyFromDeserialize deserializes the token royalty from the
+// `Data` of a token mint transaction. Tokens minted without a royalty have
+// no data and return nil.
+func NewTokenRoyaltyFromDeserialize(data []byte) (*TokenRoyalty, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	royalty := &TokenRoyalty{}
+	if err := json.Unmarshal(data, royalty); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTokenRoyaltyInvalid, err)
+	}
+	if err := royalty.Validate(); err != nil {
+		return nil, err
+	}
+	return royalty, nil
+}
+
+// SplitRoyalty returns the royalty paid to the creator of the token out of a
+// coin payment made on a transfer of the token and the proceeds left for the
+// recipient of the payment. Tokens without a creator never pay a royalty.
+func (tok *Token) SplitRoyalty(payment uint64) (royalty, proceeds uint64) {
+	if tok == nil || tok.Creator == nil || tok.RoyaltyBasisPoints == 0 || payment == 0 {
+		return 0, payment
+	}
+	bps := min(tok.RoyaltyBasisPoints, MaxRoyaltyBasisPoints)
+	amount := new(big.Int).SetUint64(payment)
+	amount.Mul(amount, big.NewInt(int64(bps)))
+	amount.Quo(amount, big.NewInt(int64(MaxRoyaltyBasisPoints)))
+	royalty = amount.Uint64()
+	return royalty, payment - royalty
+}
+
+// SplitCredit returns the coins credited to the recipient and to the royalty
+// recipient out of the `credit` of the block transaction, see `SplitFees`.
+func (tx *BlockTransaction) SplitCredit(credit uint64) (proceeds, royalty uint64) {
+	if tx.RoyaltyRecipient == nil {
+		return credit, 0
+	}
+	royalty = min(tx.Royalty, credit)
+	return credit - royalty, royalty
+}
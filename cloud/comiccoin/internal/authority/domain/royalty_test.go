@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestTokenRoyaltyDeserialize(t *testing.T) {
+	creator := common.HexToAddress("0x1")
+
+	royalty, err := NewTokenRoyaltyFromDeserialize(nil)
+	if err != nil || royalty != nil {
+		t.Fatalf("expected no royalty for empty data, got %v, %v", royalty, err)
+	}
+
+	data, err := (&TokenRoyalty{Creator: &creator, BasisPoints: 250}).Serialize()
+	if err != nil {
+		t.Fatalf("failed serializing royalty: %v", err)
+	}
+	royalty, err = NewTokenRoyaltyFromDeserialize(data)
+	if err != nil {
+		t.Fatalf("expected royalty, got %v", err)
+	}
+	if *royalty.Creator != creator || royalty.BasisPoints != 250 {
+		t.Fatalf("unexpected royalty: %+v", royalty)
+	}
+
+	data, _ = (&TokenRoyalty{Creator: &creator, BasisPoints: MaxRoyaltyBasisPoints + 1}).Serialize()
+	if _, err := NewTokenRoyaltyFromDeserialize(data); !errors.Is(err, ErrTokenRoyaltyInvalid) {
+		t.Fatalf("expected invalid royalty, got %v", err)
+	}
+	data, _ = (&TokenRoyalty{BasisPoints: 250}).Serialize()
+	if _, err := NewTokenRoyaltyFromDeserialize(data); !errors.Is(err, ErrTokenRoyaltyInvalid) {
+		t.Fatalf("expected missing creator to be invalid, got %v", err)
+	}
+}
+
+func TestTokenSplitRoyalty(t *testing.T) {
+	creator := common.HexToAddress("0x1")
+	tests := []struct {
+		name              string
+		token             *Token
+		payment           uint64
+		royalty, proceeds uint64
+	}{
+		{"no token", nil, 100, 0, 100},
+		{"no creator", &Token{RoyaltyBasisPoints: 500}, 100, 0, 100},
+		{"five percent", &Token{Creator: &creator, RoyaltyBasisPoints: 500}, 100, 5, 95},
+		{"rounds down", &Token{Creator: &creator, RoyaltyBasisPoints: 500}, 19, 0, 19},
+		{"whole payment", &Token{Creator: &creator, RoyaltyBasisPoints: MaxRoyaltyBasisPoints}, 100, 100, 0},
+		{"no overflow", &Token{Creator: &creator, RoyaltyBasisPoints: 5000}, 1 << 63, 1 << 62, 1 << 62},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			royalty, proceeds := tt.token.SplitRoyalty(tt.payment)
+			if royalty != tt.royalty || proceeds != tt.proceeds {
+				t.Fatalf("expected %v/%v, got %v/%v", tt.royalty, tt.proceeds, royalty, proceeds)
+			}
+		})
+	}
+}
+
+func TestStateDeltaReplayerRoyalty(t *testing.T) {
+	authority := common.HexToAddress("0x1")
+	seller := common.HexToAddress("0x2")
+	buyer := common.HexToAddress("0x3")
+	creator := common.HexToAddress("0x4")
+
+	replayer := NewStateDeltaReplayer()
+	replayer.Apply(&BlockData{
+		Header: &BlockHeader{NumberBytes: []byte{}, Beneficiary: authority, TransactionFee: 1},
+		Trans: []BlockTransaction{
+			{SignedTransaction: SignedTransaction{Transaction: Transaction{Type: TransactionTypeCoin, To: &seller, Value: 100}}},
+		},
+	})
+	deltas := replayer.Apply(&BlockData{
+		Header: &BlockHeader{NumberBytes: []byte{1}, Beneficiary: authority, TransactionFee: 1},
+		Trans: []BlockTransaction{
+			{
+				SignedTransaction: SignedTransaction{Transaction: Transaction{Type: TransactionTypeToken, From: &seller, To: &buyer, Value: 40, Fee: 2, TokenNonceBytes: []byte{1}}},
+				Fee:               2,
+				RoyaltyRecipient:  &creator,
+				Royalty:           4,
+			},
+		},
+	})
+
+	balances := make(map[common.Address]uint64)
+	for _, delta := range deltas {
+		balances[*delta.Address] = delta.Balance
+	}
+	expected := map[common.Address]uint64{authority: 2, seller: 58, buyer: 36, creator: 4}
+	for address, balance := range expected {
+		if balances[address] != balance {
+			t.Fatalf("expected %v to have %v, got %v", address.Hex(), balance, balances[address])
+		}
+	}
+}
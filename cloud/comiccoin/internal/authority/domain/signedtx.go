@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/fxamacker/cbor/v2"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
 )
 
 // SignedTransaction is a signed version of the transaction. This is how
@@ -26,6 +30,12 @@ type SignedTransaction struct {
 	VBytes []byte `bson:"v_bytes,omitempty" json:"v_bytes"`  // Ethereum: Recovery identifier, either 29 or 30 with comicCoinID.
 	RBytes []byte `bson:"r_bytes,omitempty"  json:"r_bytes"` // Ethereum: First coordinate of the ECDSA signature.
 	SBytes []byte `bson:"s_bytes,omitempty"  json:"s_bytes"` // Ethereum: Second coordinate of the ECDSA signature.
+
+	// The signature of the `To` account which accepts a `swap` transaction,
+	// empty for every other transaction so their hash does not change.
+	CounterpartyVBytes []byte `bson:"counterparty_v_bytes,omitempty" json:"counterparty_v_bytes,omitempty"`
+	CounterpartyRBytes []byte `bson:"counterparty_r_bytes,omitempty" json:"counterparty_r_bytes,omitempty"`
+	CounterpartySBytes []byte `bson:"counterparty_s_bytes,omitempty" json:"counterparty_s_bytes,omitempty"`
 }
 
 // SetBigIntFields allows setting *big.Int values to []byte fields for MongoDB storage.
@@ -82,10 +92,42 @@ func (stx SignedTransaction) Validate(chainID uint16, isPoA bool) error {
 		return errors.New("signature address doesn't match from address")
 	}
 
+	// A swap must also be signed by the account paying for the token.
+	if stx.Type == TransactionTypeSwap {
+		if err := stx.ValidateSwap(); err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("domain/signedtx.go -> Validate() -> === Transaction Validation Complete ===\n\n")
 	return nil
 }
 
+// TransactionHash returns the unique hash which identifies this signed
+// transaction, clients can compute it before submitting and use it to look up
+// the receipt afterwords. The hash covers the signed message and the signature
+// so two different transactions will never share the same hash.
+func (stx SignedTransaction) TransactionHash() (string, error) {
+	// DEVELOPERS NOTE:
+	// The `*_string` fields are read-only API responses which are not always
+	// populated, therefore we must exclude them so the same transaction always
+	// hashes the same way on the client and in our database.
+	tx := stx.Transaction
+	tx.NonceString = ""
+	tx.DataString = ""
+	tx.TokenIDString = ""
+	tx.TokenNonceString = ""
+
+	msgHash, err := tx.HashWithComicCoinStamp()
+	if err != nil {
+		return "", fmt.Errorf("failed to hash signed transaction: %v", err)
+	}
+	v, r, s := stx.GetBigIntFields()
+	sig := signature.ToSignatureBytesWithComicCoinID(v, r, s)
+
+	return hexutil.Encode(crypto.Keccak256(msgHash, sig)), nil
+}
+
 func (stx *SignedTransaction) Serialize() ([]byte, error) {
 	dataBytes, err := cbor.Marshal(stx)
 	if err != nil {
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSignedTransactionHash(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	from := crypto.PubkeyToAddress(privateKey.PublicKey)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	tx := Transaction{
+		ChainID:    1,
+		NonceBytes: big.NewInt(1).Bytes(),
+		From:       &from,
+		To:         &to,
+		Value:      10,
+		Type:       TransactionTypeCoin,
+	}
+	stx, err := tx.Sign(privateKey)
+	if err != nil {
+		t.Fatalf("failed signing transaction: %v", err)
+	}
+
+	hash, err := stx.TransactionHash()
+	if err != nil {
+		t.Fatalf("expected TransactionHash to succeed, got error %v", err)
+	}
+	if len(hash) != 66 {
+		t.Errorf("expected hash to be 32 bytes hex encoded with 0x prefix, got %v", hash)
+	}
+
+	t.Run("IgnoresReadOnlyStrings", func(t *testing.T) {
+		withStrings := stx
+		withStrings.NonceString = "1"
+		withStrings.TokenIDString = "0"
+		got, err := withStrings.TransactionHash()
+		if err != nil {
+			t.Fatalf("expected TransactionHash to succeed, got error %v", err)
+		}
+		if got != hash {
+			t.Errorf("expected hash %v, got %v", hash, got)
+		}
+	})
+
+	t.Run("ChangesWithTransaction", func(t *testing.T) {
+		other := tx
+		other.NonceBytes = big.NewInt(2).Bytes()
+		otherStx, err := other.Sign(privateKey)
+		if err != nil {
+			t.Fatalf("failed signing transaction: %v", err)
+		}
+		got, err := otherStx.TransactionHash()
+		if err != nil {
+			t.Fatalf("expected TransactionHash to succeed, got error %v", err)
+		}
+		if got == hash {
+			t.Errorf("expected different transactions to have different hashes")
+		}
+	})
+}
@@ -0,0 +1,289 @@
+package domain
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The `Account` and `Token` records only hold the latest state, therefore
+// every block also records a state delta: the state of every account and
+// token it changed, right after the block was applied. Looking up the
+// balance of an account at block N is then finding its latest delta at or
+// before block N.
+//
+// DEVELOPERS NOTE:
+// Unlike the `NumberBytes` of the block header, the block number of a delta
+// is saved as an integer so the deltas can be range queried by block number.
+
+const (
+	// TokenStateDeltaEventMint is recorded when the token was created.
+	TokenStateDeltaEventMint = "mint"
+
+	// TokenStateDeltaEventTransfer is recorded when the token changed owner.
+	TokenStateDeltaEventTransfer = "transfer"
+
+	// TokenStateDeltaEventBurn is recorded when the token was sent to the
+	// burn address.
+	TokenStateDeltaEventBurn = "burn"
+)
+
+// TokenBurnAddress is the owner of every burned token.
+var TokenBurnAddress = common.HexToAddress("0x0000000000000000000000000000000000000000")
+
+// AccountStateDelta is the state of an account after a block changed it.
+type AccountStateDelta struct {
+	ChainID        uint16          `bson:"chain_id" json:"chain_id"`
+	Address        *common.Address `bson:"address" json:"address"`
+	BlockNumber    uint64          `bson:"block_number" json:"block_number"`
+	BlockHash      string          `bson:"block_hash" json:"block_hash"`
+	BlockTimeStamp uint64          `bson:"block_timestamp" json:"block_timestamp"`
+	Balance        uint64          `bson:"balance" json:"balance"`
+	NonceBytes     []byte          `bson:"nonce_bytes" json:"nonce_bytes"`
+	NonceString    string          `bson:"-" json:"nonce_string"` // Read-only response in string format - will not be saved in database, only returned via API.
+}
+
+// GetNonce returns the nonce of the account after the block.
+func (d *AccountStateDelta) GetNonce() *big.Int {
+	return new(big.Int).SetBytes(d.NonceBytes)
+}
+
+// TokenStateDelta is the state of a token after a transaction of a block
+// changed it.
+type TokenStateDelta struct {
+	ChainID          uint16          `bson:"chain_id" json:"chain_id"`
+	TokenIDBytes     []byte          `bson:"token_id_bytes" json:"-"`
+	TokenIDString    string          `bson:"-" json:"token_id_string"`
+	BlockNumber      uint64          `bson:"block_number" json:"block_number"`
+	BlockHash        string          `bson:"block_hash" json:"block_hash"`
+	BlockTimeStamp   uint64          `bson:"block_timestamp" json:"block_timestamp"`
+	TransactionIndex uint64          `bson:"transaction_index" json:"transaction_index"`
+	Event            string          `bson:"event" json:"event"`
+	From             *common.Address `bson:"from" json:"from"`
+	Owner            *common.Address `bson:"owner" json:"owner"`
+	MetadataURI      string          `bson:"metadata_uri" json:"metadata_uri"`
+	TokenNonceBytes  []byte          `bson:"token_nonce_bytes" json:"-"`
+	TokenNonceString string          `bson:"-" json:"token_nonce_string"`
+}
+
+// GetTokenID returns the ID of the token.
+func (d *TokenStateDelta) GetTokenID() *big.Int {
+	return new(big.Int).SetBytes(d.TokenIDBytes)
+}
+
+// GetTokenNonce returns the nonce of the token after the transaction.
+func (d *TokenStateDelta) GetTokenNonce() *big.Int {
+	return new(big.Int).SetBytes(d.TokenNonceBytes)
+}
+
+// AccountStateDeltaRepository interface defines the methods for interacting
+// with the account state deltas.
+type AccountStateDeltaRepository interface {
+	// Upsert inserts or updates the delta of the account for the block.
+	Upsert(ctx context.Context, delta *AccountStateDelta) error
+
+	// GetLatestAtBlockNumber returns the latest delta of the account at or
+	// before the block number, or nil if the account did not exist yet.
+	GetLatestAtBlockNumber(ctx context.Context, chainID uint16, address *common.Address, blockNumber uint64) (*AccountStateDelta, error)
+
+	// ExistsAtBlockNumber returns true if any delta was recorded for the block.
+	ExistsAtBlockNumber(ctx context.Context, chainID uint16, blockNumber uint64) (bool, error)
+}
+
+// TokenStateDeltaRepository interface defines the methods for interacting
+// with the token state deltas.
+type TokenStateDeltaRepository interface {
+	// Upsert inserts or updates the delta of the token for the transaction.
+	Upsert(ctx context.Context, delta *TokenStateDelta) error
+
+	// ListByTokenID returns every delta of the token, oldest first.
+	ListByTokenID(ctx context.Context, chainID uint16, tokenID *big.Int) ([]*TokenStateDelta, error)
+}
+
+// TokenStateDeltaEvent returns what the token or swap transaction did to the
+// token.
+func TokenStateDeltaEvent(tx *Transaction) string {
+	if tx.GetTokenNonce().Sign() == 0 {
+		return TokenStateDeltaEventMint
+	}
+	if tx.To == nil || *tx.To == TokenBurnAddress {
+		return TokenStateDeltaEventBurn
+	}
+	return TokenStateDeltaEventTransfer
+}
+
+// NewTokenStateDeltas returns the delta of every token and swap transaction
+// in the block.
+func NewTokenStateDeltas(blockData *BlockData) []*TokenStateDelta {
+	deltas := make([]*TokenStateDelta, 0)
+	for i, blockTx := range blockData.Trans {
+		if !blockTx.MovesToken() {
+			continue
+		}
+		deltas = append(deltas, &TokenStateDelta{
+			ChainID:          blockData.Header.ChainID,
+			TokenIDBytes:     blockTx.TokenIDBytes,
+			BlockNumber:      blockData.Header.GetNumber().Uint64(),
+			BlockHash:        blockData.Hash,
+			BlockTimeStamp:   blockData.Header.TimeStamp,
+			TransactionIndex: uint64(i),
+			Event:            TokenStateDeltaEvent(&blockTx.Transaction),
+			From:             blockTx.From,
+			Owner:            blockTx.To,
+			MetadataURI:      blockTx.TokenMetadataURI,
+			TokenNonceBytes:  blockTx.TokenNonceBytes,
+		})
+	}
+	return deltas
+}
+
+// StateDeltaAddresses returns the address of every account the block
+// changed, sorted so the deltas are always saved in the same order.
+func StateDeltaAddresses(blockData *BlockData) []*common.Address {
+	seen := make(map[common.Address]bool)
+	addresses := make([]*common.Address, 0)
+	add := func(address *common.Address) {
+		if address != nil && !seen[*address] {
+			seen[*address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	for _, blockTx := range blockData.Trans {
+		add(blockTx.From)
+		if blockTx.PaysFee() {
+			add(blockTx.To)
+			if blockTx.Royalty > 0 {
+				add(blockTx.RoyaltyRecipient)
+			}
+			// Legacy blocks change the nonce of the beneficiary even if no
+			// fee was collected, see `BlockHeader.HasStrictAccountNonces`.
+			if _, _, fee := blockTx.SplitFees(blockData.Header.TransactionFee); fee > 0 || !blockData.Header.HasStrictAccountNonces() {
+				beneficiary := blockData.Header.Beneficiary
+				add(&beneficiary)
+			}
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return strings.ToLower(addresses[i].Hex()) < strings.ToLower(addresses[j].Hex())
+	})
+	return addresses
+}
+
+// StateDeltaReplayer rebuilds the account state deltas of blocks which were
+// sealed before the deltas were recorded. The blocks must be applied in
+// order starting from the genesis block, applying the same rules as the
+// proof of authority consensus mechanism.
+type StateDeltaReplayer struct {
+	accounts map[common.Address]*AccountStateDelta
+}
+
+// NewStateDeltaReplayer returns a replayer starting from an empty state.
+func NewStateDeltaReplayer() *StateDeltaReplayer {
+	return &StateDeltaReplayer{accounts: make(map[common.Address]*AccountStateDelta)}
+}
+
+// Apply applies the transactions of the block and returns the account deltas
+// of the block.
+func (r *StateDeltaReplayer) Apply(blockData *BlockData) []*AccountStateDelta {
+	isGenesis := blockData.Header.GetNumber().Sign() == 0
+	account := func(address *common.Address) *AccountStateDelta {
+		acc, ok := r.accounts[*address]
+		if !ok {
+			acc = &AccountStateDelta{Address: address}
+			r.accounts[*address] = acc
+		}
+		return acc
+	}
+	incrementNonce := func(acc *AccountStateDelta) {
+		acc.NonceBytes = new(big.Int).Add(acc.GetNonce(), big.NewInt(1)).Bytes()
+	}
+
+	for _, blockTx := range blockData.Trans {
+		// The genesis block creates the initial supply from nothing.
+		if isGenesis {
+			if blockTx.Type == TransactionTypeCoin && blockTx.To != nil {
+				account(blockTx.To).Balance += blockTx.Value
+			} else if blockTx.To != nil {
+				account(blockTx.To)
+			}
+			continue
+		}
+
+		debit, credit, fee := blockTx.SplitFees(blockData.Header.TransactionFee)
+		if blockTx.From != nil {
+			incrementNonce(account(blockTx.From))
+		}
+
+		// DEVELOPERS NOTE:
+		// Legacy blocks also incremented the nonce of an existing receiver of
+		// coins and of the proof of authority account for every transaction.
+		if !blockData.Header.HasStrictAccountNonces() && blockTx.PaysFee() {
+			if blockTx.Type == TransactionTypeCoin && blockTx.To != nil {
+				if acc, ok := r.accounts[*blockTx.To]; ok {
+					incrementNonce(acc)
+				}
+			}
+			beneficiary := blockData.Header.Beneficiary
+			incrementNonce(account(&beneficiary))
+		}
+		if payer := blockTx.Payer(); payer != nil {
+			account(payer).Balance -= debit
+		}
+		if !blockTx.PaysFee() {
+			continue
+		}
+		proceeds, royalty := blockTx.SplitCredit(credit)
+		if payee := blockTx.Payee(); payee != nil {
+			account(payee).Balance += proceeds
+		}
+		if royalty > 0 {
+			account(blockTx.RoyaltyRecipient).Balance += royalty
+		}
+		beneficiary := blockData.Header.Beneficiary
+		account(&beneficiary).Balance += fee
+	}
+
+	deltas := make([]*AccountStateDelta, 0)
+	for _, address := range StateDeltaAddresses(blockData) {
+		acc := account(address)
+		deltas = append(deltas, &AccountStateDelta{
+			ChainID:        blockData.Header.ChainID,
+			Address:        address,
+			BlockNumber:    blockData.Header.GetNumber().Uint64(),
+			BlockHash:      blockData.Hash,
+			BlockTimeStamp: blockData.Header.TimeStamp,
+			Balance:        acc.Balance,
+			NonceBytes:     acc.NonceBytes,
+		})
+	}
+	return deltas
+}
+
+// TokenHistory is the ownership chain of a token, from the mint to the
+// latest transfer or burn.
+type TokenHistory struct {
+	ChainID       uint16             `json:"chain_id"`
+	TokenIDString string             `json:"token_id_string"`
+	Owner         *common.Address    `json:"owner"`
+	Burned        bool               `json:"burned"`
+	Events        []*TokenStateDelta `json:"events"`
+}
+
+// NewTokenHistory returns the history of the token from its deltas, oldest
+// first.
+func NewTokenHistory(chainID uint16, tokenID *big.Int, deltas []*TokenStateDelta) *TokenHistory {
+	history := &TokenHistory{
+		ChainID:       chainID,
+		TokenIDString: tokenID.String(),
+		Events:        deltas,
+	}
+	if len(deltas) > 0 {
+		latest := deltas[len(deltas)-1]
+		history.Owner = latest.Owner
+		history.Burned = latest.Event == TokenStateDeltaEventBurn
+	}
+	return history
+}
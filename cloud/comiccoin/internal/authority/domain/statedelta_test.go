@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestTokenStateDeltaEvent(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	tests := []struct {
+		name     string
+		tx       Transaction
+		expected string
+	}{
+		{"mint", Transaction{To: &owner, TokenNonceBytes: big.NewInt(0).Bytes()}, TokenStateDeltaEventMint},
+		{"transfer", Transaction{To: &owner, TokenNonceBytes: big.NewInt(1).Bytes()}, TokenStateDeltaEventTransfer},
+		{"burn", Transaction{To: &TokenBurnAddress, TokenNonceBytes: big.NewInt(2).Bytes()}, TokenStateDeltaEventBurn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if event := TokenStateDeltaEvent(&tt.tx); event != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, event)
+			}
+		})
+	}
+}
+
+func TestStateDeltaReplayer(t *testing.T) {
+	authority := common.HexToAddress("0x1")
+	alice := common.HexToAddress("0x2")
+	newBlock := func(number int64, trans ...Transaction) *BlockData {
+		blockTxs := make([]BlockTransaction, 0, len(trans))
+		for _, tx := range trans {
+			blockTxs = append(blockTxs, BlockTransaction{SignedTransaction: SignedTransaction{Transaction: tx}})
+		}
+		return &BlockData{
+			Hash: big.NewInt(number).String(),
+			Header: &BlockHeader{
+				NumberBytes:    big.NewInt(number).Bytes(),
+				Beneficiary:    authority,
+				TransactionFee: 1,
+				Version:        BlockHeaderVersion,
+			},
+			Trans: blockTxs,
+		}
+	}
+
+	replayer := NewStateDeltaReplayer()
+	genesis := replayer.Apply(newBlock(0,
+		Transaction{Type: TransactionTypeCoin, From: &authority, To: &authority, Value: 100},
+		Transaction{Type: TransactionTypeToken, From: &authority, To: &authority},
+	))
+	if len(genesis) != 1 || genesis[0].Balance != 100 || genesis[0].GetNonce().Sign() != 0 {
+		t.Fatalf("unexpected genesis deltas: %+v", genesis)
+	}
+
+	deltas := replayer.Apply(newBlock(1,
+		Transaction{Type: TransactionTypeCoin, From: &authority, To: &alice, Value: 10},
+		Transaction{Type: TransactionTypeCoin, From: &alice, To: &authority, Value: 2, Fee: 3},
+	))
+	if len(deltas) != 2 {
+		t.Fatalf("expected 2 deltas, got %v", len(deltas))
+	}
+	// Authority: 100 - 10 + 1 (legacy fee) + 2 (value) + 3 (fee).
+	if deltas[0].Address.Hex() != authority.Hex() || deltas[0].Balance != 96 || deltas[0].GetNonce().Uint64() != 1 {
+		t.Fatalf("unexpected authority delta: %+v", deltas[0])
+	}
+	// Alice: 10 - 1 (legacy fee) - 2 (value) - 3 (fee).
+	if deltas[1].Address.Hex() != alice.Hex() || deltas[1].Balance != 4 || deltas[1].GetNonce().Uint64() != 1 {
+		t.Fatalf("unexpected alice delta: %+v", deltas[1])
+	}
+	if deltas[1].BlockNumber != 1 || deltas[1].BlockHash != "1" {
+		t.Fatalf("unexpected block of delta: %+v", deltas[1])
+	}
+}
+
+func TestStateDeltaReplayerLegacyNonces(t *testing.T) {
+	authority := common.HexToAddress("0x1")
+	alice := common.HexToAddress("0x2")
+	newLegacyBlock := func(number int64, trans ...Transaction) *BlockData {
+		blockTxs := make([]BlockTransaction, 0, len(trans))
+		for _, tx := range trans {
+			blockTxs = append(blockTxs, BlockTransaction{SignedTransaction: SignedTransaction{Transaction: tx}})
+		}
+		return &BlockData{
+			Hash: big.NewInt(number).String(),
+			Header: &BlockHeader{
+				NumberBytes:    big.NewInt(number).Bytes(),
+				Beneficiary:    authority,
+				TransactionFee: 1,
+			},
+			Trans: blockTxs,
+		}
+	}
+
+	replayer := NewStateDeltaReplayer()
+	replayer.Apply(newLegacyBlock(0,
+		Transaction{Type: TransactionTypeCoin, From: &authority, To: &authority, Value: 100},
+	))
+	deltas := replayer.Apply(newLegacyBlock(1,
+		Transaction{Type: TransactionTypeCoin, From: &authority, To: &alice, Value: 10},
+		Transaction{Type: TransactionTypeCoin, From: &alice, To: &authority, Value: 2},
+		Transaction{Type: TransactionTypeToken, From: &alice, To: &authority, Value: 1},
+	))
+	if len(deltas) != 2 {
+		t.Fatalf("expected 2 deltas, got %v", len(deltas))
+	}
+	// Authority: sent one transaction, received coins once while it existed
+	// and collected three fees.
+	if deltas[0].Address.Hex() != authority.Hex() || deltas[0].Balance != 94 || deltas[0].GetNonce().Uint64() != 5 {
+		t.Fatalf("unexpected authority delta: %+v", deltas[0])
+	}
+	// Alice: the account was created by receiving coins so only the two
+	// transactions sent from the account count.
+	if deltas[1].Address.Hex() != alice.Hex() || deltas[1].Balance != 6 || deltas[1].GetNonce().Uint64() != 2 {
+		t.Fatalf("unexpected alice delta: %+v", deltas[1])
+	}
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+)
+
+// ErrStateSnapshotInvalid is returned when a state snapshot does not match
+// the block it claims to be a snapshot of.
+var ErrStateSnapshotInvalid = errors.New("state snapshot is invalid")
+
+// StateSnapshot is a copy of every account and token of the blockchain right
+// after the block `BlockHash` was applied. New nodes can download the most
+// recent snapshot and only sync the blocks which came afterwards instead of
+// replaying the entire blockchain from the genesis block.
+//
+// The snapshot is committed to by the block header: the accounts trie root is
+// the header `StateRoot` and the tokens trie root is the header `TokensRoot`,
+// see `HashAccountsState` and `HashTokensState`. The validator also signs a
+// hash of the entire snapshot, see `StateSnapshotCommitment`.
+type StateSnapshot struct {
+	ChainID           uint16 `bson:"chain_id" json:"chain_id"`
+	BlockNumberBytes  []byte `bson:"block_number_bytes" json:"block_number_bytes"`
+	BlockNumberString string `bson:"-" json:"block_number_string"` // Read-only response in string format - will not be saved in database, only returned via API.
+	BlockHash         string `bson:"block_hash" json:"block_hash"`
+
+	// Equal to the `StateRoot` of the block header.
+	AccountHashState string `bson:"account_hash_state" json:"account_hash_state"`
+
+	// Equal to the `TokensRoot` of the block header.
+	TokenHashState string `bson:"token_hash_state" json:"token_hash_state"`
+
+	// The hash of every account followed by every token in this snapshot,
+	// see `HashStateSnapshotContents`.
+	ContentHash string `bson:"content_hash" json:"content_hash"`
+
+	Accounts []*Account `bson:"accounts" json:"accounts"`
+	Tokens   []*Token   `bson:"tokens" json:"tokens"`
+
+	// The validators authorised to seal the blocks after this block and
+	// their hash, see `HashValidatorSet`.
+	Validators       []*Validator `bson:"validators" json:"validators"`
+	ValidatorSetHash string       `bson:"validator_set_hash" json:"validator_set_hash"`
+
+	// The signature of the `StateSnapshotCommitment` which was applied by
+	// the proof-of-authority validator.
+	SignatureBytes []byte     `bson:"signature_bytes" json:"signature_bytes"`
+	Validator      *Validator `bson:"validator" json:"validator"`
+
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// StateSnapshotCommitment is the part of the state snapshot which is signed
+// by the validator, the accounts and tokens are committed to by their hashes.
+type StateSnapshotCommitment struct {
+	ChainID          uint16 `json:"chain_id"`
+	BlockNumberBytes []byte `json:"block_number_bytes"`
+	BlockHash        string `json:"block_hash"`
+	AccountHashState string `json:"account_hash_state"`
+	TokenHashState   string `json:"token_hash_state"`
+	ContentHash      string `json:"content_hash"`
+	ValidatorSetHash string `json:"validator_set_hash"`
+}
+
+// StateSnapshotRepository interface defines the methods for storing the
+// state snapshots exported by the authority.
+type StateSnapshotRepository interface {
+	// Upsert inserts or updates the state snapshot for the block.
+	Upsert(ctx context.Context, snapshot *StateSnapshot) error
+
+	// GetLatestByChainID retrieves the most recent state snapshot for the
+	// particular chain.
+	GetLatestByChainID(ctx context.Context, chainID uint16) (*StateSnapshot, error)
+
+	// DeleteAllExceptLatestByChainID deletes every state snapshot of the
+	// particular chain except for the `keep` most recent ones.
+	DeleteAllExceptLatestByChainID(ctx context.Context, chainID uint16, keep int64) error
+}
+
+func (s *StateSnapshot) GetBlockNumber() *big.Int {
+	return new(big.Int).SetBytes(s.BlockNumberBytes)
+}
+
+// Commitment returns the part of the snapshot which is signed by the validator.
+func (s *StateSnapshot) Commitment() *StateSnapshotCommitment {
+	return &StateSnapshotCommitment{
+		ChainID:          s.ChainID,
+		BlockNumberBytes: s.BlockNumberBytes,
+		BlockHash:        s.BlockHash,
+		AccountHashState: s.AccountHashState,
+		TokenHashState:   s.TokenHashState,
+		ContentHash:      s.ContentHash,
+		ValidatorSetHash: s.ValidatorSetHash,
+	}
+}
+
+// HashStateSnapshotContents returns the hash of the accounts, sorted by
+// address, followed by the tokens, sorted by token ID.
+func HashStateSnapshotContents(accounts []*Account, tokens []*Token) (string, error) {
+	sortedAccounts := make([]*Account, len(accounts))
+	copy(sortedAccounts, accounts)
+	sort.Slice(sortedAccounts, func(i, j int) bool {
+		return strings.ToLower(sortedAccounts[i].Address.String()) < strings.ToLower(sortedAccounts[j].Address.String())
+	})
+	sortedTokens := make([]*Token, len(tokens))
+	copy(sortedTokens, tokens)
+	sort.Slice(sortedTokens, func(i, j int) bool {
+		return sortedTokens[i].GetID().Cmp(sortedTokens[j].GetID()) < 0
+	})
+
+	contentBytes := make([]byte, 0)
+	for _, account := range sortedAccounts {
+		accountBytes, err := account.Serialize()
+		if err != nil {
+			return "", err
+		}
+		contentBytes = append(contentBytes, accountBytes...)
+	}
+	for _, tok := range sortedTokens {
+		tokBytes, err := tok.Serialize()
+		if err != nil {
+			return "", err
+		}
+		contentBytes = append(contentBytes, tokBytes...)
+	}
+	return signature.Hash(contentBytes), nil
+}
+
+// Verify checks the snapshot was signed by the validator and that the
+// accounts and tokens in the snapshot match the block header of the block
+// the snapshot was taken at.
+func (s *StateSnapshot) Verify(header *BlockHeader, blockHash string, validator *Validator) error {
+	if header == nil || validator == nil {
+		return fmt.Errorf("%w: missing block header or validator", ErrStateSnapshotInvalid)
+	}
+	if s.BlockHash != blockHash || s.GetBlockNumber().Cmp(header.GetNumber()) != 0 || s.ChainID != header.ChainID {
+		return fmt.Errorf("%w: snapshot was not taken at block %v", ErrStateSnapshotInvalid, blockHash)
+	}
+	if !validator.Verify(s.SignatureBytes, s.Commitment()) {
+		return fmt.Errorf("%w: signature is invalid", ErrStateSnapshotInvalid)
+	}
+
+	accountHashState, err := HashAccountsState(s.Accounts)
+	if err != nil {
+		return err
+	}
+	if accountHashState != s.AccountHashState || accountHashState != header.StateRoot {
+		return fmt.Errorf("%w: accounts do not match block state root, got %v, exp %v", ErrStateSnapshotInvalid, accountHashState, header.StateRoot)
+	}
+	tokenHashState, err := HashTokensState(s.Tokens)
+	if err != nil {
+		return err
+	}
+	if tokenHashState != s.TokenHashState || tokenHashState != header.TokensRoot {
+		return fmt.Errorf("%w: tokens do not match block tokens root, got %v, exp %v", ErrStateSnapshotInvalid, tokenHashState, header.TokensRoot)
+	}
+	contentHash, err := HashStateSnapshotContents(s.Accounts, s.Tokens)
+	if err != nil {
+		return err
+	}
+	if contentHash != s.ContentHash {
+		return fmt.Errorf("%w: content hash does not match, got %v, exp %v", ErrStateSnapshotInvalid, contentHash, s.ContentHash)
+	}
+	validatorSetHash, err := HashValidatorSet(s.Validators)
+	if err != nil {
+		return err
+	}
+	if len(s.Validators) == 0 || validatorSetHash != s.ValidatorSetHash {
+		return fmt.Errorf("%w: validator set hash does not match, got %v, exp %v", ErrStateSnapshotInvalid, validatorSetHash, s.ValidatorSetHash)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// stateSnapshotVector is a state snapshot exported by the Authority, the CLI
+// bootstraps from the same file so both must agree on how it is verified.
+type stateSnapshotVector struct {
+	GenesisValidator *Validator     `json:"genesis_validator"`
+	BlockData        *BlockData     `json:"block_data"`
+	Snapshot         *StateSnapshot `json:"snapshot"`
+}
+
+func TestStateSnapshotVector(t *testing.T) {
+	data, err := os.ReadFile("testdata/state_snapshot_vector.json")
+	if err != nil {
+		t.Fatalf("failed reading state snapshot vector: %v", err)
+	}
+	vector := &stateSnapshotVector{}
+	if err := json.Unmarshal(data, vector); err != nil {
+		t.Fatalf("failed decoding state snapshot vector: %v", err)
+	}
+	snapshot := vector.Snapshot
+
+	if err := snapshot.Verify(vector.BlockData.Header, vector.BlockData.Hash, vector.GenesisValidator); err != nil {
+		t.Fatalf("expected state snapshot to be valid, got %v", err)
+	}
+	if !vector.GenesisValidator.Verify(vector.BlockData.HeaderSignatureBytes, vector.BlockData.Header) {
+		t.Fatalf("expected block header signature to be valid")
+	}
+
+	// The roots must be the roots of the accounts trie and the tokens trie.
+	accountHashState, err := HashAccountsState(snapshot.Accounts)
+	if err != nil {
+		t.Fatalf("failed hashing accounts: %v", err)
+	}
+	if accountHashState != vector.BlockData.Header.StateRoot {
+		t.Fatalf("expected state root %v, got %v", vector.BlockData.Header.StateRoot, accountHashState)
+	}
+	tokenHashState, err := HashTokensState(snapshot.Tokens)
+	if err != nil {
+		t.Fatalf("failed hashing tokens: %v", err)
+	}
+	if tokenHashState != vector.BlockData.Header.TokensRoot {
+		t.Fatalf("expected tokens root %v, got %v", vector.BlockData.Header.TokensRoot, tokenHashState)
+	}
+}
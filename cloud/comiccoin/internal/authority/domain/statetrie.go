@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
+)
+
+// The accounts and the tokens are each stored in a sparse merkle tree (see
+// `smt` package), the roots of these trees are the `StateRoot` and the
+// `TokensRoot` of the block header. Both the Authority and the wallets must
+// compute the keys and leaves the exact same way.
+const (
+	StateTrieAccounts = "accounts"
+	StateTrieTokens   = "tokens"
+)
+
+// StateTrieAccountKey returns the key of the account in the accounts trie.
+func StateTrieAccountKey(address *common.Address) []byte {
+	return smt.Key(address.Bytes())
+}
+
+// StateTrieAccountLeafHash returns the hash of the leaf which stores the
+// account in the accounts trie.
+func StateTrieAccountLeafHash(account *Account) ([]byte, error) {
+	// DEVELOPERS NOTE:
+	// Normalize the nonce so an account loaded from the database serializes
+	// the same as an account which was just created with a nonce of zero.
+	acc := *account
+	acc.NonceBytes = acc.GetNonce().Bytes()
+	accountBytes, err := acc.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return smt.LeafHash(StateTrieAccountKey(acc.Address), accountBytes), nil
+}
+
+// StateTrieTokenKey returns the key of the token in the tokens trie.
+func StateTrieTokenKey(tokenID *big.Int) []byte {
+	return smt.Key(common.LeftPadBytes(tokenID.Bytes(), 32))
+}
+
+// StateTrieTokenLeafHash returns the hash of the leaf which stores the token
+// in the tokens trie.
+func StateTrieTokenLeafHash(token *Token) ([]byte, error) {
+	tok := *token
+	tok.IDBytes = tok.GetID().Bytes()
+	tok.NonceBytes = tok.GetNonce().Bytes()
+	tokBytes, err := tok.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return smt.LeafHash(StateTrieTokenKey(tok.GetID()), tokBytes), nil
+}
+
+// StateTrieRootHex returns the root in the format stored in the block header.
+func StateTrieRootHex(root []byte) string {
+	return hexutil.Encode(root)
+}
+
+// AccountBalanceProof proves the balance and nonce of an account, or that
+// the account does not exist, against the `StateRoot` of a block header
+// signed by the proof of authority. Wallets can use it to verify their
+// balance without downloading the blockchain.
+type AccountBalanceProof struct {
+	// Address is the address of the account being proven.
+	Address *common.Address `json:"address"`
+
+	// Account is the account in the accounts trie or nil if the account
+	// does not exist.
+	Account *Account `json:"account"`
+
+	// Proof is the sparse merkle proof of the account leaf.
+	Proof *smt.Proof `json:"proof"`
+
+	// BlockHash is the unique hash of the block whose state is proven.
+	BlockHash string `json:"block_hash"`
+
+	// Header is the header of the block, the `StateRoot` field is the root
+	// which the proof must resolve to.
+	Header *BlockHeader `json:"header"`
+
+	// The signature of the block's header which was applied by the
+	// proof-of-authority validator.
+	HeaderSignatureBytes []byte `json:"header_signature_bytes"`
+
+	// The proof-of-authority validator whom signed the block header.
+	Validator *Validator `json:"validator"`
+}
+
+// Verify checks the account hashes up to the state root of the block header
+// and that the block header was signed by the validator. Callers must still
+// check the validator is the one they trust.
+func (p *AccountBalanceProof) Verify() error {
+	if p.Header == nil {
+		return errors.New("proof is missing block header")
+	}
+	if p.Address == nil || p.Proof == nil {
+		return errors.New("proof is missing account")
+	}
+	if p.Validator == nil {
+		return errors.New("proof is missing validator")
+	}
+
+	// Step 1: Make sure the proof is for the account.
+	key := StateTrieAccountKey(p.Address)
+	if !bytes.Equal(p.Proof.Key, key) {
+		return errors.New("proof is not for the account address")
+	}
+	var leafHash []byte
+	if p.Account != nil {
+		if p.Account.Address == nil || *p.Account.Address != *p.Address {
+			return errors.New("proof account does not match the address")
+		}
+		var err error
+		if leafHash, err = StateTrieAccountLeafHash(p.Account); err != nil {
+			return fmt.Errorf("failed hashing account: %v", err)
+		}
+	}
+
+	// Step 2: Hash the account up to the state root of the block.
+	stateRoot, err := hexutil.Decode(p.Header.StateRoot)
+	if err != nil {
+		return fmt.Errorf("failed decoding state root: %v", err)
+	}
+	if !p.Proof.Verify(stateRoot, leafHash) {
+		return errors.New("account is not included in block state")
+	}
+
+	// Step 3: Verify the validator signed the block header.
+	header := p.Header.WithoutJSONStrings()
+	if !p.Validator.Verify(p.HeaderSignatureBytes, header) {
+		return errors.New("block header signature is invalid")
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
+)
+
+// testStateTrieStore keeps the nodes of the state trie in memory.
+type testStateTrieStore map[string][]byte
+
+func (s testStateTrieStore) GetNodes(ctx context.Context, ids []string) (map[string][]byte, error) {
+	res := make(map[string][]byte)
+	for _, id := range ids {
+		if hash, ok := s[id]; ok {
+			res[id] = hash
+		}
+	}
+	return res, nil
+}
+
+func (s testStateTrieStore) PutNodes(ctx context.Context, nodes map[string][]byte) error {
+	for id, hash := range nodes {
+		if hash == nil {
+			delete(s, id)
+		} else {
+			s[id] = hash
+		}
+	}
+	return nil
+}
+
+func TestAccountBalanceProofVerify(t *testing.T) {
+	ctx := context.Background()
+	validatorKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	validator := &Validator{
+		ID:             "test",
+		PublicKeyBytes: crypto.FromECDSAPub(&validatorKey.PublicKey),
+	}
+
+	// Build the accounts trie incrementally, like the Authority does.
+	accounts := make([]*Account, 0, 3)
+	tree := smt.New(testStateTrieStore{})
+	var root []byte
+	for i := int64(1); i <= 3; i++ {
+		addr := common.BigToAddress(big.NewInt(i))
+		account := &Account{ChainID: 1, Address: &addr, NonceBytes: big.NewInt(i).Bytes(), Balance: uint64(i * 100)}
+		accounts = append(accounts, account)
+		leafHash, err := StateTrieAccountLeafHash(account)
+		if err != nil {
+			t.Fatalf("failed hashing account: %v", err)
+		}
+		if root, err = tree.Update(ctx, StateTrieAccountKey(&addr), leafHash); err != nil {
+			t.Fatalf("failed updating trie: %v", err)
+		}
+	}
+
+	// The incremental root must match the root built from scratch.
+	stateRoot, err := HashAccountsState(accounts)
+	if err != nil {
+		t.Fatalf("failed hashing accounts state: %v", err)
+	}
+	if stateRoot != StateTrieRootHex(root) {
+		t.Fatalf("incremental root %v does not match accounts state %v", StateTrieRootHex(root), stateRoot)
+	}
+
+	header := &BlockHeader{
+		ChainID:     1,
+		NumberBytes: big.NewInt(1).Bytes(),
+		StateRoot:   stateRoot,
+	}
+	headerSig, err := validator.Sign(validatorKey, header)
+	if err != nil {
+		t.Fatalf("failed signing header: %v", err)
+	}
+
+	newProof := func(t *testing.T, addr *common.Address, account *Account) *AccountBalanceProof {
+		proof, err := tree.Prove(ctx, StateTrieAccountKey(addr))
+		if err != nil {
+			t.Fatalf("failed proving account: %v", err)
+		}
+		return &AccountBalanceProof{
+			Address:              addr,
+			Account:              account,
+			Proof:                proof,
+			Header:               header,
+			HeaderSignatureBytes: headerSig,
+			Validator:            validator,
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		for _, account := range accounts {
+			if err := newProof(t, account.Address, account).Verify(); err != nil {
+				t.Fatalf("expected proof to verify: %v", err)
+			}
+		}
+	})
+
+	t.Run("Absent", func(t *testing.T) {
+		missing := common.BigToAddress(big.NewInt(42))
+		if err := newProof(t, &missing, nil).Verify(); err != nil {
+			t.Fatalf("expected proof of absence to verify: %v", err)
+		}
+	})
+
+	t.Run("TamperedBalance", func(t *testing.T) {
+		tampered := *accounts[0]
+		tampered.Balance++
+		if err := newProof(t, tampered.Address, &tampered).Verify(); err == nil {
+			t.Fatal("expected proof with tampered balance to fail")
+		}
+	})
+
+	t.Run("TamperedHeader", func(t *testing.T) {
+		proof := newProof(t, accounts[0].Address, accounts[0])
+		h := *header
+		h.TimeStamp = 1
+		proof.Header = &h
+		if err := proof.Verify(); err == nil {
+			t.Fatal("expected proof with tampered header to fail")
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+)
+
+// A swap sells the token of the seller, the `From` account, to the buyer,
+// the `To` account, for the `Value` in coins. The seller signs the swap
+// first, this is the offer which gets handed to the buyer, afterwords the
+// buyer co-signs the exact same transaction and submits it. The proof of
+// authority applies the coin payment and the token transfer in the same
+// session so either both happen or neither does.
+//
+// The swap uses the nonce of the seller, therefore the offer stops being
+// valid as soon as the seller sends any other transaction.
+
+// ErrSwapInvalid is returned when a swap transaction is malformed.
+var ErrSwapInvalid = errors.New("swap transaction is invalid")
+
+// ErrSwapNotCoSigned is returned when a swap transaction is missing the
+// signature of the buyer.
+var ErrSwapNotCoSigned = errors.New("swap transaction is not signed by the buyer")
+
+// SetCounterpartyBigIntFields allows setting *big.Int values of the buyer
+// signature to []byte fields for MongoDB storage.
+func (tx *SignedTransaction) SetCounterpartyBigIntFields(v, r, s *big.Int) {
+	tx.CounterpartyVBytes = v.Bytes()
+	tx.CounterpartyRBytes = r.Bytes()
+	tx.CounterpartySBytes = s.Bytes()
+}
+
+// GetCounterpartyBigIntFields retrieves *big.Int values of the buyer
+// signature from []byte fields after loading from MongoDB.
+func (tx *SignedTransaction) GetCounterpartyBigIntFields() (*big.Int, *big.Int, *big.Int) {
+	return new(big.Int).SetBytes(tx.CounterpartyVBytes), new(big.Int).SetBytes(tx.CounterpartyRBytes), new(big.Int).SetBytes(tx.CounterpartySBytes)
+}
+
+// IsCoSigned returns true if the buyer has signed the swap.
+func (stx SignedTransaction) IsCoSigned() bool {
+	return len(stx.CounterpartyVBytes) > 0 && len(stx.CounterpartyRBytes) > 0 && len(stx.CounterpartySBytes) > 0
+}
+
+// CoSign signs the swap offer with the private key of the buyer and returns
+// the swap ready to be submitted.
+func (stx SignedTransaction) CoSign(privateKey *ecdsa.PrivateKey) (SignedTransaction, error) {
+	if stx.Type != TransactionTypeSwap {
+		return SignedTransaction{}, fmt.Errorf("%w: transaction type %v is not a swap", ErrSwapInvalid, stx.Type)
+	}
+	v, r, s, err := signature.Sign(stx.Transaction, privateKey)
+	if err != nil {
+		return SignedTransaction{}, err
+	}
+	stx.SetCounterpartyBigIntFields(v, r, s)
+	return stx, nil
+}
+
+// CounterpartyAddress extracts the account address of the buyer by
+// recovering the public key from the buyer signature.
+func (stx SignedTransaction) CounterpartyAddress() (string, error) {
+	if !stx.IsCoSigned() {
+		return "", ErrSwapNotCoSigned
+	}
+	v, r, s := stx.GetCounterpartyBigIntFields()
+	return signature.FromAddress(stx.Transaction, v, r, s)
+}
+
+// ValidateOffer verifies the swap is well formed and signed by the seller,
+// the buyer signature is not required yet.
+func (stx SignedTransaction) ValidateOffer(chainID uint16) error {
+	if stx.Type != TransactionTypeSwap {
+		return fmt.Errorf("%w: transaction type %v is not a swap", ErrSwapInvalid, stx.Type)
+	}
+	if stx.ChainID != chainID {
+		return fmt.Errorf("%w: invalid chain id, got[%d] exp[%d]", ErrSwapInvalid, stx.ChainID, chainID)
+	}
+	if stx.From == nil || stx.To == nil {
+		return fmt.Errorf("%w: missing seller or buyer", ErrSwapInvalid)
+	}
+	if *stx.From == *stx.To {
+		return fmt.Errorf("%w: seller and buyer are the same account", ErrSwapInvalid)
+	}
+	if *stx.To == TokenBurnAddress {
+		return fmt.Errorf("%w: buyer cannot be the burn address", ErrSwapInvalid)
+	}
+	if stx.Value == 0 {
+		return fmt.Errorf("%w: missing price", ErrSwapInvalid)
+	}
+	if stx.IsTokenNonceZero() {
+		return fmt.Errorf("%w: token must already be minted", ErrSwapInvalid)
+	}
+	address, err := stx.FromAddress()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSwapInvalid, err)
+	}
+	if address != stx.From.Hex() {
+		return fmt.Errorf("%w: signature address doesn't match seller address", ErrSwapInvalid)
+	}
+	return nil
+}
+
+// ValidateSwap verifies the swap is well formed and the buyer signature
+// matches the `To` account. The seller signature is verified by `Validate`.
+func (stx SignedTransaction) ValidateSwap() error {
+	if err := stx.ValidateOffer(stx.ChainID); err != nil {
+		return err
+	}
+	address, err := stx.CounterpartyAddress()
+	if err != nil {
+		return err
+	}
+	if address != stx.To.Hex() {
+		return fmt.Errorf("%w: signature address doesn't match buyer address", ErrSwapInvalid)
+	}
+	return nil
+}
+
+// MovesToken returns true if the transaction changes the owner of a token,
+// either a token transaction or a swap.
+func (tx *Transaction) MovesToken() bool {
+	return tx.Type == TransactionTypeToken || tx.Type == TransactionTypeSwap
+}
+
+// Payer returns the account the `debit` of `SplitFees` is taken from, for
+// a swap this is the buyer and otherwise the sender.
+func (tx *Transaction) Payer() *common.Address {
+	if tx.Type == TransactionTypeSwap {
+		return tx.To
+	}
+	return tx.From
+}
+
+// Payee returns the account the `credit` of `SplitFees` is given to, for a
+// swap this is the seller and otherwise the recipient.
+func (tx *Transaction) Payee() *common.Address {
+	if tx.Type == TransactionTypeSwap {
+		return tx.From
+	}
+	return tx.To
+}
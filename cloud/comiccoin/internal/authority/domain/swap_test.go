@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSwapCoSign(t *testing.T) {
+	sellerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	buyerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	seller := crypto.PubkeyToAddress(sellerKey.PublicKey)
+	buyer := crypto.PubkeyToAddress(buyerKey.PublicKey)
+
+	tx := Transaction{
+		ChainID:          1,
+		NonceBytes:       big.NewInt(1).Bytes(),
+		From:             &seller,
+		To:               &buyer,
+		Value:            50,
+		Type:             TransactionTypeSwap,
+		TokenIDBytes:     big.NewInt(7).Bytes(),
+		TokenMetadataURI: "ipfs://metadata",
+		TokenNonceBytes:  big.NewInt(2).Bytes(),
+		Version:          TransactionVersion,
+		Fee:              1,
+	}
+	offer, err := tx.Sign(sellerKey)
+	if err != nil {
+		t.Fatalf("failed signing offer: %v", err)
+	}
+	if err := offer.ValidateOffer(1); err != nil {
+		t.Fatalf("expected offer to be valid, got %v", err)
+	}
+	if err := offer.Validate(1, true); !errors.Is(err, ErrSwapNotCoSigned) {
+		t.Fatalf("expected %v, got %v", ErrSwapNotCoSigned, err)
+	}
+
+	t.Run("CoSignedByBuyer", func(t *testing.T) {
+		swap, err := offer.CoSign(buyerKey)
+		if err != nil {
+			t.Fatalf("failed co-signing swap: %v", err)
+		}
+		if err := swap.Validate(1, true); err != nil {
+			t.Fatalf("expected swap to be valid, got %v", err)
+		}
+	})
+
+	t.Run("CoSignedByOther", func(t *testing.T) {
+		otherKey, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("failed generating key: %v", err)
+		}
+		swap, err := offer.CoSign(otherKey)
+		if err != nil {
+			t.Fatalf("failed co-signing swap: %v", err)
+		}
+		if err := swap.Validate(1, true); !errors.Is(err, ErrSwapInvalid) {
+			t.Fatalf("expected %v, got %v", ErrSwapInvalid, err)
+		}
+	})
+
+	t.Run("ChangedAfterCoSign", func(t *testing.T) {
+		swap, err := offer.CoSign(buyerKey)
+		if err != nil {
+			t.Fatalf("failed co-signing swap: %v", err)
+		}
+		swap.Value = 1
+		if err := swap.Validate(1, true); err == nil {
+			t.Fatalf("expected changed swap to be invalid")
+		}
+	})
+}
+
+func TestStateDeltaReplayerSwap(t *testing.T) {
+	authority := common.HexToAddress("0x1")
+	seller := common.HexToAddress("0x2")
+	buyer := common.HexToAddress("0x3")
+	creator := common.HexToAddress("0x4")
+
+	replayer := NewStateDeltaReplayer()
+	replayer.Apply(&BlockData{
+		Header: &BlockHeader{NumberBytes: []byte{}, Beneficiary: authority, TransactionFee: 1},
+		Trans: []BlockTransaction{
+			{SignedTransaction: SignedTransaction{Transaction: Transaction{Type: TransactionTypeCoin, To: &buyer, Value: 100}}},
+		},
+	})
+	blockData := &BlockData{
+		Header: &BlockHeader{NumberBytes: []byte{1}, Beneficiary: authority, TransactionFee: 1},
+		Trans: []BlockTransaction{
+			{
+				SignedTransaction: SignedTransaction{Transaction: Transaction{Type: TransactionTypeSwap, From: &seller, To: &buyer, Value: 40, Fee: 2, TokenNonceBytes: []byte{2}}},
+				Fee:               2,
+				RoyaltyRecipient:  &creator,
+				Royalty:           4,
+			},
+		},
+	}
+	deltas := replayer.Apply(blockData)
+
+	balances := make(map[common.Address]uint64)
+	nonces := make(map[common.Address]uint64)
+	for _, delta := range deltas {
+		balances[*delta.Address] = delta.Balance
+		nonces[*delta.Address] = delta.GetNonce().Uint64()
+	}
+	expected := map[common.Address]uint64{authority: 2, seller: 36, buyer: 58, creator: 4}
+	for address, balance := range expected {
+		if balances[address] != balance {
+			t.Fatalf("expected %v to have %v, got %v", address.Hex(), balance, balances[address])
+		}
+	}
+	if nonces[seller] != 1 || nonces[buyer] != 0 {
+		t.Fatalf("expected only the seller nonce to increase, got seller %v and buyer %v", nonces[seller], nonces[buyer])
+	}
+
+	tokenDeltas := NewTokenStateDeltas(blockData)
+	if len(tokenDeltas) != 1 || tokenDeltas[0].Event != TokenStateDeltaEventTransfer || *tokenDeltas[0].Owner != buyer {
+		t.Fatalf("expected the swap to transfer the token to the buyer, got %+v", tokenDeltas)
+	}
+}
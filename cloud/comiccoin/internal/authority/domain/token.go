@@ -7,6 +7,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fxamacker/cbor/v2"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
 )
 
 type Token struct {
@@ -17,6 +19,12 @@ type Token struct {
 	Owner       *common.Address `bson:"owner" json:"owner"`
 	MetadataURI string          `bson:"metadata_uri" json:"metadata_uri"` // ComicCoin: URI pointing to Token metadata file (if this transaciton is an Token).
 	NonceBytes  []byte          `bson:"nonce_bytes" json:"nonce_bytes"`   // ComicCoin: Newly minted tokens always start at zero and for every transaction action afterwords (transfer, burn, etc) this value is increment by 1.
+
+	// The creator and royalty recorded when the token was minted, see
+	// `TokenRoyalty`. Tokens minted without a royalty leave these empty so
+	// they are hashed into the tokens trie exactly as before.
+	Creator            *common.Address `bson:"creator,omitempty" json:"creator,omitempty"`
+	RoyaltyBasisPoints uint16          `bson:"royalty_basis_points,omitempty" json:"royalty_basis_points,omitempty"`
 }
 
 // TokenRepository interface defines the methods for interacting with the token repository.
@@ -41,8 +49,9 @@ type TokenRepository interface {
 	// DeleteByID deletes an token by its ID.
 	DeleteByID(ctx context.Context, id *big.Int) error
 
-	// HashStateByChainID returns a hash based on the contents of the tokens and
-	// their metadata. This is added to each block and checked by peers.
+	// HashStateByChainID returns the root of the tokens trie, which commits to
+	// the contents of the tokens and their metadata. This is added to each
+	// block and checked by peers.
 	HashStateByChainID(ctx context.Context, chainID uint16) (string, error)
 
 	OpenTransaction() error
@@ -114,3 +123,22 @@ func ToTokenIDsArray(toks []*Token) []*big.Int {
 	}
 	return tokIDs
 }
+
+// HashTokensState returns the root of the tokens trie built from scratch
+// from the tokens. This is the value stored in the block header
+// `TokensRoot` field and checked by peers.
+func HashTokensState(tokens []*Token) (string, error) {
+	leaves := make([]smt.Leaf, 0, len(tokens))
+	for _, tok := range tokens {
+		leafHash, err := StateTrieTokenLeafHash(tok)
+		if err != nil {
+			return "", err
+		}
+		leaves = append(leaves, smt.Leaf{Key: StateTrieTokenKey(tok.GetID()), LeafHash: leafHash})
+	}
+	root, err := smt.Root(leaves)
+	if err != nil {
+		return "", err
+	}
+	return StateTrieRootHex(root), nil
+}
@@ -3,6 +3,7 @@ package domain
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -14,8 +15,26 @@ import (
 const (
 	TransactionTypeCoin  = "coin"
 	TransactionTypeToken = "token"
+
+	// TransactionTypeValidator changes the validator set, the `Data` field holds
+	// a `ValidatorSetChange` and only the proof of authority account may send it.
+	TransactionTypeValidator = "validator"
+
+	// TransactionTypeSwap exchanges a token of the `From` account for a coin
+	// payment of the `To` account, both accounts sign the transaction and
+	// the exchange is applied entirely or not at all. See `swap.go`.
+	TransactionTypeSwap = "swap"
 )
 
+// ErrTransactionNonceReplayed is returned when a transaction uses a nonce which
+// the sending account has already used, this happens when the same signed
+// transaction gets submitted more then once.
+var ErrTransactionNonceReplayed = errors.New("transaction nonce already used by account")
+
+// ErrTransactionNonceGap is returned when a transaction skips ahead of the
+// next nonce expected for the sending account.
+var ErrTransactionNonceGap = errors.New("transaction nonce is not the next nonce for account")
+
 // Transaction structure represents a transfer of coins between accounts
 // which have not been added to the blockchain yet and are waiting for the miner
 // to receive and verify. Once  transactions have been veriried
@@ -36,8 +55,20 @@ type Transaction struct {
 	TokenMetadataURI string          `bson:"token_metadata_uri" json:"token_metadata_uri"` // ComicCoin: URI pointing to Token metadata file (if this transaciton is an Token).
 	TokenNonceBytes  []byte          `bson:"token_nonce_bytes" json:"token_nonce_bytes"`   // ComicCoin: For every transaction action (mint, transfer, burn, etc), increment token nonce by value of 1.
 	TokenNonceString string          `bson:"-" json:"token_nonce_string"`                  // Read-only response in string format - will not be saved in database, only returned via API.
+	Version          uint8           `bson:"version,omitempty" json:"version,omitempty"`   // ComicCoin: The signing version, see `signature.VersionCanonical`; zero is the legacy JSON signing.
+	Fee              uint64          `bson:"fee,omitempty" json:"fee,omitempty"`           // ComicCoin: Fee paid to the authority on top of the value, zero means the legacy fee is taken out of the value. See `SplitFees`.
 }
 
+const (
+	// TransactionVersionFee is the canonical signing version which also
+	// signs the `Fee` of the transaction.
+	TransactionVersionFee uint8 = 2
+
+	// TransactionVersion is the signing version of the transactions created
+	// by this build.
+	TransactionVersion = TransactionVersionFee
+)
+
 func (tx *Transaction) GetNonce() *big.Int {
 	return new(big.Int).SetBytes(tx.NonceBytes)
 }
@@ -77,6 +108,54 @@ func (tx *Transaction) SetTokenNonce(n *big.Int) {
 	tx.TokenNonceBytes = n.Bytes()
 }
 
+// SigningVersion returns the signing version of the transaction.
+func (tx Transaction) SigningVersion() uint8 {
+	return tx.Version
+}
+
+// CanonicalFields returns the fields of the transaction in the order they
+// are signed for its signing version. The read-only `_string` fields are
+// never signed.
+//
+//	1: [version, chain_id, nonce, from, to, value, data, type, token_id,
+//	    token_metadata_uri, token_nonce]
+//	2: [version, chain_id, nonce, from, to, value, data, type, token_id,
+//	    token_metadata_uri, token_nonce, fee]
+func (tx Transaction) CanonicalFields() ([]any, error) {
+	fields := []any{
+		tx.ChainID,
+		tx.GetNonce().Bytes(),
+		canonicalAddress(tx.From),
+		canonicalAddress(tx.To),
+		tx.Value,
+		tx.Data,
+		tx.Type,
+		tx.GetTokenID().Bytes(),
+		tx.TokenMetadataURI,
+		tx.GetTokenNonce().Bytes(),
+	}
+	switch tx.Version {
+	case signature.VersionCanonical:
+		// The fee is not signed in this version so it must not be set.
+		if tx.Fee != 0 {
+			return nil, fmt.Errorf("transaction fee requires signing version %d", TransactionVersionFee)
+		}
+		return fields, nil
+	case TransactionVersionFee:
+		return append(fields, tx.Fee), nil
+	default:
+		return nil, fmt.Errorf("%w: %d", signature.ErrUnsupportedVersion, tx.Version)
+	}
+}
+
+// canonicalAddress returns the bytes of the address or nil if missing.
+func canonicalAddress(addr *common.Address) []byte {
+	if addr == nil {
+		return nil
+	}
+	return addr.Bytes()
+}
+
 // Sign function signs the  transaction using the user's private key
 // and returns a signed version of that transaction.
 func (tx Transaction) Sign(privateKey *ecdsa.PrivateKey) (SignedTransaction, error) {
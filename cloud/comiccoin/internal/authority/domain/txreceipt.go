@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// TransactionReceiptStatusPending indicates the transaction was accepted
+	// into our mempool and is waiting to be sealed in a block.
+	TransactionReceiptStatusPending = "pending"
+
+	// TransactionReceiptStatusIncluded indicates the transaction was sealed
+	// in a block on the blockchain.
+	TransactionReceiptStatusIncluded = "included"
+
+	// TransactionReceiptStatusRejected indicates the transaction failed
+	// verification by the consensus mechanism and will never be included.
+	TransactionReceiptStatusRejected = "rejected"
+)
+
+// TransactionReceipt represents the outcome of a signed transaction which was
+// submitted to the authority, it is looked up by the transaction hash.
+type TransactionReceipt struct {
+	// The unique hash of the signed transaction, see `SignedTransaction.TransactionHash`.
+	Hash string `bson:"hash" json:"hash"`
+
+	// The mempool transaction ID returned to the caller on submission.
+	MempoolTransactionID primitive.ObjectID `bson:"mempool_transaction_id" json:"mempool_transaction_id"`
+
+	ChainID           uint16          `bson:"chain_id" json:"chain_id"`
+	From              *common.Address `bson:"from" json:"from"`
+	NonceBytes        []byte          `bson:"nonce_bytes" json:"nonce_bytes"`
+	NonceString       string          `bson:"-" json:"nonce_string"` // Read-only response in string format - will not be saved in database, only returned via API.
+	Status            string          `bson:"status" json:"status"`  // Either `pending`, `included` or `rejected`.
+	RejectionReason   string          `bson:"rejection_reason,omitempty" json:"rejection_reason,omitempty"`
+	BlockNumberBytes  []byte          `bson:"block_number_bytes,omitempty" json:"block_number_bytes,omitempty"`
+	BlockNumberString string          `bson:"-" json:"block_number_string,omitempty"` // Read-only response in string format - will not be saved in database, only returned via API.
+	BlockHash         string          `bson:"block_hash,omitempty" json:"block_hash,omitempty"`
+
+	// The position of the transaction inside `BlockData.Trans` (if included).
+	TransactionIndex uint64 `bson:"transaction_index" json:"transaction_index"`
+
+	CreatedAt  time.Time `bson:"created_at" json:"created_at"`
+	ModifiedAt time.Time `bson:"modified_at" json:"modified_at"`
+}
+
+func (r *TransactionReceipt) GetNonce() *big.Int {
+	return new(big.Int).SetBytes(r.NonceBytes)
+}
+
+func (r *TransactionReceipt) GetBlockNumber() *big.Int {
+	return new(big.Int).SetBytes(r.BlockNumberBytes)
+}
+
+// IsFinal returns true if the receipt will not change status anymore.
+func (r *TransactionReceipt) IsFinal() bool {
+	return r.Status == TransactionReceiptStatusIncluded || r.Status == TransactionReceiptStatusRejected
+}
+
+// TransactionReceiptRepository interface defines the methods for keeping
+// track of the receipts of submitted transactions.
+type TransactionReceiptRepository interface {
+	// Upsert inserts or updates the receipt of a transaction.
+	Upsert(ctx context.Context, receipt *TransactionReceipt) error
+
+	// GetByHash retrieves the receipt of a transaction by the transaction hash.
+	GetByHash(ctx context.Context, hash string) (*TransactionReceipt, error)
+}
@@ -9,6 +9,8 @@ import (
 	"log"
 
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
 )
 
 // Validator represents a trusted validator in the network.
@@ -17,8 +19,11 @@ type Validator struct {
 	PublicKeyBytes []byte `bson:"public_key_bytes" json:"public_key_bytes"`
 }
 
+// Sign signs the value, values which use the canonical signing version (see
+// `signature.CanonicalBytes`) are signed in their canonical encoding and all
+// other values are signed in JSON.
 func (validator *Validator) Sign(privateKey *ecdsa.PrivateKey, value any) ([]byte, error) {
-	data, err := json.Marshal(value)
+	data, err := validatorSigningBytes(value)
 	if err != nil {
 		return nil, err
 	}
@@ -46,9 +51,9 @@ func (validator *Validator) Verify(sig []byte, data any) bool {
 	}
 
 	// Prepare the data for signing.
-	dataBytes, err := json.Marshal(data)
+	dataBytes, err := validatorSigningBytes(data)
 	if err != nil {
-		log.Printf("VALIDATOR: VERIFY FAILED: json.Marshal(value) err %v\n", err)
+		log.Printf("VALIDATOR: VERIFY FAILED: validatorSigningBytes(value) err %v\n", err)
 		return false
 	}
 
@@ -66,6 +71,19 @@ func (validator *Validator) Verify(sig []byte, data any) bool {
 	return ecdsa.VerifyASN1(validatorPubKey, hash[:], sig)
 }
 
+// validatorSigningBytes returns the bytes of the value which the validator
+// signs.
+func validatorSigningBytes(value any) ([]byte, error) {
+	data, version, err := signature.CanonicalBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	if version != signature.VersionLegacy {
+		return data, nil
+	}
+	return json.Marshal(value)
+}
+
 func (validator *Validator) GetPublicKeyECDSA() (*ecdsa.PublicKey, error) {
 	if validator == nil {
 		return nil, fmt.Errorf("validator error: %v", "d.n.e.")
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+)
+
+const (
+	ValidatorSetChangeActionAdd    = "add"
+	ValidatorSetChangeActionRemove = "remove"
+)
+
+// ValidatorFallbackTimeout is how long after the previous block the
+// scheduled validator has to seal the next block, afterwards the turn passes
+// to the next validator in the schedule, see `ScheduledValidatorAt`.
+const ValidatorFallbackTimeout = 30 * time.Second
+
+// ValidatorMaxClockDrift is how far past our local time the timestamp of a
+// block header may be. It is shorter than `ValidatorFallbackTimeout` so a
+// validator cannot take the turn of the scheduled validator by sealing the
+// block with a timestamp in the future.
+const ValidatorMaxClockDrift = 5 * time.Second
+
+// ErrBlockTimeStampInFuture is returned when the timestamp of a block header
+// is more than `ValidatorMaxClockDrift` past our local time.
+var ErrBlockTimeStampInFuture = errors.New("block timestamp is too far in the future")
+
+// ErrNotScheduledValidator is returned when a block was, or is about to be,
+// signed by a validator which is not scheduled to seal the block.
+var ErrNotScheduledValidator = errors.New("validator is not scheduled to seal this block")
+
+// ErrValidatorSetChangeInvalid is returned when a `validator` transaction
+// cannot be applied to the current validator set.
+var ErrValidatorSetChangeInvalid = errors.New("validator set change is invalid")
+
+// ValidatorSetMember represents a validator which is authorised to seal
+// blocks for the particular chain.
+//
+// The validator set is part of the blockchain state: it starts with the
+// validator which signed the genesis block and changes only when a block
+// includes a `validator` transaction sent by the proof of authority account.
+// A change included in block `N` takes effect from block `N+1` onwards.
+type ValidatorSetMember struct {
+	ChainID   uint16    `bson:"chain_id" json:"chain_id"`
+	Validator Validator `bson:",inline" json:"validator"`
+}
+
+// ValidatorSetChange is the payload stored in the `Data` field of a
+// transaction of type `validator`.
+type ValidatorSetChange struct {
+	Action    string     `json:"action"`
+	Validator *Validator `json:"validator"`
+}
+
+// ValidatorSetRepository interface defines the methods for storing the
+// validators which are currently authorised to seal blocks.
+type ValidatorSetRepository interface {
+	// Upsert inserts or updates the validator in the set.
+	Upsert(ctx context.Context, member *ValidatorSetMember) error
+
+	// DeleteByPublicKey removes the validator from the set.
+	DeleteByPublicKey(ctx context.Context, chainID uint16, publicKeyBytes []byte) error
+
+	// ListByChainID retrieves the validator set for the particular chain.
+	ListByChainID(ctx context.Context, chainID uint16) ([]*ValidatorSetMember, error)
+}
+
+// Serialize serializes the validator set change into the transaction `Data`.
+func (c *ValidatorSetChange) Serialize() ([]byte, error) {
+	dataBytes, err := json.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize validator set change: %v", err)
+	}
+	return dataBytes, nil
+}
+
+// NewValidatorSetChangeFromDeserialize deserializes the validator set change
+// from the transaction `Data` and verifies it is well formed.
+func NewValidatorSetChangeFromDeserialize(data []byte) (*ValidatorSetChange, error) {
+	change := &ValidatorSetChange{}
+	if err := json.Unmarshal(data, change); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidatorSetChangeInvalid, err)
+	}
+	if change.Action != ValidatorSetChangeActionAdd && change.Action != ValidatorSetChangeActionRemove {
+		return nil, fmt.Errorf("%w: unsupported action: %v", ErrValidatorSetChangeInvalid, change.Action)
+	}
+	if change.Validator == nil {
+		return nil, fmt.Errorf("%w: missing validator", ErrValidatorSetChangeInvalid)
+	}
+	if _, err := crypto.UnmarshalPubkey(change.Validator.PublicKeyBytes); err != nil {
+		return nil, fmt.Errorf("%w: invalid validator public key: %v", ErrValidatorSetChangeInvalid, err)
+	}
+	return change, nil
+}
+
+// ApplyValidatorSetChange returns the validator set which results from
+// applying the change to the set. The callers slice is not modified.
+func ApplyValidatorSetChange(validators []*Validator, change *ValidatorSetChange) ([]*Validator, error) {
+	index := -1
+	for i, v := range validators {
+		if bytes.Equal(v.PublicKeyBytes, change.Validator.PublicKeyBytes) {
+			index = i
+			break
+		}
+	}
+
+	result := make([]*Validator, 0, len(validators)+1)
+	switch change.Action {
+	case ValidatorSetChangeActionAdd:
+		if index >= 0 {
+			return nil, fmt.Errorf("%w: validator already exists", ErrValidatorSetChangeInvalid)
+		}
+		result = append(result, validators...)
+		result = append(result, change.Validator)
+	case ValidatorSetChangeActionRemove:
+		if index < 0 {
+			return nil, fmt.Errorf("%w: validator does not exist", ErrValidatorSetChangeInvalid)
+		}
+		if len(validators) == 1 {
+			return nil, fmt.Errorf("%w: cannot remove the last validator", ErrValidatorSetChangeInvalid)
+		}
+		result = append(result, validators[:index]...)
+		result = append(result, validators[index+1:]...)
+	default:
+		return nil, fmt.Errorf("%w: unsupported action: %v", ErrValidatorSetChangeInvalid, change.Action)
+	}
+	return result, nil
+}
+
+// HashValidatorSet returns the hash of the validators sorted by public key.
+func HashValidatorSet(validators []*Validator) (string, error) {
+	sorted := sortedValidators(validators)
+	dataBytes, err := json.Marshal(sorted)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize validator set: %v", err)
+	}
+	return signature.Hash(dataBytes), nil
+}
+
+// sortedValidators returns a copy of the validators sorted by public key.
+func sortedValidators(validators []*Validator) []*Validator {
+	sorted := make([]*Validator, len(validators))
+	copy(sorted, validators)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].PublicKeyBytes, sorted[j].PublicKeyBytes) < 0
+	})
+	return sorted
+}
+
+// ScheduledValidator returns the validator which must seal the block with
+// the particular block number. Validators take turns in the order of their
+// public keys so every node computes the same schedule. Returns nil if the
+// validator set is empty.
+//
+// DEVELOPERS NOTE:
+// If the scheduled validator is offline then the blockchain would not
+// advance, so validators seal according to `ScheduledValidatorAt` which
+// hands the turn to the next validator after `ValidatorFallbackTimeout`.
+func ScheduledValidator(validators []*Validator, blockNumber *big.Int) *Validator {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	sorted := sortedValidators(validators)
+	index := new(big.Int).Mod(blockNumber, big.NewInt(int64(len(sorted))))
+	return sorted[index.Int64()]
+}
+
+// ScheduledValidatorAt returns the validator which may seal the block with
+// the particular block number and header timestamp, given the timestamp of
+// the previous block (both in milliseconds). Every `ValidatorFallbackTimeout`
+// which passed since the previous block skips one validator in the schedule,
+// so an offline validator only delays the blockchain instead of halting it.
+func ScheduledValidatorAt(validators []*Validator, blockNumber *big.Int, previousTimeStamp, timeStamp uint64) *Validator {
+	skipped := new(big.Int)
+	if timeStamp > previousTimeStamp {
+		skipped.SetUint64((timeStamp - previousTimeStamp) / uint64(ValidatorFallbackTimeout.Milliseconds()))
+	}
+	return ScheduledValidator(validators, skipped.Add(skipped, blockNumber))
+}
+
+// ValidateBlockTimeStamp verifies the timestamp of the block header is not
+// more than `ValidatorMaxClockDrift` past the time `now`, returns
+// `ErrBlockTimeStampInFuture` otherwise.
+func ValidateBlockTimeStamp(header *BlockHeader, now time.Time) error {
+	maxTimeStamp := uint64(now.Add(ValidatorMaxClockDrift).UnixMilli())
+	if header.TimeStamp > maxTimeStamp {
+		return fmt.Errorf("%w: block %v timestamp %v is after %v", ErrBlockTimeStampInFuture, header.GetNumber(), header.TimeStamp, maxTimeStamp)
+	}
+	return nil
+}
+
+// VerifyScheduledValidator verifies the block was signed by the validator
+// scheduled to seal it, taking into account the fallback to the next
+// validator, returns `ErrNotScheduledValidator` otherwise. Blocks with a
+// timestamp in the future are rejected with `ErrBlockTimeStampInFuture` as
+// the timestamp decides how many validators were skipped.
+func VerifyScheduledValidator(blockData *BlockData, previousTimeStamp uint64, validators []*Validator) error {
+	if blockData.Header == nil || blockData.Validator == nil {
+		return ErrNotScheduledValidator
+	}
+	if err := ValidateBlockTimeStamp(blockData.Header, time.Now()); err != nil {
+		return err
+	}
+	scheduled := ScheduledValidatorAt(validators, blockData.Header.GetNumber(), previousTimeStamp, blockData.Header.TimeStamp)
+	if scheduled == nil || !bytes.Equal(scheduled.PublicKeyBytes, blockData.Validator.PublicKeyBytes) {
+		return ErrNotScheduledValidator
+	}
+	if !scheduled.Verify(blockData.HeaderSignatureBytes, blockData.Header) {
+		return fmt.Errorf("%w: invalid header signature", ErrNotScheduledValidator)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func newTestValidator(t *testing.T, id string) (*Validator, *BlockData) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	validator := &Validator{
+		ID:             id,
+		PublicKeyBytes: crypto.FromECDSAPub(&key.PublicKey),
+	}
+	blockData := &BlockData{
+		Header: &BlockHeader{
+			ChainID:     1,
+			NumberBytes: big.NewInt(0).Bytes(),
+		},
+		Validator: validator,
+	}
+	sig, err := validator.Sign(key, blockData.Header)
+	if err != nil {
+		t.Fatalf("failed signing header: %v", err)
+	}
+	blockData.HeaderSignatureBytes = sig
+	return validator, blockData
+}
+
+func TestScheduledValidatorRoundRobin(t *testing.T) {
+	a, _ := newTestValidator(t, "a")
+	b, _ := newTestValidator(t, "b")
+
+	// The schedule must not depend on the order of the set.
+	for n := int64(0); n < 4; n++ {
+		got1 := ScheduledValidator([]*Validator{a, b}, big.NewInt(n))
+		got2 := ScheduledValidator([]*Validator{b, a}, big.NewInt(n))
+		if got1 != got2 {
+			t.Fatalf("block %d: schedule depends on the order of the set", n)
+		}
+		next := ScheduledValidator([]*Validator{a, b}, big.NewInt(n+1))
+		if got1 == next {
+			t.Fatalf("block %d: same validator scheduled twice in a row", n)
+		}
+	}
+	if ScheduledValidator(nil, big.NewInt(1)) != nil {
+		t.Fatal("expected no validator for an empty set")
+	}
+}
+
+func TestScheduledValidatorAtFallback(t *testing.T) {
+	a, _ := newTestValidator(t, "a")
+	b, _ := newTestValidator(t, "b")
+	c, _ := newTestValidator(t, "c")
+	set := []*Validator{a, b, c}
+
+	previous := uint64(1_700_000_000_000)
+	timeout := uint64(ValidatorFallbackTimeout.Milliseconds())
+	n := big.NewInt(7)
+
+	if got := ScheduledValidatorAt(set, n, previous, previous+timeout-1); got != ScheduledValidator(set, n) {
+		t.Fatal("expected scheduled validator before the timeout")
+	}
+	if got := ScheduledValidatorAt(set, n, previous, previous+timeout); got != ScheduledValidator(set, big.NewInt(8)) {
+		t.Fatal("expected next validator after one timeout")
+	}
+	if got := ScheduledValidatorAt(set, n, previous, previous+2*timeout); got != ScheduledValidator(set, big.NewInt(9)) {
+		t.Fatal("expected validator after next after two timeouts")
+	}
+	if got := ScheduledValidatorAt(set, n, previous, previous-timeout); got != ScheduledValidator(set, n) {
+		t.Fatal("expected scheduled validator when the timestamp is before the previous block")
+	}
+}
+
+func TestVerifyScheduledValidator(t *testing.T) {
+	a, blockDataA := newTestValidator(t, "a")
+	b, blockDataB := newTestValidator(t, "b")
+
+	set := []*Validator{a, b}
+	scheduled, other := blockDataA, blockDataB
+	if ScheduledValidator(set, big.NewInt(0)) == b {
+		scheduled, other = blockDataB, blockDataA
+	}
+	if err := VerifyScheduledValidator(scheduled, 0, set); err != nil {
+		t.Fatalf("expected scheduled validator to be accepted: %v", err)
+	}
+	if err := VerifyScheduledValidator(other, 0, set); !errors.Is(err, ErrNotScheduledValidator) {
+		t.Fatalf("expected ErrNotScheduledValidator but got %v", err)
+	}
+
+	// Tampering with the header must invalidate the signature.
+	scheduled.Header.TimeStamp = 1
+	if err := VerifyScheduledValidator(scheduled, 0, set); !errors.Is(err, ErrNotScheduledValidator) {
+		t.Fatalf("expected ErrNotScheduledValidator but got %v", err)
+	}
+}
+
+func TestVerifyScheduledValidatorFutureTimeStamp(t *testing.T) {
+	keys := make(map[*Validator]*ecdsa.PrivateKey)
+	set := make([]*Validator, 0, 2)
+	for _, id := range []string{"a", "b"} {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("failed generating key: %v", err)
+		}
+		validator := &Validator{ID: id, PublicKeyBytes: crypto.FromECDSAPub(&key.PublicKey)}
+		keys[validator] = key
+		set = append(set, validator)
+	}
+
+	// The validator whose turn only comes after the scheduled validator
+	// missed its turn.
+	number := big.NewInt(5)
+	outOfTurn := ScheduledValidator(set, new(big.Int).Add(number, big.NewInt(1)))
+	seal := func(previousTimeStamp, timeStamp uint64) *BlockData {
+		blockData := &BlockData{
+			Header: &BlockHeader{
+				ChainID:     1,
+				NumberBytes: number.Bytes(),
+				TimeStamp:   timeStamp,
+			},
+			Validator: outOfTurn,
+		}
+		sig, err := outOfTurn.Sign(keys[outOfTurn], blockData.Header)
+		if err != nil {
+			t.Fatalf("failed signing header: %v", err)
+		}
+		blockData.HeaderSignatureBytes = sig
+		return blockData
+	}
+	timeout := uint64(ValidatorFallbackTimeout.Milliseconds())
+	now := uint64(time.Now().UnixMilli())
+
+	// Inflating the timestamp must not hand the turn to the validator.
+	previous := now
+	if err := VerifyScheduledValidator(seal(previous, previous+timeout), previous, set); !errors.Is(err, ErrBlockTimeStampInFuture) {
+		t.Fatalf("expected ErrBlockTimeStampInFuture but got %v", err)
+	}
+
+	// Once the scheduled validator really missed its turn it is accepted.
+	previous = now - timeout
+	if err := VerifyScheduledValidator(seal(previous, now), previous, set); err != nil {
+		t.Fatalf("expected fallback validator to be accepted: %v", err)
+	}
+}
+
+func TestValidateBlockTimeStamp(t *testing.T) {
+	now := time.Now()
+	drift := uint64(ValidatorMaxClockDrift.Milliseconds())
+	header := &BlockHeader{TimeStamp: uint64(now.UnixMilli()) + drift}
+	if err := ValidateBlockTimeStamp(header, now); err != nil {
+		t.Fatalf("expected timestamp within the drift to be accepted: %v", err)
+	}
+	header.TimeStamp++
+	if err := ValidateBlockTimeStamp(header, now); !errors.Is(err, ErrBlockTimeStampInFuture) {
+		t.Fatalf("expected ErrBlockTimeStampInFuture but got %v", err)
+	}
+}
+
+func TestApplyValidatorSetChange(t *testing.T) {
+	a, _ := newTestValidator(t, "a")
+	b, _ := newTestValidator(t, "b")
+
+	set, err := ApplyValidatorSetChange([]*Validator{a}, &ValidatorSetChange{Action: ValidatorSetChangeActionAdd, Validator: b})
+	if err != nil || len(set) != 2 {
+		t.Fatalf("failed adding validator: %v", err)
+	}
+	if _, err := ApplyValidatorSetChange(set, &ValidatorSetChange{Action: ValidatorSetChangeActionAdd, Validator: b}); !errors.Is(err, ErrValidatorSetChangeInvalid) {
+		t.Fatalf("expected duplicate add to fail but got %v", err)
+	}
+	set, err = ApplyValidatorSetChange(set, &ValidatorSetChange{Action: ValidatorSetChangeActionRemove, Validator: a})
+	if err != nil || len(set) != 1 || set[0] != b {
+		t.Fatalf("failed removing validator: %v", err)
+	}
+	if _, err := ApplyValidatorSetChange(set, &ValidatorSetChange{Action: ValidatorSetChangeActionRemove, Validator: b}); !errors.Is(err, ErrValidatorSetChangeInvalid) {
+		t.Fatalf("expected removing the last validator to fail but got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	svc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/account"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetAccountHTTPHandler struct {
+	logger         *slog.Logger
+	service        svc_account.GetAccountService
+	atBlockService svc_account.GetAccountAtBlockService
+}
+
+func NewGetAccountHTTPHandler(
+	logger *slog.Logger,
+	s1 svc_account.GetAccountService,
+	s2 svc_account.GetAccountAtBlockService,
+) *GetAccountHTTPHandler {
+	return &GetAccountHTTPHandler{logger, s1, s2}
+}
+
+func (h *GetAccountHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, addressStr string) {
+	ctx := r.Context()
+
+	if !common.IsHexAddress(addressStr) {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("address", "invalid address"))
+		return
+	}
+	address := common.HexToAddress(addressStr)
+
+	// If a block number was provided then return the state of the account
+	// right after that block was sealed, else the latest state.
+	if atBlockStr := r.URL.Query().Get("at_block"); atBlockStr != "" {
+		atBlock, err := strconv.ParseUint(atBlockStr, 10, 64)
+		if err != nil {
+			httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("at_block", "must be a block number"))
+			return
+		}
+
+		delta, err := h.atBlockService.Execute(ctx, &address, atBlock)
+		if err != nil {
+			httperror.ResponseError(w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(&delta); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		return
+	}
+
+	account, err := h.service.Execute(ctx, &address)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+	if account == nil {
+		httperror.ResponseError(w, httperror.NewForNotFoundWithSingleField("address", "Account does not exist"))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	resp := map[string]any{}
+	resp["chain_id"] = account.ChainID
+	resp["address"] = account.Address
+	resp["balance"] = account.Balance
+	resp["nonce_string"] = account.GetNonce().String()
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
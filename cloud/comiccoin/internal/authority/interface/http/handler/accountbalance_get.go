@@ -12,15 +12,17 @@ import (
 )
 
 type GetAccountBalanceHTTPHandler struct {
-	logger  *slog.Logger
-	service svc_account.GetAccountService
+	logger           *slog.Logger
+	service          svc_account.GetAccountService
+	nextNonceService svc_account.GetAccountNextNonceService
 }
 
 func NewGetAccountBalanceHTTPHandler(
 	logger *slog.Logger,
 	s1 svc_account.GetAccountService,
+	s2 svc_account.GetAccountNextNonceService,
 ) *GetAccountBalanceHTTPHandler {
-	return &GetAccountBalanceHTTPHandler{logger, s1}
+	return &GetAccountBalanceHTTPHandler{logger, s1, s2}
 }
 
 func (h *GetAccountBalanceHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
@@ -44,9 +46,19 @@ func (h *GetAccountBalanceHTTPHandler) Execute(w http.ResponseWriter, r *http.Re
 	}
 
 	if account != nil {
+		// Include the nonce the next transaction from this account must use
+		// so senders signing their own transactions do not get rejected.
+		nextNonce, err := h.nextNonceService.Execute(ctx, &address)
+		if err != nil {
+			httperror.ResponseError(w, err)
+			return
+		}
+
 		w.WriteHeader(http.StatusOK)
-		resp := map[string]uint64{}
+		resp := map[string]any{}
 		resp["balance"] = account.Balance
+		resp["nonce_string"] = account.GetNonce().String()
+		resp["next_nonce_string"] = nextNonce.String()
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
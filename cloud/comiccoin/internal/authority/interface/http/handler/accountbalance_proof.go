@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	svc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/account"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetAccountBalanceProofHTTPHandler struct {
+	logger  *slog.Logger
+	service svc_account.GetAccountBalanceProofService
+}
+
+func NewGetAccountBalanceProofHTTPHandler(
+	logger *slog.Logger,
+	s1 svc_account.GetAccountBalanceProofService,
+) *GetAccountBalanceProofHTTPHandler {
+	return &GetAccountBalanceProofHTTPHandler{logger, s1}
+}
+
+func (h *GetAccountBalanceProofHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, addressStr string) {
+	ctx := r.Context()
+	h.logger.Debug("Account balance proof requested by address")
+
+	if !common.IsHexAddress(addressStr) {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("address", "invalid address"))
+		return
+	}
+	address := common.HexToAddress(addressStr)
+
+	resp, err := h.service.Execute(ctx, &address)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
+)
+
+// authorizeAdministrationAPIKey authenticates the API key in the
+// `Authorization` header of the request against our administration secret
+// key. Only our administrators are allowed to mint tokens and create
+// collections.
+func authorizeAdministrationAPIKey(cfg *config.Configuration, logger *slog.Logger, jwtp jwt.Provider, passp password.Provider, r *http.Request) error {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		logger.Error("Authorization header is missing")
+		return httperror.NewForUnauthorizedWithSingleField("api_key", "Authorization header is missing")
+	}
+
+	// Parse the JWT token
+	apiKey := strings.TrimPrefix(authHeader, "JWT ")
+
+	apiKeyDecoded, err := jwtp.ProcessJWTToken(apiKey)
+	if err != nil {
+		err := httperror.NewForUnauthorizedWithSingleField("api_key", fmt.Sprintf("bad formatting: %v", err))
+		logger.Error("Failed processing JWT token",
+			slog.Any("error", err))
+		return err
+	}
+	apiKeyPayload := strings.Split(apiKeyDecoded, "@")
+	if len(apiKeyPayload) < 2 {
+		logger.Error("api_key - corrupted payload: bad structure")
+		return httperror.NewForUnauthorizedWithSingleField("api_key", "corrupted payload: bad structure")
+	}
+	if apiKeyPayload[0] == "" {
+		logger.Error("api_key - corrupted payload: missing `chain_id`")
+		return httperror.NewForUnauthorizedWithSingleField("api_key", "corrupted payload: missing `chain_id`")
+	}
+	if apiKeyPayload[1] == "" {
+		logger.Error("api_key - corrupted payload: missing `secret`")
+		return httperror.NewForUnauthorizedWithSingleField("api_key", "corrupted payload: missing `secret`")
+	}
+	chainID := apiKeyPayload[0]
+	if chainID != fmt.Sprintf("%v", constants.ComicCoinChainID) {
+		logger.Error("api_key - invalid: `chain_id` does not match mainnet value")
+		return httperror.NewForUnauthorizedWithSingleField("api_key", "invalid: `chain_id` does not match mainnet value")
+	}
+
+	apiKeyPayloadSecure, err := securestring.NewSecureString(apiKeyPayload[1])
+	if err != nil {
+		logger.Error("failed to secure api key payload",
+			slog.Any("apiKeyPayload[1]", apiKeyPayload[1]),
+		)
+		return err
+	}
+	defer apiKeyPayloadSecure.Wipe()
+
+	// Verify the api key secret and project hashed secret match.
+	passwordMatch, _ := passp.ComparePasswordAndHash(apiKeyPayloadSecure, cfg.App.AdministrationSecretKey.String())
+	if passwordMatch == false {
+		logger.Error("password - does not match")
+		return httperror.NewForUnauthorizedWithSingleField("api_key", "unauthorized")
+	}
+	return nil
+}
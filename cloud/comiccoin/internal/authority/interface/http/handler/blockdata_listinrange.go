@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+
+	sv_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/blockdata"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+const (
+	contentTypeNDJSON  = "application/x-ndjson"
+	contentTypeCBORSeq = "application/cbor-seq"
+)
+
+type ListBlockDataInRangeHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_blockdata.ListBlockDataInRangeService
+}
+
+func NewListBlockDataInRangeHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_blockdata.ListBlockDataInRangeService,
+) *ListBlockDataInRangeHTTPHandler {
+	return &ListBlockDataInRangeHTTPHandler{logger, s1}
+}
+
+// Execute streams the block data between the `from` and `to` header numbers
+// (inclusive), one block per record. By default the records are newline
+// delimited JSON, clients may request a CBOR sequence instead by setting the
+// `Accept` header to `application/cbor-seq` or the `format=cbor` parameter.
+// The range is limited per request, clients continue from the block after
+// the last one received.
+func (h *ListBlockDataInRangeHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	e := make(map[string]string)
+	from, ok := new(big.Int).SetString(query.Get("from"), 10)
+	if !ok {
+		e["from"] = "missing or invalid value"
+	}
+	to, ok := new(big.Int).SetString(query.Get("to"), 10)
+	if !ok {
+		e["to"] = "missing or invalid value"
+	}
+	if len(e) != 0 {
+		httperror.ResponseError(w, httperror.NewForBadRequest(&e))
+		return
+	}
+
+	h.logger.Debug("BlockData requested by header number range",
+		slog.String("from", from.String()),
+		slog.String("to", to.String()))
+
+	resp, err := h.service.Execute(ctx, from, to)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	useCBOR := query.Get("format") == "cbor" || strings.Contains(r.Header.Get("Accept"), contentTypeCBORSeq)
+	if useCBOR {
+		w.Header().Set("Content-Type", contentTypeCBORSeq)
+	} else {
+		w.Header().Set("Content-Type", contentTypeNDJSON)
+	}
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	jsonEncoder := json.NewEncoder(w)
+	cborEncoder := cbor.NewEncoder(w)
+	for _, blockData := range resp {
+		if useCBOR {
+			err = cborEncoder.Encode(blockData)
+		} else {
+			err = jsonEncoder.Encode(blockData) // Note: Encode terminates each record with a newline.
+		}
+		if err != nil {
+			// The status was already sent so we can only stop streaming.
+			h.logger.Error("Failed streaming block data",
+				slog.Any("hash", blockData.Hash),
+				slog.Any("error", err))
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
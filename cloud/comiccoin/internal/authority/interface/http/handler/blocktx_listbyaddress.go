@@ -41,8 +41,8 @@ func (h *ListBlockTransactionsByAddressHTTPHandler) Execute(w http.ResponseWrite
 	// Note: Optional field
 	filterByType := query.Get("type")
 	if filterByType != "" {
-		if filterByType != "coin" && filterByType != "token" {
-			err := httperror.NewForNotFoundWithSingleField("type", "Type only accepted options are `coin` or `token`")
+		if filterByType != "coin" && filterByType != "token" && filterByType != "swap" {
+			err := httperror.NewForNotFoundWithSingleField("type", "Type only accepted options are `coin`, `token` or `swap`")
 			httperror.ResponseError(w, err)
 			return
 		}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sv_blocktx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/blocktx"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetBlockTransactionProofHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_blocktx.GetBlockTransactionProofService
+}
+
+func NewGetBlockTransactionProofHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_blocktx.GetBlockTransactionProofService,
+) *GetBlockTransactionProofHTTPHandler {
+	return &GetBlockTransactionProofHTTPHandler{logger, s1}
+}
+
+func (h *GetBlockTransactionProofHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, transactionNonceStr string) {
+	ctx := r.Context()
+	h.logger.Debug("Block transaction proof requested by transaction nonce")
+
+	transactionNonce, ok := new(big.Int).SetString(transactionNonceStr, 10)
+	if !ok {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("nonce", "invalid transaction nonce"))
+		return
+	}
+
+	// Nonces are only unique per account so callers should provide the
+	// account which sent the transaction.
+	var from *common.Address
+	if addressStr := r.URL.Query().Get("address"); addressStr != "" {
+		if !common.IsHexAddress(addressStr) {
+			httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("address", "invalid address"))
+			return
+		}
+		addr := common.HexToAddress(addressStr)
+		from = &addr
+	}
+
+	resp, err := h.service.Execute(ctx, transactionNonce, from)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	sv_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/collection"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+)
+
+type CreateCollectionHTTPHandler struct {
+	config           *config.Configuration
+	logger           *slog.Logger
+	jwtProvider      jwt.Provider
+	passwordProvider password.Provider
+	service          sv_collection.CreateCollectionService
+}
+
+func NewCreateCollectionHTTPHandler(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	jwtp jwt.Provider,
+	passp password.Provider,
+	s1 sv_collection.CreateCollectionService,
+) *CreateCollectionHTTPHandler {
+	return &CreateCollectionHTTPHandler{cfg, logger, jwtp, passp, s1}
+}
+
+type CreateCollectionRequestIDO struct {
+	CreatorAddress  string `json:"creator_address"`
+	Name            string `json:"name"`
+	BaseMetadataURI string `json:"base_metadata_uri"`
+	MaxSupply       uint64 `json:"max_supply"`
+}
+
+func (h *CreateCollectionHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	//
+	// STEP 1:
+	// Authenticate the provided API key
+	//
+
+	if err := authorizeAdministrationAPIKey(h.config, h.logger, h.jwtProvider, h.passwordProvider, r); err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	//
+	// STEP 2:
+	// Unmarshal the payload.
+	//
+
+	ctx := r.Context()
+
+	var req *CreateCollectionRequestIDO
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req == nil {
+		httperror.ResponseError(w, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"))
+		return
+	}
+
+	var creator *common.Address
+	if req.CreatorAddress != "" {
+		addr := common.HexToAddress(strings.ToLower(req.CreatorAddress))
+		creator = &addr
+	}
+
+	//
+	// STEP 3:
+	// Execute in our service.
+	//
+
+	col, err := h.service.Execute(ctx, creator, req.Name, req.BaseMetadataURI, req.MaxSupply)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(&col); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
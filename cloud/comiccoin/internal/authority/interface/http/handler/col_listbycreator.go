@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sv_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/collection"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListCollectionsByCreatorHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_collection.ListCollectionsByCreatorService
+}
+
+func NewListCollectionsByCreatorHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_collection.ListCollectionsByCreatorService,
+) *ListCollectionsByCreatorHTTPHandler {
+	return &ListCollectionsByCreatorHTTPHandler{logger, s1}
+}
+
+func (h *ListCollectionsByCreatorHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	creatorAddressStr := r.URL.Query().Get("creator_address")
+	if creatorAddressStr == "" {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("creator_address", "missing value"))
+		return
+	}
+
+	h.logger.Debug("Collection list by creator requested",
+		slog.Any("creator_address", creatorAddressStr))
+
+	creator := common.HexToAddress(strings.ToLower(creatorAddressStr))
+	resp, err := h.service.Execute(ctx, &creator)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
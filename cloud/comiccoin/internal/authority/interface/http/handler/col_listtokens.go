@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+
+	sv_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/collection"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListCollectionTokensHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_collection.ListCollectionTokensService
+}
+
+func NewListCollectionTokensHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_collection.ListCollectionTokensService,
+) *ListCollectionTokensHTTPHandler {
+	return &ListCollectionTokensHTTPHandler{logger, s1}
+}
+
+func (h *ListCollectionTokensHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, idStr string) {
+	ctx := r.Context()
+	h.logger.Debug("Collection tokens requested", slog.String("id", idStr))
+
+	id, ok := new(big.Int).SetString(idStr, 10)
+	if !ok {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "must be a collection ID"))
+		return
+	}
+
+	toks, err := h.service.Execute(ctx, id)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&toks); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
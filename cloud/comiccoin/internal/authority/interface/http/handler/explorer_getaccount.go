@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sv_explorer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/explorer"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetExplorerAccountHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_explorer.GetExplorerAccountService
+}
+
+func NewGetExplorerAccountHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_explorer.GetExplorerAccountService,
+) *GetExplorerAccountHTTPHandler {
+	return &GetExplorerAccountHTTPHandler{logger, s1}
+}
+
+func (h *GetExplorerAccountHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, addressStr string) {
+	ctx := r.Context()
+	h.logger.Debug("Explorer account requested by address")
+
+	if !common.IsHexAddress(addressStr) {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("address", "invalid address"))
+		return
+	}
+	address := common.HexToAddress(addressStr)
+
+	resp, err := h.service.Execute(ctx, &address)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
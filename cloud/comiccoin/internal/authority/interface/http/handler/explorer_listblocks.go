@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	sv_explorer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/explorer"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// parseExplorerLimit returns the `limit` parameter of the explorer lists,
+// zero if it was not provided.
+func parseExplorerLimit(query url.Values) (int64, error) {
+	limitStr := query.Get("limit")
+	if limitStr == "" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil || limit < 0 {
+		return 0, httperror.NewForBadRequestWithSingleField("limit", "invalid value")
+	}
+	return limit, nil
+}
+
+type ListExplorerBlocksHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_explorer.ListExplorerBlocksService
+}
+
+func NewListExplorerBlocksHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_explorer.ListExplorerBlocksService,
+) *ListExplorerBlocksHTTPHandler {
+	return &ListExplorerBlocksHTTPHandler{logger, s1}
+}
+
+func (h *ListExplorerBlocksHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit, err := parseExplorerLimit(query)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+	h.logger.Debug("Explorer blocks requested",
+		slog.String("cursor", query.Get("cursor")),
+		slog.Int64("limit", limit))
+
+	resp, err := h.service.Execute(ctx, query.Get("cursor"), limit)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	sv_explorer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/explorer"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListExplorerTransactionsHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_explorer.ListExplorerTransactionsService
+}
+
+func NewListExplorerTransactionsHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_explorer.ListExplorerTransactionsService,
+) *ListExplorerTransactionsHTTPHandler {
+	return &ListExplorerTransactionsHTTPHandler{logger, s1}
+}
+
+// Execute lists the transactions from the newest to the oldest. The results
+// may be filtered by the `type`, `address`, `token_id` and the inclusive
+// `timestamp_start` and `timestamp_end` parameters, in unix milliseconds.
+func (h *ListExplorerTransactionsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	e := make(map[string]string)
+	filter := &domain.ExplorerTransactionFilter{
+		Type: query.Get("type"),
+	}
+	if addressStr := query.Get("address"); addressStr != "" {
+		if !common.IsHexAddress(addressStr) {
+			e["address"] = "invalid address"
+		} else {
+			address := common.HexToAddress(addressStr)
+			filter.Address = &address
+		}
+	}
+	if tokenIDStr := query.Get("token_id"); tokenIDStr != "" {
+		tokenID, ok := new(big.Int).SetString(tokenIDStr, 10)
+		if !ok {
+			e["token_id"] = "invalid value"
+		}
+		filter.TokenID = tokenID
+	}
+	if startStr := query.Get("timestamp_start"); startStr != "" {
+		start, err := strconv.ParseUint(startStr, 10, 64)
+		if err != nil {
+			e["timestamp_start"] = "invalid value"
+		}
+		filter.TimeStampStart = start
+	}
+	if endStr := query.Get("timestamp_end"); endStr != "" {
+		end, err := strconv.ParseUint(endStr, 10, 64)
+		if err != nil {
+			e["timestamp_end"] = "invalid value"
+		}
+		filter.TimeStampEnd = end
+	}
+	if cursorStr := query.Get("cursor"); cursorStr != "" {
+		cursor, err := domain.DecodeExplorerTransactionCursor(cursorStr)
+		if err != nil {
+			e["cursor"] = err.Error()
+		}
+		filter.Cursor = cursor
+	}
+	limit, err := parseExplorerLimit(query)
+	if err != nil {
+		e["limit"] = "invalid value"
+	}
+	filter.Limit = limit
+	if len(e) != 0 {
+		httperror.ResponseError(w, httperror.NewForBadRequest(&e))
+		return
+	}
+
+	h.logger.Debug("Explorer transactions requested",
+		slog.Any("filter", filter))
+
+	resp, err := h.service.Execute(ctx, filter)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
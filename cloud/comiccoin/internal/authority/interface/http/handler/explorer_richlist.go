@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	sv_explorer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/explorer"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListExplorerRichListHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_explorer.ListExplorerRichListService
+}
+
+func NewListExplorerRichListHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_explorer.ListExplorerRichListService,
+) *ListExplorerRichListHTTPHandler {
+	return &ListExplorerRichListHTTPHandler{logger, s1}
+}
+
+func (h *ListExplorerRichListHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit, err := parseExplorerLimit(query)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+	h.logger.Debug("Explorer rich list requested",
+		slog.String("cursor", query.Get("cursor")),
+		slog.Int64("limit", limit))
+
+	resp, err := h.service.Execute(ctx, query.Get("cursor"), limit)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
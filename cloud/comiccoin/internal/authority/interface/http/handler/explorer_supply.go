@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	sv_explorer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/explorer"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetExplorerSupplyHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_explorer.GetExplorerSupplyService
+}
+
+func NewGetExplorerSupplyHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_explorer.GetExplorerSupplyService,
+) *GetExplorerSupplyHTTPHandler {
+	return &GetExplorerSupplyHTTPHandler{logger, s1}
+}
+
+func (h *GetExplorerSupplyHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h.logger.Debug("Explorer supply requested")
+
+	resp, err := h.service.Execute(ctx)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
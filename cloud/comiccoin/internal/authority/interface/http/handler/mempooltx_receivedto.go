@@ -38,9 +38,10 @@ func (h *MempoolTransactionReceiveDTOFromNetworkServiceHTTPHandler) Execute(w ht
 	err := json.NewDecoder(r.Body).Decode(&requestData) // [1]
 	if err != nil {
 		httperror.ResponseError(w, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"))
+		return
 	}
 
-	serviceExecErr := h.service.Execute(
+	status, serviceExecErr := h.service.Execute(
 		ctx,
 		requestData,
 	)
@@ -49,5 +50,12 @@ func (h *MempoolTransactionReceiveDTOFromNetworkServiceHTTPHandler) Execute(w ht
 		return
 	}
 
+	// DEVELOPERS NOTE:
+	// We acknowledge right away with the mempool transaction ID, the caller
+	// can use it to poll or stream the final status of their transaction.
 	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(&status); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 }
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	sv_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/mempooltx"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetMempoolTransactionStatusHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_mempooltx.GetMempoolTransactionStatusService
+}
+
+func NewGetMempoolTransactionStatusHTTPHandler(
+	logger *slog.Logger,
+	s sv_mempooltx.GetMempoolTransactionStatusService,
+) *GetMempoolTransactionStatusHTTPHandler {
+	return &GetMempoolTransactionStatusHTTPHandler{logger, s}
+}
+
+func (h *GetMempoolTransactionStatusHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, idStr string) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(idStr)
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "invalid mempool transaction id"))
+		return
+	}
+
+	status, err := h.service.Execute(ctx, id)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&status); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
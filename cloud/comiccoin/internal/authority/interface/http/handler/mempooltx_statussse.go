@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	sv_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/mempooltx"
+)
+
+// MempoolTransactionStatusServerSentEventsHTTPHandler is responsible for
+// streaming to the client the status of their submitted mempool transaction
+// until the transaction was either confirmed or rejected.
+type MempoolTransactionStatusServerSentEventsHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_mempooltx.GetMempoolTransactionStatusService
+}
+
+func NewMempoolTransactionStatusServerSentEventsHTTPHandler(
+	logger *slog.Logger,
+	s sv_mempooltx.GetMempoolTransactionStatusService,
+) *MempoolTransactionStatusServerSentEventsHTTPHandler {
+	return &MempoolTransactionStatusServerSentEventsHTTPHandler{logger, s}
+}
+
+func (h *MempoolTransactionStatusServerSentEventsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, idStr string) {
+	ctx := r.Context()
+	ipAddress, _ := ctx.Value(constants.SessionIPAddress).(string)
+
+	// Set CORS headers to allow all origins. You may want to restrict this to specific origins in a production environment.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Expose-Headers", "Content-Type")
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+
+	id, err := primitive.ObjectIDFromHex(idStr)
+	if err != nil {
+		http.Error(w, "Invalid mempool transaction id", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("Mempool transaction status server sent events connected client",
+		slog.Any("id", id),
+		slog.Any("ip_address", ipAddress))
+
+	// Create a channel for client disconnection
+	clientGone := r.Context().Done()
+
+	// Create ticker that will check the status every second and only send a
+	// SSE to the client when the status changes.
+	t := time.NewTicker(1 * time.Second)
+	defer t.Stop()
+
+	var lastStatus string
+	for {
+		select {
+		case <-clientGone:
+			h.logger.Debug("Client disconnected",
+				slog.Any("id", id),
+				slog.Any("ip_address", ipAddress))
+			return
+		case <-t.C:
+			status, err := h.service.Execute(ctx, id)
+			if err != nil {
+				fmt.Fprintf(w, "event: error\ndata: %v\n\n", err.Error())
+				w.(http.Flusher).Flush()
+				return
+			}
+
+			if status.Status != lastStatus {
+				lastStatus = status.Status
+
+				statusBytes, err := status.Serialize()
+				if err != nil {
+					h.logger.Error("Failed serializing mempool transaction status",
+						slog.Any("error", err))
+					return
+				}
+
+				// Send an event to the client.
+				fmt.Fprintf(w, "data: %s\n\n", statusBytes)
+				w.(http.Flusher).Flush()
+			}
+
+			// Once the transaction was processed there is nothing more to
+			// send so close the stream.
+			if status.IsFinal() {
+				return
+			}
+		}
+	}
+}
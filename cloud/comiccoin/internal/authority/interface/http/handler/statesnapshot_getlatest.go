@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	sv_statesnapshot "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/statesnapshot"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetLatestStateSnapshotHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_statesnapshot.GetLatestStateSnapshotService
+}
+
+func NewGetLatestStateSnapshotHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_statesnapshot.GetLatestStateSnapshotService,
+) *GetLatestStateSnapshotHTTPHandler {
+	return &GetLatestStateSnapshotHTTPHandler{logger, s1}
+}
+
+func (h *GetLatestStateSnapshotHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h.logger.Debug("Latest state snapshot requested")
+
+	snapshot, err := h.service.Execute(ctx)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&snapshot); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+
+	sv_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/token"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetTokenHistoryHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_token.GetTokenHistoryService
+}
+
+func NewGetTokenHistoryHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_token.GetTokenHistoryService,
+) *GetTokenHistoryHTTPHandler {
+	return &GetTokenHistoryHTTPHandler{logger, s1}
+}
+
+func (h *GetTokenHistoryHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, idStr string) {
+	ctx := r.Context()
+	h.logger.Debug("Token history requested", slog.String("id", idStr))
+
+	id, ok := new(big.Int).SetString(idStr, 10)
+	if !ok {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "must be a token ID"))
+		return
+	}
+
+	history, err := h.service.Execute(ctx, id)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&history); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -3,20 +3,19 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log/slog"
+	"math/big"
 	"net/http"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
 	sv_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/token"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
 )
 
 type TokenMintServiceHTTPHandler struct {
@@ -40,9 +39,26 @@ func NewTokenMintServiceHTTPHandler(
 type TokenMintServiceRequestIDO struct {
 	WalletAddress string `json:"wallet_address"`
 	MetadataURI   string `json:"metadata_uri"`
+
+	// Optional: mint the editions into the collection instead of a single
+	// token, editions without a metadata URI use the collection's base
+	// metadata.
+	CollectionID string                               `json:"collection_id,omitempty"`
+	Editions     []*TokenMintServiceEditionRequestIDO `json:"editions,omitempty"`
+
+	// Optional: the creator paid a royalty, in basis points, on every
+	// transfer of the token made with a coin payment. Collection mints
+	// default to the creator of the collection.
+	CreatorAddress     string `json:"creator_address,omitempty"`
+	RoyaltyBasisPoints uint16 `json:"royalty_basis_points,omitempty"`
+}
+
+type TokenMintServiceEditionRequestIDO struct {
+	MetadataURI string `json:"metadata_uri"`
 }
 
 type BlockchainTokenMintServiceResponseIDO struct {
+	Editions []*domain.CollectionEdition `json:"editions,omitempty"`
 }
 
 func (h *TokenMintServiceHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
@@ -51,70 +67,7 @@ func (h *TokenMintServiceHTTPHandler) Execute(w http.ResponseWriter, r *http.Req
 	// Authenticate the provided API key
 	//
 
-	authHeader := r.Header.Get("Authorization")
-	if authHeader == "" {
-		h.logger.Error("Authorization header is missing")
-		http.Error(w, "Authorization header is missing", http.StatusUnauthorized)
-		return
-	}
-
-	// Parse the JWT token
-	apiKey := strings.TrimPrefix(authHeader, "JWT ")
-
-	apiKeyDecoded, err := h.jwtProvider.ProcessJWTToken(apiKey)
-	if err != nil {
-		err := httperror.NewForUnauthorizedWithSingleField("api_key", fmt.Sprintf("bad formatting: %v", err))
-		h.logger.Error("Failed processing JWT token",
-			slog.Any("error", err))
-		httperror.ResponseError(w, err)
-		return
-	}
-	apiKeyPayload := strings.Split(apiKeyDecoded, "@")
-	if len(apiKeyPayload) < 2 {
-		err := httperror.NewForUnauthorizedWithSingleField("api_key", "corrupted payload: bad structure")
-		h.logger.Error("api_key - corrupted payload: bad structure")
-		httperror.ResponseError(w, err)
-		return
-	}
-	if apiKeyPayload[0] == "" {
-		err := httperror.NewForUnauthorizedWithSingleField("api_key", "corrupted payload: missing `chain_id`")
-		h.logger.Error("api_key - corrupted payload: missing `chain_id`")
-		httperror.ResponseError(w, err)
-		return
-	}
-	if apiKeyPayload[1] == "" {
-		err := httperror.NewForUnauthorizedWithSingleField("api_key", "corrupted payload: missing `secret`")
-		h.logger.Error("api_key - corrupted payload: missing `secret`")
-		httperror.ResponseError(w, err)
-		return
-	}
-	chainID := apiKeyPayload[0]
-	if chainID != fmt.Sprintf("%v", constants.ComicCoinChainID) {
-		err := httperror.NewForUnauthorizedWithSingleField("api_key", "invalid: `chain_id` does not match mainnet value")
-		h.logger.Error("api_key - invalid: `chain_id` does not match mainnet value")
-		httperror.ResponseError(w, err)
-		return
-	}
-
-	apiKeyPayloadSecure, err := securestring.NewSecureString(apiKeyPayload[1])
-	if err != nil {
-		h.logger.Error("failed to secure api key payload",
-			slog.Any("apiKeyPayload[1]", apiKeyPayload[1]),
-		)
-		httperror.ResponseError(w, err)
-		return
-	}
-	defer apiKeyPayloadSecure.Wipe()
-
-	// Verify the api key secret and project hashed secret match.
-	passwordMatch, _ := h.passwordProvider.ComparePasswordAndHash(apiKeyPayloadSecure, h.config.App.AdministrationSecretKey.String())
-	if passwordMatch == false {
-		err := httperror.NewForUnauthorizedWithSingleField("api_key", "unauthorized")
-		h.logger.Error("password - does not match")
-		// h.logger.Error("password - does not match",
-		// 	slog.Any("password", apiKeyPayload[1]),
-		// 	slog.Any("encodedHash", h.config.App.AdministrationSecretKey.String()),
-		// )
+	if err := authorizeAdministrationAPIKey(h.config, h.logger, h.jwtProvider, h.passwordProvider, r); err != nil {
 		httperror.ResponseError(w, err)
 		return
 	}
@@ -139,15 +92,58 @@ func (h *TokenMintServiceHTTPHandler) Execute(w http.ResponseWriter, r *http.Req
 		slog.Any("wallet_address", waAddr),
 		slog.Any("metadata_uri", req.MetadataURI))
 
+	var royalty *domain.TokenRoyalty
+	if req.CreatorAddress != "" || req.RoyaltyBasisPoints > 0 {
+		royalty = &domain.TokenRoyalty{BasisPoints: req.RoyaltyBasisPoints}
+		if req.CreatorAddress != "" {
+			creatorAddr := common.HexToAddress(strings.ToLower(req.CreatorAddress))
+			royalty.Creator = &creatorAddr
+		}
+	}
+
 	//
 	// STEP 3:
 	// Execute in our service.
 	//
 
+	if req.CollectionID != "" || len(req.Editions) > 0 {
+		collectionID, ok := new(big.Int).SetString(req.CollectionID, 10)
+		if !ok {
+			httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("collection_id", "invalid value"))
+			return
+		}
+		metadataURIs := make([]string, 0, len(req.Editions))
+		for _, edition := range req.Editions {
+			if edition == nil {
+				httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("editions", "invalid value"))
+				return
+			}
+			metadataURIs = append(metadataURIs, edition.MetadataURI)
+		}
+
+		editions, serviceExecErr := h.service.ExecuteForCollection(ctx, &waAddr, collectionID, metadataURIs, royalty)
+		if serviceExecErr != nil {
+			httperror.ResponseError(w, serviceExecErr)
+			return
+		}
+
+		//
+		// STEP 4: Return results.
+		//
+
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(&BlockchainTokenMintServiceResponseIDO{Editions: editions}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		return
+	}
+
 	tokID, serviceExecErr := h.service.Execute(
 		ctx,
 		&waAddr,
 		req.MetadataURI,
+		royalty,
 	)
 	if serviceExecErr != nil {
 		httperror.ResponseError(w, serviceExecErr)
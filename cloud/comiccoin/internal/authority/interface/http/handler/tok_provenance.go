@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+
+	sv_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/token"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetTokenProvenanceHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_token.GetTokenProvenanceService
+}
+
+func NewGetTokenProvenanceHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_token.GetTokenProvenanceService,
+) *GetTokenProvenanceHTTPHandler {
+	return &GetTokenProvenanceHTTPHandler{logger, s1}
+}
+
+func (h *GetTokenProvenanceHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, idStr string) {
+	ctx := r.Context()
+	h.logger.Debug("Token provenance requested", slog.String("id", idStr))
+
+	id, ok := new(big.Int).SetString(idStr, 10)
+	if !ok {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "must be a token ID"))
+		return
+	}
+
+	provenance, err := h.service.Execute(ctx, id)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&provenance); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
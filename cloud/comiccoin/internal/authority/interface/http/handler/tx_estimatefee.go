@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	sv_tx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/tx"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type EstimateFeeHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_tx.EstimateFeeService
+}
+
+func NewEstimateFeeHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_tx.EstimateFeeService,
+) *EstimateFeeHTTPHandler {
+	return &EstimateFeeHTTPHandler{logger, s1}
+}
+
+func (h *EstimateFeeHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h.logger.Debug("Fee estimate requested")
+
+	estimate, err := h.service.Execute(ctx)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&estimate); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	sv_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/txreceipt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetTransactionReceiptHTTPHandler struct {
+	logger  *slog.Logger
+	service sv_txreceipt.GetTransactionReceiptService
+}
+
+func NewGetTransactionReceiptHTTPHandler(
+	logger *slog.Logger,
+	s1 sv_txreceipt.GetTransactionReceiptService,
+) *GetTransactionReceiptHTTPHandler {
+	return &GetTransactionReceiptHTTPHandler{logger, s1}
+}
+
+func (h *GetTransactionReceiptHTTPHandler) Execute(w http.ResponseWriter, r *http.Request, hash string) {
+	ctx := r.Context()
+	h.logger.Debug("Transaction receipt requested by hash")
+
+	resp, err := h.service.Execute(ctx, hash)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -49,6 +49,26 @@ type httpServerImpl struct {
 	tokenListByOwnerHTTPHandler                                   *handler.TokenListByOwnerHTTPHandler
 	tokenMintServiceHTTPHandler                                   *handler.TokenMintServiceHTTPHandler
 	getAccountBalanceHTTPHandler                                  *handler.GetAccountBalanceHTTPHandler
+	getMempoolTransactionStatusHTTPHandler                        *handler.GetMempoolTransactionStatusHTTPHandler
+	mempoolTransactionStatusServerSentEventsHTTPHandler           *handler.MempoolTransactionStatusServerSentEventsHTTPHandler
+	getTransactionReceiptHTTPHandler                              *handler.GetTransactionReceiptHTTPHandler
+	getBlockTransactionProofHTTPHandler                           *handler.GetBlockTransactionProofHTTPHandler
+	listBlockDataInRangeHTTPHandler                               *handler.ListBlockDataInRangeHTTPHandler
+	getLatestStateSnapshotHTTPHandler                             *handler.GetLatestStateSnapshotHTTPHandler
+	getAccountBalanceProofHTTPHandler                             *handler.GetAccountBalanceProofHTTPHandler
+	estimateFeeHTTPHandler                                        *handler.EstimateFeeHTTPHandler
+	listExplorerBlocksHTTPHandler                                 *handler.ListExplorerBlocksHTTPHandler
+	listExplorerTransactionsHTTPHandler                           *handler.ListExplorerTransactionsHTTPHandler
+	getExplorerAccountHTTPHandler                                 *handler.GetExplorerAccountHTTPHandler
+	listExplorerRichListHTTPHandler                               *handler.ListExplorerRichListHTTPHandler
+	getExplorerSupplyHTTPHandler                                  *handler.GetExplorerSupplyHTTPHandler
+	getAccountHTTPHandler                                         *handler.GetAccountHTTPHandler
+	getTokenHistoryHTTPHandler                                    *handler.GetTokenHistoryHTTPHandler
+	getTokenProvenanceHTTPHandler                                 *handler.GetTokenProvenanceHTTPHandler
+	createCollectionHTTPHandler                                   *handler.CreateCollectionHTTPHandler
+	getCollectionHTTPHandler                                      *handler.GetCollectionHTTPHandler
+	listCollectionsByCreatorHTTPHandler                           *handler.ListCollectionsByCreatorHTTPHandler
+	listCollectionTokensHTTPHandler                               *handler.ListCollectionTokensHTTPHandler
 }
 
 // NewHTTPServer creates a new HTTP server instance.
@@ -74,6 +94,26 @@ func NewHTTPServer(
 	http16 *handler.TokenMintServiceHTTPHandler,
 	http17 *handler.GetAccountBalanceHTTPHandler,
 	http18 *handler.IndexHTTPHandler,
+	http19 *handler.GetMempoolTransactionStatusHTTPHandler,
+	http20 *handler.MempoolTransactionStatusServerSentEventsHTTPHandler,
+	http21 *handler.GetTransactionReceiptHTTPHandler,
+	http22 *handler.GetBlockTransactionProofHTTPHandler,
+	http23 *handler.ListBlockDataInRangeHTTPHandler,
+	http24 *handler.GetLatestStateSnapshotHTTPHandler,
+	http25 *handler.GetAccountBalanceProofHTTPHandler,
+	http26 *handler.EstimateFeeHTTPHandler,
+	http27 *handler.ListExplorerBlocksHTTPHandler,
+	http28 *handler.ListExplorerTransactionsHTTPHandler,
+	http29 *handler.GetExplorerAccountHTTPHandler,
+	http30 *handler.ListExplorerRichListHTTPHandler,
+	http31 *handler.GetExplorerSupplyHTTPHandler,
+	http32 *handler.GetAccountHTTPHandler,
+	http33 *handler.GetTokenHistoryHTTPHandler,
+	http34 *handler.GetTokenProvenanceHTTPHandler,
+	http35 *handler.CreateCollectionHTTPHandler,
+	http36 *handler.GetCollectionHTTPHandler,
+	http37 *handler.ListCollectionsByCreatorHTTPHandler,
+	http38 *handler.ListCollectionTokensHTTPHandler,
 ) HTTPServer {
 	// Check if the HTTP address is set in the configuration.
 	if cfg.App.IP == "" {
@@ -106,6 +146,26 @@ func NewHTTPServer(
 		tokenMintServiceHTTPHandler:                                   http16,
 		getAccountBalanceHTTPHandler:                                  http17,
 		indexHTTPHandler:                                              http18,
+		getMempoolTransactionStatusHTTPHandler:                        http19,
+		mempoolTransactionStatusServerSentEventsHTTPHandler:           http20,
+		getTransactionReceiptHTTPHandler:                              http21,
+		getBlockTransactionProofHTTPHandler:                           http22,
+		listBlockDataInRangeHTTPHandler:                               http23,
+		getLatestStateSnapshotHTTPHandler:                             http24,
+		getAccountBalanceProofHTTPHandler:                             http25,
+		estimateFeeHTTPHandler:                                        http26,
+		listExplorerBlocksHTTPHandler:                                 http27,
+		listExplorerTransactionsHTTPHandler:                           http28,
+		getExplorerAccountHTTPHandler:                                 http29,
+		listExplorerRichListHTTPHandler:                               http30,
+		getExplorerSupplyHTTPHandler:                                  http31,
+		getAccountHTTPHandler:                                         http32,
+		getTokenHistoryHTTPHandler:                                    http33,
+		getTokenProvenanceHTTPHandler:                                 http34,
+		createCollectionHTTPHandler:                                   http35,
+		getCollectionHTTPHandler:                                      http36,
+		listCollectionsByCreatorHTTPHandler:                           http37,
+		listCollectionTokensHTTPHandler:                               http38,
 	}
 
 	return port
@@ -163,6 +223,9 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "latest-block-transaction" && p[4] == "sse" && r.Method == http.MethodPost:
 			port.getLatestBlockTransactionByAddressServerSentEventsHTTPHandler.Execute(w, r)
 
+		case n == 4 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "blockdata" && r.Method == http.MethodGet:
+			port.listBlockDataInRangeHTTPHandler.Execute(w, r)
+
 		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "blockdata" && r.Method == http.MethodGet:
 			port.getBlockDataHTTPHandler.ExecuteByHash(w, r, p[4])
 
@@ -181,6 +244,9 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "block-transaction-by-nonce" && r.Method == http.MethodGet:
 			port.getBlockTransactionByNonceHTTPHandler.ExecuteByNonce(w, r, p[4])
 
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "block-transactions" && p[5] == "proof" && r.Method == http.MethodGet:
+			port.getBlockTransactionProofHTTPHandler.Execute(w, r, p[4])
+
 		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "block-transactions" && p[4] == "owned-tokens" && r.Method == http.MethodGet:
 			port.listOwnedTokenBlockTransactionsByAddressHTTPHandler.Execute(w, r)
 
@@ -192,6 +258,13 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 
 		case n == 4 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "mempool-transactions" && r.Method == http.MethodPost:
 			port.mempoolTransactionReceiveDTOFromNetworkServiceHTTPHandler.Execute(w, r)
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "mempool-transactions" && p[5] == "status" && r.Method == http.MethodGet:
+			port.getMempoolTransactionStatusHTTPHandler.Execute(w, r, p[4])
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "mempool-transactions" && p[5] == "sse" && r.Method == http.MethodPost:
+			port.mempoolTransactionStatusServerSentEventsHTTPHandler.Execute(w, r, p[4])
+
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "transactions" && p[5] == "receipt" && r.Method == http.MethodGet:
+			port.getTransactionReceiptHTTPHandler.Execute(w, r, p[4])
 
 		case n == 4 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "tokens" && r.Method == http.MethodGet:
 			port.tokenListByOwnerHTTPHandler.Execute(w, r)
@@ -199,9 +272,55 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 		case n == 4 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "tokens" && r.Method == http.MethodPost:
 			port.tokenMintServiceHTTPHandler.Execute(w, r)
 
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "tokens" && p[5] == "history" && r.Method == http.MethodGet:
+			port.getTokenHistoryHTTPHandler.Execute(w, r, p[4])
+
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "tokens" && p[5] == "provenance" && r.Method == http.MethodGet:
+			port.getTokenProvenanceHTTPHandler.Execute(w, r, p[4])
+
+		case n == 4 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "collections" && r.Method == http.MethodGet:
+			port.listCollectionsByCreatorHTTPHandler.Execute(w, r)
+
+		case n == 4 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "collections" && r.Method == http.MethodPost:
+			port.createCollectionHTTPHandler.Execute(w, r)
+
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "collections" && r.Method == http.MethodGet:
+			port.getCollectionHTTPHandler.Execute(w, r, p[4])
+
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "collections" && p[5] == "tokens" && r.Method == http.MethodGet:
+			port.listCollectionTokensHTTPHandler.Execute(w, r, p[4])
+
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "accounts" && r.Method == http.MethodGet:
+			port.getAccountHTTPHandler.Execute(w, r, p[4])
+
 		case n == 4 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "account-balance" && r.Method == http.MethodGet:
 			port.getAccountBalanceHTTPHandler.Execute(w, r)
 
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "account-balance" && p[5] == "proof" && r.Method == http.MethodGet:
+			port.getAccountBalanceProofHTTPHandler.Execute(w, r, p[4])
+
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "state-snapshots" && p[4] == "latest" && r.Method == http.MethodGet:
+			port.getLatestStateSnapshotHTTPHandler.Execute(w, r)
+
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "fees" && p[4] == "estimate" && r.Method == http.MethodGet:
+			port.estimateFeeHTTPHandler.Execute(w, r)
+
+		// --- EXPLORER (READ-ONLY) ---
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "explorer" && p[4] == "blocks" && r.Method == http.MethodGet:
+			port.listExplorerBlocksHTTPHandler.Execute(w, r)
+
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "explorer" && p[4] == "transactions" && r.Method == http.MethodGet:
+			port.listExplorerTransactionsHTTPHandler.Execute(w, r)
+
+		case n == 6 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "explorer" && p[4] == "accounts" && r.Method == http.MethodGet:
+			port.getExplorerAccountHTTPHandler.Execute(w, r, p[5])
+
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "explorer" && p[4] == "rich-list" && r.Method == http.MethodGet:
+			port.listExplorerRichListHTTPHandler.Execute(w, r)
+
+		case n == 5 && p[0] == "authority" && p[1] == "api" && p[2] == "v1" && p[3] == "explorer" && p[4] == "supply" && r.Method == http.MethodGet:
+			port.getExplorerSupplyHTTPHandler.Execute(w, r)
+
 		// --- CATCH ALL: D.N.E. ---
 		default:
 			// Log a message to indicate that the request is not found.
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+)
+
+// MempoolTransactionInsertionDetectorTaskHandler blocks until a new
+// transaction was inserted into our mempool so the block producer can be
+// woken up right away instead of waiting for its next tick.
+type MempoolTransactionInsertionDetectorTaskHandler struct {
+	config                                     *config.Configuration
+	logger                                     *slog.Logger
+	mempoolTransactionInsertionDetectorUseCase uc_mempooltx.MempoolTransactionInsertionDetectorUseCase
+}
+
+func NewMempoolTransactionInsertionDetectorTaskHandler(
+	config *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_mempooltx.MempoolTransactionInsertionDetectorUseCase,
+) *MempoolTransactionInsertionDetectorTaskHandler {
+	return &MempoolTransactionInsertionDetectorTaskHandler{config, logger, uc1}
+}
+
+func (s *MempoolTransactionInsertionDetectorTaskHandler) Execute(ctx context.Context) (*domain.MempoolTransaction, error) {
+	return s.mempoolTransactionInsertionDetectorUseCase.Execute(ctx)
+}
+
+func (s *MempoolTransactionInsertionDetectorTaskHandler) Terminate() {
+	s.mempoolTransactionInsertionDetectorUseCase.Terminate()
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
+)
+
+type ProofOfAuthorityBlockAssemblyTaskHandler struct {
+	config                               *config.Configuration
+	logger                               *slog.Logger
+	proofOfAuthorityBlockAssemblyService sv_poa.ProofOfAuthorityBlockAssemblyService
+}
+
+func NewProofOfAuthorityBlockAssemblyTaskHandler(
+	config *config.Configuration,
+	logger *slog.Logger,
+	s1 sv_poa.ProofOfAuthorityBlockAssemblyService,
+) *ProofOfAuthorityBlockAssemblyTaskHandler {
+	return &ProofOfAuthorityBlockAssemblyTaskHandler{config, logger, s1}
+}
+
+func (s *ProofOfAuthorityBlockAssemblyTaskHandler) Execute(ctx context.Context) (int, error) {
+	return s.proofOfAuthorityBlockAssemblyService.Execute(ctx)
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	sv_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/statedelta"
+)
+
+type BackfillStateDeltasTaskHandler struct {
+	config                     *config.Configuration
+	logger                     *slog.Logger
+	backfillStateDeltasService sv_statedelta.BackfillStateDeltasService
+}
+
+func NewBackfillStateDeltasTaskHandler(
+	config *config.Configuration,
+	logger *slog.Logger,
+	s1 sv_statedelta.BackfillStateDeltasService,
+) *BackfillStateDeltasTaskHandler {
+	return &BackfillStateDeltasTaskHandler{config, logger, s1}
+}
+
+func (s *BackfillStateDeltasTaskHandler) Execute(ctx context.Context) error {
+	return s.backfillStateDeltasService.Execute(ctx)
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	sv_statesnapshot "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/statesnapshot"
+)
+
+type ExportStateSnapshotTaskHandler struct {
+	config                     *config.Configuration
+	logger                     *slog.Logger
+	exportStateSnapshotService sv_statesnapshot.ExportStateSnapshotService
+}
+
+func NewExportStateSnapshotTaskHandler(
+	config *config.Configuration,
+	logger *slog.Logger,
+	s1 sv_statesnapshot.ExportStateSnapshotService,
+) *ExportStateSnapshotTaskHandler {
+	return &ExportStateSnapshotTaskHandler{config, logger, s1}
+}
+
+func (s *ExportStateSnapshotTaskHandler) Execute(ctx context.Context) (*domain.StateSnapshot, error) {
+	return s.exportStateSnapshotService.Execute(ctx)
+}
@@ -1,9 +1,12 @@
 package task
 
 import (
+	"context"
 	"log/slog"
+	"time"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	taskhandler "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/interface/task/handler"
 )
 
 type TaskManager interface {
@@ -12,45 +15,183 @@ type TaskManager interface {
 }
 
 type taskManagerImpl struct {
-	cfg    *config.Configuration
-	logger *slog.Logger
-	// proofOfAuthorityConsensusMechanismTaskHandler *taskhandler.ProofOfAuthorityConsensusMechanismTaskHandler
+	cfg                                            *config.Configuration
+	logger                                         *slog.Logger
+	proofOfAuthorityBlockAssemblyTaskHandler       *taskhandler.ProofOfAuthorityBlockAssemblyTaskHandler
+	mempoolTransactionInsertionDetectorTaskHandler *taskhandler.MempoolTransactionInsertionDetectorTaskHandler
+	exportStateSnapshotTaskHandler                 *taskhandler.ExportStateSnapshotTaskHandler
+	backfillStateDeltasTaskHandler                 *taskhandler.BackfillStateDeltasTaskHandler
+	wake                                           chan struct{}
+	quit                                           chan struct{}
+	cancel                                         context.CancelFunc
 }
 
 func NewTaskManager(
 	cfg *config.Configuration,
 	logger *slog.Logger,
-	// task1 *taskhandler.ProofOfAuthorityConsensusMechanismTaskHandler,
-
+	task1 *taskhandler.ProofOfAuthorityBlockAssemblyTaskHandler,
+	task2 *taskhandler.MempoolTransactionInsertionDetectorTaskHandler,
+	task3 *taskhandler.ExportStateSnapshotTaskHandler,
+	task4 *taskhandler.BackfillStateDeltasTaskHandler,
 ) TaskManager {
 	port := &taskManagerImpl{
-		cfg:    cfg,
-		logger: logger,
-		// proofOfAuthorityConsensusMechanismTaskHandler: task1,
+		cfg:                                      cfg,
+		logger:                                   logger,
+		proofOfAuthorityBlockAssemblyTaskHandler: task1,
+		mempoolTransactionInsertionDetectorTaskHandler: task2,
+		exportStateSnapshotTaskHandler:                 task3,
+		backfillStateDeltasTaskHandler:                 task4,
+		wake:                                           make(chan struct{}, 1),
+		quit:                                           make(chan struct{}),
 	}
 	return port
 }
 
 func (port *taskManagerImpl) Run() {
-	// ctx := context.Background()
 	port.logger.Info("Running Task Manager")
-	// backgroundCtx := context.Background()
-
-	// go func(task *taskhandler.ProofOfAuthorityConsensusMechanismTaskHandler, loggerp *slog.Logger) {
-	// 	loggerp.Info("Starting PoA consensus mechanism...")
-	//
-	// 	for {
-	// 		if err := task.Execute(backgroundCtx); err != nil {
-	// 			loggerp.Error("Failed executing PoA consensus mechanism",
-	// 				slog.Any("error", err))
-	// 		}
-	// 		// DEVELOPERS NOTE:
-	// 		// No need for delays, automatically start executing again.
-	// 		port.logger.Debug("poa consensus mechanism will run again ...")
-	// 	}
-	// }(port.proofOfAuthorityConsensusMechanismTaskHandler, port.logger)
+	backgroundCtx, cancel := context.WithCancel(context.Background())
+	port.cancel = cancel
+
+	//
+	// Mempool insertion detector.
+	//
+
+	go func(task *taskhandler.MempoolTransactionInsertionDetectorTaskHandler, loggerp *slog.Logger) {
+		loggerp.Info("Starting mempool insertion detector...")
+
+		for {
+			mempoolTx, err := task.Execute(backgroundCtx)
+			if err != nil {
+				if backgroundCtx.Err() != nil {
+					loggerp.Info("Stopped mempool insertion detector")
+					return
+				}
+				loggerp.Error("Failed detecting mempool insertion",
+					slog.Any("error", err))
+				time.Sleep(1 * time.Second)
+				continue
+			}
+
+			loggerp.Debug("Mempool insertion detected",
+				slog.Any("id", mempoolTx.ID))
+
+			// DEVELOPERS NOTE:
+			// Wake up the block producer without blocking, if the block
+			// producer is already awake then it will pick up this transaction
+			// when it lists the mempool.
+			select {
+			case port.wake <- struct{}{}:
+			default:
+			}
+		}
+	}(port.mempoolTransactionInsertionDetectorTaskHandler, port.logger)
+
+	//
+	// Block producer.
+	//
+
+	go func(task *taskhandler.ProofOfAuthorityBlockAssemblyTaskHandler, loggerp *slog.Logger) {
+		loggerp.Info("Starting PoA block assembly...")
+
+		for {
+			select {
+			case <-port.quit:
+				loggerp.Info("Stopped PoA block assembly")
+				return
+			default:
+			}
+
+			count, err := task.Execute(backgroundCtx)
+			if err != nil {
+				loggerp.Error("Failed executing PoA block assembly",
+					slog.Any("error", err))
+			}
+
+			// DEVELOPERS NOTE:
+			// If we sealed a full block then there may be more transactions
+			// waiting so automatically start executing again, else wait until
+			// a new transaction arrives in the mempool. We still wake up every
+			// second so partially filled blocks get sealed once the oldest
+			// transaction has waited the maximum block interval.
+			if count == 0 || count < int(port.cfg.Blockchain.TransPerBlock) || err != nil {
+				select {
+				case <-port.quit:
+					loggerp.Info("Stopped PoA block assembly")
+					return
+				case <-port.wake:
+				case <-time.After(1 * time.Second):
+				}
+			}
+		}
+	}(port.proofOfAuthorityBlockAssemblyTaskHandler, port.logger)
+
+	//
+	// State snapshot exporter.
+	//
+
+	go func(task *taskhandler.ExportStateSnapshotTaskHandler, loggerp *slog.Logger) {
+		loggerp.Info("Starting state snapshot exporter...")
+
+		ticker := time.NewTicker(port.cfg.Blockchain.StateSnapshotInterval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := task.Execute(backgroundCtx); err != nil {
+				if backgroundCtx.Err() != nil {
+					loggerp.Info("Stopped state snapshot exporter")
+					return
+				}
+				loggerp.Error("Failed exporting state snapshot",
+					slog.Any("error", err))
+			}
+
+			select {
+			case <-port.quit:
+				loggerp.Info("Stopped state snapshot exporter")
+				return
+			case <-ticker.C:
+			}
+		}
+	}(port.exportStateSnapshotTaskHandler, port.logger)
+
+	//
+	// State deltas backfill.
+	//
+
+	go func(task *taskhandler.BackfillStateDeltasTaskHandler, loggerp *slog.Logger) {
+		loggerp.Info("Starting state deltas backfill...")
+
+		// DEVELOPERS NOTE:
+		// Retry until the backfill succeeds, once the blocks sealed before
+		// the deltas were recorded are replayed there is nothing left to do.
+		for {
+			err := task.Execute(backgroundCtx)
+			if err == nil {
+				loggerp.Info("Finished state deltas backfill")
+				return
+			}
+			if backgroundCtx.Err() != nil {
+				loggerp.Info("Stopped state deltas backfill")
+				return
+			}
+			loggerp.Error("Failed backfilling state deltas",
+				slog.Any("error", err))
+
+			select {
+			case <-port.quit:
+				loggerp.Info("Stopped state deltas backfill")
+				return
+			case <-time.After(1 * time.Minute):
+			}
+		}
+	}(port.backfillStateDeltasTaskHandler, port.logger)
 }
 
 func (port *taskManagerImpl) Shutdown() {
 	port.logger.Info("Gracefully shutting down Task Manager")
+	close(port.quit)
+	if port.cancel != nil {
+		port.cancel()
+	}
+	port.mempoolTransactionInsertionDetectorTaskHandler.Terminate()
 }
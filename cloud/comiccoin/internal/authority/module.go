@@ -11,26 +11,38 @@ import (
 	httphandler "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/interface/http/handler"
 	httpmiddle "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/interface/http/middleware"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/interface/task"
+	taskhandler "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/interface/task/handler"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/repo"
 	sv_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/account"
 	sv_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/blockchainstate"
 	sv_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/blockdata"
 	sv_blocktx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/blocktx"
+	sv_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/collection"
+	sv_explorer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/explorer"
 	sv_genesis "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/genesis"
 	sv_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/mempooltx"
 	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
 	sv_signedtx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/signedtx"
+	sv_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/statedelta"
+	sv_statesnapshot "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/statesnapshot"
 	sv_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/token"
 	sv_tx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/tx"
+	sv_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/txreceipt"
 	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
 	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
 	uc_blocktx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blocktx"
+	uc_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collection"
+	uc_collectionedition "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collectionedition"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
 	uc_nftok "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/nftok"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
+	uc_statesnapshot "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statesnapshot"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/hdkeystore"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
@@ -73,10 +85,18 @@ func NewModule(
 	// walletRepo := repo.NewWalletRepo(cfg, logger, dbClient)
 	accountRepo := repo.NewAccountRepo(cfg, logger, dbClient)
 	bdRepo := repo.NewBlockDataRepo(cfg, logger, dbClient)
+	validatorSetRepo := repo.NewValidatorSetRepo(cfg, logger, dbClient)
 	gbdRepo := repo.NewGenesisBlockDataRepo(cfg, logger, dbClient)
 	bcStateRepo := repo.NewBlockchainStateRepo(cfg, logger, dbClient)
 	mempoolTxRepo := repo.NewMempoolTransactionRepo(cfg, logger, dbClient)
+	mempoolTxStatusRepo := repo.NewMempoolTransactionStatusRepo(cfg, logger, cachep)
+	txReceiptRepo := repo.NewTransactionReceiptRepo(cfg, logger, dbClient)
 	tokenRepo := repo.NewTokenRepo(cfg, logger, dbClient)
+	collectionRepo := repo.NewCollectionRepo(cfg, logger, dbClient)
+	collectionEditionRepo := repo.NewCollectionEditionRepo(cfg, logger, dbClient)
+	stateSnapshotRepo := repo.NewStateSnapshotRepo(cfg, logger, dbClient)
+	accountStateDeltaRepo := repo.NewAccountStateDeltaRepo(cfg, logger, dbClient)
+	tokenStateDeltaRepo := repo.NewTokenStateDeltaRepo(cfg, logger, dbClient)
 	nftAssetRepoConfig := repo.NewNFTAssetRepoConfigurationProvider(cfg.NFTStore.URI, "")
 	nftAssetRepo := repo.NewNFTAssetRepo(nftAssetRepoConfig, logger)
 
@@ -122,6 +142,26 @@ func NewModule(
 		logger,
 		bdRepo,
 	)
+	listBlockDataInHeaderNumberRangeUseCase := uc_blockdata.NewListBlockDataInHeaderNumberRangeUseCase(
+		cfg,
+		logger,
+		bdRepo,
+	)
+	listBlockDataByTokenIDUseCase := uc_blockdata.NewListBlockDataByTokenIDUseCase(
+		cfg,
+		logger,
+		bdRepo,
+	)
+	listExplorerTransactionsByFilterUseCase := uc_blockdata.NewListExplorerTransactionsByFilterUseCase(
+		cfg,
+		logger,
+		bdRepo,
+	)
+	getExplorerAccountActivityUseCase := uc_blockdata.NewGetExplorerAccountActivityUseCase(
+		cfg,
+		logger,
+		bdRepo,
+	)
 	getLatestTokenIDUseCase := uc_blockdata.NewGetLatestTokenIDUseCase(
 		cfg,
 		logger,
@@ -189,11 +229,21 @@ func NewModule(
 		logger,
 		accountRepo,
 	)
+	getAccountStateProofUseCase := uc_account.NewGetAccountStateProofUseCase(
+		cfg,
+		logger,
+		accountRepo,
+	)
 	upsertAccountUseCase := uc_account.NewUpsertAccountUseCase(
 		cfg,
 		logger,
 		accountRepo,
 	)
+	listAccountsByChainIDUseCase := uc_account.NewListAccountsByChainIDUseCase(
+		cfg,
+		logger,
+		accountRepo,
+	)
 
 	// Token
 	getTokenUseCase := uc_token.NewGetTokenUseCase(
@@ -216,6 +266,43 @@ func NewModule(
 		logger,
 		tokenRepo,
 	)
+	listTokensByChainIDUseCase := uc_token.NewListTokensByChainIDUseCase(
+		cfg,
+		logger,
+		tokenRepo,
+	)
+
+	// Collection
+	getCollectionUseCase := uc_collection.NewGetCollectionUseCase(
+		cfg,
+		logger,
+		collectionRepo,
+	)
+	getLatestCollectionIDUseCase := uc_collection.NewGetLatestCollectionIDUseCase(
+		cfg,
+		logger,
+		collectionRepo,
+	)
+	upsertCollectionUseCase := uc_collection.NewUpsertCollectionUseCase(
+		cfg,
+		logger,
+		collectionRepo,
+	)
+	listCollectionsByCreatorUseCase := uc_collection.NewListCollectionsByCreatorUseCase(
+		cfg,
+		logger,
+		collectionRepo,
+	)
+	upsertCollectionEditionUseCase := uc_collectionedition.NewUpsertCollectionEditionUseCase(
+		cfg,
+		logger,
+		collectionEditionRepo,
+	)
+	listCollectionEditionsByCollectionIDUseCase := uc_collectionedition.NewListCollectionEditionsByCollectionIDUseCase(
+		cfg,
+		logger,
+		collectionEditionRepo,
+	)
 
 	// Token Assets
 	downloadNFTokMetadataUsecase := uc_nftok.NewDownloadMetadataNonFungibleTokenUseCase(
@@ -233,22 +320,87 @@ func NewModule(
 		logger,
 		mempoolTxRepo,
 	)
-	_ = mempoolTransactionListByChainIDUseCase
+	mempoolTransactionListByFromAddressUseCase := uc_mempooltx.NewMempoolTransactionListByFromAddressUseCase(
+		cfg,
+		logger,
+		mempoolTxRepo,
+	)
 	mempoolTransactionDeleteByIDUseCase := uc_mempooltx.NewMempoolTransactionDeleteByIDUseCase(
 		cfg,
 		logger,
 		mempoolTxRepo,
 	)
+	mempoolTransactionStatusUpsertUseCase := uc_mempooltx.NewMempoolTransactionStatusUpsertUseCase(
+		cfg,
+		logger,
+		mempoolTxStatusRepo,
+	)
+	mempoolTransactionStatusGetUseCase := uc_mempooltx.NewMempoolTransactionStatusGetUseCase(
+		cfg,
+		logger,
+		mempoolTxStatusRepo,
+	)
 	mempoolTransactionInsertionDetectorUseCase := uc_mempooltx.NewMempoolTransactionInsertionDetectorUseCase(
 		cfg,
 		logger,
 		mempoolTxRepo,
 	)
-	defer func() {
-		// When we are done, we will need to terminate our access to this resource.
-		mempoolTransactionInsertionDetectorUseCase.Terminate()
-	}()
-	_ = mempoolTransactionInsertionDetectorUseCase
+
+	// Transaction Receipt
+	upsertTransactionReceiptUseCase := uc_txreceipt.NewUpsertTransactionReceiptUseCase(
+		cfg,
+		logger,
+		txReceiptRepo,
+	)
+	getTransactionReceiptUseCase := uc_txreceipt.NewGetTransactionReceiptUseCase(
+		cfg,
+		logger,
+		txReceiptRepo,
+	)
+
+	// State Snapshot
+	upsertStateSnapshotUseCase := uc_statesnapshot.NewUpsertStateSnapshotUseCase(
+		cfg,
+		logger,
+		stateSnapshotRepo,
+	)
+	getLatestStateSnapshotUseCase := uc_statesnapshot.NewGetLatestStateSnapshotUseCase(
+		cfg,
+		logger,
+		stateSnapshotRepo,
+	)
+	pruneStateSnapshotsUseCase := uc_statesnapshot.NewPruneStateSnapshotsUseCase(
+		cfg,
+		logger,
+		stateSnapshotRepo,
+	)
+
+	// State Deltas
+	upsertAccountStateDeltaUseCase := uc_statedelta.NewUpsertAccountStateDeltaUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	getAccountStateDeltaAtBlockNumberUseCase := uc_statedelta.NewGetAccountStateDeltaAtBlockNumberUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	accountStateDeltaExistsAtBlockNumberUseCase := uc_statedelta.NewAccountStateDeltaExistsAtBlockNumberUseCase(
+		cfg,
+		logger,
+		accountStateDeltaRepo,
+	)
+	upsertTokenStateDeltaUseCase := uc_statedelta.NewUpsertTokenStateDeltaUseCase(
+		cfg,
+		logger,
+		tokenStateDeltaRepo,
+	)
+	listTokenStateDeltasByTokenIDUseCase := uc_statedelta.NewListTokenStateDeltasByTokenIDUseCase(
+		cfg,
+		logger,
+		tokenStateDeltaRepo,
+	)
 
 	// Proof of Work
 	proofOfWorkUseCase := uc_pow.NewProofOfWorkUseCase(
@@ -267,6 +419,25 @@ func NewModule(
 
 	// Account
 	getAccountUseService := sv_account.NewGetAccountService(logger, getAccountUseCase)
+	getAccountNextNonceService := sv_account.NewGetAccountNextNonceService(
+		logger,
+		getAccountUseCase,
+		mempoolTransactionListByFromAddressUseCase,
+	)
+	getAccountBalanceProofService := sv_account.NewGetAccountBalanceProofService(
+		cfg,
+		logger,
+		getBlockchainStateUseCase,
+		getBlockDataUseCase,
+		getAccountUseCase,
+		getAccountStateProofUseCase,
+	)
+	getAccountAtBlockService := sv_account.NewGetAccountAtBlockService(
+		cfg,
+		logger,
+		getBlockchainStateUseCase,
+		getAccountStateDeltaAtBlockNumberUseCase,
+	)
 
 	// Blockchain State
 	getBlockchainStateService := sv_blockchainstate.NewGetBlockchainStateService(
@@ -281,6 +452,11 @@ func NewModule(
 		logger,
 		getBlockDataUseCase,
 	)
+	listBlockDataInRangeService := sv_blockdata.NewListBlockDataInRangeService(
+		cfg,
+		logger,
+		listBlockDataInHeaderNumberRangeUseCase,
+	)
 
 	// Block Transaction
 	listBlockTransactionsByAddressService := sv_blocktx.NewListBlockTransactionsByAddressService(
@@ -293,6 +469,11 @@ func NewModule(
 		logger,
 		getBlockTransactionUseCase,
 	)
+	getBlockTransactionProofService := sv_blocktx.NewGetBlockTransactionProofService(
+		cfg,
+		logger,
+		getBlockDataUseCase,
+	)
 	listOwnedTokenBlockTransactionsByAddressService := sv_blocktx.NewListOwnedTokenBlockTransactionsByAddressService(
 		cfg,
 		logger,
@@ -320,6 +501,44 @@ func NewModule(
 		logger,
 		getBlockchainStateUseCase,
 		getTokenUseCase,
+		getAccountNextNonceService,
+	)
+	estimateFeeService := sv_tx.NewEstimateFeeService(
+		cfg,
+		logger,
+		getBlockchainStateUseCase,
+		listBlockDataInHeaderNumberRangeUseCase,
+		mempoolTransactionListByChainIDUseCase,
+	)
+
+	// Explorer
+	listExplorerBlocksService := sv_explorer.NewListExplorerBlocksService(
+		cfg,
+		logger,
+		getBlockchainStateUseCase,
+		listBlockDataInHeaderNumberRangeUseCase,
+	)
+	listExplorerTransactionsService := sv_explorer.NewListExplorerTransactionsService(
+		cfg,
+		logger,
+		listExplorerTransactionsByFilterUseCase,
+	)
+	getExplorerAccountService := sv_explorer.NewGetExplorerAccountService(
+		cfg,
+		logger,
+		getAccountUseCase,
+		getExplorerAccountActivityUseCase,
+	)
+	listExplorerRichListService := sv_explorer.NewListExplorerRichListService(
+		cfg,
+		logger,
+		listAccountsByChainIDUseCase,
+	)
+	getExplorerSupplyService := sv_explorer.NewGetExplorerSupplyService(
+		cfg,
+		logger,
+		getBlockchainStateUseCase,
+		listAccountsByChainIDUseCase,
 	)
 
 	// Proof of Authority Consensus Mechanism
@@ -328,6 +547,21 @@ func NewModule(
 		logger,
 		privateKeyFromHDWalletUseCase,
 	)
+	listValidatorSetUseCase := uc_validatorset.NewListValidatorSetUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	upsertValidatorSetMemberUseCase := uc_validatorset.NewUpsertValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
+	deleteValidatorSetMemberUseCase := uc_validatorset.NewDeleteValidatorSetMemberUseCase(
+		cfg,
+		logger,
+		validatorSetRepo,
+	)
 	proofOfAuthorityConsensusMechanismService := sv_poa.NewProofOfAuthorityConsensusMechanismService(
 		cfg,
 		logger,
@@ -348,13 +582,42 @@ func NewModule(
 		proofOfWorkUseCase,
 		upsertBlockDataUseCase,
 		blockchainStatePublishUseCase,
+		mempoolTransactionStatusUpsertUseCase,
+		upsertTransactionReceiptUseCase,
+		listValidatorSetUseCase,
+		upsertValidatorSetMemberUseCase,
+		deleteValidatorSetMemberUseCase,
+		upsertAccountStateDeltaUseCase,
+		upsertTokenStateDeltaUseCase,
+	)
+	proofOfAuthorityBlockAssemblyService := sv_poa.NewProofOfAuthorityBlockAssemblyService(
+		cfg,
+		logger,
+		mempoolTransactionListByChainIDUseCase,
+		proofOfAuthorityConsensusMechanismService,
 	)
 
 	// MempoolTransaction
 	mempoolTransactionReceiveDTOFromNetworkService := sv_mempooltx.NewMempoolTransactionReceiveDTOFromNetworkService(
 		cfg,
 		logger,
-		proofOfAuthorityConsensusMechanismService,
+		getAccountUseCase,
+		mempoolTransactionListByFromAddressUseCase,
+		mempoolTransactionCreateUseCase,
+		mempoolTransactionStatusUpsertUseCase,
+		upsertTransactionReceiptUseCase,
+	)
+	getMempoolTransactionStatusService := sv_mempooltx.NewGetMempoolTransactionStatusService(
+		cfg,
+		logger,
+		mempoolTransactionStatusGetUseCase,
+	)
+
+	// Transaction Receipt
+	getTransactionReceiptService := sv_txreceipt.NewGetTransactionReceiptService(
+		cfg,
+		logger,
+		getTransactionReceiptUseCase,
 	)
 
 	// Tokens
@@ -362,6 +625,20 @@ func NewModule(
 		logger,
 		listTokensByOwnerUseCase,
 	)
+	getTokenHistoryService := sv_token.NewGetTokenHistoryService(
+		cfg,
+		logger,
+		listTokenStateDeltasByTokenIDUseCase,
+	)
+	getTokenProvenanceService := sv_token.NewGetTokenProvenanceService(
+		cfg,
+		logger,
+		getProofOfAuthorityPrivateKeyService,
+		getBlockchainStateUseCase,
+		getBlockDataUseCase,
+		listBlockDataByTokenIDUseCase,
+		listValidatorSetUseCase,
+	)
 	tokenMintService := sv_token.NewTokenMintService(
 		cfg,
 		logger,
@@ -373,7 +650,66 @@ func NewModule(
 		getLatestTokenIDUseCase,
 		getBlockDataUseCase,
 		mempoolTransactionCreateUseCase,
+		getAccountNextNonceService,
 		proofOfAuthorityConsensusMechanismService,
+		getCollectionUseCase,
+		upsertCollectionUseCase,
+		upsertCollectionEditionUseCase,
+	)
+
+	// Collections
+	createCollectionService := sv_collection.NewCreateCollectionService(
+		cfg,
+		logger,
+		dmutex,
+		getLatestCollectionIDUseCase,
+		upsertCollectionUseCase,
+	)
+	getCollectionService := sv_collection.NewGetCollectionService(
+		logger,
+		getCollectionUseCase,
+	)
+	listCollectionsByCreatorService := sv_collection.NewListCollectionsByCreatorService(
+		logger,
+		listCollectionsByCreatorUseCase,
+	)
+	listCollectionTokensService := sv_collection.NewListCollectionTokensService(
+		logger,
+		getCollectionUseCase,
+		listCollectionEditionsByCollectionIDUseCase,
+		getTokenUseCase,
+	)
+
+	// State Snapshots
+	exportStateSnapshotService := sv_statesnapshot.NewExportStateSnapshotService(
+		cfg,
+		logger,
+		dmutex,
+		getProofOfAuthorityPrivateKeyService,
+		getBlockchainStateUseCase,
+		getBlockDataUseCase,
+		listAccountsByChainIDUseCase,
+		listTokensByChainIDUseCase,
+		getLatestStateSnapshotUseCase,
+		upsertStateSnapshotUseCase,
+		pruneStateSnapshotsUseCase,
+		listValidatorSetUseCase,
+	)
+	getLatestStateSnapshotService := sv_statesnapshot.NewGetLatestStateSnapshotService(
+		cfg,
+		logger,
+		getLatestStateSnapshotUseCase,
+	)
+
+	// State Deltas
+	backfillStateDeltasService := sv_statedelta.NewBackfillStateDeltasService(
+		cfg,
+		logger,
+		getBlockchainStateUseCase,
+		listBlockDataInHeaderNumberRangeUseCase,
+		accountStateDeltaExistsAtBlockNumberUseCase,
+		upsertAccountStateDeltaUseCase,
+		upsertTokenStateDeltaUseCase,
 	)
 
 	// Stream Latest Blockchain State Change
@@ -392,15 +728,33 @@ func NewModule(
 	//
 
 	// --- Task Manager --- //
-	// poaConsensusMechanismTask := taskhandler.NewProofOfAuthorityConsensusMechanismTaskHandler(
-	// 	cfg,
-	// 	logger,
-	// 	proofOfAuthorityConsensusMechanismService,
-	// )
+	poaBlockAssemblyTask := taskhandler.NewProofOfAuthorityBlockAssemblyTaskHandler(
+		cfg,
+		logger,
+		proofOfAuthorityBlockAssemblyService,
+	)
+	mempoolInsertionDetectorTask := taskhandler.NewMempoolTransactionInsertionDetectorTaskHandler(
+		cfg,
+		logger,
+		mempoolTransactionInsertionDetectorUseCase,
+	)
+	exportStateSnapshotTask := taskhandler.NewExportStateSnapshotTaskHandler(
+		cfg,
+		logger,
+		exportStateSnapshotService,
+	)
+	backfillStateDeltasTask := taskhandler.NewBackfillStateDeltasTaskHandler(
+		cfg,
+		logger,
+		backfillStateDeltasService,
+	)
 	taskManager := task.NewTaskManager(
 		cfg,
 		logger,
-		// poaConsensusMechanismTask,
+		poaBlockAssemblyTask,
+		mempoolInsertionDetectorTask,
+		exportStateSnapshotTask,
+		backfillStateDeltasTask,
 	)
 
 	// --- HTTP --- //
@@ -419,6 +773,9 @@ func NewModule(
 	getBlockDataHTTPHandler := httphandler.NewGetBlockDataHTTPHandler(
 		logger,
 		getBlockDataService)
+	listBlockDataInRangeHTTPHandler := httphandler.NewListBlockDataInRangeHTTPHandler(
+		logger,
+		listBlockDataInRangeService)
 	getBlockchainStateHTTPHandler := httphandler.NewGetBlockchainStateHTTPHandler(
 		logger,
 		getBlockchainStateService)
@@ -466,6 +823,87 @@ func NewModule(
 	getAccountBalance := httphandler.NewGetAccountBalanceHTTPHandler(
 		logger,
 		getAccountUseService,
+		getAccountNextNonceService,
+	)
+	getMempoolTransactionStatusHTTPHandler := httphandler.NewGetMempoolTransactionStatusHTTPHandler(
+		logger,
+		getMempoolTransactionStatusService,
+	)
+	mempoolTransactionStatusServerSentEventsHTTPHandler := httphandler.NewMempoolTransactionStatusServerSentEventsHTTPHandler(
+		logger,
+		getMempoolTransactionStatusService,
+	)
+	getTransactionReceiptHTTPHandler := httphandler.NewGetTransactionReceiptHTTPHandler(
+		logger,
+		getTransactionReceiptService,
+	)
+	getBlockTransactionProofHTTPHandler := httphandler.NewGetBlockTransactionProofHTTPHandler(
+		logger,
+		getBlockTransactionProofService,
+	)
+	getLatestStateSnapshotHTTPHandler := httphandler.NewGetLatestStateSnapshotHTTPHandler(
+		logger,
+		getLatestStateSnapshotService,
+	)
+	getAccountBalanceProofHTTPHandler := httphandler.NewGetAccountBalanceProofHTTPHandler(
+		logger,
+		getAccountBalanceProofService,
+	)
+	estimateFeeHTTPHandler := httphandler.NewEstimateFeeHTTPHandler(
+		logger,
+		estimateFeeService,
+	)
+	listExplorerBlocksHTTPHandler := httphandler.NewListExplorerBlocksHTTPHandler(
+		logger,
+		listExplorerBlocksService,
+	)
+	listExplorerTransactionsHTTPHandler := httphandler.NewListExplorerTransactionsHTTPHandler(
+		logger,
+		listExplorerTransactionsService,
+	)
+	getExplorerAccountHTTPHandler := httphandler.NewGetExplorerAccountHTTPHandler(
+		logger,
+		getExplorerAccountService,
+	)
+	listExplorerRichListHTTPHandler := httphandler.NewListExplorerRichListHTTPHandler(
+		logger,
+		listExplorerRichListService,
+	)
+	getExplorerSupplyHTTPHandler := httphandler.NewGetExplorerSupplyHTTPHandler(
+		logger,
+		getExplorerSupplyService,
+	)
+	getAccountHTTPHandler := httphandler.NewGetAccountHTTPHandler(
+		logger,
+		getAccountUseService,
+		getAccountAtBlockService,
+	)
+	getTokenHistoryHTTPHandler := httphandler.NewGetTokenHistoryHTTPHandler(
+		logger,
+		getTokenHistoryService,
+	)
+	getTokenProvenanceHTTPHandler := httphandler.NewGetTokenProvenanceHTTPHandler(
+		logger,
+		getTokenProvenanceService,
+	)
+	createCollectionHTTPHandler := httphandler.NewCreateCollectionHTTPHandler(
+		cfg,
+		logger,
+		jwtp,
+		passp,
+		createCollectionService,
+	)
+	getCollectionHTTPHandler := httphandler.NewGetCollectionHTTPHandler(
+		logger,
+		getCollectionService,
+	)
+	listCollectionsByCreatorHTTPHandler := httphandler.NewListCollectionsByCreatorHTTPHandler(
+		logger,
+		listCollectionsByCreatorService,
+	)
+	listCollectionTokensHTTPHandler := httphandler.NewListCollectionTokensHTTPHandler(
+		logger,
+		listCollectionTokensService,
 	)
 	httpMiddleware := httpmiddle.NewMiddleware(
 		logger,
@@ -494,6 +932,26 @@ func NewModule(
 		tokenMintServiceHTTPHandler,
 		getAccountBalance,
 		indexHTTPHandler,
+		getMempoolTransactionStatusHTTPHandler,
+		mempoolTransactionStatusServerSentEventsHTTPHandler,
+		getTransactionReceiptHTTPHandler,
+		getBlockTransactionProofHTTPHandler,
+		listBlockDataInRangeHTTPHandler,
+		getLatestStateSnapshotHTTPHandler,
+		getAccountBalanceProofHTTPHandler,
+		estimateFeeHTTPHandler,
+		listExplorerBlocksHTTPHandler,
+		listExplorerTransactionsHTTPHandler,
+		getExplorerAccountHTTPHandler,
+		listExplorerRichListHTTPHandler,
+		getExplorerSupplyHTTPHandler,
+		getAccountHTTPHandler,
+		getTokenHistoryHTTPHandler,
+		getTokenProvenanceHTTPHandler,
+		createCollectionHTTPHandler,
+		getCollectionHTTPHandler,
+		listCollectionsByCreatorHTTPHandler,
+		listCollectionTokensHTTPHandler,
 	)
 
 	return &AuthorityModule{
@@ -4,8 +4,6 @@ import (
 	"context"
 	"log"
 	"log/slog"
-	"sort"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson"
@@ -14,14 +12,15 @@ import (
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
 )
 
 type AccountRepo struct {
-	config     *config.Configuration
-	logger     *slog.Logger
-	dbClient   *mongo.Client
-	collection *mongo.Collection
+	config              *config.Configuration
+	logger              *slog.Logger
+	dbClient            *mongo.Client
+	collection          *mongo.Collection
+	stateTrieCollection *mongo.Collection
 }
 
 func NewAccountRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) *AccountRepo {
@@ -48,16 +47,31 @@ func NewAccountRepo(cfg *config.Configuration, logger *slog.Logger, client *mong
 	}
 
 	return &AccountRepo{
-		config:     cfg,
-		logger:     logger,
-		dbClient:   client,
-		collection: uc,
+		config:              cfg,
+		logger:              logger,
+		dbClient:            client,
+		collection:          uc,
+		stateTrieCollection: newStateTrieCollection(cfg, client),
 	}
 }
 
 func (r *AccountRepo) Upsert(ctx context.Context, account *domain.Account) error {
+	if err := r.buildStateTrieIfMissing(ctx, account.ChainID); err != nil {
+		return err
+	}
+
 	opts := options.Update().SetUpsert(true)
-	_, err := r.collection.UpdateOne(ctx, bson.M{"address": account.Address}, bson.M{"$set": account}, opts)
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"address": account.Address}, bson.M{"$set": account}, opts); err != nil {
+		return err
+	}
+
+	// Update the leaf of the account in the accounts trie, this re-hashes
+	// only the path from the leaf to the root.
+	leafHash, err := domain.StateTrieAccountLeafHash(account)
+	if err != nil {
+		return err
+	}
+	_, err = r.stateTrie(account.ChainID).Update(ctx, domain.StateTrieAccountKey(account.Address), leafHash)
 	return err
 }
 
@@ -122,74 +136,55 @@ func (r *AccountRepo) ListWithFilterByAddresses(ctx context.Context, addrs []*co
 }
 
 func (r *AccountRepo) DeleteByAddress(ctx context.Context, address *common.Address) error {
-	_, err := r.collection.DeleteOne(ctx, bson.M{"address": address})
+	chainID := r.config.Blockchain.ChainID
+	if err := r.buildStateTrieIfMissing(ctx, chainID); err != nil {
+		return err
+	}
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"address": address}); err != nil {
+		return err
+	}
+	_, err := r.stateTrie(chainID).Update(ctx, domain.StateTrieAccountKey(address), nil)
 	return err
 }
 
 func (r *AccountRepo) HashStateByChainID(ctx context.Context, chainID uint16) (string, error) {
-	accounts, err := r.ListByChainID(ctx, chainID)
-	if err != nil {
+	if err := r.buildStateTrieIfMissing(ctx, chainID); err != nil {
 		return "", err
 	}
-
-	// Variable used to only store the accounts which have a balance greater
-	// then the value of zero.
-	accountsWithBalance := make([]*domain.Account, 0)
-
-	// Iterate through all the accounts and only save the accounts with balance.
-	for _, account := range accounts {
-		if account.Balance > 0 {
-			accountsWithBalance = append(accountsWithBalance, account)
-		}
-	}
-
-	// Sort the accounts by address
-	sort.Sort(byAccount(accountsWithBalance))
-
-	// Serialize the accounts to JSON
-	accountsBytes := make([]byte, 0)
-	for _, account := range accountsWithBalance {
-		// DEVELOPERS NOTE:
-		// In Go, the order of struct fields is determined by the order in which
-		// they are defined in the struct. However, this order is not guaranteed
-		// to be the same across different nodes or even different runs of the
-		// same program.
-		//
-		// To fix this issue, you can use a deterministic serialization
-		// algorithm, such as JSON or CBOR, to serialize the Account struct
-		// before hashing it. This will ensure that the fields are always
-		// serialized in the same order, regardless of the node or run.
-		accountBytes, err := account.Serialize()
-		if err != nil {
-			return "", err
-		}
-		accountsBytes = append(accountsBytes, accountBytes...)
+	root, err := r.stateTrie(chainID).Root(ctx)
+	if err != nil {
+		return "", err
 	}
-
-	// Hash the deterministic serialized accounts
-	res := signature.Hash(accountsBytes)
-	return res, nil
+	return domain.StateTrieRootHex(root), nil
 }
 
-// =============================================================================
-
-// byAccount provides sorting support by the account id value.
-type byAccount []*domain.Account
-
-// Len returns the number of transactions in the list.
-func (ba byAccount) Len() int {
-	return len(ba)
+func (r *AccountRepo) ProveByAddress(ctx context.Context, chainID uint16, address *common.Address) (*smt.Proof, error) {
+	if err := r.buildStateTrieIfMissing(ctx, chainID); err != nil {
+		return nil, err
+	}
+	return r.stateTrie(chainID).Prove(ctx, domain.StateTrieAccountKey(address))
 }
 
-// Less helps to sort the list by account id in ascending order to keep the
-// accounts in the right order of processing.
-func (ba byAccount) Less(i, j int) bool {
-	return strings.ToLower(ba[i].Address.String()) < strings.ToLower(ba[j].Address.String())
+func (r *AccountRepo) stateTrie(chainID uint16) *smt.Tree {
+	return newStateTrie(r.stateTrieCollection, chainID, domain.StateTrieAccounts)
 }
 
-// Swap moves accounts in the order of the account id value.
-func (ba byAccount) Swap(i, j int) {
-	ba[i], ba[j] = ba[j], ba[i]
+func (r *AccountRepo) buildStateTrieIfMissing(ctx context.Context, chainID uint16) error {
+	return buildStateTrieIfMissing(ctx, r.stateTrieCollection, chainID, domain.StateTrieAccounts, func() ([]smt.Leaf, error) {
+		accounts, err := r.ListByChainID(ctx, chainID)
+		if err != nil {
+			return nil, err
+		}
+		leaves := make([]smt.Leaf, 0, len(accounts))
+		for _, account := range accounts {
+			leafHash, err := domain.StateTrieAccountLeafHash(account)
+			if err != nil {
+				return nil, err
+			}
+			leaves = append(leaves, smt.Leaf{Key: domain.StateTrieAccountKey(account.Address), LeafHash: leafHash})
+		}
+		return leaves, nil
+	})
 }
 
 func (r *AccountRepo) OpenTransaction() error {
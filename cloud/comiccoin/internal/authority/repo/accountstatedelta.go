@@ -0,0 +1,83 @@
+package repo
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type AccountStateDeltaRepo struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	dbClient   *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewAccountStateDeltaRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) *AccountStateDeltaRepo {
+	// ctx := context.Background()
+	uc := client.Database(cfg.DB.AuthorityName).Collection("account_state_deltas")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "chain_id", Value: 1}, {Key: "address", Value: 1}, {Key: "block_number", Value: -1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "chain_id", Value: 1}, {Key: "block_number", Value: 1}}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	return &AccountStateDeltaRepo{
+		config:     cfg,
+		logger:     logger,
+		dbClient:   client,
+		collection: uc,
+	}
+}
+
+func (r *AccountStateDeltaRepo) Upsert(ctx context.Context, delta *domain.AccountStateDelta) error {
+	filter := bson.M{"chain_id": delta.ChainID, "address": delta.Address, "block_number": delta.BlockNumber}
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": delta}, opts)
+	return err
+}
+
+func (r *AccountStateDeltaRepo) GetLatestAtBlockNumber(ctx context.Context, chainID uint16, address *common.Address, blockNumber uint64) (*domain.AccountStateDelta, error) {
+	var delta domain.AccountStateDelta
+	filter := bson.M{"chain_id": chainID, "address": address, "block_number": bson.M{"$lte": blockNumber}}
+	opts := options.FindOne().SetSort(bson.D{{Key: "block_number", Value: -1}})
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&delta)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Include the read-only `_string` fields.
+	delta.NonceString = delta.GetNonce().String()
+	return &delta, nil
+}
+
+func (r *AccountStateDeltaRepo) ExistsAtBlockNumber(ctx context.Context, chainID uint16, blockNumber uint64) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"chain_id": chainID, "block_number": blockNumber}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
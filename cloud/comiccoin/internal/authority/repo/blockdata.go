@@ -8,6 +8,7 @@ import (
 	"log"
 	"log/slog"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson"
@@ -49,8 +50,10 @@ func NewBlockDataRepo(cfg *config.Configuration, logger *slog.Logger, client *mo
 		{Keys: bson.D{{Key: "hash", Value: 1}}},
 		{Keys: bson.D{{Key: "header.chain_id", Value: 1}}},
 		{Keys: bson.D{{Key: "header.number", Value: 1}}},
+		{Keys: bson.D{{Key: "header.number_bytes", Value: 1}}},
 		{Keys: bson.D{{Key: "header.timestamp", Value: 1}}},
 		{Keys: bson.D{{Key: "trans.signedtransaction.transaction.nonce_bytes", Value: 1}}},
+		{Keys: bson.D{{Key: "trans.signedtransaction.transaction.token_id_bytes", Value: 1}}},
 		{Keys: bson.D{
 			{Key: "hash", Value: "text"},
 		}},
@@ -172,6 +175,33 @@ func (r *BlockDataRepo) GetByTransactionNonce(ctx context.Context, txNonce *big.
 
 }
 
+func (r *BlockDataRepo) GetByTransactionFromAddressAndNonce(ctx context.Context, from *common.Address, txNonce *big.Int) (*domain.BlockData, error) {
+	if from == nil || txNonce == nil {
+		return nil, fmt.Errorf("transaction from address and nonce cannot be nil")
+	}
+
+	filter := bson.M{
+		"trans": bson.M{
+			"$elemMatch": bson.M{
+				"signedtransaction.transaction.from":        from,
+				"signedtransaction.transaction.nonce_bytes": txNonce.Bytes(),
+			},
+		},
+	}
+
+	var blockData domain.BlockData
+	err := r.collection.FindOne(ctx, filter).Decode(&blockData)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // No document found
+		}
+		return nil, fmt.Errorf("failed to get block by transaction from address and nonce: %v", err)
+	}
+
+	r.includeJSONStrings(&blockData)
+	return &blockData, nil
+}
+
 func (r *BlockDataRepo) ListByChainID(ctx context.Context, chainID uint16) ([]*domain.BlockData, error) {
 	blockDatas := make([]*domain.BlockData, 0)
 	filter := bson.M{"header.chain_id": chainID}
@@ -195,6 +225,87 @@ func (r *BlockDataRepo) ListByChainID(ctx context.Context, chainID uint16) ([]*d
 	return blockDatas, nil
 }
 
+func (r *BlockDataRepo) ListInHeaderNumberRangeForChainID(ctx context.Context, chainID uint16, from, to *big.Int) ([]*domain.BlockData, error) {
+	// DEVELOPERS NOTE:
+	// Header numbers are stored as big-endian bytes which cannot be safely
+	// compared with `$gte` and `$lte`, therefore we lookup every number in
+	// the range; callers must keep the range small (see `BlockDataRangeMaxSize`).
+	headerNumbers := make([][]byte, 0)
+	for n := new(big.Int).Set(from); n.Cmp(to) <= 0; n.Add(n, big.NewInt(1)) {
+		headerNumbers = append(headerNumbers, n.Bytes())
+	}
+
+	blockDatas := make([]*domain.BlockData, 0, len(headerNumbers))
+	filter := bson.M{
+		"header.chain_id":     chainID,
+		"header.number_bytes": bson.M{"$in": headerNumbers},
+	}
+	cur, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var blockData domain.BlockData
+		err := cur.Decode(&blockData)
+		if err != nil {
+			return nil, err
+		}
+		r.includeJSONStrings(&blockData)
+		blockDatas = append(blockDatas, &blockData)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(blockDatas, func(i, j int) bool {
+		return blockDatas[i].Header.GetNumber().Cmp(blockDatas[j].Header.GetNumber()) < 0
+	})
+	return blockDatas, nil
+}
+
+func (r *BlockDataRepo) ListByTokenIDForChainID(ctx context.Context, chainID uint16, tokenID *big.Int) ([]*domain.BlockData, error) {
+	var tokenIDFilter any = tokenID.Bytes()
+	if tokenID.Sign() == 0 {
+		// DEVELOPERS NOTE:
+		// The bytes of token zero are empty which may have been saved as null.
+		tokenIDFilter = bson.M{"$in": bson.A{[]byte{}, nil}}
+	}
+	filter := bson.M{
+		"header.chain_id": chainID,
+		"trans": bson.M{
+			"$elemMatch": bson.M{
+				"signedtransaction.transaction.type":           bson.M{"$in": bson.A{domain.TransactionTypeToken, domain.TransactionTypeSwap}},
+				"signedtransaction.transaction.token_id_bytes": tokenIDFilter,
+			},
+		},
+	}
+
+	blockDatas := make([]*domain.BlockData, 0)
+	cur, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var blockData domain.BlockData
+		err := cur.Decode(&blockData)
+		if err != nil {
+			return nil, err
+		}
+		r.includeJSONStrings(&blockData)
+		blockDatas = append(blockDatas, &blockData)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(blockDatas, func(i, j int) bool {
+		return blockDatas[i].Header.GetNumber().Cmp(blockDatas[j].Header.GetNumber()) < 0
+	})
+	return blockDatas, nil
+}
+
 // ListInHashes method is deprecated.
 func (r *BlockDataRepo) ListInHashes(ctx context.Context, hashes []string) ([]*domain.BlockData, error) {
 	blockDatas := make([]*domain.BlockData, 0)
@@ -342,6 +453,7 @@ func (r *BlockDataRepo) ListBlockTransactionsByAddress(ctx context.Context, addr
 				"$or": []bson.M{
 					{"signedtransaction.transaction.from": addressBytes},
 					{"signedtransaction.transaction.to": addressBytes},
+					{"royalty_recipient": addressBytes},
 				},
 			},
 		},
@@ -361,10 +473,11 @@ func (r *BlockDataRepo) ListBlockTransactionsByAddress(ctx context.Context, addr
 			return nil, err
 		}
 
-		// Filter transactions by `from` or `to` address
+		// Filter transactions by `from`, `to` or royalty recipient address
 		for _, blocktx := range blockData.Trans {
 			if (blocktx.SignedTransaction.Transaction.From != nil && bytes.Equal(blocktx.SignedTransaction.Transaction.From.Bytes(), addressBytes)) ||
-				(blocktx.SignedTransaction.Transaction.To != nil && bytes.Equal(blocktx.SignedTransaction.Transaction.To.Bytes(), addressBytes)) {
+				(blocktx.SignedTransaction.Transaction.To != nil && bytes.Equal(blocktx.SignedTransaction.Transaction.To.Bytes(), addressBytes)) ||
+				(blocktx.RoyaltyRecipient != nil && bytes.Equal(blocktx.RoyaltyRecipient.Bytes(), addressBytes)) {
 
 				if !blocktx.SignedTransaction.IsNonceZero() {
 					blocktx.SignedTransaction.NonceString = blocktx.SignedTransaction.GetNonce().String()
@@ -412,6 +525,7 @@ func (r *BlockDataRepo) ListWithLimitForBlockTransactionsByAddress(ctx context.C
 				"$or": []bson.M{
 					{"signedtransaction.transaction.from": addressBytes},
 					{"signedtransaction.transaction.to": addressBytes},
+					{"royalty_recipient": addressBytes},
 				},
 			},
 		},
@@ -432,10 +546,11 @@ func (r *BlockDataRepo) ListWithLimitForBlockTransactionsByAddress(ctx context.C
 			return nil, err
 		}
 
-		// Filter transactions by `from` or `to` address
+		// Filter transactions by `from`, `to` or royalty recipient address
 		for _, blocktx := range blockData.Trans {
 			if (blocktx.SignedTransaction.Transaction.From != nil && bytes.Equal(blocktx.SignedTransaction.Transaction.From.Bytes(), addressBytes)) ||
-				(blocktx.SignedTransaction.Transaction.To != nil && bytes.Equal(blocktx.SignedTransaction.Transaction.To.Bytes(), addressBytes)) {
+				(blocktx.SignedTransaction.Transaction.To != nil && bytes.Equal(blocktx.SignedTransaction.Transaction.To.Bytes(), addressBytes)) ||
+				(blocktx.RoyaltyRecipient != nil && bytes.Equal(blocktx.RoyaltyRecipient.Bytes(), addressBytes)) {
 
 				if !blocktx.SignedTransaction.IsNonceZero() {
 					blocktx.SignedTransaction.NonceString = blocktx.SignedTransaction.GetNonce().String()
@@ -769,6 +884,179 @@ func (r *BlockDataRepo) ListLatestBlockTransactions(ctx context.Context, limit i
 	return transactions, nil
 }
 
+// ListExplorerTransactionsByFilter lists a page of the transactions matching
+// the filter, ordered from the newest block to the oldest and from the last
+// transaction in a block to the first.
+func (r *BlockDataRepo) ListExplorerTransactionsByFilter(ctx context.Context, filter *domain.ExplorerTransactionFilter) (*domain.ExplorerTransactionListResult, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("filter cannot be nil")
+	}
+	limit := domain.ExplorerPageSize(filter.Limit)
+
+	// Match the blocks first so the indexes can be used before we unwind
+	// the transactions of every block.
+	blockMatch := bson.M{"header.chain_id": filter.ChainID}
+	if filter.Cursor != nil {
+		blockMatch["header.timestamp"] = bson.M{"$lte": filter.Cursor.BlockTimeStamp}
+	}
+
+	txMatch := bson.M{}
+	if filter.Type != "" {
+		txMatch["trans.signedtransaction.transaction.type"] = filter.Type
+	}
+	if filter.Address != nil {
+		txMatch["$or"] = []bson.M{
+			{"trans.signedtransaction.transaction.from": filter.Address.Bytes()},
+			{"trans.signedtransaction.transaction.to": filter.Address.Bytes()},
+		}
+		blockMatch["$or"] = txMatch["$or"]
+	}
+	if filter.TokenID != nil {
+		txMatch["trans.signedtransaction.transaction.token_id_bytes"] = filter.TokenID.Bytes()
+		blockMatch["trans.signedtransaction.transaction.token_id_bytes"] = filter.TokenID.Bytes()
+	}
+	if filter.TimeStampStart != 0 || filter.TimeStampEnd != 0 {
+		timestampFilter := bson.M{}
+		if filter.TimeStampStart != 0 {
+			timestampFilter["$gte"] = filter.TimeStampStart
+		}
+		if filter.TimeStampEnd != 0 {
+			timestampFilter["$lte"] = filter.TimeStampEnd
+		}
+		txMatch["trans.timestamp"] = timestampFilter
+	}
+
+	// Handle cursor-based pagination
+	if filter.Cursor != nil {
+		txMatch["$and"] = []bson.M{{
+			"$or": []bson.M{
+				{"header.timestamp": bson.M{"$lt": filter.Cursor.BlockTimeStamp}},
+				{"header.timestamp": filter.Cursor.BlockTimeStamp, "hash": bson.M{"$lt": filter.Cursor.BlockHash}},
+				{"header.timestamp": filter.Cursor.BlockTimeStamp, "hash": filter.Cursor.BlockHash, "transaction_index": bson.M{"$lt": filter.Cursor.TransactionIndex}},
+			},
+		}}
+	}
+
+	pipeline := []bson.M{
+		{"$match": blockMatch},
+		{"$unwind": bson.M{"path": "$trans", "includeArrayIndex": "transaction_index"}},
+		{"$match": txMatch},
+		{"$sort": bson.D{
+			{Key: "header.timestamp", Value: -1},
+			{Key: "hash", Value: -1},
+			{Key: "transaction_index", Value: -1},
+		}},
+		{"$limit": limit + 1}, // Fetch one more to know if there is another page.
+		{"$replaceRoot": bson.M{"newRoot": bson.M{"$mergeObjects": []any{
+			"$trans",
+			bson.M{
+				"block_hash":         "$hash",
+				"block_number_bytes": "$header.number_bytes",
+				"block_timestamp":    "$header.timestamp",
+				"transaction_index":  "$transaction_index",
+			},
+		}}}},
+	}
+
+	cur, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute aggregation: %v", err)
+	}
+	defer cur.Close(ctx)
+
+	result := &domain.ExplorerTransactionListResult{Transactions: make([]*domain.ExplorerTransaction, 0, limit)}
+	for cur.Next(ctx) {
+		var tx domain.ExplorerTransaction
+		if err := cur.Decode(&tx); err != nil {
+			return nil, fmt.Errorf("failed to decode transaction: %v", err)
+		}
+		if int64(len(result.Transactions)) == limit {
+			last := result.Transactions[limit-1]
+			result.NextCursor = (&domain.ExplorerTransactionCursor{
+				BlockTimeStamp:   last.BlockTimeStamp,
+				BlockHash:        last.BlockHash,
+				TransactionIndex: last.TransactionIndex,
+			}).Encode()
+			break
+		}
+
+		// Add string representations for various fields
+		if !tx.SignedTransaction.Transaction.IsNonceZero() {
+			tx.SignedTransaction.Transaction.NonceString = tx.SignedTransaction.Transaction.GetNonce().String()
+		}
+		if !tx.SignedTransaction.Transaction.IsTokenIDZero() {
+			tx.SignedTransaction.Transaction.TokenIDString = tx.SignedTransaction.Transaction.GetTokenID().String()
+		}
+		if !tx.SignedTransaction.Transaction.IsTokenNonceZero() {
+			tx.SignedTransaction.Transaction.TokenNonceString = tx.SignedTransaction.Transaction.GetTokenNonce().String()
+		}
+		if tx.SignedTransaction.Transaction.Data != nil {
+			tx.SignedTransaction.Transaction.DataString = string(tx.SignedTransaction.Transaction.Data)
+		}
+		tx.BlockNumberString = tx.GetBlockNumber().String()
+
+		result.Transactions = append(result.Transactions, &tx)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetExplorerAccountActivityByAddress summarizes the transactions sent from
+// or to the address.
+func (r *BlockDataRepo) GetExplorerAccountActivityByAddress(ctx context.Context, chainID uint16, address *common.Address) (*domain.ExplorerAccountActivity, error) {
+	if address == nil {
+		return nil, fmt.Errorf("address cannot be nil")
+	}
+	addressMatch := []bson.M{
+		{"trans.signedtransaction.transaction.from": address.Bytes()},
+		{"trans.signedtransaction.transaction.to": address.Bytes()},
+	}
+	activity := func(timestamp string) bson.M {
+		return bson.M{
+			"block_hash":         "$hash",
+			"block_number_bytes": "$header.number_bytes",
+			"timestamp":          timestamp,
+		}
+	}
+	pipeline := []bson.M{
+		{"$match": bson.M{"header.chain_id": chainID, "$or": addressMatch}},
+		{"$unwind": "$trans"},
+		{"$match": bson.M{"$or": addressMatch}},
+		{"$sort": bson.D{
+			{Key: "header.timestamp", Value: 1},
+			{Key: "trans.timestamp", Value: 1},
+		}},
+		{"$group": bson.M{
+			"_id":               nil,
+			"transaction_count": bson.M{"$sum": 1},
+			"first_activity":    bson.M{"$first": activity("$trans.timestamp")},
+			"last_activity":     bson.M{"$last": activity("$trans.timestamp")},
+		}},
+	}
+
+	cur, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute aggregation: %v", err)
+	}
+	defer cur.Close(ctx)
+
+	var results []*domain.ExplorerAccountActivity
+	if err := cur.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return &domain.ExplorerAccountActivity{}, nil // The account never sent or received a transaction.
+	}
+	for _, a := range []*domain.ExplorerActivity{results[0].FirstActivity, results[0].LastActivity} {
+		if a != nil {
+			a.BlockNumberString = new(big.Int).SetBytes(a.BlockNumberBytes).String()
+		}
+	}
+	return results[0], nil
+}
+
 // Helper function to truncate addresses for cleaner logging
 func truncateAddress(addr string) string {
 	if len(addr) <= 10 {
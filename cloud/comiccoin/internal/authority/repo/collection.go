@@ -0,0 +1,106 @@
+package repo
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type CollectionRepo struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	dbClient   *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewCollectionRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) domain.CollectionRepository {
+	// ctx := context.Background()
+	uc := client.Database(cfg.DB.AuthorityName).Collection("collections")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "chain_id", Value: 1}, {Key: "id_bytes", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "creator", Value: 1}}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	return &CollectionRepo{
+		config:     cfg,
+		logger:     logger,
+		dbClient:   client,
+		collection: uc,
+	}
+}
+
+func (r *CollectionRepo) Upsert(ctx context.Context, col *domain.Collection) error {
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"chain_id": col.ChainID,
+		"id_bytes": col.IDBytes,
+	}
+	_, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": col}, opts)
+	return err
+}
+
+func (r *CollectionRepo) GetByID(ctx context.Context, id *big.Int) (*domain.Collection, error) {
+	var col domain.Collection
+	err := r.collection.FindOne(ctx, bson.M{"id_bytes": id.Bytes()}).Decode(&col)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &col, nil
+}
+
+func (r *CollectionRepo) GetLatestIDByChainID(ctx context.Context, chainID uint16) (*big.Int, error) {
+	// Developers Note:
+	// MongoDB compares binary data by length before content, therefore
+	// sorting the big-endian `id_bytes` sorts the collections numerically.
+	opts := options.FindOne().SetSort(bson.D{{Key: "id_bytes", Value: -1}})
+
+	var col domain.Collection
+	err := r.collection.FindOne(ctx, bson.M{"chain_id": chainID}, opts).Decode(&col)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+	return col.GetID(), nil
+}
+
+func (r *CollectionRepo) ListByCreator(ctx context.Context, creator *common.Address) ([]*domain.Collection, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"creator": creator}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var cols []*domain.Collection
+	if err := cursor.All(ctx, &cols); err != nil {
+		return nil, err
+	}
+	return cols, nil
+}
@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"math/big"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type CollectionEditionRepo struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	dbClient   *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewCollectionEditionRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) domain.CollectionEditionRepository {
+	// ctx := context.Background()
+	uc := client.Database(cfg.DB.AuthorityName).Collection("collection_editions")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "token_id_bytes", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "collection_id_bytes", Value: 1}, {Key: "edition", Value: 1}}, Options: options.Index().SetUnique(true)},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	return &CollectionEditionRepo{
+		config:     cfg,
+		logger:     logger,
+		dbClient:   client,
+		collection: uc,
+	}
+}
+
+func (r *CollectionEditionRepo) Upsert(ctx context.Context, edition *domain.CollectionEdition) error {
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, bson.M{"token_id_bytes": edition.TokenIDBytes}, bson.M{"$set": edition}, opts)
+	return err
+}
+
+func (r *CollectionEditionRepo) ListByCollectionID(ctx context.Context, collectionID *big.Int) ([]*domain.CollectionEdition, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "edition", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"collection_id_bytes": collectionID.Bytes()}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var editions []*domain.CollectionEdition
+	if err := cursor.All(ctx, &editions); err != nil {
+		return nil, err
+	}
+	return editions, nil
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -34,10 +35,11 @@ func NewMempoolTransactionRepo(cfg *config.Configuration, logger *slog.Logger, c
 	// The following few lines of code will create the index for our app for this
 	// colleciton.
 	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
-		{Keys: bson.D{{Key: "transaction.chain_id", Value: 1}}},
-		{Keys: bson.D{{Key: "transaction.nonce", Value: 1}}},
+		{Keys: bson.D{{Key: "signedtransaction.transaction.chain_id", Value: 1}}},
+		{Keys: bson.D{{Key: "signedtransaction.transaction.nonce_bytes", Value: 1}}},
+		{Keys: bson.D{{Key: "signedtransaction.transaction.from", Value: 1}}},
 		{Keys: bson.D{
-			{Key: "transaction.data", Value: "text"},
+			{Key: "signedtransaction.transaction.data", Value: "text"},
 		}},
 	})
 	if err != nil {
@@ -86,8 +88,37 @@ func (r *MempoolTransactionRepo) ListByChainID(ctx context.Context, chainID uint
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, 30*time.Second) // Use to prevent resource leaks.
 	defer cancel()
 
+	// DEVELOPERS NOTE:
+	// Sorting by `_id` gives us the order in which the transactions were
+	// received by the mempool as object IDs are prefixed by their creation time.
 	mempoolTxs := make([]*domain.MempoolTransaction, 0)
-	cur, err := r.collection.Find(ctxWithTimeout, bson.M{"chain_id": chainID})
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cur, err := r.collection.Find(ctxWithTimeout, bson.M{"signedtransaction.transaction.chain_id": chainID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctxWithTimeout)
+	for cur.Next(ctxWithTimeout) {
+		var mempoolTx domain.MempoolTransaction
+		err := cur.Decode(&mempoolTx)
+		if err != nil {
+			return nil, err
+		}
+		mempoolTxs = append(mempoolTxs, &mempoolTx)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return mempoolTxs, nil
+}
+
+func (r *MempoolTransactionRepo) ListByFromAddress(ctx context.Context, addr *common.Address) ([]*domain.MempoolTransaction, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, 30*time.Second) // Use to prevent resource leaks.
+	defer cancel()
+
+	mempoolTxs := make([]*domain.MempoolTransaction, 0)
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cur, err := r.collection.Find(ctxWithTimeout, bson.M{"signedtransaction.transaction.from": addr}, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +140,7 @@ func (r *MempoolTransactionRepo) ListByChainID(ctx context.Context, chainID uint
 func (r *MempoolTransactionRepo) DeleteByChainID(ctx context.Context, chainID uint16) error {
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, 30*time.Second) // Use to prevent resource leaks.
 	defer cancel()
-	_, err := r.collection.DeleteMany(ctxWithTimeout, bson.M{"chain_id": chainID})
+	_, err := r.collection.DeleteMany(ctxWithTimeout, bson.M{"signedtransaction.transaction.chain_id": chainID})
 	return err
 }
 
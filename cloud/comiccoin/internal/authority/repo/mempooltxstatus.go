@@ -0,0 +1,55 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/memory/redis"
+)
+
+// mempoolTransactionStatusExpiry is how long we keep the status of a
+// submitted transaction around for callers to poll.
+const mempoolTransactionStatusExpiry = 24 * time.Hour
+
+// MempoolTransactionStatusRepo keeps the status of submitted mempool
+// transactions in our cache as they are only useful for a short period of
+// time after submission.
+type MempoolTransactionStatusRepo struct {
+	config *config.Configuration
+	logger *slog.Logger
+	cache  redis.Cacher
+}
+
+func NewMempoolTransactionStatusRepo(cfg *config.Configuration, logger *slog.Logger, cache redis.Cacher) *MempoolTransactionStatusRepo {
+	return &MempoolTransactionStatusRepo{
+		config: cfg,
+		logger: logger,
+		cache:  cache,
+	}
+}
+
+func (r *MempoolTransactionStatusRepo) key(id primitive.ObjectID) string {
+	return fmt.Sprintf("mempooltx_status:%v", id.Hex())
+}
+
+func (r *MempoolTransactionStatusRepo) Upsert(ctx context.Context, status *domain.MempoolTransactionStatus) error {
+	statusBytes, err := status.Serialize()
+	if err != nil {
+		return err
+	}
+	return r.cache.SetWithExpiry(ctx, r.key(status.ID), statusBytes, mempoolTransactionStatusExpiry)
+}
+
+func (r *MempoolTransactionStatusRepo) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.MempoolTransactionStatus, error) {
+	statusBytes, err := r.cache.Get(ctx, r.key(id))
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewMempoolTransactionStatusFromDeserialize(statusBytes)
+}
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type StateSnapshotRepo struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	dbClient   *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewStateSnapshotRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) *StateSnapshotRepo {
+	// ctx := context.Background()
+	uc := client.Database(cfg.DB.AuthorityName).Collection("state_snapshots")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "block_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "chain_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	return &StateSnapshotRepo{
+		config:     cfg,
+		logger:     logger,
+		dbClient:   client,
+		collection: uc,
+	}
+}
+
+func (r *StateSnapshotRepo) Upsert(ctx context.Context, snapshot *domain.StateSnapshot) error {
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, bson.M{"block_hash": snapshot.BlockHash}, bson.M{"$set": snapshot}, opts)
+	return err
+}
+
+func (r *StateSnapshotRepo) GetLatestByChainID(ctx context.Context, chainID uint16) (*domain.StateSnapshot, error) {
+	var snapshot domain.StateSnapshot
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	err := r.collection.FindOne(ctx, bson.M{"chain_id": chainID}, opts).Decode(&snapshot)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Include the read-only `_string` fields.
+	snapshot.BlockNumberString = snapshot.GetBlockNumber().String()
+	return &snapshot, nil
+}
+
+func (r *StateSnapshotRepo) DeleteAllExceptLatestByChainID(ctx context.Context, chainID uint16, keep int64) error {
+	// Find the oldest snapshot we keep and delete everything created before it.
+	var oldest domain.StateSnapshot
+	opts := options.FindOne().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(keep - 1).
+		SetProjection(bson.M{"created_at": 1})
+	err := r.collection.FindOne(ctx, bson.M{"chain_id": chainID}, opts).Decode(&oldest)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil // Nothing to delete.
+		}
+		return err
+	}
+	_, err = r.collection.DeleteMany(ctx, bson.M{
+		"chain_id":   chainID,
+		"created_at": bson.M{"$lt": oldest.CreatedAt},
+	})
+	return err
+}
@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
+)
+
+// stateTrieNode is a non-empty node of a sparse merkle tree.
+type stateTrieNode struct {
+	ChainID uint16 `bson:"chain_id"`
+	Trie    string `bson:"trie"`
+	NodeID  string `bson:"node_id"`
+	Hash    []byte `bson:"hash"`
+}
+
+// stateTrieStore persists the nodes of the sparse merkle tree of the
+// accounts or tokens of a particular chain, see `smt.Store`.
+type stateTrieStore struct {
+	collection *mongo.Collection
+	chainID    uint16
+	trie       string
+}
+
+func newStateTrieCollection(cfg *config.Configuration, client *mongo.Client) *mongo.Collection {
+	uc := client.Database(cfg.DB.AuthorityName).Collection("state_trie_nodes")
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "chain_id", Value: 1}, {Key: "trie", Value: 1}, {Key: "node_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+	return uc
+}
+
+func newStateTrie(collection *mongo.Collection, chainID uint16, trie string) *smt.Tree {
+	return smt.New(&stateTrieStore{collection, chainID, trie})
+}
+
+func (s *stateTrieStore) GetNodes(ctx context.Context, ids []string) (map[string][]byte, error) {
+	filter := bson.M{
+		"chain_id": s.chainID,
+		"trie":     s.trie,
+		"node_id":  bson.M{"$in": ids},
+	}
+	cursor, err := s.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var nodes []*stateTrieNode
+	if err := cursor.All(ctx, &nodes); err != nil {
+		return nil, err
+	}
+	res := make(map[string][]byte, len(nodes))
+	for _, node := range nodes {
+		res[node.NodeID] = node.Hash
+	}
+	return res, nil
+}
+
+func (s *stateTrieStore) PutNodes(ctx context.Context, nodes map[string][]byte) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(nodes))
+	for id, hash := range nodes {
+		filter := bson.M{
+			"chain_id": s.chainID,
+			"trie":     s.trie,
+			"node_id":  id,
+		}
+		if hash == nil {
+			models = append(models, mongo.NewDeleteOneModel().SetFilter(filter))
+			continue
+		}
+		node := &stateTrieNode{
+			ChainID: s.chainID,
+			Trie:    s.trie,
+			NodeID:  id,
+			Hash:    hash,
+		}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(bson.M{"$set": node}).SetUpsert(true))
+	}
+	_, err := s.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// buildStateTrieIfMissing builds the sparse merkle tree from scratch from the
+// leaves returned by `listLeaves` if the tree was never saved, this happens
+// once for a blockchain which existed before the state trie was introduced.
+func buildStateTrieIfMissing(ctx context.Context, collection *mongo.Collection, chainID uint16, trie string, listLeaves func() ([]smt.Leaf, error)) error {
+	store := &stateTrieStore{collection, chainID, trie}
+	root, err := smt.New(store).Root(ctx)
+	if err != nil {
+		return err
+	}
+	if root != nil {
+		return nil
+	}
+
+	leaves, err := listLeaves()
+	if err != nil {
+		return err
+	}
+	_, nodes, err := smt.Build(leaves)
+	if err != nil {
+		return err
+	}
+	return store.PutNodes(ctx, nodes)
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 	"log/slog"
 	"math/big"
-	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson"
@@ -14,14 +13,15 @@ import (
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
 )
 
 type TokenRepo struct {
-	config     *config.Configuration
-	logger     *slog.Logger
-	dbClient   *mongo.Client
-	collection *mongo.Collection
+	config              *config.Configuration
+	logger              *slog.Logger
+	dbClient            *mongo.Client
+	collection          *mongo.Collection
+	stateTrieCollection *mongo.Collection
 }
 
 func NewTokenRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) domain.TokenRepository {
@@ -56,16 +56,31 @@ func NewTokenRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.
 	}
 
 	return &TokenRepo{
-		config:     cfg,
-		logger:     logger,
-		dbClient:   client,
-		collection: uc,
+		config:              cfg,
+		logger:              logger,
+		dbClient:            client,
+		collection:          uc,
+		stateTrieCollection: newStateTrieCollection(cfg, client),
 	}
 }
 
 func (r *TokenRepo) Upsert(ctx context.Context, token *domain.Token) error {
+	if err := r.buildStateTrieIfMissing(ctx, token.ChainID); err != nil {
+		return err
+	}
+
 	opts := options.Update().SetUpsert(true)
-	_, err := r.collection.UpdateOne(ctx, bson.M{"id_bytes": token.IDBytes}, bson.M{"$set": token}, opts)
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"id_bytes": token.IDBytes}, bson.M{"$set": token}, opts); err != nil {
+		return err
+	}
+
+	// Update the leaf of the token in the tokens trie, this re-hashes only
+	// the path from the leaf to the root.
+	leafHash, err := domain.StateTrieTokenLeafHash(token)
+	if err != nil {
+		return err
+	}
+	_, err = r.stateTrie(token.ChainID).Update(ctx, domain.StateTrieTokenKey(token.GetID()), leafHash)
 	return err
 }
 
@@ -134,42 +149,49 @@ func (r *TokenRepo) CountByOwner(ctx context.Context, owner *common.Address) (in
 }
 
 func (r *TokenRepo) DeleteByID(ctx context.Context, id *big.Int) error {
+	chainID := r.config.Blockchain.ChainID
+	if err := r.buildStateTrieIfMissing(ctx, chainID); err != nil {
+		return err
+	}
 	idBytes := id.Bytes()
-	_, err := r.collection.DeleteOne(ctx, bson.M{"id_bytes": idBytes})
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"id_bytes": idBytes}); err != nil {
+		return err
+	}
+	_, err := r.stateTrie(chainID).Update(ctx, domain.StateTrieTokenKey(id), nil)
 	return err
 }
 
 func (r *TokenRepo) HashStateByChainID(ctx context.Context, chainID uint16) (string, error) {
-	tokens, err := r.ListByChainID(ctx, chainID)
+	if err := r.buildStateTrieIfMissing(ctx, chainID); err != nil {
+		return "", err
+	}
+	root, err := r.stateTrie(chainID).Root(ctx)
 	if err != nil {
 		return "", err
 	}
+	return domain.StateTrieRootHex(root), nil
+}
+
+func (r *TokenRepo) stateTrie(chainID uint16) *smt.Tree {
+	return newStateTrie(r.stateTrieCollection, chainID, domain.StateTrieTokens)
+}
 
-	// Sort and hash our tokens.
-	sort.Sort(byToken(tokens))
-
-	// Serialize the tokens to JSON
-	tokensBytes := make([]byte, 0)
-	for _, tok := range tokens {
-		// DEVELOPERS NOTE:
-		// In Go, the order of struct fields is determined by the order in which
-		// they are defined in the struct. However, this order is not guaranteed
-		// to be the same across different nodes or even different runs of the
-		// same program.
-		//
-		// To fix this issue, you can use a deterministic serialization
-		// algorithm, such as JSON or CBOR, to serialize the Token struct
-		// before hashing it. This will ensure that the fields are always
-		// serialized in the same order, regardless of the node or run.
-		tokBytes, err := tok.Serialize()
+func (r *TokenRepo) buildStateTrieIfMissing(ctx context.Context, chainID uint16) error {
+	return buildStateTrieIfMissing(ctx, r.stateTrieCollection, chainID, domain.StateTrieTokens, func() ([]smt.Leaf, error) {
+		tokens, err := r.ListByChainID(ctx, chainID)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		tokensBytes = append(tokensBytes, tokBytes...)
-	}
-
-	// Hash the deterministic serialized tokens.
-	return signature.Hash(tokensBytes), nil
+		leaves := make([]smt.Leaf, 0, len(tokens))
+		for _, tok := range tokens {
+			leafHash, err := domain.StateTrieTokenLeafHash(tok)
+			if err != nil {
+				return nil, err
+			}
+			leaves = append(leaves, smt.Leaf{Key: domain.StateTrieTokenKey(tok.GetID()), LeafHash: leafHash})
+		}
+		return leaves, nil
+	})
 }
 
 func (r *TokenRepo) OpenTransaction() error {
@@ -185,24 +207,3 @@ func (r *TokenRepo) CommitTransaction() error {
 func (r *TokenRepo) DiscardTransaction() {
 	log.Fatal("Unsupported feature in the `comiccoin` repository.")
 }
-
-// =============================================================================
-
-// byToken provides sorting support by the token id value.
-type byToken []*domain.Token
-
-// Len returns the number of transactions in the list.
-func (ba byToken) Len() int {
-	return len(ba)
-}
-
-// Less helps to sort the list by token id in ascending order to keep the
-// tokens in the right order of processing.
-func (ba byToken) Less(i, j int) bool {
-	return ba[i].GetID().Cmp(ba[j].GetID()) < 0
-}
-
-// Swap moves tokens in the order of the token id value.
-func (ba byToken) Swap(i, j int) {
-	ba[i], ba[j] = ba[j], ba[i]
-}
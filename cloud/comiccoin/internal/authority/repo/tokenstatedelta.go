@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"math/big"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type TokenStateDeltaRepo struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	dbClient   *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewTokenStateDeltaRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) *TokenStateDeltaRepo {
+	// ctx := context.Background()
+	uc := client.Database(cfg.DB.AuthorityName).Collection("token_state_deltas")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "block_hash", Value: 1}, {Key: "transaction_index", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "chain_id", Value: 1}, {Key: "token_id_bytes", Value: 1}, {Key: "block_number", Value: 1}}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	return &TokenStateDeltaRepo{
+		config:     cfg,
+		logger:     logger,
+		dbClient:   client,
+		collection: uc,
+	}
+}
+
+func (r *TokenStateDeltaRepo) Upsert(ctx context.Context, delta *domain.TokenStateDelta) error {
+	filter := bson.M{"block_hash": delta.BlockHash, "transaction_index": delta.TransactionIndex}
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": delta}, opts)
+	return err
+}
+
+func (r *TokenStateDeltaRepo) ListByTokenID(ctx context.Context, chainID uint16, tokenID *big.Int) ([]*domain.TokenStateDelta, error) {
+	filter := bson.M{"chain_id": chainID, "token_id_bytes": tokenID.Bytes()}
+	if tokenID.Sign() == 0 {
+		// DEVELOPERS NOTE:
+		// The bytes of token zero are empty which may have been saved as null.
+		filter["token_id_bytes"] = bson.M{"$in": bson.A{[]byte{}, nil}}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "block_number", Value: 1}, {Key: "transaction_index", Value: 1}})
+	cur, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	deltas := make([]*domain.TokenStateDelta, 0)
+	for cur.Next(ctx) {
+		var delta domain.TokenStateDelta
+		if err := cur.Decode(&delta); err != nil {
+			return nil, err
+		}
+
+		// Include the read-only `_string` fields.
+		delta.TokenIDString = delta.GetTokenID().String()
+		delta.TokenNonceString = delta.GetTokenNonce().String()
+		deltas = append(deltas, &delta)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return deltas, nil
+}
@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type TransactionReceiptRepo struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	dbClient   *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewTransactionReceiptRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) *TransactionReceiptRepo {
+	// ctx := context.Background()
+	uc := client.Database(cfg.DB.AuthorityName).Collection("transaction_receipts")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "mempool_transaction_id", Value: 1}}},
+		{Keys: bson.D{{Key: "from", Value: 1}}},
+		{Keys: bson.D{{Key: "block_hash", Value: 1}}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	return &TransactionReceiptRepo{
+		config:     cfg,
+		logger:     logger,
+		dbClient:   client,
+		collection: uc,
+	}
+}
+
+func (r *TransactionReceiptRepo) Upsert(ctx context.Context, receipt *domain.TransactionReceipt) error {
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, bson.M{"hash": receipt.Hash}, bson.M{"$set": receipt}, opts)
+	return err
+}
+
+func (r *TransactionReceiptRepo) GetByHash(ctx context.Context, hash string) (*domain.TransactionReceipt, error) {
+	var receipt domain.TransactionReceipt
+	err := r.collection.FindOne(ctx, bson.M{"hash": hash}).Decode(&receipt)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Include the read-only `_string` fields.
+	receipt.NonceString = receipt.GetNonce().String()
+	if receipt.BlockNumberBytes != nil {
+		receipt.BlockNumberString = receipt.GetBlockNumber().String()
+	}
+	return &receipt, nil
+}
@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type ValidatorSetRepo struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	dbClient   *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewValidatorSetRepo(cfg *config.Configuration, logger *slog.Logger, client *mongo.Client) *ValidatorSetRepo {
+	// ctx := context.Background()
+	uc := client.Database(cfg.DB.AuthorityName).Collection("validators")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "chain_id", Value: 1}, {Key: "public_key_bytes", Value: 1}}, Options: options.Index().SetUnique(true)},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	return &ValidatorSetRepo{
+		config:     cfg,
+		logger:     logger,
+		dbClient:   client,
+		collection: uc,
+	}
+}
+
+func (r *ValidatorSetRepo) Upsert(ctx context.Context, member *domain.ValidatorSetMember) error {
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"chain_id":         member.ChainID,
+		"public_key_bytes": member.Validator.PublicKeyBytes,
+	}
+	_, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": member}, opts)
+	return err
+}
+
+func (r *ValidatorSetRepo) DeleteByPublicKey(ctx context.Context, chainID uint16, publicKeyBytes []byte) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{
+		"chain_id":         chainID,
+		"public_key_bytes": publicKeyBytes,
+	})
+	return err
+}
+
+func (r *ValidatorSetRepo) ListByChainID(ctx context.Context, chainID uint16) ([]*domain.ValidatorSetMember, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"chain_id": chainID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var members []*domain.ValidatorSetMember
+	if err := cursor.All(ctx, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
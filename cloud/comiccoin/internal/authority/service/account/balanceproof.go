@@ -0,0 +1,140 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// accountBalanceProofAttempts is the number of times we try to prove the
+// account before giving up because new blocks kept being sealed while we
+// were reading the state.
+const accountBalanceProofAttempts = 3
+
+// GetAccountBalanceProofService returns the proof of the balance of an
+// account against the state root of the latest block so light clients can
+// verify their balance without downloading the blockchain.
+type GetAccountBalanceProofService interface {
+	Execute(ctx context.Context, address *common.Address) (*domain.AccountBalanceProof, error)
+}
+
+type getAccountBalanceProofServiceImpl struct {
+	config                      *config.Configuration
+	logger                      *slog.Logger
+	getBlockchainStateUseCase   uc_blockchainstate.GetBlockchainStateUseCase
+	getBlockDataUseCase         uc_blockdata.GetBlockDataUseCase
+	getAccountUseCase           uc_account.GetAccountUseCase
+	getAccountStateProofUseCase uc_account.GetAccountStateProofUseCase
+}
+
+func NewGetAccountBalanceProofService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_blockdata.GetBlockDataUseCase,
+	uc3 uc_account.GetAccountUseCase,
+	uc4 uc_account.GetAccountStateProofUseCase,
+) GetAccountBalanceProofService {
+	return &getAccountBalanceProofServiceImpl{cfg, logger, uc1, uc2, uc3, uc4}
+}
+
+func (s *getAccountBalanceProofServiceImpl) Execute(ctx context.Context, address *common.Address) (*domain.AccountBalanceProof, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if address == nil {
+		e["address"] = "missing value"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Prove the account against the latest block. If a block is sealed
+	// while we are reading then the proof will not match the header we
+	// read, so we try again.
+	//
+
+	var lastErr error
+	for attempt := 0; attempt < accountBalanceProofAttempts; attempt++ {
+		proof, err := s.prove(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		if lastErr = proof.Verify(); lastErr == nil {
+			return proof, nil
+		}
+		s.logger.Debug("Account balance proof does not match latest block, retrying...",
+			slog.Any("address", address),
+			slog.Int("attempt", attempt),
+			slog.Any("error", lastErr))
+	}
+	err := fmt.Errorf("failed proving account balance: %v", lastErr)
+	s.logger.Error("Failed proving account balance",
+		slog.Any("address", address),
+		slog.Any("error", err))
+	return nil, err
+}
+
+func (s *getAccountBalanceProofServiceImpl) prove(ctx context.Context, address *common.Address) (*domain.AccountBalanceProof, error) {
+	blockchainState, err := s.getBlockchainStateUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting blockchain state",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if blockchainState == nil {
+		return nil, httperror.NewForNotFoundWithSingleField("chain_id", "Blockchain state does not exist")
+	}
+
+	blockData, err := s.getBlockDataUseCase.ExecuteByHash(ctx, blockchainState.LatestHash)
+	if err != nil {
+		s.logger.Error("Failed getting latest block data",
+			slog.Any("hash", blockchainState.LatestHash),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if blockData == nil {
+		return nil, fmt.Errorf("Latest block data does not exist for hash: %v", blockchainState.LatestHash)
+	}
+
+	account, err := s.getAccountUseCase.Execute(ctx, address)
+	if err != nil {
+		s.logger.Error("Failed getting account",
+			slog.Any("address", address),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	proof, err := s.getAccountStateProofUseCase.Execute(ctx, s.config.Blockchain.ChainID, address)
+	if err != nil {
+		s.logger.Error("Failed getting account state proof",
+			slog.Any("address", address),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	return &domain.AccountBalanceProof{
+		Address:              address,
+		Account:              account,
+		Proof:                proof,
+		BlockHash:            blockData.Hash,
+		Header:               blockData.Header,
+		HeaderSignatureBytes: blockData.HeaderSignatureBytes,
+		Validator:            blockData.Validator,
+	}, nil
+}
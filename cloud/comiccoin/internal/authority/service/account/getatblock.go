@@ -0,0 +1,83 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetAccountAtBlockService returns the state of the account right after the
+// block was sealed.
+type GetAccountAtBlockService interface {
+	Execute(ctx context.Context, address *common.Address, blockNumber uint64) (*domain.AccountStateDelta, error)
+}
+
+type getAccountAtBlockServiceImpl struct {
+	config                                   *config.Configuration
+	logger                                   *slog.Logger
+	getBlockchainStateUseCase                uc_blockchainstate.GetBlockchainStateUseCase
+	getAccountStateDeltaAtBlockNumberUseCase uc_statedelta.GetAccountStateDeltaAtBlockNumberUseCase
+}
+
+func NewGetAccountAtBlockService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_statedelta.GetAccountStateDeltaAtBlockNumberUseCase,
+) GetAccountAtBlockService {
+	return &getAccountAtBlockServiceImpl{cfg, logger, uc1, uc2}
+}
+
+func (s *getAccountAtBlockServiceImpl) Execute(ctx context.Context, address *common.Address, blockNumber uint64) (*domain.AccountStateDelta, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if address == nil {
+		e["address"] = "missing value"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	blkchState, err := s.getBlockchainStateUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting blockchain state", slog.Any("error", err))
+		return nil, err
+	}
+	if blkchState == nil {
+		errStr := fmt.Sprintf("Blockchain state does not exist for chain ID: %v", s.config.Blockchain.ChainID)
+		s.logger.Error("Failed getting blockchain state", slog.Any("error", errStr))
+		return nil, httperror.NewForNotFoundWithSingleField("chain_id", errStr)
+	}
+	if latest := blkchState.GetLatestBlockNumber(); !latest.IsUint64() || latest.Uint64() < blockNumber {
+		return nil, httperror.NewForBadRequestWithSingleField("at_block", fmt.Sprintf("Block %v has not been sealed yet, the latest block is %v", blockNumber, latest))
+	}
+
+	//
+	// STEP 2: Get the latest delta of the account at or before the block.
+	//
+
+	delta, err := s.getAccountStateDeltaAtBlockNumberUseCase.Execute(ctx, s.config.Blockchain.ChainID, address, blockNumber)
+	if err != nil {
+		s.logger.Error("Failed getting account state delta",
+			slog.Any("address", address),
+			slog.Any("block_number", blockNumber),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if delta == nil {
+		errStr := fmt.Sprintf("Account did not exist at block %v", blockNumber)
+		return nil, httperror.NewForNotFoundWithSingleField("address", errStr)
+	}
+	return delta, nil
+}
@@ -0,0 +1,91 @@
+package account
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetAccountNextNonceService returns the nonce which the next transaction
+// sent from the account must use, taking into account the transactions from
+// this account which are still waiting in the mempool.
+type GetAccountNextNonceService interface {
+	Execute(ctx context.Context, address *common.Address) (*big.Int, error)
+}
+
+type getAccountNextNonceServiceImpl struct {
+	logger                                     *slog.Logger
+	getAccountUseCase                          uc_account.GetAccountUseCase
+	mempoolTransactionListByFromAddressUseCase uc_mempooltx.MempoolTransactionListByFromAddressUseCase
+}
+
+func NewGetAccountNextNonceService(
+	logger *slog.Logger,
+	uc1 uc_account.GetAccountUseCase,
+	uc2 uc_mempooltx.MempoolTransactionListByFromAddressUseCase,
+) GetAccountNextNonceService {
+	return &getAccountNextNonceServiceImpl{logger, uc1, uc2}
+}
+
+func (s *getAccountNextNonceServiceImpl) Execute(ctx context.Context, address *common.Address) (*big.Int, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if address == nil {
+		e["address"] = "missing value"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Get the last nonce used by this account on the blockchain. If the account
+	// does not exist yet then no transactions were sent from it yet.
+	//
+
+	nonce := big.NewInt(0)
+
+	account, err := s.getAccountUseCase.Execute(ctx, address)
+	if err != nil {
+		if !strings.Contains(err.Error(), "does not exist") {
+			s.logger.Error("failed getting account",
+				slog.Any("address", address),
+				slog.Any("error", err))
+			return nil, err
+		}
+	}
+	if account != nil {
+		nonce = account.GetNonce()
+	}
+
+	//
+	// STEP 3:
+	// Skip past any nonces already used by pending transactions in our mempool
+	// so the sender can queue up more then one transaction per block.
+	//
+
+	pendingTxs, err := s.mempoolTransactionListByFromAddressUseCase.Execute(ctx, address)
+	if err != nil {
+		s.logger.Error("failed listing mempool transactions",
+			slog.Any("address", address),
+			slog.Any("error", err))
+		return nil, err
+	}
+	for _, pendingTx := range pendingTxs {
+		if pendingTx.GetNonce().Cmp(nonce) > 0 {
+			nonce = pendingTx.GetNonce()
+		}
+	}
+
+	return nonce.Add(nonce, big.NewInt(1)), nil
+}
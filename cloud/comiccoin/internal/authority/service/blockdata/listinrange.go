@@ -0,0 +1,80 @@
+package blockdata
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// ListBlockDataInRangeService returns a page of consecutive blocks so nodes
+// can catch up without downloading one block per request.
+type ListBlockDataInRangeService interface {
+	Execute(ctx context.Context, from, to *big.Int) ([]*domain.BlockData, error)
+}
+
+type listBlockDataInRangeServiceImpl struct {
+	config                                  *config.Configuration
+	logger                                  *slog.Logger
+	listBlockDataInHeaderNumberRangeUseCase uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase
+}
+
+func NewListBlockDataInRangeService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase,
+) ListBlockDataInRangeService {
+	return &listBlockDataInRangeServiceImpl{cfg, logger, uc}
+}
+
+func (s *listBlockDataInRangeServiceImpl) Execute(ctx context.Context, from, to *big.Int) ([]*domain.BlockData, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if from == nil {
+		e["from"] = "From header number is required"
+	} else if from.Sign() < 0 {
+		e["from"] = "From header number cannot be negative"
+	}
+	if to == nil {
+		e["to"] = "To header number is required"
+	} else if from != nil && to.Cmp(from) < 0 {
+		e["to"] = "To header number cannot be less than from header number"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Limit the page size, the client is expected to request the next page
+	// starting after the last block it received.
+	//
+
+	maxTo := new(big.Int).Add(from, big.NewInt(domain.BlockDataRangeMaxSize-1))
+	if to.Cmp(maxTo) > 0 {
+		to = maxTo
+	}
+
+	//
+	// STEP 3: Get from database.
+	//
+
+	data, err := s.listBlockDataInHeaderNumberRangeUseCase.Execute(ctx, from, to)
+	if err != nil {
+		s.logger.Error("Failed listing block data in range",
+			slog.String("from", from.String()),
+			slog.String("to", to.String()),
+			slog.Any("error", err))
+		return nil, err
+	}
+	return data, nil
+}
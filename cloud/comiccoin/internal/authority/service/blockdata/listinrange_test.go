@@ -0,0 +1,101 @@
+package blockdata
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// fakeListBlockDataInHeaderNumberRangeUseCase records the range it was
+// called with and returns one block per header number.
+type fakeListBlockDataInHeaderNumberRangeUseCase struct {
+	from, to *big.Int
+}
+
+func (uc *fakeListBlockDataInHeaderNumberRangeUseCase) Execute(ctx context.Context, from, to *big.Int) ([]*domain.BlockData, error) {
+	uc.from, uc.to = from, to
+	var blockDatas []*domain.BlockData
+	for n := new(big.Int).Set(from); n.Cmp(to) <= 0; n = new(big.Int).Add(n, big.NewInt(1)) {
+		blockDatas = append(blockDatas, &domain.BlockData{Header: &domain.BlockHeader{NumberBytes: n.Bytes()}})
+	}
+	return blockDatas, nil
+}
+
+func TestListBlockDataInRangeService_Execute(t *testing.T) {
+
+	// Common setup
+	cfg := &config.Configuration{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("success case", func(t *testing.T) {
+		uc := &fakeListBlockDataInHeaderNumberRangeUseCase{}
+		service := NewListBlockDataInRangeService(cfg, logger, uc)
+
+		blockDatas, err := service.Execute(context.Background(), big.NewInt(5), big.NewInt(9))
+
+		assert.NoError(t, err)
+		assert.Len(t, blockDatas, 5)
+		assert.Equal(t, int64(9), uc.to.Int64())
+	})
+
+	t.Run("single block", func(t *testing.T) {
+		uc := &fakeListBlockDataInHeaderNumberRangeUseCase{}
+		service := NewListBlockDataInRangeService(cfg, logger, uc)
+
+		blockDatas, err := service.Execute(context.Background(), big.NewInt(7), big.NewInt(7))
+
+		assert.NoError(t, err)
+		assert.Len(t, blockDatas, 1)
+	})
+
+	t.Run("range is clamped to the maximum size", func(t *testing.T) {
+		uc := &fakeListBlockDataInHeaderNumberRangeUseCase{}
+		service := NewListBlockDataInRangeService(cfg, logger, uc)
+
+		blockDatas, err := service.Execute(context.Background(), big.NewInt(10), big.NewInt(100000))
+
+		assert.NoError(t, err)
+		assert.Len(t, blockDatas, domain.BlockDataRangeMaxSize)
+		assert.Equal(t, int64(10), uc.from.Int64())
+		assert.Equal(t, int64(10+domain.BlockDataRangeMaxSize-1), uc.to.Int64())
+	})
+
+	t.Run("validation fails", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			from  *big.Int
+			to    *big.Int
+			field string
+		}{
+			{"missing from", nil, big.NewInt(1), "from"},
+			{"missing to", big.NewInt(1), nil, "to"},
+			{"negative from", big.NewInt(-1), big.NewInt(1), "from"},
+			{"to less than from", big.NewInt(10), big.NewInt(9), "to"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				uc := &fakeListBlockDataInHeaderNumberRangeUseCase{}
+				service := NewListBlockDataInRangeService(cfg, logger, uc)
+
+				blockDatas, err := service.Execute(context.Background(), tt.from, tt.to)
+
+				assert.Nil(t, blockDatas)
+				httpErr, ok := err.(httperror.HTTPError)
+				if assert.True(t, ok, "expected an http error, got %v", err) {
+					assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+					assert.Contains(t, *httpErr.Errors, tt.field)
+				}
+				assert.Nil(t, uc.from, "expected the database not to be queried")
+			})
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package blocktx
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetBlockTransactionProofService returns the merkle inclusion proof of a
+// transaction so light clients can verify the transaction was included in a
+// signed block without downloading the blockchain.
+type GetBlockTransactionProofService interface {
+	// Execute returns the proof for the transaction with the nonce. Because
+	// nonces are only unique per account, the `from` address should be
+	// provided; if it is nil then the first transaction with the nonce is used.
+	Execute(ctx context.Context, txNonce *big.Int, from *common.Address) (*domain.BlockTransactionProof, error)
+}
+
+type getBlockTransactionProofServiceImpl struct {
+	config              *config.Configuration
+	logger              *slog.Logger
+	getBlockDataUseCase uc_blockdata.GetBlockDataUseCase
+}
+
+func NewGetBlockTransactionProofService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockdata.GetBlockDataUseCase,
+) GetBlockTransactionProofService {
+	return &getBlockTransactionProofServiceImpl{cfg, logger, uc1}
+}
+
+func (s *getBlockTransactionProofServiceImpl) Execute(ctx context.Context, txNonce *big.Int, from *common.Address) (*domain.BlockTransactionProof, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if txNonce == nil {
+		e["nonce"] = "missing value"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get the block which contains the transaction.
+	//
+
+	var blockData *domain.BlockData
+	var err error
+	if from != nil {
+		blockData, err = s.getBlockDataUseCase.ExecuteByTransactionFromAddressAndNonce(ctx, from, txNonce)
+	} else {
+		blockData, err = s.getBlockDataUseCase.ExecuteByTransactionNonce(ctx, txNonce)
+	}
+	if err != nil {
+		s.logger.Error("Failed getting block data by transaction nonce",
+			slog.Any("nonce", txNonce),
+			slog.Any("from", from),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if blockData == nil {
+		return nil, httperror.NewForNotFoundWithSingleField("nonce", fmt.Sprintf("Block transaction does not exist for nonce: %v", txNonce.String()))
+	}
+
+	//
+	// STEP 3:
+	// Find the transaction inside the block.
+	//
+
+	txIndex := -1
+	for i, blockTx := range blockData.Trans {
+		if blockTx.GetNonce().Cmp(txNonce) == 0 && (from == nil || (blockTx.From != nil && *blockTx.From == *from)) {
+			txIndex = i
+			break
+		}
+	}
+	if txIndex == -1 {
+		return nil, httperror.NewForNotFoundWithSingleField("nonce", fmt.Sprintf("Block transaction does not exist for nonce: %v", txNonce.String()))
+	}
+
+	//
+	// STEP 4:
+	// Rebuild the merkle tree of the block and return the proof.
+	//
+
+	proof, err := domain.NewBlockTransactionProof(blockData, txIndex)
+	if err != nil {
+		s.logger.Error("Failed creating merkle proof",
+			slog.String("block_hash", blockData.Hash),
+			slog.Any("error", err))
+		return nil, err
+	}
+	return proof, nil
+}
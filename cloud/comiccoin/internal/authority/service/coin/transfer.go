@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"math/big"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -139,12 +137,13 @@ func (s *coinTransferServiceImpl) Execute(
 
 	tx := &domain.Transaction{
 		ChainID:    s.config.Blockchain.ChainID,
-		NonceBytes: big.NewInt(time.Now().Unix()).Bytes(),
+		NonceBytes: account.NextNonce().Bytes(),
 		From:       fromAccountAddress,
 		To:         to,
 		Value:      value + s.config.Blockchain.TransactionFee, // Note: The transanction fee gets reclaimed by the us, so it's fully recirculating when authority calls this.
 		Data:       data,
 		Type:       domain.TransactionTypeCoin,
+		Version:    domain.TransactionVersion,
 	}
 
 	stx, signingErr := tx.Sign(privateKey)
@@ -195,7 +194,7 @@ func (s *coinTransferServiceImpl) Execute(
 	// Instead of submitting to mempool, directly process through consensus
 	//
 
-	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, mempoolTx); err != nil {
+	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, []*domain.MempoolTransaction{mempoolTx}); err != nil {
 		s.logger.Error("Failed to process transaction through consensus mechanism",
 			slog.Any("error", err))
 		return err
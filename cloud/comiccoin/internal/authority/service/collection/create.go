@@ -0,0 +1,106 @@
+package collection
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collection"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// CreateCollectionService creates a new collection which editions can be
+// minted into, see `TokenMintService.ExecuteForCollection`.
+type CreateCollectionService interface {
+	Execute(ctx context.Context, creator *common.Address, name string, baseMetadataURI string, maxSupply uint64) (*domain.Collection, error)
+}
+
+type createCollectionServiceImpl struct {
+	config                       *config.Configuration
+	logger                       *slog.Logger
+	dmutex                       distributedmutex.Adapter
+	getLatestCollectionIDUseCase uc_collection.GetLatestCollectionIDUseCase
+	upsertCollectionUseCase      uc_collection.UpsertCollectionUseCase
+}
+
+func NewCreateCollectionService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	dmutex distributedmutex.Adapter,
+	uc1 uc_collection.GetLatestCollectionIDUseCase,
+	uc2 uc_collection.UpsertCollectionUseCase,
+) CreateCollectionService {
+	return &createCollectionServiceImpl{cfg, logger, dmutex, uc1, uc2}
+}
+
+func (s *createCollectionServiceImpl) Execute(ctx context.Context, creator *common.Address, name string, baseMetadataURI string, maxSupply uint64) (*domain.Collection, error) {
+	// Lock so concurrent requests never get the same collection ID.
+	s.dmutex.Acquire(ctx, "CreateCollectionService")
+	defer s.dmutex.Release(ctx, "CreateCollectionService")
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if creator == nil {
+		e["creator_address"] = "missing value"
+	}
+	if strings.TrimSpace(name) == "" {
+		e["name"] = "missing value"
+	}
+	if maxSupply == 0 {
+		e["max_supply"] = "missing value"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating create collection parameters",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Generate the new collection ID by incrementing the latest.
+	//
+
+	latestCollectionID, err := s.getLatestCollectionIDUseCase.ExecuteByChainID(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting latest collection id",
+			slog.Any("error", err))
+		return nil, err
+	}
+	collectionID := new(big.Int).Add(latestCollectionID, big.NewInt(1))
+
+	//
+	// STEP 3: Save the collection.
+	//
+
+	col := &domain.Collection{
+		ChainID:         s.config.Blockchain.ChainID,
+		IDBytes:         collectionID.Bytes(),
+		Creator:         creator,
+		Name:            strings.TrimSpace(name),
+		BaseMetadataURI: baseMetadataURI,
+		MaxSupply:       maxSupply,
+		Supply:          0,
+		CreatedAt:       time.Now(),
+	}
+	if err := s.upsertCollectionUseCase.Execute(ctx, col); err != nil {
+		s.logger.Error("Failed saving collection",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Info("Collection created",
+		slog.Any("collection_id", collectionID),
+		slog.Any("creator", creator),
+		slog.Any("max_supply", maxSupply))
+
+	return col, nil
+}
@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collection"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetCollectionService interface {
+	Execute(ctx context.Context, id *big.Int) (*domain.Collection, error)
+}
+
+type getCollectionServiceImpl struct {
+	logger               *slog.Logger
+	getCollectionUseCase uc_collection.GetCollectionUseCase
+}
+
+func NewGetCollectionService(
+	logger *slog.Logger,
+	uc1 uc_collection.GetCollectionUseCase,
+) GetCollectionService {
+	return &getCollectionServiceImpl{logger, uc1}
+}
+
+func (s *getCollectionServiceImpl) Execute(ctx context.Context, id *big.Int) (*domain.Collection, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id == nil {
+		e["id"] = "missing value"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get the collection.
+	//
+
+	col, err := s.getCollectionUseCase.Execute(ctx, id)
+	if err != nil {
+		s.logger.Error("failed getting collection",
+			slog.Any("id", id),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if col == nil {
+		return nil, httperror.NewForNotFoundWithSingleField("id", fmt.Sprintf("Collection %v does not exist", id))
+	}
+	return col, nil
+}
@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collection"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListCollectionsByCreatorService interface {
+	Execute(ctx context.Context, creator *common.Address) ([]*domain.Collection, error)
+}
+
+type listCollectionsByCreatorServiceImpl struct {
+	logger                          *slog.Logger
+	listCollectionsByCreatorUseCase uc_collection.ListCollectionsByCreatorUseCase
+}
+
+func NewListCollectionsByCreatorService(
+	logger *slog.Logger,
+	uc1 uc_collection.ListCollectionsByCreatorUseCase,
+) ListCollectionsByCreatorService {
+	return &listCollectionsByCreatorServiceImpl{logger, uc1}
+}
+
+func (s *listCollectionsByCreatorServiceImpl) Execute(ctx context.Context, creator *common.Address) ([]*domain.Collection, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if creator == nil {
+		e["creator_address"] = "missing value"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List the collections.
+	//
+
+	cols, err := s.listCollectionsByCreatorUseCase.Execute(ctx, creator)
+	if err != nil {
+		s.logger.Error("failed listing collections by creator",
+			slog.Any("creator", creator),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if cols == nil {
+		cols = make([]*domain.Collection, 0)
+	}
+	return cols, nil
+}
@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collection"
+	uc_collectionedition "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collectionedition"
+	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// ListCollectionTokensService lists the tokens of a collection in edition
+// order. Burned editions are skipped as their token no longer exists.
+type ListCollectionTokensService interface {
+	Execute(ctx context.Context, id *big.Int) ([]*domain.CollectionToken, error)
+}
+
+type listCollectionTokensServiceImpl struct {
+	logger                                      *slog.Logger
+	getCollectionUseCase                        uc_collection.GetCollectionUseCase
+	listCollectionEditionsByCollectionIDUseCase uc_collectionedition.ListCollectionEditionsByCollectionIDUseCase
+	getTokenUseCase                             uc_token.GetTokenUseCase
+}
+
+func NewListCollectionTokensService(
+	logger *slog.Logger,
+	uc1 uc_collection.GetCollectionUseCase,
+	uc2 uc_collectionedition.ListCollectionEditionsByCollectionIDUseCase,
+	uc3 uc_token.GetTokenUseCase,
+) ListCollectionTokensService {
+	return &listCollectionTokensServiceImpl{logger, uc1, uc2, uc3}
+}
+
+func (s *listCollectionTokensServiceImpl) Execute(ctx context.Context, id *big.Int) ([]*domain.CollectionToken, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id == nil {
+		e["id"] = "missing value"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	col, err := s.getCollectionUseCase.Execute(ctx, id)
+	if err != nil {
+		s.logger.Error("failed getting collection",
+			slog.Any("id", id),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if col == nil {
+		return nil, httperror.NewForNotFoundWithSingleField("id", fmt.Sprintf("Collection %v does not exist", id))
+	}
+
+	//
+	// STEP 2: Get the token of every edition.
+	//
+
+	editions, err := s.listCollectionEditionsByCollectionIDUseCase.Execute(ctx, id)
+	if err != nil {
+		s.logger.Error("failed listing collection editions",
+			slog.Any("id", id),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	toks := make([]*domain.CollectionToken, 0, len(editions))
+	for _, edition := range editions {
+		tok, err := s.getTokenUseCase.Execute(ctx, edition.GetTokenID())
+		if err != nil {
+			s.logger.Error("failed getting token",
+				slog.Any("token_id", edition.GetTokenID()),
+				slog.Any("error", err))
+			return nil, err
+		}
+		if tok == nil {
+			continue
+		}
+		toks = append(toks, &domain.CollectionToken{
+			Edition:   edition.Edition,
+			MaxSupply: edition.MaxSupply,
+			Token:     tok,
+		})
+	}
+	return toks, nil
+}
@@ -0,0 +1,76 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetExplorerAccountService returns the summary of an account, including when
+// the account first and last sent or received a transaction.
+type GetExplorerAccountService interface {
+	Execute(ctx context.Context, address *common.Address) (*domain.ExplorerAccount, error)
+}
+
+type getExplorerAccountServiceImpl struct {
+	config                            *config.Configuration
+	logger                            *slog.Logger
+	getAccountUseCase                 uc_account.GetAccountUseCase
+	getExplorerAccountActivityUseCase uc_blockdata.GetExplorerAccountActivityUseCase
+}
+
+func NewGetExplorerAccountService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_account.GetAccountUseCase,
+	uc2 uc_blockdata.GetExplorerAccountActivityUseCase,
+) GetExplorerAccountService {
+	return &getExplorerAccountServiceImpl{cfg, logger, uc1, uc2}
+}
+
+func (s *getExplorerAccountServiceImpl) Execute(ctx context.Context, address *common.Address) (*domain.ExplorerAccount, error) {
+	//
+	// STEP 1: Get related records.
+	//
+
+	account, err := s.getAccountUseCase.Execute(ctx, address)
+	if err != nil {
+		s.logger.Error("Failed getting account",
+			slog.Any("address", address),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if account == nil {
+		errStr := fmt.Sprintf("Account does not exist for address: %v", address.Hex())
+		s.logger.Warn("Failed getting account", slog.Any("error", errStr))
+		return nil, httperror.NewForNotFoundWithSingleField("address", errStr)
+	}
+
+	activity, err := s.getExplorerAccountActivityUseCase.Execute(ctx, address)
+	if err != nil {
+		s.logger.Error("Failed getting account activity",
+			slog.Any("address", address),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 2: Return the summary.
+	//
+
+	return &domain.ExplorerAccount{
+		ChainID:                 account.ChainID,
+		Address:                 account.Address,
+		Balance:                 account.Balance,
+		NonceString:             account.GetNonce().String(),
+		ExplorerAccountActivity: *activity,
+	}, nil
+}
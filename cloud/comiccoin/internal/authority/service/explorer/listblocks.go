@@ -0,0 +1,98 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// ListExplorerBlocksService lists the blocks from the newest to the oldest.
+// The cursor is the number of the block to start the page from.
+type ListExplorerBlocksService interface {
+	Execute(ctx context.Context, cursor string, limit int64) (*domain.ExplorerBlockListResult, error)
+}
+
+type listExplorerBlocksServiceImpl struct {
+	config                                  *config.Configuration
+	logger                                  *slog.Logger
+	getBlockchainStateUseCase               uc_blockchainstate.GetBlockchainStateUseCase
+	listBlockDataInHeaderNumberRangeUseCase uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase
+}
+
+func NewListExplorerBlocksService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase,
+) ListExplorerBlocksService {
+	return &listExplorerBlocksServiceImpl{cfg, logger, uc1, uc2}
+}
+
+func (s *listExplorerBlocksServiceImpl) Execute(ctx context.Context, cursor string, limit int64) (*domain.ExplorerBlockListResult, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	var to *big.Int
+	if cursor != "" {
+		n, ok := new(big.Int).SetString(cursor, 10)
+		if !ok || n.Sign() < 0 {
+			s.logger.Warn("Failed validating", slog.String("cursor", cursor))
+			return nil, httperror.NewForBadRequestWithSingleField("cursor", domain.ErrInvalidExplorerCursor.Error())
+		}
+		to = n
+	}
+
+	//
+	// STEP 2:
+	// Start from the latest block if no cursor was provided.
+	//
+
+	if to == nil {
+		blkchState, err := s.getBlockchainStateUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+		if err != nil {
+			s.logger.Error("Failed getting blockchain state", slog.Any("error", err))
+			return nil, err
+		}
+		if blkchState == nil {
+			errStr := fmt.Sprintf("Blockchain state does not exist for chain ID: %v", s.config.Blockchain.ChainID)
+			s.logger.Error("Failed getting blockchain state", slog.Any("error", errStr))
+			return nil, httperror.NewForNotFoundWithSingleField("chain_id", errStr)
+		}
+		to = blkchState.GetLatestBlockNumber()
+	}
+
+	//
+	// STEP 3:
+	// Get the page of blocks and return them newest first.
+	//
+
+	from := new(big.Int).Sub(to, big.NewInt(domain.ExplorerPageSize(limit)-1))
+	if from.Sign() < 0 {
+		from = big.NewInt(0)
+	}
+	blocks, err := s.listBlockDataInHeaderNumberRangeUseCase.Execute(ctx, from, to)
+	if err != nil {
+		s.logger.Error("Failed listing blocks",
+			slog.Any("from", from),
+			slog.Any("to", to),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	result := &domain.ExplorerBlockListResult{Blocks: make([]*domain.ExplorerBlock, 0, len(blocks))}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		result.Blocks = append(result.Blocks, domain.NewExplorerBlock(blocks[i]))
+	}
+	if from.Sign() > 0 {
+		result.NextCursor = new(big.Int).Sub(from, big.NewInt(1)).String()
+	}
+	return result, nil
+}
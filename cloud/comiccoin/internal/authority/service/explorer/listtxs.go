@@ -0,0 +1,43 @@
+package explorer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+)
+
+// ListExplorerTransactionsService lists the transactions matching the filter
+// from the newest to the oldest.
+type ListExplorerTransactionsService interface {
+	Execute(ctx context.Context, filter *domain.ExplorerTransactionFilter) (*domain.ExplorerTransactionListResult, error)
+}
+
+type listExplorerTransactionsServiceImpl struct {
+	config                                  *config.Configuration
+	logger                                  *slog.Logger
+	listExplorerTransactionsByFilterUseCase uc_blockdata.ListExplorerTransactionsByFilterUseCase
+}
+
+func NewListExplorerTransactionsService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockdata.ListExplorerTransactionsByFilterUseCase,
+) ListExplorerTransactionsService {
+	return &listExplorerTransactionsServiceImpl{cfg, logger, uc1}
+}
+
+func (s *listExplorerTransactionsServiceImpl) Execute(ctx context.Context, filter *domain.ExplorerTransactionFilter) (*domain.ExplorerTransactionListResult, error) {
+	if filter != nil {
+		filter.ChainID = s.config.Blockchain.ChainID // Only our blockchain is available.
+	}
+	result, err := s.listExplorerTransactionsByFilterUseCase.Execute(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed listing transactions",
+			slog.Any("error", err))
+		return nil, err
+	}
+	return result, nil
+}
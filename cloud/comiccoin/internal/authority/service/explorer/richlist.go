@@ -0,0 +1,48 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// ListExplorerRichListService lists the accounts holding the most coins.
+type ListExplorerRichListService interface {
+	Execute(ctx context.Context, cursor string, limit int64) (*domain.ExplorerRichListResult, error)
+}
+
+type listExplorerRichListServiceImpl struct {
+	config                       *config.Configuration
+	logger                       *slog.Logger
+	listAccountsByChainIDUseCase uc_account.ListAccountsByChainIDUseCase
+}
+
+func NewListExplorerRichListService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_account.ListAccountsByChainIDUseCase,
+) ListExplorerRichListService {
+	return &listExplorerRichListServiceImpl{cfg, logger, uc1}
+}
+
+func (s *listExplorerRichListServiceImpl) Execute(ctx context.Context, cursor string, limit int64) (*domain.ExplorerRichListResult, error) {
+	accounts, err := s.listAccountsByChainIDUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed listing accounts",
+			slog.Any("error", err))
+		return nil, err
+	}
+	result, err := domain.RankAccountsByBalance(accounts, cursor, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidExplorerCursor) {
+			return nil, httperror.NewForBadRequestWithSingleField("cursor", err.Error())
+		}
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetExplorerSupplyService returns the statistics of the coins in
+// circulation.
+type GetExplorerSupplyService interface {
+	Execute(ctx context.Context) (*domain.ExplorerSupply, error)
+}
+
+type getExplorerSupplyServiceImpl struct {
+	config                       *config.Configuration
+	logger                       *slog.Logger
+	getBlockchainStateUseCase    uc_blockchainstate.GetBlockchainStateUseCase
+	listAccountsByChainIDUseCase uc_account.ListAccountsByChainIDUseCase
+}
+
+func NewGetExplorerSupplyService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_account.ListAccountsByChainIDUseCase,
+) GetExplorerSupplyService {
+	return &getExplorerSupplyServiceImpl{cfg, logger, uc1, uc2}
+}
+
+func (s *getExplorerSupplyServiceImpl) Execute(ctx context.Context) (*domain.ExplorerSupply, error) {
+	blkchState, err := s.getBlockchainStateUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting blockchain state", slog.Any("error", err))
+		return nil, err
+	}
+	if blkchState == nil {
+		errStr := fmt.Sprintf("Blockchain state does not exist for chain ID: %v", s.config.Blockchain.ChainID)
+		s.logger.Error("Failed getting blockchain state", slog.Any("error", errStr))
+		return nil, httperror.NewForNotFoundWithSingleField("chain_id", errStr)
+	}
+
+	accounts, err := s.listAccountsByChainIDUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed listing accounts", slog.Any("error", err))
+		return nil, err
+	}
+
+	supply := domain.NewExplorerSupply(accounts, s.config.Blockchain.ProofOfAuthorityAccountAddress)
+	supply.ChainID = s.config.Blockchain.ChainID
+	supply.LatestBlockNumberString = blkchState.GetLatestBlockNumber().String()
+	supply.LatestTokenIDString = blkchState.GetLatestTokenID().String()
+	return supply, nil
+}
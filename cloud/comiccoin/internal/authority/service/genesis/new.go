@@ -25,6 +25,7 @@ import (
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 )
 
 type CreateGenesisBlockDataService interface {
@@ -45,6 +46,7 @@ type createGenesisBlockDataServiceImpl struct {
 	upsertBlockDataUseCase                    uc_blockdata.UpsertBlockDataUseCase
 	upsertBlockchainStateUseCase              uc_blockchainstate.UpsertBlockchainStateUseCase
 	getBlockchainStateUseCase                 uc_blockchainstate.GetBlockchainStateUseCase
+	upsertValidatorSetMemberUseCase           uc_validatorset.UpsertValidatorSetMemberUseCase
 }
 
 func NewCreateGenesisBlockDataService(
@@ -61,8 +63,9 @@ func NewCreateGenesisBlockDataService(
 	uc8 uc_blockdata.UpsertBlockDataUseCase,
 	uc9 uc_blockchainstate.UpsertBlockchainStateUseCase,
 	uc10 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc11 uc_validatorset.UpsertValidatorSetMemberUseCase,
 ) CreateGenesisBlockDataService {
-	return &createGenesisBlockDataServiceImpl{config, logger, s1, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8, uc9, uc10}
+	return &createGenesisBlockDataServiceImpl{config, logger, s1, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8, uc9, uc10, uc11}
 }
 
 func (s *createGenesisBlockDataServiceImpl) Execute(sessCtx mongo.SessionContext) (*domain.BlockchainState, error) {
@@ -158,6 +161,7 @@ func (s *createGenesisBlockDataServiceImpl) Execute(sessCtx mongo.SessionContext
 		Value:      initialSupply,
 		Data:       make([]byte, 0),
 		Type:       domain.TransactionTypeCoin,
+		Version:    domain.TransactionVersion,
 	}
 	signedCoinTx, err := coinTx.Sign(coinbasePrivateKey)
 	if err != nil {
@@ -181,6 +185,7 @@ func (s *createGenesisBlockDataServiceImpl) Execute(sessCtx mongo.SessionContext
 		TokenIDBytes:     big.NewInt(0).Bytes(), // The very first token in our entire blockchain starts at the value of zero.
 		TokenMetadataURI: "https://cpscapsule.com/comiccoin/tokens/0/metadata.json",
 		TokenNonceBytes:  big.NewInt(0).Bytes(), // Newly minted tokens always have their nonce start at value of zero.
+		Version:          domain.TransactionVersion,
 	}
 	signedTokenTx, err := tokenTx.Sign(coinbasePrivateKey)
 	if err != nil {
@@ -218,7 +223,7 @@ func (s *createGenesisBlockDataServiceImpl) Execute(sessCtx mongo.SessionContext
 	// Save our token to our database.
 	//
 
-	if err := s.upsertTokenIfPreviousTokenNonceGTEUseCase.Execute(sessCtx, tokenTx.GetTokenID(), tokenTx.To, tokenTx.TokenMetadataURI, tokenTx.GetTokenNonce()); err != nil {
+	if err := s.upsertTokenIfPreviousTokenNonceGTEUseCase.Execute(sessCtx, tokenTx.GetTokenID(), tokenTx.To, tokenTx.TokenMetadataURI, tokenTx.GetTokenNonce(), nil); err != nil {
 		return nil, err
 	}
 
@@ -282,6 +287,7 @@ func (s *createGenesisBlockDataServiceImpl) Execute(sessCtx mongo.SessionContext
 			NonceBytes:         big.NewInt(0).Bytes(), // Will be identified by the POW algorithm.
 			LatestTokenIDBytes: big.NewInt(0).Bytes(), // ComicCoin: Token ID values start at zero.
 			TokensRoot:         tokensRoot,
+			Version:            domain.BlockHeaderVersion,
 		},
 		MerkleTree: tree,
 	}
@@ -361,6 +367,12 @@ func (s *createGenesisBlockDataServiceImpl) Execute(sessCtx mongo.SessionContext
 		return nil, fmt.Errorf("Failed to write genesis block data to file: %v", err)
 	}
 
+	// The genesis validator is the first member of our validator set, more
+	// validators are added afterwards with `validator` transactions.
+	if err := s.upsertValidatorSetMemberUseCase.Execute(sessCtx, s.config.Blockchain.ChainID, poaValidator); err != nil {
+		return nil, fmt.Errorf("Failed to save genesis validator: %v", err)
+	}
+
 	s.logger.Debug("genesis block created, finished running service",
 		slog.String("hash", genesisBlockData.Hash))
 
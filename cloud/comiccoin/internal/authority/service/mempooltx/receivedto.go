@@ -5,31 +5,48 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
-	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
 )
 
+// MempoolTransactionReceiveDTOFromNetworkService persists the submitted
+// transaction to our mempool and acknowledges it right away, the transaction
+// will be sealed into a block later on by the block producer.
 type MempoolTransactionReceiveDTOFromNetworkService interface {
-	Execute(ctx context.Context, dto *domain.MempoolTransactionDTO) error
+	Execute(ctx context.Context, dto *domain.MempoolTransactionDTO) (*domain.MempoolTransactionStatus, error)
 }
 
 type mempoolTransactionReceiveDTOFromNetworkServiceImpl struct {
-	config                                    *config.Configuration
-	logger                                    *slog.Logger
-	proofOfAuthorityConsensusMechanismService sv_poa.ProofOfAuthorityConsensusMechanismService
+	config                                     *config.Configuration
+	logger                                     *slog.Logger
+	getAccountUseCase                          uc_account.GetAccountUseCase
+	mempoolTransactionListByFromAddressUseCase uc_mempooltx.MempoolTransactionListByFromAddressUseCase
+	mempoolTransactionCreateUseCase            uc_mempooltx.MempoolTransactionCreateUseCase
+	mempoolTransactionStatusUpsertUseCase      uc_mempooltx.MempoolTransactionStatusUpsertUseCase
+	upsertTransactionReceiptUseCase            uc_txreceipt.UpsertTransactionReceiptUseCase
 }
 
 func NewMempoolTransactionReceiveDTOFromNetworkService(
 	cfg *config.Configuration,
 	logger *slog.Logger,
-	proofOfAuthorityConsensusMechanismService sv_poa.ProofOfAuthorityConsensusMechanismService,
+	getAccountUseCase uc_account.GetAccountUseCase,
+	mempoolTransactionListByFromAddressUseCase uc_mempooltx.MempoolTransactionListByFromAddressUseCase,
+	mempoolTransactionCreateUseCase uc_mempooltx.MempoolTransactionCreateUseCase,
+	mempoolTransactionStatusUpsertUseCase uc_mempooltx.MempoolTransactionStatusUpsertUseCase,
+	upsertTransactionReceiptUseCase uc_txreceipt.UpsertTransactionReceiptUseCase,
 ) MempoolTransactionReceiveDTOFromNetworkService {
-	return &mempoolTransactionReceiveDTOFromNetworkServiceImpl{cfg, logger, proofOfAuthorityConsensusMechanismService}
+	return &mempoolTransactionReceiveDTOFromNetworkServiceImpl{cfg, logger, getAccountUseCase, mempoolTransactionListByFromAddressUseCase, mempoolTransactionCreateUseCase, mempoolTransactionStatusUpsertUseCase, upsertTransactionReceiptUseCase}
 }
 
-func (s *mempoolTransactionReceiveDTOFromNetworkServiceImpl) Execute(ctx context.Context, dto *domain.MempoolTransactionDTO) error {
+func (s *mempoolTransactionReceiveDTOFromNetworkServiceImpl) Execute(ctx context.Context, dto *domain.MempoolTransactionDTO) (*domain.MempoolTransactionStatus, error) {
 	//
 	// STEP 1: Validation.
 	//
@@ -38,7 +55,7 @@ func (s *mempoolTransactionReceiveDTOFromNetworkServiceImpl) Execute(ctx context
 		err := fmt.Errorf("Cannot have empty mempool transaction dto")
 		// s.logger.Warn("Validation failed for received",
 		// 	slog.Any("error", err))
-		return err
+		return nil, err
 	}
 
 	//
@@ -47,6 +64,18 @@ func (s *mempoolTransactionReceiveDTOFromNetworkServiceImpl) Execute(ctx context
 
 	mempoolTx := dto.ToIDO()
 
+	// DEVELOPERS NOTE:
+	// The authority decides the order of the mempool, not the sender, so we
+	// always assign our own identifier which is prefixed by the time received.
+	mempoolTx.ID = primitive.NewObjectID()
+
+	txHash, err := mempoolTx.SignedTransaction.TransactionHash()
+	if err != nil {
+		s.logger.Warn("Failed hashing mempool transaction",
+			slog.Any("error", err))
+		return nil, httperror.NewForBadRequestWithSingleField("signed_transaction", err.Error())
+	}
+
 	s.logger.Debug("Received mempooltx",
 		slog.Any("id", dto.ID),
 		slog.Any("v_bytes", dto.VBytes),
@@ -69,15 +98,89 @@ func (s *mempoolTransactionReceiveDTOFromNetworkServiceImpl) Execute(ctx context
 	)
 
 	//
-	// STEP 3: Perform proof of authority work immediately.
+	// STEP 3:
+	// Reject replayed transactions early. The consensus mechanism is the final
+	// authority on nonces, but there is no reason to keep a transaction in our
+	// mempool if the nonce was already used on the blockchain or is already
+	// used by another pending transaction from the same account.
 	//
 
-	execErr := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, mempoolTx)
-	if execErr != nil {
-		// s.logger.Warn("Validation failed for received",
-		// 	slog.Any("error", upsertErr))
-		return execErr
+	if mempoolTx.From != nil {
+		account, err := s.getAccountUseCase.Execute(ctx, mempoolTx.From)
+		if err != nil && !strings.Contains(err.Error(), "does not exist") {
+			s.logger.Error("Failed getting account",
+				slog.Any("from", mempoolTx.From),
+				slog.Any("error", err))
+			return nil, err
+		}
+		if account != nil && mempoolTx.GetNonce().Cmp(account.GetNonce()) <= 0 {
+			s.logger.Warn("Rejected replayed mempool transaction",
+				slog.Any("from", mempoolTx.From),
+				slog.Any("nonce", mempoolTx.GetNonce()),
+				slog.Any("account_nonce", account.GetNonce()))
+			return nil, httperror.NewForBadRequestWithSingleField("nonce", domain.ErrTransactionNonceReplayed.Error())
+		}
+
+		pendingTxs, err := s.mempoolTransactionListByFromAddressUseCase.Execute(ctx, mempoolTx.From)
+		if err != nil {
+			s.logger.Error("Failed listing pending mempool transactions",
+				slog.Any("from", mempoolTx.From),
+				slog.Any("error", err))
+			return nil, err
+		}
+		for _, pendingTx := range pendingTxs {
+			if pendingTx.GetNonce().Cmp(mempoolTx.GetNonce()) == 0 {
+				s.logger.Warn("Rejected duplicate mempool transaction",
+					slog.Any("from", mempoolTx.From),
+					slog.Any("nonce", mempoolTx.GetNonce()),
+					slog.Any("pending_id", pendingTx.ID))
+				return nil, httperror.NewForBadRequestWithSingleField("nonce", domain.ErrTransactionNonceReplayed.Error())
+			}
+		}
 	}
-	return nil
 
+	//
+	// STEP 4: Save to our mempool so the block assembly task can seal it
+	// together with the other pending transactions.
+	//
+
+	if createErr := s.mempoolTransactionCreateUseCase.Execute(ctx, mempoolTx); createErr != nil {
+		s.logger.Warn("Failed saving to mempool",
+			slog.Any("error", createErr))
+		return nil, createErr
+	}
+
+	//
+	// STEP 5:
+	// Keep track of the status so the caller can poll or stream the outcome
+	// of their transaction once the block producer has processed it.
+	//
+
+	receipt := &domain.TransactionReceipt{
+		Hash:                 txHash,
+		MempoolTransactionID: mempoolTx.ID,
+		ChainID:              mempoolTx.ChainID,
+		From:                 mempoolTx.From,
+		NonceBytes:           mempoolTx.NonceBytes,
+		Status:               domain.TransactionReceiptStatusPending,
+	}
+	if err := s.upsertTransactionReceiptUseCase.Execute(ctx, receipt); err != nil {
+		s.logger.Error("Failed saving transaction receipt",
+			slog.String("hash", txHash),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	status := &domain.MempoolTransactionStatus{
+		ID:              mempoolTx.ID,
+		TransactionHash: txHash,
+		Status:          domain.MempoolTransactionStatusPending,
+	}
+	if err := s.mempoolTransactionStatusUpsertUseCase.Execute(ctx, status); err != nil {
+		s.logger.Error("Failed saving mempool transaction status",
+			slog.Any("id", mempoolTx.ID),
+			slog.Any("error", err))
+		return nil, err
+	}
+	return status, nil
 }
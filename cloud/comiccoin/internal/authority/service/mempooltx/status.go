@@ -0,0 +1,47 @@
+package mempooltx
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetMempoolTransactionStatusService returns the processing status of a
+// transaction which was previously submitted to our mempool.
+type GetMempoolTransactionStatusService interface {
+	Execute(ctx context.Context, id primitive.ObjectID) (*domain.MempoolTransactionStatus, error)
+}
+
+type getMempoolTransactionStatusServiceImpl struct {
+	config                             *config.Configuration
+	logger                             *slog.Logger
+	mempoolTransactionStatusGetUseCase uc_mempooltx.MempoolTransactionStatusGetUseCase
+}
+
+func NewGetMempoolTransactionStatusService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_mempooltx.MempoolTransactionStatusGetUseCase,
+) GetMempoolTransactionStatusService {
+	return &getMempoolTransactionStatusServiceImpl{cfg, logger, uc1}
+}
+
+func (s *getMempoolTransactionStatusServiceImpl) Execute(ctx context.Context, id primitive.ObjectID) (*domain.MempoolTransactionStatus, error) {
+	status, err := s.mempoolTransactionStatusGetUseCase.Execute(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed getting mempool transaction status",
+			slog.Any("id", id),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if status == nil {
+		return nil, httperror.NewForNotFoundWithSingleField("id", "mempool transaction status does not exist or has expired")
+	}
+	return status, nil
+}
@@ -0,0 +1,109 @@
+package poa
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+)
+
+// ProofOfAuthorityBlockAssemblyService drains pending transactions from the
+// mempool and hands them off to the consensus mechanism so they can be sealed
+// together in a single block.
+type ProofOfAuthorityBlockAssemblyService interface {
+	// Execute seals a block if enough transactions are waiting in the mempool
+	// or if the oldest transaction has waited longer than the configured
+	// maximum block interval. Returns the number of transactions submitted
+	// to the consensus mechanism.
+	Execute(ctx context.Context) (int, error)
+}
+
+type proofOfAuthorityBlockAssemblyServiceImpl struct {
+	config                                    *config.Configuration
+	logger                                    *slog.Logger
+	mempoolTransactionListByChainIDUseCase    uc_mempooltx.MempoolTransactionListByChainIDUseCase
+	proofOfAuthorityConsensusMechanismService ProofOfAuthorityConsensusMechanismService
+}
+
+func NewProofOfAuthorityBlockAssemblyService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_mempooltx.MempoolTransactionListByChainIDUseCase,
+	s1 ProofOfAuthorityConsensusMechanismService,
+) ProofOfAuthorityBlockAssemblyService {
+	return &proofOfAuthorityBlockAssemblyServiceImpl{config, logger, uc1, s1}
+}
+
+func (s *proofOfAuthorityBlockAssemblyServiceImpl) Execute(ctx context.Context) (int, error) {
+	//
+	// STEP 1:
+	// Fetch all the pending transactions in the order they were received.
+	//
+
+	pendingTxs, err := s.mempoolTransactionListByChainIDUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed listing mempool transactions",
+			slog.Any("error", err))
+		return 0, err
+	}
+	if len(pendingTxs) == 0 {
+		return 0, nil
+	}
+
+	//
+	// STEP 2:
+	// Decide whether we have enough transactions to seal a block or whether
+	// we should keep waiting for more transactions to arrive.
+	//
+
+	transPerBlock := int(s.config.Blockchain.TransPerBlock)
+	if transPerBlock <= 0 {
+		transPerBlock = 1 // Defensive code: Always make progress.
+	}
+
+	// DEVELOPERS NOTE:
+	// The mempool transaction `_id` is a MongoDB object ID which is prefixed
+	// by the time it was created, therefore we can use it to know how long the
+	// oldest transaction has been waiting.
+	oldestWait := time.Since(pendingTxs[0].ID.Timestamp())
+	if len(pendingTxs) < transPerBlock && oldestWait < s.config.Blockchain.MaxBlockInterval {
+		s.logger.Debug("Waiting for more mempool transactions before sealing block",
+			slog.Int("pending", len(pendingTxs)),
+			slog.Int("trans_per_block", transPerBlock),
+			slog.Duration("oldest_wait", oldestWait))
+		return 0, nil
+	}
+
+	// The transactions paying the highest fees are sealed first, the rest
+	// wait in the mempool for the next block.
+	pendingTxs = domain.SortMempoolTransactionsByFee(pendingTxs, s.config.Blockchain.TransactionFee)
+	batch := pendingTxs[:min(len(pendingTxs), transPerBlock)]
+
+	//
+	// STEP 3:
+	// Seal the block.
+	//
+
+	s.logger.Debug("Assembling block from mempool transactions",
+		slog.Int("batch", len(batch)),
+		slog.Int("pending", len(pendingTxs)),
+		slog.Duration("oldest_wait", oldestWait))
+
+	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, batch); err != nil {
+		// Another authority is scheduled to seal the next block so we leave
+		// the transactions in the mempool for them.
+		if errors.Is(err, domain.ErrNotScheduledValidator) {
+			s.logger.Debug("Skipped sealing block, another validator is scheduled",
+				slog.Int("batch", len(batch)))
+			return 0, nil
+		}
+		s.logger.Error("Failed sealing block from mempool transactions",
+			slog.Any("error", err))
+		return len(batch), err
+	}
+	return len(batch), nil
+}
@@ -1,12 +1,16 @@
 package poa
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
@@ -18,15 +22,32 @@ import (
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/genesisblockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
 	uc_pow "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/pow"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/merkle"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
 )
 
 type ProofOfAuthorityConsensusMechanismService interface {
-	Execute(ctx context.Context, mempoolTx *dom.MempoolTransaction) error
+	// Execute seals the mempool transactions, in the order provided, into a
+	// single new block. Transactions which fail verification are rejected and
+	// removed from the mempool without affecting the rest of the block.
+	Execute(ctx context.Context, mempoolTxs []*dom.MempoolTransaction) error
+
+	// ExecuteWithSealedHook is like `Execute` but, before the block is
+	// committed, calls the hook inside the database transaction of the block
+	// with the transactions which were sealed, in block order. An error from
+	// the hook discards the whole block.
+	ExecuteWithSealedHook(ctx context.Context, mempoolTxs []*dom.MempoolTransaction, hook SealedTransactionsHook) error
 }
 
+// SealedTransactionsHook lets callers save their own records in the same
+// database transaction as the block which sealed their transactions. The
+// hook may be called more then once if the database transaction is retried.
+type SealedTransactionsHook func(sessCtx mongo.SessionContext, sealedTxs []*dom.MempoolTransaction) error
+
 // ProofOfAuthorityConsensusMechanismService represents the service which
 // delivers comparatively fast transactions using identity as a stake.
 //
@@ -52,6 +73,13 @@ type proofOfAuthorityConsensusMechanismServiceImpl struct {
 	proofOfWorkUseCase                        uc_pow.ProofOfWorkUseCase
 	upsertBlockDataUseCase                    uc_blockdata.UpsertBlockDataUseCase
 	blockchainStatePublishUseCase             uc_blockchainstate.BlockchainStatePublishUseCase
+	mempoolTransactionStatusUpsertUseCase     uc_mempooltx.MempoolTransactionStatusUpsertUseCase
+	upsertTransactionReceiptUseCase           uc_txreceipt.UpsertTransactionReceiptUseCase
+	listValidatorSetUseCase                   uc_validatorset.ListValidatorSetUseCase
+	upsertValidatorSetMemberUseCase           uc_validatorset.UpsertValidatorSetMemberUseCase
+	deleteValidatorSetMemberUseCase           uc_validatorset.DeleteValidatorSetMemberUseCase
+	upsertAccountStateDeltaUseCase            uc_statedelta.UpsertAccountStateDeltaUseCase
+	upsertTokenStateDeltaUseCase              uc_statedelta.UpsertTokenStateDeltaUseCase
 }
 
 func NewProofOfAuthorityConsensusMechanismService(
@@ -74,11 +102,22 @@ func NewProofOfAuthorityConsensusMechanismService(
 	uc12 uc_pow.ProofOfWorkUseCase,
 	uc13 uc_blockdata.UpsertBlockDataUseCase,
 	uc14 uc_blockchainstate.BlockchainStatePublishUseCase,
+	uc15 uc_mempooltx.MempoolTransactionStatusUpsertUseCase,
+	uc16 uc_txreceipt.UpsertTransactionReceiptUseCase,
+	uc17 uc_validatorset.ListValidatorSetUseCase,
+	uc18 uc_validatorset.UpsertValidatorSetMemberUseCase,
+	uc19 uc_validatorset.DeleteValidatorSetMemberUseCase,
+	uc20 uc_statedelta.UpsertAccountStateDeltaUseCase,
+	uc21 uc_statedelta.UpsertTokenStateDeltaUseCase,
 ) ProofOfAuthorityConsensusMechanismService {
-	return &proofOfAuthorityConsensusMechanismServiceImpl{config, logger, dmutex, client, s1, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8, uc9, uc10, uc11, uc12, uc13, uc14}
+	return &proofOfAuthorityConsensusMechanismServiceImpl{config, logger, dmutex, client, s1, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8, uc9, uc10, uc11, uc12, uc13, uc14, uc15, uc16, uc17, uc18, uc19, uc20, uc21}
+}
+
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Context, mempoolTxs []*dom.MempoolTransaction) error {
+	return s.ExecuteWithSealedHook(ctx, mempoolTxs, nil)
 }
 
-func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Context, mempoolTx *dom.MempoolTransaction) error {
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) ExecuteWithSealedHook(ctx context.Context, mempoolTxs []*dom.MempoolTransaction, hook SealedTransactionsHook) error {
 	// Protect our resource - this PoA consensus mechanism can only exist as
 	// a single instance any time. So if we have more then one authority nodes
 	// running on the network, coordinate view the distributed mutext, that
@@ -87,53 +126,67 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 	defer s.dmutex.Release(ctx, "ProofOfAuthorityConsensusMechanism")
 
 	//
-	// STEP 1: For debugging purposes only.
+	// STEP 1: Validation.
 	//
 
-	s.logger.Debug("New memory pool transaction detected!",
-		slog.Any("chain_id", mempoolTx.ChainID),
-		slog.Any("nonce", mempoolTx.GetNonce()),
-		slog.Any("from", mempoolTx.From),
-		slog.Any("to", mempoolTx.To),
-		slog.Any("value", mempoolTx.Value),
-		slog.Any("data", mempoolTx.Data),
-		slog.Any("type", mempoolTx.Type),
-		slog.Any("token_id", mempoolTx.GetTokenID()),
-		slog.Any("token_metadata_uri", mempoolTx.TokenMetadataURI),
-		slog.Any("token_nonce", mempoolTx.GetTokenNonce()),
-		// slog.Any("tx_sig_v_bytes", mempoolTx.VBytes),
-		// slog.Any("tx_sig_r_bytes", mempoolTx.RBytes),
-		// slog.Any("tx_sig_s_bytes", mempoolTx.SBytes)
-	)
-
-	if mempoolTx.VBytes == nil {
-		err := fmt.Errorf("Missing: %v", "v_bytes")
-		s.logger.Error("Failed validating memory pool transaction",
-			slog.Any("error", err))
-		return err
+	if len(mempoolTxs) == 0 {
+		s.logger.Warn("No memory pool transactions to process")
+		return nil
 	}
-	if mempoolTx.RBytes == nil {
-		err := fmt.Errorf("Missing: %v", "r_bytes")
-		s.logger.Error("Failed validating memory pool transaction",
-			slog.Any("error", err))
-		return err
-	}
-	if mempoolTx.SBytes == nil {
-		err := fmt.Errorf("Missing: %v", "s_bytes")
-		s.logger.Error("Failed validating memory pool transaction",
+	if s.config.Blockchain.TransPerBlock > 0 && len(mempoolTxs) > int(s.config.Blockchain.TransPerBlock) {
+		err := fmt.Errorf("Too many transactions for block: got %v but maximum is %v", len(mempoolTxs), s.config.Blockchain.TransPerBlock)
+		s.logger.Error("Failed validating memory pool transactions",
 			slog.Any("error", err))
 		return err
 	}
-	if mempoolTx.SignedTransaction.Transaction.Data != nil {
-		if len(mempoolTx.SignedTransaction.Transaction.Data) > 16384 {
-			s.logger.Error("Data is too large.",
-				slog.Any("data", mempoolTx.SignedTransaction.Transaction.Data),
-				slog.Any("length", len(mempoolTx.SignedTransaction.Transaction.Data)),
-			)
-			return fmt.Errorf("Data is too large")
+
+	// Variable holds all the transactions which failed the initial
+	// validation and the reason why they were rejected.
+	invalidTxs := make([]*dom.MempoolTransaction, 0)
+	invalidErrs := make([]error, 0)
+
+	// Variable holds all the transactions which passed the initial
+	// validation and are candidates to be sealed in our block.
+	candidateTxs := make([]*dom.MempoolTransaction, 0, len(mempoolTxs))
+
+	for _, mempoolTx := range mempoolTxs {
+		s.logger.Debug("New memory pool transaction detected!",
+			slog.Any("chain_id", mempoolTx.ChainID),
+			slog.Any("nonce", mempoolTx.GetNonce()),
+			slog.Any("from", mempoolTx.From),
+			slog.Any("to", mempoolTx.To),
+			slog.Any("value", mempoolTx.Value),
+			slog.Any("data", mempoolTx.Data),
+			slog.Any("type", mempoolTx.Type),
+			slog.Any("token_id", mempoolTx.GetTokenID()),
+			slog.Any("token_metadata_uri", mempoolTx.TokenMetadataURI),
+			slog.Any("token_nonce", mempoolTx.GetTokenNonce()),
+		)
+
+		if err := s.validateMempoolTransaction(mempoolTx); err != nil {
+			s.logger.Error("Failed validating memory pool transaction",
+				slog.Any("id", mempoolTx.ID),
+				slog.Any("error", err))
+			invalidTxs = append(invalidTxs, mempoolTx)
+			invalidErrs = append(invalidErrs, err)
+			continue
 		}
+		candidateTxs = append(candidateTxs, mempoolTx)
 	}
 
+	// Variable keeps track of the reason why every transaction was rejected
+	// from this block so we can report back to the caller when nothing was
+	// sealed.
+	var rejectedErrs []error
+
+	// Variable keeps track of the final status of every transaction processed
+	// so we can let the submitters know once our changes were committed.
+	var txStatuses []*dom.MempoolTransactionStatus
+
+	// Variable keeps track of the receipt of every transaction processed, the
+	// receipts are saved together with the block.
+	var txReceipts []*dom.TransactionReceipt
+
 	//
 	// STEP 2:
 	// Start a transaction so we can discard all changes made to the database in
@@ -152,6 +205,23 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 	transactionFunc := func(sessCtx mongo.SessionContext) (interface{}, error) {
 		s.logger.Debug("Transaction started")
 
+		// DEVELOPERS NOTE:
+		// MongoDB may retry this function on transient errors so always
+		// start from a clean list.
+		rejectedErrs = append(make([]error, 0, len(mempoolTxs)), invalidErrs...)
+		txStatuses = make([]*dom.MempoolTransactionStatus, 0, len(mempoolTxs))
+		txReceipts = make([]*dom.TransactionReceipt, 0, len(mempoolTxs))
+		for i, mempoolTx := range invalidTxs {
+			txStatuses = append(txStatuses, &dom.MempoolTransactionStatus{
+				ID:     mempoolTx.ID,
+				Status: dom.MempoolTransactionStatusRejected,
+				Error:  invalidErrs[i].Error(),
+			})
+			if txReceipt := s.newTransactionReceipt(mempoolTx, dom.TransactionReceiptStatusRejected, invalidErrs[i]); txReceipt != nil {
+				txReceipts = append(txReceipts, txReceipt)
+			}
+		}
+
 		//
 		// STEP 3:
 		// Fetch related records.
@@ -222,31 +292,60 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 			return nil, fmt.Errorf("Latest block data does not exist")
 		}
 
-		// We want to attach on-chain our identity.
-		poaValidator := recentBlockData.Validator
+		blockNumber := recentBlockData.Header.GetNumber()
+		newBlockNumber := blockNumber.Add(blockNumber, big.NewInt(1))
 
-		// Variable used to create the transactions to store on the blockchain.
-		trans := make([]domain.BlockTransaction, 0)
+		// The timestamp of our new block, it decides whose turn it is once
+		// the scheduled validator missed its turn.
+		now := time.Now().UTC()
+		timeStamp := uint64(now.UnixMilli())
 
-		//
-		// STEP 4:
-		// Verify the transaction.
-		//
-		if err := s.verifyMempoolTransaction(sessCtx, mempoolTx); err != nil {
-			s.logger.Error("Failed verifying the mempool block transaction",
+		// Never build on a block which claims to be sealed in the future,
+		// every validator would count the missed turns from its timestamp.
+		if err := dom.ValidateBlockTimeStamp(recentBlockData.Header, now); err != nil {
+			s.logger.Warn("Latest block timestamp is in the future, waiting before sealing",
+				slog.Any("block_number", recentBlockData.Header.GetNumber()),
 				slog.Any("error", err))
 			sessCtx.AbortTransaction(ctx)
 			return nil, err
 		}
 
-		//
-		// STEP 6:
-		// Process 🪙 coin value
-		//
+		// We want to attach on-chain our identity, however we may only seal
+		// this block if our key belongs to the validator scheduled for it.
+		// Otherwise we leave the transactions in the mempool for the
+		// authority whose turn it is.
+		validators, err := s.getValidatorSet(sessCtx, genesis)
+		if err != nil {
+			s.logger.Error("Failed getting validator set.",
+				slog.Any("error", err))
+			sessCtx.AbortTransaction(ctx)
+			return nil, err
+		}
+		poaValidator := dom.ScheduledValidatorAt(validators, newBlockNumber, recentBlockData.Header.TimeStamp, timeStamp)
+		if poaValidator == nil || !bytes.Equal(poaValidator.PublicKeyBytes, crypto.FromECDSAPub(&proofOfAuthorityPrivateKey.PublicKey)) {
+			s.logger.Debug("Not our turn to seal block",
+				slog.Any("block_number", newBlockNumber),
+				slog.Int("validators", len(validators)))
+			sessCtx.AbortTransaction(ctx)
+			return nil, dom.ErrNotScheduledValidator
+		}
+
+		// Variable used to create the transactions to store on the blockchain.
+		trans := make([]domain.BlockTransaction, 0, len(candidateTxs))
+		sealedTxs := make([]*dom.MempoolTransaction, 0, len(candidateTxs))
 
-		if mempoolTx.Type == domain.TransactionTypeCoin {
-			if err := s.processAccountForCoinMempoolTransaction(sessCtx, mempoolTx); err != nil {
-				s.logger.Error("Failed processing account in pending block transaction",
+		// Transactions may only carry their own fee once our new block
+		// reached the activation height of the fee market.
+		feeMarketActive := dom.IsFeeMarketActive(s.config.Blockchain.FeeMarketActivationHeight, newBlockNumber)
+
+		// Variable used to track the latest token ID as we apply the
+		// transactions in this block.
+		latestTokenID := blockchainState.GetLatestTokenID()
+
+		// Rejected transactions must never be processed again.
+		for _, mempoolTx := range invalidTxs {
+			if err := s.mempoolTransactionDeleteByIDUseCase.Execute(sessCtx, mempoolTx.ID); err != nil {
+				s.logger.Error("Failed deleting rejected mempool transaction",
 					slog.Any("error", err))
 				sessCtx.AbortTransaction(ctx)
 				return nil, err
@@ -254,35 +353,141 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 		}
 
 		//
-		// STEP 7:
-		// Process 🎟️ tokens.
+		// STEP 4:
+		// Apply every transaction in the order provided. Because all reads and
+		// writes happen inside this session, every transaction is verified
+		// against the account and token state left behind by the transactions
+		// before it in this block.
 		//
 
-		latestTokenID := blockchainState.GetLatestTokenID()
-		if mempoolTx.Type == domain.TransactionTypeToken {
-			if err := s.processAccountForTokenMempoolTransaction(sessCtx, mempoolTx, blockchainState); err != nil {
-				s.logger.Error("Failed processing token in mempool block transaction",
+		for _, mempoolTx := range candidateTxs {
+			if err := s.verifyMempoolTransaction(sessCtx, mempoolTx, feeMarketActive); err != nil {
+				s.logger.Warn("Rejected mempool transaction from block",
+					slog.Any("id", mempoolTx.ID),
 					slog.Any("error", err))
-				sessCtx.AbortTransaction(ctx)
-				return nil, err
+				rejectedErrs = append(rejectedErrs, err)
+				txStatuses = append(txStatuses, &dom.MempoolTransactionStatus{
+					ID:     mempoolTx.ID,
+					Status: dom.MempoolTransactionStatusRejected,
+					Error:  err.Error(),
+				})
+				if txReceipt := s.newTransactionReceipt(mempoolTx, dom.TransactionReceiptStatusRejected, err); txReceipt != nil {
+					txReceipts = append(txReceipts, txReceipt)
+				}
+
+				// Rejected transactions must never be processed again.
+				if err := s.mempoolTransactionDeleteByIDUseCase.Execute(sessCtx, mempoolTx.ID); err != nil {
+					s.logger.Error("Failed deleting rejected mempool transaction",
+						slog.Any("error", err))
+					sessCtx.AbortTransaction(ctx)
+					return nil, err
+				}
+				continue
+			}
+
+			// Variables hold the royalty paid to the creator of a token
+			// out of the coin payment made with the token, if any.
+			var royaltyRecipient *common.Address
+			var royalty uint64
+
+			// Process 🪙 coin value
+			if mempoolTx.Type == domain.TransactionTypeCoin {
+				if err := s.processAccountForCoinMempoolTransaction(sessCtx, mempoolTx); err != nil {
+					s.logger.Error("Failed processing account in pending block transaction",
+						slog.Any("error", err))
+					sessCtx.AbortTransaction(ctx)
+					return nil, err
+				}
 			}
 
-			// New `token_id` set in our blockchain state.
-			latestTokenID = mempoolTx.SignedTransaction.Transaction.GetTokenID()
+			// Process 🎟️ tokens.
+			if mempoolTx.Type == domain.TransactionTypeToken {
+				royaltyRecipient, royalty, err = s.processAccountForTokenMempoolTransaction(sessCtx, mempoolTx, blockchainState)
+				if err != nil {
+					s.logger.Error("Failed processing token in mempool block transaction",
+						slog.Any("error", err))
+					sessCtx.AbortTransaction(ctx)
+					return nil, err
+				}
+
+				// New `token_id` set in our blockchain state.
+				if txTokenID := mempoolTx.SignedTransaction.Transaction.GetTokenID(); txTokenID.Cmp(latestTokenID) > 0 {
+					latestTokenID = txTokenID
+
+					s.logger.Debug("New token detected",
+						slog.Any("latest_token_id", latestTokenID))
+				}
+			}
+
+			// Process 🤝 swaps of a token for coins.
+			if mempoolTx.Type == domain.TransactionTypeSwap {
+				royaltyRecipient, royalty, err = s.processSwapMempoolTransaction(sessCtx, mempoolTx)
+				if err != nil {
+					s.logger.Error("Failed processing swap in mempool block transaction",
+						slog.Any("error", err))
+					sessCtx.AbortTransaction(ctx)
+					return nil, err
+				}
+			}
 
-			s.logger.Debug("New token detected",
-				slog.Any("latest_token_id", latestTokenID))
+			// Process 🔑 validator set changes.
+			if mempoolTx.Type == domain.TransactionTypeValidator {
+				if err := s.processValidatorSetMempoolTransaction(sessCtx, mempoolTx, genesis); err != nil {
+					s.logger.Error("Failed processing validator set change in mempool block transaction",
+						slog.Any("error", err))
+					sessCtx.AbortTransaction(ctx)
+					return nil, err
+				}
+			}
+
+			_, _, fee := mempoolTx.SplitFees(s.config.Blockchain.TransactionFee)
+			blockTx := domain.BlockTransaction{
+				SignedTransaction: mempoolTx.SignedTransaction,
+				TimeStamp:         uint64(time.Now().UTC().UnixMilli()),
+				Fee:               fee, // This is the fee collected by the authority for this transaction.
+				RoyaltyRecipient:  royaltyRecipient,
+				Royalty:           royalty,
+			}
+			blockTx = blockTx.WithoutJSONStrings() // Read-only fields must never be hashed into the merkle tree.
+			if txReceipt := s.newTransactionReceipt(mempoolTx, dom.TransactionReceiptStatusIncluded, nil); txReceipt != nil {
+				txReceipt.TransactionIndex = uint64(len(trans)) // Position inside `BlockData.Trans`.
+				txReceipts = append(txReceipts, txReceipt)
+			}
+			trans = append(trans, blockTx)
+			sealedTxs = append(sealedTxs, mempoolTx)
+			txStatuses = append(txStatuses, &dom.MempoolTransactionStatus{
+				ID:     mempoolTx.ID,
+				Status: dom.MempoolTransactionStatusConfirmed,
+			})
+
+			// Delete mempool data as it has been processed.
+			if err := s.mempoolTransactionDeleteByIDUseCase.Execute(sessCtx, mempoolTx.ID); err != nil {
+				s.logger.Error("Failed deleting processed mempool transaction",
+					slog.Any("error", err))
+				sessCtx.AbortTransaction(ctx)
+				return nil, err
+			}
 		}
 
-		// Create our block.
-		blockTx := domain.BlockTransaction{
-			SignedTransaction: mempoolTx.SignedTransaction,
-			TimeStamp:         uint64(time.Now().UTC().UnixMilli()),
-			Fee:               s.config.Blockchain.TransactionFee, // This is the fee that is applied by the authority to subtract from the value of the this transaction.
+		// Defensive code: If every transaction was rejected then there is
+		// nothing to seal; however, we still commit so the rejected
+		// transactions are removed from our mempool.
+		if len(trans) == 0 {
+			s.logger.Warn("No valid transactions to seal in block",
+				slog.Int("rejected", len(rejectedErrs)))
+			if err := s.saveTransactionReceipts(sessCtx, txReceipts); err != nil {
+				sessCtx.AbortTransaction(ctx)
+				return nil, err
+			}
+			if err := sessCtx.CommitTransaction(ctx); err != nil {
+				s.logger.Error("Failed comming transaction",
+					slog.Any("error", err))
+				return nil, err
+			}
+			return nil, nil
 		}
-		trans = append(trans, blockTx)
 
-		// Construct a merkle tree from the transaction for this block. The root
+		// Construct a merkle tree from the transactions for this block. The root
 		// of this tree will be part of the block to be mined.
 		tree, err := merkle.NewTree(trans)
 		if err != nil {
@@ -292,30 +497,20 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 			return nil, err
 		}
 
-		// // Query the local database and get the most recent token ID.
-		// latestToken, err := s.getTokenUseCase.Execute(sessCtx, blockchainState.LatestTokenID)
-		// if err != nil {
-		// 	s.logger.Error("Failed getting blockchains latest token id",
-		// 		slog.Any("error", err))
-		// 	return nil, err
-		// }
-
-		// Iterate through all the accounts from this local machine, sort them and
-		// then hash all of them - this hash represents our `stateRoot` which is
-		// in essence a snapshot of the current accounts and their balances. Why is
-		// this important?
+		// Get the root of the accounts trie - this hash represents our
+		// `stateRoot` which is in essence a snapshot of the current accounts and
+		// their balances. Why is this important?
 		//
-		// At the start of creating a new block to be mined, a hash of this map is
-		// created and stored in the block under the StateRoot field. This allows
-		// each node to validate the current state of the peer’s accounts database
-		// as part of block validation.
+		// At the start of creating a new block to be mined, the root is stored
+		// in the block under the StateRoot field. This allows each node to
+		// validate the current state of the peer’s accounts database as part of
+		// block validation, and lets a wallet prove the balance of a single
+		// account against the signed block header.
 		//
-		// It’s critically important that the order of the account balances are
-		// exact when hashing the data. The Go spec does not define the order of
-		// map iteration and leaves it up to the compiler. Since Go 1.0,
-		// the compiler has chosen to have map iteration be random. This function
-		//  sorts the accounts and their balances into a slice first and then
-		// performs a hash of that slice.
+		// The accounts trie is a sparse merkle tree keyed by the account
+		// address, every time an account is upserted while processing the
+		// transactions above only the path from its leaf to the root is
+		// re-hashed so we never have to load every account per block.
 		//
 		// When a new block is received, the node can take a hash of their current
 		// accounts database and match that to the StateRoot field in the
@@ -342,16 +537,13 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 			return nil, err
 		}
 
-		blockNumber := recentBlockData.Header.GetNumber()
-		newBlockNumber := blockNumber.Add(blockNumber, big.NewInt(1))
-
 		// Construct the block.
 		block := domain.Block{
 			Header: &domain.BlockHeader{
 				ChainID:            s.config.Blockchain.ChainID,
 				NumberBytes:        newBlockNumber.Bytes(),
 				PrevBlockHash:      string(blockchainState.LatestHash),
-				TimeStamp:          uint64(time.Now().UTC().UnixMilli()),
+				TimeStamp:          timeStamp,
 				Difficulty:         s.config.Blockchain.Difficulty,
 				Beneficiary:        *s.config.Blockchain.ProofOfAuthorityAccountAddress,
 				TransactionFee:     s.config.Blockchain.TransactionFee, // This is what is applied by the authority.
@@ -360,22 +552,23 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 				NonceBytes:         big.NewInt(0).Bytes(), // Will be identified by the PoW algorithm.
 				LatestTokenIDBytes: latestTokenID.Bytes(), // Ensure our blockchain state has always the latest token ID recorded.
 				TokensRoot:         tokensRoot,
+				Version:            domain.BlockHeaderVersion,
 			},
 			HeaderSignatureBytes: []byte{}, // Will be identified by the PoA algorithm in this function!
 			MerkleTree:           tree,
 		}
 
 		//
-		// STEP 8:
+		// STEP 5:
 		// Execute the proof of work to find our nonce to meet the hash difficulty.
 		//
 
 		nonce, powErr := s.proofOfWorkUseCase.Execute(sessCtx, &block, s.config.Blockchain.Difficulty)
 		if powErr != nil {
 			s.logger.Error("Failed to mine block header",
-				slog.Any("error", err))
+				slog.Any("error", powErr))
 			sessCtx.AbortTransaction(ctx)
-			return nil, err
+			return nil, powErr
 		}
 
 		block.Header.NonceBytes = nonce.Bytes()
@@ -384,7 +577,7 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 		blockData := domain.NewBlockData(block)
 
 		//
-		// STEP 9:
+		// STEP 6:
 		// Our proof-of-authority signs this block data's header.
 		//
 
@@ -403,38 +596,27 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 			slog.String("hash", blockData.Hash),
 			slog.Any("block_number", blockData.Header.GetNumber()),
 			slog.String("prev_block_hash", blockData.Header.PrevBlockHash),
-			// slog.Uint64("timestamp", blockData.Header.TimeStamp),
-			// slog.String("beneficiary", blockData.Header.Beneficiary.String()),
-			// slog.Uint64("difficulty", uint64(blockData.Header.Difficulty)),
-			// slog.Uint64("mining_reward", blockData.Header.MiningReward),
 			slog.String("state_root", blockData.Header.StateRoot),
 			slog.String("trans_root", blockData.Header.TransRoot),
-			// slog.Uint64("nonce", blockData.Header.Nonce),
-			// slog.Uint64("latest_token_id", blockData.Header.LatestTokenID),
-			// slog.Any("trans", blockData.Trans),
-			// slog.Any("header_signature_bytes", blockData.HeaderSignatureBytes))
+			slog.Int("trans_count", len(blockData.Trans)),
+			slog.Int("rejected_count", len(rejectedErrs)),
 		)
 
 		//
-		// STEP 10:
-		// Delete mempool data as it has already been processed.
+		// STEP 7:
+		// Save to (local) blockchain database
 		//
 
-		if err := s.mempoolTransactionDeleteByIDUseCase.Execute(sessCtx, mempoolTx.ID); err != nil {
-			s.logger.Error("Failed deleting all pending block transactions",
+		if err := s.upsertBlockDataUseCase.Execute(sessCtx, blockData.Hash, blockData.Header, blockData.HeaderSignatureBytes, blockData.Trans, blockData.Validator); err != nil {
+			s.logger.Error("Authority failed saving block data",
 				slog.Any("error", err))
 			sessCtx.AbortTransaction(ctx)
 			return nil, err
 		}
 
-		//
-		// STEP 11:
-		// Save to (local) blockchain database
-		//
-
-		if err := s.upsertBlockDataUseCase.Execute(sessCtx, blockData.Hash, blockData.Header, blockData.HeaderSignatureBytes, blockData.Trans, blockData.Validator); err != nil {
-			s.logger.Error("Authority failed saving block data",
-				slog.Any("error", err))
+		// Record the state of every account and token this block changed so
+		// their history can be looked up by block number.
+		if err := s.saveStateDeltas(sessCtx, blockData); err != nil {
 			sessCtx.AbortTransaction(ctx)
 			return nil, err
 		}
@@ -448,6 +630,35 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 			slog.String("previous_state_root", recentBlockData.Header.StateRoot),
 		)
 
+		// Attach the block to the transactions which were sealed in it.
+		for _, txStatus := range txStatuses {
+			if txStatus.Status == dom.MempoolTransactionStatusConfirmed {
+				txStatus.BlockNumberString = blockData.Header.GetNumber().String()
+				txStatus.BlockHash = blockData.Hash
+			}
+		}
+		for _, txReceipt := range txReceipts {
+			if txReceipt.Status == dom.TransactionReceiptStatusIncluded {
+				txReceipt.BlockNumberBytes = blockData.Header.NumberBytes
+				txReceipt.BlockHash = blockData.Hash
+			}
+		}
+		if err := s.saveTransactionReceipts(sessCtx, txReceipts); err != nil {
+			sessCtx.AbortTransaction(ctx)
+			return nil, err
+		}
+
+		// Let the caller save their records for the sealed transactions
+		// together with our block.
+		if hook != nil {
+			if err := hook(sessCtx, sealedTxs); err != nil {
+				s.logger.Error("Failed executing sealed transactions hook",
+					slog.Any("error", err))
+				sessCtx.AbortTransaction(ctx)
+				return nil, err
+			}
+		}
+
 		blockchainState.LatestBlockNumberBytes = blockData.Header.NumberBytes
 		blockchainState.LatestHash = blockData.Hash
 		blockchainState.LatestTokenIDBytes = latestTokenID.Bytes()
@@ -502,14 +713,145 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) Execute(ctx context.Cont
 
 	// Start a transaction
 	if _, err := session.WithTransaction(ctx, transactionFunc); err != nil {
+		// It is not our turn to seal the block, this is expected when
+		// more then one validator is running so do not report it as an error.
+		if errors.Is(err, dom.ErrNotScheduledValidator) {
+			return err
+		}
 		s.logger.Error("session failed error",
 			slog.Any("error", err))
 		return err
 	}
+
+	//
+	// STEP 8:
+	// Let the submitters know the outcome of their transactions. Our changes
+	// were already committed so failing here must not fail the block.
+	//
+
+	for _, txStatus := range txStatuses {
+		if err := s.mempoolTransactionStatusUpsertUseCase.Execute(ctx, txStatus); err != nil {
+			s.logger.Warn("Failed saving mempool transaction status",
+				slog.Any("id", txStatus.ID),
+				slog.Any("status", txStatus.Status),
+				slog.Any("error", err))
+		}
+	}
+
+	// If nothing was sealed then let the caller know why.
+	if len(rejectedErrs) == len(mempoolTxs) {
+		return errors.Join(rejectedErrs...)
+	}
 	return nil
 }
 
-func (s *proofOfAuthorityConsensusMechanismServiceImpl) verifyMempoolTransaction(sessCtx mongo.SessionContext, mempoolTx *domain.MempoolTransaction) error {
+// newTransactionReceipt creates the receipt for the mempool transaction. If
+// the transaction cannot be hashed then no receipt can ever be looked up for
+// it and therefore nil is returned.
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) newTransactionReceipt(mempoolTx *dom.MempoolTransaction, status string, reason error) *dom.TransactionReceipt {
+	txHash, err := mempoolTx.SignedTransaction.TransactionHash()
+	if err != nil {
+		s.logger.Warn("Failed hashing mempool transaction for receipt",
+			slog.Any("id", mempoolTx.ID),
+			slog.Any("error", err))
+		return nil
+	}
+	receipt := &dom.TransactionReceipt{
+		Hash:                 txHash,
+		MempoolTransactionID: mempoolTx.ID,
+		ChainID:              mempoolTx.ChainID,
+		From:                 mempoolTx.From,
+		NonceBytes:           mempoolTx.NonceBytes,
+		Status:               status,
+	}
+	if reason != nil {
+		receipt.RejectionReason = reason.Error()
+	}
+	return receipt
+}
+
+// saveStateDeltas saves the state of the accounts and tokens changed by the
+// block, the accounts are read inside the session so they already include
+// every transaction of the block.
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) saveStateDeltas(sessCtx mongo.SessionContext, blockData *domain.BlockData) error {
+	for _, address := range dom.StateDeltaAddresses(blockData) {
+		acc, err := s.getAccountUseCase.Execute(sessCtx, address)
+		if err != nil {
+			s.logger.Error("Failed getting account for state delta",
+				slog.Any("address", address),
+				slog.Any("error", err))
+			return err
+		}
+		if acc == nil {
+			continue
+		}
+		delta := &dom.AccountStateDelta{
+			ChainID:        blockData.Header.ChainID,
+			Address:        acc.Address,
+			BlockNumber:    blockData.Header.GetNumber().Uint64(),
+			BlockHash:      blockData.Hash,
+			BlockTimeStamp: blockData.Header.TimeStamp,
+			Balance:        acc.Balance,
+			NonceBytes:     acc.NonceBytes,
+		}
+		if err := s.upsertAccountStateDeltaUseCase.Execute(sessCtx, delta); err != nil {
+			s.logger.Error("Failed saving account state delta",
+				slog.Any("address", address),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	for _, delta := range dom.NewTokenStateDeltas(blockData) {
+		if err := s.upsertTokenStateDeltaUseCase.Execute(sessCtx, delta); err != nil {
+			s.logger.Error("Failed saving token state delta",
+				slog.Any("token_id", delta.GetTokenID()),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// saveTransactionReceipts saves the receipts inside the session so they are
+// only visible once the block they reference was committed.
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) saveTransactionReceipts(sessCtx mongo.SessionContext, receipts []*dom.TransactionReceipt) error {
+	for _, receipt := range receipts {
+		if err := s.upsertTransactionReceiptUseCase.Execute(sessCtx, receipt); err != nil {
+			s.logger.Error("Failed saving transaction receipt",
+				slog.String("hash", receipt.Hash),
+				slog.String("status", receipt.Status),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// validateMempoolTransaction performs the stateless checks on the mempool
+// transaction which do not require access to our database.
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) validateMempoolTransaction(mempoolTx *domain.MempoolTransaction) error {
+	if mempoolTx.VBytes == nil {
+		return fmt.Errorf("Missing: %v", "v_bytes")
+	}
+	if mempoolTx.RBytes == nil {
+		return fmt.Errorf("Missing: %v", "r_bytes")
+	}
+	if mempoolTx.SBytes == nil {
+		return fmt.Errorf("Missing: %v", "s_bytes")
+	}
+	if mempoolTx.SignedTransaction.Transaction.Data != nil {
+		if len(mempoolTx.SignedTransaction.Transaction.Data) > 16384 {
+			s.logger.Error("Data is too large.",
+				slog.Any("data", mempoolTx.SignedTransaction.Transaction.Data),
+				slog.Any("length", len(mempoolTx.SignedTransaction.Transaction.Data)),
+			)
+			return fmt.Errorf("Data is too large")
+		}
+	}
+	return nil
+}
+
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) verifyMempoolTransaction(sessCtx mongo.SessionContext, mempoolTx *domain.MempoolTransaction, feeMarketActive bool) error {
 	s.logger.Debug("Preparing to verify",
 		slog.Any("chain_id", mempoolTx.ChainID),
 		slog.Any("nonce", mempoolTx.GetNonce()),
@@ -581,20 +923,58 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) verifyMempoolTransaction
 		return err
 	}
 
-	// STEP 3: Verify account has enough 🪙 coins
+	// STEP 3: Verify the transaction nonce is the next nonce for the account,
+	// this prevents the same signed transaction from being applied twice.
+	if err := account.ValidateTransactionNonce(&mempoolTx.Transaction); err != nil {
+		s.logger.Warn("Failed validating transaction nonce",
+			slog.Any("chain_id", s.config.Blockchain.ChainID),
+			slog.Any("from", mempoolTx.From),
+			slog.Any("nonce", mempoolTx.GetNonce()),
+			slog.Any("account_nonce", account.GetNonce()),
+			slog.Any("error", err))
+		return err
+	}
+
+	// STEP 4: Verify the transaction pays the fee required by the block.
+	if err := mempoolTx.ValidateFee(feeMarketActive, s.config.Blockchain.TransactionFee); err != nil {
+		s.logger.Warn("Failed validating transaction fee",
+			slog.Any("chain_id", s.config.Blockchain.ChainID),
+			slog.Any("value", mempoolTx.Value),
+			slog.Any("fee", mempoolTx.Fee),
+			slog.Bool("fee_market_active", feeMarketActive),
+			slog.Any("error", err))
+		return err
+	}
+
+	// STEP 5: Verify account has enough 🪙 coins
 	// If the account is sending, then we need to verify the user has
-	// enough coins in the balance.
-	if mempoolTx.Value > account.Balance {
-		err := fmt.Errorf("Insufficient balance in account: Have currently %v in account but transaction is requesting %v", account.Balance, mempoolTx.Value)
+	// enough coins in the balance to pay for the value and the fee. For a
+	// swap it is the buyer who pays and not the sender.
+	payer := account
+	if mempoolTx.Type == domain.TransactionTypeSwap {
+		payer, err = s.getAccountUseCase.Execute(sessCtx, mempoolTx.To)
+		if err != nil {
+			s.logger.Error("Failed getting account.",
+				slog.Any("chain_id", s.config.Blockchain.ChainID),
+				slog.Any("error", err))
+			return err
+		}
+		if payer == nil {
+			return fmt.Errorf("Failed validating account: d.n.e. for: %v", mempoolTx.To)
+		}
+	}
+	if debit, _, _ := mempoolTx.SplitFees(s.config.Blockchain.TransactionFee); debit > payer.Balance {
+		err := fmt.Errorf("Insufficient balance in account: Have currently %v in account but transaction is requesting %v", payer.Balance, debit)
 		s.logger.Error("Failed validating account",
 			slog.Any("chain_id", s.config.Blockchain.ChainID),
 			slog.Any("value", mempoolTx.Value),
+			slog.Any("fee", mempoolTx.Fee),
 			slog.Any("error", err))
 		return err
 	}
 
-	// STEP 4: Verify account belongs to 🎟️ token (if tx is token-based)
-	if mempoolTx.Type == domain.TransactionTypeToken {
+	// STEP 6: Verify account belongs to 🎟️ token (if tx is token-based)
+	if mempoolTx.MovesToken() {
 		// Get the token for the particular token ID.
 		token, err := s.getTokenUseCase.Execute(sessCtx, mempoolTx.GetTokenID())
 		if err != nil {
@@ -605,6 +985,19 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) verifyMempoolTransaction
 
 		// Defensive code.
 		if token == nil {
+			// A swap can only sell a token which was already minted.
+			if mempoolTx.Type == domain.TransactionTypeSwap {
+				return fmt.Errorf("%w: token %v does not exist", dom.ErrSwapInvalid, mempoolTx.GetTokenID())
+			}
+
+			// Verify the royalty the token is minted with, if any.
+			if _, err := dom.NewTokenRoyaltyFromDeserialize(mempoolTx.Data); mempoolTx.IsTokenNonceZero() && err != nil {
+				s.logger.Warn("failed validating token royalty",
+					slog.Any("token_id", mempoolTx.GetTokenID()),
+					slog.Any("error", err))
+				return err
+			}
+
 			// Do nothing! This means it hasn't been created yet, meaning
 			// it was just minted! So in that case all we have to do is skip
 			// this function and this token will be created later on.
@@ -620,18 +1013,76 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) verifyMempoolTransaction
 			s.logger.Warn("permission failed")
 			return fmt.Errorf("permission denied: token address is %v but your address is %v", token.Owner.Hex(), account.Address.Hex())
 		}
+
+		// Verify coins are only paid together with a transfer, a burned
+		// token has nobody to receive them.
+		if _, credit, _ := mempoolTx.SplitFees(s.config.Blockchain.TransactionFee); credit > 0 && dom.TokenStateDeltaEvent(&mempoolTx.Transaction) != dom.TokenStateDeltaEventTransfer {
+			return fmt.Errorf("coin payment of %v is only allowed when transferring a token", credit)
+		}
+
+		// Verify the swap moves the token forward, otherwise the buyer
+		// would pay for a token which never changes owner.
+		if mempoolTx.Type == domain.TransactionTypeSwap && mempoolTx.GetTokenNonce().Cmp(token.GetNonce()) <= 0 {
+			return fmt.Errorf("%w: token nonce %v is not after the current token nonce %v", dom.ErrSwapInvalid, mempoolTx.GetTokenNonce(), token.GetNonce())
+		}
+	}
+
+	// STEP 7: Verify only our administrator changes the 🔑 validator set
+	// and the change can be applied to the current validator set.
+	if mempoolTx.Type == domain.TransactionTypeValidator {
+		if account.Address.Hex() != s.config.Blockchain.ProofOfAuthorityAccountAddress.Hex() {
+			s.logger.Warn("permission failed")
+			return fmt.Errorf("permission denied: only the proof of authority account can change the validator set")
+		}
+		change, err := dom.NewValidatorSetChangeFromDeserialize(mempoolTx.Data)
+		if err != nil {
+			return err
+		}
+		genesis, err := s.getGenesisBlockDataUseCase.Execute(sessCtx, s.config.Blockchain.ChainID)
+		if err != nil {
+			return err
+		}
+		validators, err := s.getValidatorSet(sessCtx, genesis)
+		if err != nil {
+			return err
+		}
+		if _, err := dom.ApplyValidatorSetChange(validators, change); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// getValidatorSet returns the validators which are currently authorised to
+// seal blocks. Blockchains created before the validator set existed only
+// have the genesis validator.
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) getValidatorSet(sessCtx mongo.SessionContext, genesis *domain.GenesisBlockData) ([]*domain.Validator, error) {
+	validators, err := s.listValidatorSetUseCase.Execute(sessCtx, s.config.Blockchain.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 && genesis != nil && genesis.Validator != nil {
+		validators = []*domain.Validator{genesis.Validator}
+	}
+	return validators, nil
+}
+
+// processAccountForCoinMempoolTransaction transfers the coins and collects
+// the transaction fee. Every account upsert also updates the leaf of the
+// account in the accounts trie so the state root is kept up-to-date
+// incrementally.
 func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForCoinMempoolTransaction(
 	sessCtx mongo.SessionContext,
 	mempoolTx *domain.MempoolTransaction,
 ) error {
+	// Variables hold the coins taken from the sender, the coins given to the
+	// recipient and the fee collected by the authority.
+	debit, credit, fee := mempoolTx.SplitFees(s.config.Blockchain.TransactionFee)
+
 	//
 	// STEP 1
-	// Subtract the value the from the sender's account balance.
+	// Subtract the value and the fee from the sender's account balance.
 	//
 
 	if mempoolTx.From != nil {
@@ -643,7 +1094,7 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForCoinMem
 				slog.Any("hash", mempoolTx.From))
 			return fmt.Errorf("The `From` account does not exist in our database for hash: %v", mempoolTx.From.String())
 		}
-		acc.Balance -= mempoolTx.Value
+		acc.Balance -= debit
 
 		// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
 		accNonce := acc.GetNonce()
@@ -663,10 +1114,6 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForCoinMem
 	//
 
 	if mempoolTx.To != nil {
-		// Variable holds the value of coins to transfer to the account after
-		// the transaction fee was collected.
-		var valueMinusFees uint64 = mempoolTx.Value - s.config.Blockchain.TransactionFee
-
 		// DEVELOPERS NOTE:
 		// It is perfectly normal that our account would possibly not exist
 		// so we would need to create a new Account record in our database.
@@ -678,15 +1125,15 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForCoinMem
 				// Always start by zero, increment by 1 after mining successful.
 				NonceBytes: big.NewInt(0).Bytes(),
 
-				Balance: valueMinusFees,
+				Balance: credit,
 			}
 		} else {
-			acc.Balance += valueMinusFees
-
-			// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
-			accNonce := acc.GetNonce()
-			accNonce.Add(accNonce, big.NewInt(1))
-			acc.NonceBytes = accNonce.Bytes()
+			// DEVELOPERS NOTE:
+			// Receiving coins does not change the account nonce as the nonce
+			// only tracks the transactions sent from this account. This block
+			// is sealed with a canonical header so the legacy nonce rules do
+			// not apply, see `BlockHeader.HasStrictAccountNonces`.
+			acc.Balance += credit
 		}
 
 		if err := s.upsertAccountUseCase.Execute(sessCtx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
@@ -713,12 +1160,7 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForCoinMem
 	}
 
 	// Collect transaction fee from this coin transaction.
-	proofOfAuthorityAccount.Balance += s.config.Blockchain.TransactionFee
-
-	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
-	pofNonce := proofOfAuthorityAccount.GetNonce()
-	pofNonce.Add(pofNonce, big.NewInt(1))
-	proofOfAuthorityAccount.NonceBytes = pofNonce.Bytes()
+	proofOfAuthorityAccount.Balance += fee
 
 	if err := s.upsertAccountUseCase.Execute(sessCtx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
 		s.logger.Error("Failed upserting account.",
@@ -727,7 +1169,7 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForCoinMem
 	}
 	s.logger.Debug("Authority collected transaction fee from coin transfer",
 		slog.Any("authority_address", proofOfAuthorityAccount.Address),
-		slog.Any("collected_fee", s.config.Blockchain.TransactionFee),
+		slog.Any("collected_fee", fee),
 		slog.Any("new_balance", proofOfAuthorityAccount.Balance),
 	)
 
@@ -738,7 +1180,32 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForTokenMe
 	sessCtx mongo.SessionContext,
 	mempoolTx *domain.MempoolTransaction,
 	blockchainState *domain.BlockchainState,
-) error {
+) (royaltyRecipient *common.Address, royalty uint64, err error) {
+	// Variables hold the coins taken from the sender, the coin payment made
+	// to the recipient together with the token and the fee collected by the
+	// authority. Legacy token transactions never transfer coins.
+	debit, credit, fee := mempoolTx.SplitFees(s.config.Blockchain.TransactionFee)
+
+	// The royalty of the token is recorded when the token is minted and
+	// every coin payment made when transferring the token pays the creator.
+	var tokenRoyalty *dom.TokenRoyalty
+	if mempoolTx.IsTokenNonceZero() {
+		if tokenRoyalty, err = dom.NewTokenRoyaltyFromDeserialize(mempoolTx.Data); err != nil {
+			return nil, 0, err
+		}
+	} else {
+		token, err := s.getTokenUseCase.Execute(sessCtx, mempoolTx.GetTokenID())
+		if err != nil {
+			s.logger.Error("Failed getting token.",
+				slog.Any("token_id", mempoolTx.GetTokenID()),
+				slog.Any("error", err))
+			return nil, 0, err
+		}
+		if royalty, credit = token.SplitRoyalty(credit); royalty > 0 {
+			royaltyRecipient = token.Creator
+		}
+	}
+
 	//
 	// STEP 1:
 	// Check to see if we have an account for this particular token and
@@ -752,10 +1219,10 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForTokenMe
 		if acc == nil {
 			s.logger.Error("The `From` account does not exist in our database.",
 				slog.Any("hash", mempoolTx.From))
-			return fmt.Errorf("The `From` account does not exist in our database for hash: %v", mempoolTx.From.String())
+			return nil, 0, fmt.Errorf("The `From` account does not exist in our database for hash: %v", mempoolTx.From.String())
 		}
 
-		acc.Balance -= mempoolTx.Value // Note: The value is equal to the transaction fee.
+		acc.Balance -= debit
 
 		// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
 		accNonce := acc.GetNonce()
@@ -765,7 +1232,7 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForTokenMe
 		if err := s.upsertAccountUseCase.Execute(sessCtx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
 			s.logger.Error("Failed upserting account.",
 				slog.Any("error", err))
-			return err
+			return nil, 0, err
 		}
 	}
 
@@ -783,16 +1250,48 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForTokenMe
 				NonceBytes: big.NewInt(0).Bytes(), // Always start by zero, increment by 1 after mining successful.
 				Balance:    0,
 			}
-			if err := s.upsertAccountUseCase.Execute(sessCtx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
-				s.logger.Error("Failed upserting account.",
-					slog.Any("error", err))
-				return err
-			}
+		}
+
+		// Deposit the coin payment made with the token, less the royalty.
+		acc.Balance += credit
+
+		if err := s.upsertAccountUseCase.Execute(sessCtx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting account.",
+				slog.Any("error", err))
+			return nil, 0, err
 		}
 	}
 
 	//
 	// STEP 3:
+	// Pay the royalty of the coin payment to the creator of the token.
+	//
+
+	if royalty > 0 {
+		acc, _ := s.getAccountUseCase.Execute(sessCtx, royaltyRecipient)
+		if acc == nil {
+			acc = &domain.Account{
+				Address:    royaltyRecipient,
+				NonceBytes: big.NewInt(0).Bytes(), // Always start by zero, increment by 1 after mining successful.
+				Balance:    0,
+			}
+		}
+		acc.Balance += royalty
+
+		if err := s.upsertAccountUseCase.Execute(sessCtx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting account.",
+				slog.Any("error", err))
+			return nil, 0, err
+		}
+		s.logger.Debug("Creator collected royalty from token transfer",
+			slog.Any("creator_address", acc.Address),
+			slog.Any("royalty", royalty),
+			slog.Any("new_balance", acc.Balance),
+		)
+	}
+
+	//
+	// STEP 4:
 	// Deposit the transaction fee back to the coinbase to be recirculated.
 	//
 
@@ -800,34 +1299,29 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForTokenMe
 	if err != nil {
 		s.logger.Error("Failed getting proof of authority account.",
 			slog.Any("error", err))
-		return err
+		return nil, 0, err
 	}
 	if proofOfAuthorityAccount == nil {
 		s.logger.Error("Proof of authority account does not exist")
-		return fmt.Errorf("Proof of authority account does not exist")
+		return nil, 0, fmt.Errorf("Proof of authority account does not exist")
 	}
 
 	// Collect transaction fee from this token transaction.
-	proofOfAuthorityAccount.Balance += mempoolTx.Value // Note: The value is equal to the transaction fee.
-
-	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
-	pofNonce := proofOfAuthorityAccount.GetNonce()
-	pofNonce.Add(pofNonce, big.NewInt(1))
-	proofOfAuthorityAccount.NonceBytes = pofNonce.Bytes()
+	proofOfAuthorityAccount.Balance += fee
 
 	if err := s.upsertAccountUseCase.Execute(sessCtx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
 		s.logger.Error("Failed upserting account.",
 			slog.Any("error", err))
-		return err
+		return nil, 0, err
 	}
 	s.logger.Debug("Authority collected transaction fee from token transfer or burn",
 		slog.Any("authority_address", proofOfAuthorityAccount.Address),
-		slog.Any("collected_fee", s.config.Blockchain.TransactionFee),
+		slog.Any("collected_fee", fee),
 		slog.Any("new_balance", proofOfAuthorityAccount.Balance),
 	)
 
 	//
-	// STEP 4:
+	// STEP 5:
 	//
 
 	// Save our token to the local database ONLY if this transaction
@@ -838,11 +1332,12 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForTokenMe
 		mempoolTx.GetTokenID(),
 		mempoolTx.To,
 		mempoolTx.TokenMetadataURI,
-		mempoolTx.GetTokenNonce())
+		mempoolTx.GetTokenNonce(),
+		tokenRoyalty)
 	if upsertErr != nil {
 		s.logger.Error("Failed upserting (if previous token nonce GTE then current)",
 			slog.Any("error", upsertErr))
-		return upsertErr
+		return nil, 0, upsertErr
 	}
 
 	// DEVELOPERS NOTE:
@@ -854,9 +1349,230 @@ func (s *proofOfAuthorityConsensusMechanismServiceImpl) processAccountForTokenMe
 		if err := s.upsertBlockchainStateUseCase.Execute(sessCtx, blockchainState); err != nil {
 			s.logger.Error("validator failed saving latest hash",
 				slog.Any("error", err))
+			return nil, 0, err
+		}
+	}
+
+	return royaltyRecipient, royalty, nil
+}
+
+// processSwapMempoolTransaction pays the seller with the coins of the buyer
+// and gives the token to the buyer. Both happen inside the session of the
+// block so a failure of either aborts the whole swap.
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) processSwapMempoolTransaction(
+	sessCtx mongo.SessionContext,
+	mempoolTx *domain.MempoolTransaction,
+) (royaltyRecipient *common.Address, royalty uint64, err error) {
+	// Variables hold the coins taken from the buyer, the price given to the
+	// seller and the fee collected by the authority.
+	debit, credit, fee := mempoolTx.SplitFees(s.config.Blockchain.TransactionFee)
+
+	token, err := s.getTokenUseCase.Execute(sessCtx, mempoolTx.GetTokenID())
+	if err != nil {
+		s.logger.Error("Failed getting token.",
+			slog.Any("token_id", mempoolTx.GetTokenID()),
+			slog.Any("error", err))
+		return nil, 0, err
+	}
+	if token == nil {
+		return nil, 0, fmt.Errorf("%w: token %v does not exist", dom.ErrSwapInvalid, mempoolTx.GetTokenID())
+	}
+	if royalty, credit = token.SplitRoyalty(credit); royalty > 0 {
+		royaltyRecipient = token.Creator
+	}
+
+	//
+	// STEP 1:
+	// Take the price and the fee from the buyer.
+	//
+
+	buyer, _ := s.getAccountUseCase.Execute(sessCtx, mempoolTx.To)
+	if buyer == nil {
+		s.logger.Error("The `To` account does not exist in our database.",
+			slog.Any("hash", mempoolTx.To))
+		return nil, 0, fmt.Errorf("The `To` account does not exist in our database for hash: %v", mempoolTx.To.String())
+	}
+	if debit > buyer.Balance {
+		return nil, 0, fmt.Errorf("Insufficient balance in account: Have currently %v in account but transaction is requesting %v", buyer.Balance, debit)
+	}
+	buyer.Balance -= debit
+
+	// DEVELOPERS NOTE:
+	// The swap is sent from the seller therefore only the seller nonce
+	// changes, the buyer signature is only valid together with it.
+	if err := s.upsertAccountUseCase.Execute(sessCtx, buyer.Address, buyer.Balance, buyer.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return nil, 0, err
+	}
+
+	//
+	// STEP 2:
+	// Pay the seller the price, less the royalty.
+	//
+
+	seller, _ := s.getAccountUseCase.Execute(sessCtx, mempoolTx.From)
+	if seller == nil {
+		s.logger.Error("The `From` account does not exist in our database.",
+			slog.Any("hash", mempoolTx.From))
+		return nil, 0, fmt.Errorf("The `From` account does not exist in our database for hash: %v", mempoolTx.From.String())
+	}
+	seller.Balance += credit
+
+	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
+	sellerNonce := seller.GetNonce()
+	sellerNonce.Add(sellerNonce, big.NewInt(1))
+	seller.NonceBytes = sellerNonce.Bytes()
+
+	if err := s.upsertAccountUseCase.Execute(sessCtx, seller.Address, seller.Balance, seller.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return nil, 0, err
+	}
+
+	//
+	// STEP 3:
+	// Pay the royalty of the price to the creator of the token.
+	//
+
+	if royalty > 0 {
+		acc, _ := s.getAccountUseCase.Execute(sessCtx, royaltyRecipient)
+		if acc == nil {
+			acc = &domain.Account{
+				Address:    royaltyRecipient,
+				NonceBytes: big.NewInt(0).Bytes(), // Always start by zero, increment by 1 after mining successful.
+				Balance:    0,
+			}
+		}
+		acc.Balance += royalty
+
+		if err := s.upsertAccountUseCase.Execute(sessCtx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting account.",
+				slog.Any("error", err))
+			return nil, 0, err
+		}
+	}
+
+	//
+	// STEP 4:
+	// Deposit the transaction fee back to the coinbase to be recirculated.
+	//
+
+	proofOfAuthorityAccount, err := s.getAccountUseCase.Execute(sessCtx, s.config.Blockchain.ProofOfAuthorityAccountAddress)
+	if err != nil {
+		s.logger.Error("Failed getting proof of authority account.",
+			slog.Any("error", err))
+		return nil, 0, err
+	}
+	if proofOfAuthorityAccount == nil {
+		s.logger.Error("Proof of authority account does not exist")
+		return nil, 0, fmt.Errorf("Proof of authority account does not exist")
+	}
+	proofOfAuthorityAccount.Balance += fee
+
+	if err := s.upsertAccountUseCase.Execute(sessCtx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return nil, 0, err
+	}
+
+	//
+	// STEP 5:
+	// Give the token to the buyer.
+	//
+
+	if err := s.upsertTokenIfPreviousTokenNonceGTEUseCase.Execute(
+		sessCtx,
+		mempoolTx.GetTokenID(),
+		mempoolTx.To,
+		mempoolTx.TokenMetadataURI,
+		mempoolTx.GetTokenNonce(),
+		nil); err != nil {
+		s.logger.Error("Failed upserting (if previous token nonce GTE then current)",
+			slog.Any("error", err))
+		return nil, 0, err
+	}
+
+	s.logger.Debug("Swapped token for coins",
+		slog.Any("token_id", mempoolTx.GetTokenID()),
+		slog.Any("seller", mempoolTx.From),
+		slog.Any("buyer", mempoolTx.To),
+		slog.Any("price", mempoolTx.Value),
+		slog.Any("royalty", royalty),
+		slog.Any("fee", fee),
+	)
+
+	return royaltyRecipient, royalty, nil
+}
+
+func (s *proofOfAuthorityConsensusMechanismServiceImpl) processValidatorSetMempoolTransaction(
+	sessCtx mongo.SessionContext,
+	mempoolTx *domain.MempoolTransaction,
+	genesis *domain.GenesisBlockData,
+) error {
+	//
+	// STEP 1:
+	// Increment the nonce of the sender, no coins are transfered.
+	//
+
+	acc, _ := s.getAccountUseCase.Execute(sessCtx, mempoolTx.From)
+	if acc == nil {
+		s.logger.Error("The `From` account does not exist in our database.",
+			slog.Any("hash", mempoolTx.From))
+		return fmt.Errorf("The `From` account does not exist in our database for hash: %v", mempoolTx.From.String())
+	}
+
+	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
+	accNonce := acc.GetNonce()
+	accNonce.Add(accNonce, big.NewInt(1))
+	acc.NonceBytes = accNonce.Bytes()
+
+	if err := s.upsertAccountUseCase.Execute(sessCtx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return err
+	}
+
+	//
+	// STEP 2:
+	// Apply the change to our validator set.
+	//
+
+	change, err := dom.NewValidatorSetChangeFromDeserialize(mempoolTx.Data)
+	if err != nil {
+		return err
+	}
+	validators, err := s.getValidatorSet(sessCtx, genesis)
+	if err != nil {
+		return err
+	}
+	validators, err = dom.ApplyValidatorSetChange(validators, change)
+	if err != nil {
+		return err
+	}
+
+	// DEVELOPERS NOTE:
+	// We save every remaining validator so blockchains which only had the
+	// genesis validator get their validator set saved as well.
+	for _, validator := range validators {
+		if err := s.upsertValidatorSetMemberUseCase.Execute(sessCtx, s.config.Blockchain.ChainID, validator); err != nil {
+			s.logger.Error("Failed upserting validator.",
+				slog.Any("error", err))
+			return err
+		}
+	}
+	if change.Action == dom.ValidatorSetChangeActionRemove {
+		if err := s.deleteValidatorSetMemberUseCase.Execute(sessCtx, s.config.Blockchain.ChainID, change.Validator.PublicKeyBytes); err != nil {
+			s.logger.Error("Failed deleting validator.",
+				slog.Any("error", err))
 			return err
 		}
 	}
 
+	s.logger.Info("Validator set changed",
+		slog.String("action", change.Action),
+		slog.String("validator_id", change.Validator.ID),
+		slog.Int("validators", len(validators)))
+
 	return nil
 }
@@ -0,0 +1,151 @@
+package statedelta
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sort"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
+)
+
+// BackfillStateDeltasService records the state deltas of the blocks sealed
+// before the authority started recording them, this happens once per chain.
+type BackfillStateDeltasService interface {
+	Execute(ctx context.Context) error
+}
+
+type backfillStateDeltasServiceImpl struct {
+	config                                      *config.Configuration
+	logger                                      *slog.Logger
+	getBlockchainStateUseCase                   uc_blockchainstate.GetBlockchainStateUseCase
+	listBlockDataInHeaderNumberRangeUseCase     uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase
+	accountStateDeltaExistsAtBlockNumberUseCase uc_statedelta.AccountStateDeltaExistsAtBlockNumberUseCase
+	upsertAccountStateDeltaUseCase              uc_statedelta.UpsertAccountStateDeltaUseCase
+	upsertTokenStateDeltaUseCase                uc_statedelta.UpsertTokenStateDeltaUseCase
+}
+
+func NewBackfillStateDeltasService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase,
+	uc3 uc_statedelta.AccountStateDeltaExistsAtBlockNumberUseCase,
+	uc4 uc_statedelta.UpsertAccountStateDeltaUseCase,
+	uc5 uc_statedelta.UpsertTokenStateDeltaUseCase,
+) BackfillStateDeltasService {
+	return &backfillStateDeltasServiceImpl{cfg, logger, uc1, uc2, uc3, uc4, uc5}
+}
+
+func (s *backfillStateDeltasServiceImpl) Execute(ctx context.Context) error {
+	//
+	// STEP 1:
+	// The deltas of the genesis block are saved last, so if they exist then
+	// every block was already backfilled.
+	//
+
+	exists, err := s.accountStateDeltaExistsAtBlockNumberUseCase.Execute(ctx, s.config.Blockchain.ChainID, 0)
+	if err != nil {
+		s.logger.Error("Failed checking genesis state delta", slog.Any("error", err))
+		return err
+	}
+	if exists {
+		s.logger.Debug("State deltas already backfilled")
+		return nil
+	}
+
+	blkchState, err := s.getBlockchainStateUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting blockchain state", slog.Any("error", err))
+		return err
+	}
+	if blkchState == nil {
+		s.logger.Warn("Blockchain state does not exist, skipping state deltas backfill")
+		return nil
+	}
+	latestBlockNumber := blkchState.GetLatestBlockNumber()
+
+	s.logger.Info("Backfilling state deltas...",
+		slog.Any("latest_block_number", latestBlockNumber))
+
+	//
+	// STEP 2:
+	// Replay every block in order, in pages the size of the maximum range.
+	//
+
+	replayer := domain.NewStateDeltaReplayer()
+	var genesisAccountDeltas []*domain.AccountStateDelta
+	var genesisTokenDeltas []*domain.TokenStateDelta
+	for from := big.NewInt(0); from.Cmp(latestBlockNumber) <= 0; from = new(big.Int).Add(from, big.NewInt(domain.BlockDataRangeMaxSize)) {
+		to := new(big.Int).Add(from, big.NewInt(domain.BlockDataRangeMaxSize-1))
+		if to.Cmp(latestBlockNumber) > 0 {
+			to = latestBlockNumber
+		}
+		blockDatas, err := s.listBlockDataInHeaderNumberRangeUseCase.Execute(ctx, from, to)
+		if err != nil {
+			s.logger.Error("Failed listing blocks", slog.Any("from", from), slog.Any("to", to), slog.Any("error", err))
+			return err
+		}
+		expected := new(big.Int).Sub(to, from).Int64() + 1
+		if int64(len(blockDatas)) != expected {
+			err := fmt.Errorf("expected %v blocks from %v to %v but got %v", expected, from, to, len(blockDatas))
+			s.logger.Error("Failed listing blocks", slog.Any("error", err))
+			return err
+		}
+		sort.Slice(blockDatas, func(i, j int) bool {
+			return blockDatas[i].Header.GetNumber().Cmp(blockDatas[j].Header.GetNumber()) < 0
+		})
+
+		for _, blockData := range blockDatas {
+			accountDeltas := replayer.Apply(blockData)
+			tokenDeltas := domain.NewTokenStateDeltas(blockData)
+			if blockData.Header.GetNumber().Sign() == 0 {
+				genesisAccountDeltas, genesisTokenDeltas = accountDeltas, tokenDeltas
+				continue
+			}
+			if err := s.saveStateDeltas(ctx, accountDeltas, tokenDeltas); err != nil {
+				return err
+			}
+		}
+	}
+
+	//
+	// STEP 3:
+	// Save the genesis deltas to mark the backfill as completed.
+	//
+
+	if err := s.saveStateDeltas(ctx, genesisAccountDeltas, genesisTokenDeltas); err != nil {
+		return err
+	}
+
+	s.logger.Info("Backfilled state deltas",
+		slog.Any("latest_block_number", latestBlockNumber))
+	return nil
+}
+
+func (s *backfillStateDeltasServiceImpl) saveStateDeltas(ctx context.Context, accountDeltas []*domain.AccountStateDelta, tokenDeltas []*domain.TokenStateDelta) error {
+	for _, delta := range accountDeltas {
+		if err := s.upsertAccountStateDeltaUseCase.Execute(ctx, delta); err != nil {
+			s.logger.Error("Failed saving account state delta",
+				slog.Any("address", delta.Address),
+				slog.Any("block_number", delta.BlockNumber),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	for _, delta := range tokenDeltas {
+		if err := s.upsertTokenStateDeltaUseCase.Execute(ctx, delta); err != nil {
+			s.logger.Error("Failed saving token state delta",
+				slog.Any("token_id", delta.GetTokenID()),
+				slog.Any("block_number", delta.BlockNumber),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	return nil
+}
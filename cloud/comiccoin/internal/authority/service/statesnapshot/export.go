@@ -0,0 +1,251 @@
+package statesnapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	s_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	uc_statesnapshot "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statesnapshot"
+	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
+)
+
+// stateSnapshotsToKeep is the number of most recent state snapshots we keep
+// around, older snapshots are deleted after every export.
+const stateSnapshotsToKeep = 3
+
+// ExportStateSnapshotService takes a signed snapshot of every account and
+// token of the blockchain at the latest block so new nodes can bootstrap
+// from it instead of replaying the entire blockchain.
+type ExportStateSnapshotService interface {
+	// Execute exports a state snapshot at the latest block. Returns nil if
+	// a snapshot was already exported for the latest block.
+	Execute(ctx context.Context) (*domain.StateSnapshot, error)
+}
+
+type exportStateSnapshotServiceImpl struct {
+	config                               *config.Configuration
+	logger                               *slog.Logger
+	dmutex                               distributedmutex.Adapter
+	getProofOfAuthorityPrivateKeyService s_poa.GetProofOfAuthorityPrivateKeyService
+	getBlockchainStateUseCase            uc_blockchainstate.GetBlockchainStateUseCase
+	getBlockDataUseCase                  uc_blockdata.GetBlockDataUseCase
+	listAccountsByChainIDUseCase         uc_account.ListAccountsByChainIDUseCase
+	listTokensByChainIDUseCase           uc_token.ListTokensByChainIDUseCase
+	getLatestStateSnapshotUseCase        uc_statesnapshot.GetLatestStateSnapshotUseCase
+	upsertStateSnapshotUseCase           uc_statesnapshot.UpsertStateSnapshotUseCase
+	pruneStateSnapshotsUseCase           uc_statesnapshot.PruneStateSnapshotsUseCase
+	listValidatorSetUseCase              uc_validatorset.ListValidatorSetUseCase
+}
+
+func NewExportStateSnapshotService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	dmutex distributedmutex.Adapter,
+	s1 s_poa.GetProofOfAuthorityPrivateKeyService,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_blockdata.GetBlockDataUseCase,
+	uc3 uc_account.ListAccountsByChainIDUseCase,
+	uc4 uc_token.ListTokensByChainIDUseCase,
+	uc5 uc_statesnapshot.GetLatestStateSnapshotUseCase,
+	uc6 uc_statesnapshot.UpsertStateSnapshotUseCase,
+	uc7 uc_statesnapshot.PruneStateSnapshotsUseCase,
+	uc8 uc_validatorset.ListValidatorSetUseCase,
+) ExportStateSnapshotService {
+	return &exportStateSnapshotServiceImpl{config, logger, dmutex, s1, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8}
+}
+
+func (s *exportStateSnapshotServiceImpl) Execute(ctx context.Context) (*domain.StateSnapshot, error) {
+	// DEVELOPERS NOTE:
+	// We share the lock with the PoA consensus mechanism so no new block gets
+	// sealed while we read the accounts and tokens, otherwise our snapshot
+	// could be a mix of the state of two different blocks.
+	s.dmutex.Acquire(ctx, "ProofOfAuthorityConsensusMechanism")
+	defer s.dmutex.Release(ctx, "ProofOfAuthorityConsensusMechanism")
+
+	chainID := s.config.Blockchain.ChainID
+
+	//
+	// STEP 1:
+	// Get the latest block and skip if we already have a snapshot of it.
+	//
+
+	blockchainState, err := s.getBlockchainStateUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed getting blockchain state.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if blockchainState == nil {
+		return nil, fmt.Errorf("Blockchain state does not exist for chain ID: %v", chainID)
+	}
+
+	latestSnapshot, err := s.getLatestStateSnapshotUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed getting latest state snapshot.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if latestSnapshot != nil && latestSnapshot.BlockHash == blockchainState.LatestHash {
+		s.logger.Debug("State snapshot is already up to date",
+			slog.Any("block_hash", latestSnapshot.BlockHash))
+		return nil, nil
+	}
+
+	blockData, err := s.getBlockDataUseCase.ExecuteByHash(ctx, blockchainState.LatestHash)
+	if err != nil {
+		s.logger.Error("Failed getting latest block data.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if blockData == nil {
+		return nil, fmt.Errorf("Latest block data does not exist for hash: %v", blockchainState.LatestHash)
+	}
+
+	// The snapshot is signed by the validator which sealed the latest block,
+	// if that was another authority then it will export the snapshot.
+	privateKey, err := s.getProofOfAuthorityPrivateKeyService.Execute(ctx)
+	if err != nil {
+		s.logger.Error("Failed getting proof of authority private key.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if privateKey == nil {
+		return nil, fmt.Errorf("Proof of authority private key does not exist")
+	}
+	if !bytes.Equal(blockData.Validator.PublicKeyBytes, crypto.FromECDSAPub(&privateKey.PublicKey)) {
+		s.logger.Debug("Latest block was sealed by another validator, skipping state snapshot",
+			slog.Any("block_hash", blockData.Hash),
+			slog.Any("validator_id", blockData.Validator.ID))
+		return nil, nil
+	}
+
+	//
+	// STEP 2:
+	// Read the accounts and tokens and make sure they match what the latest
+	// block header committed to.
+	//
+
+	accounts, err := s.listAccountsByChainIDUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed listing accounts.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	tokens, err := s.listTokensByChainIDUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed listing tokens.",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	accountHashState, err := domain.HashAccountsState(accounts)
+	if err != nil {
+		return nil, err
+	}
+	if accountHashState != blockData.Header.StateRoot {
+		s.logger.Error("Accounts do not match the latest block state root",
+			slog.Any("got", accountHashState),
+			slog.Any("exp", blockData.Header.StateRoot))
+		return nil, fmt.Errorf("Accounts do not match the latest block state root")
+	}
+	tokenHashState, err := domain.HashTokensState(tokens)
+	if err != nil {
+		return nil, err
+	}
+	if tokenHashState != blockData.Header.TokensRoot {
+		s.logger.Error("Tokens do not match the latest block tokens root",
+			slog.Any("got", tokenHashState),
+			slog.Any("exp", blockData.Header.TokensRoot))
+		return nil, fmt.Errorf("Tokens do not match the latest block tokens root")
+	}
+	contentHash, err := domain.HashStateSnapshotContents(accounts, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	// Blockchains which never changed their validator set only have the
+	// validator which sealed every block, including the latest one.
+	validators, err := s.listValidatorSetUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed listing validator set.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if len(validators) == 0 {
+		validators = []*domain.Validator{blockData.Validator}
+	}
+	validatorSetHash, err := domain.HashValidatorSet(validators)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// STEP 3:
+	// Sign the snapshot with our proof of authority identity.
+	//
+
+	snapshot := &domain.StateSnapshot{
+		ChainID:           chainID,
+		BlockNumberBytes:  blockData.Header.NumberBytes,
+		BlockNumberString: blockData.Header.GetNumber().String(),
+		BlockHash:         blockData.Hash,
+		AccountHashState:  accountHashState,
+		TokenHashState:    tokenHashState,
+		ContentHash:       contentHash,
+		Accounts:          accounts,
+		Tokens:            tokens,
+		Validators:        validators,
+		ValidatorSetHash:  validatorSetHash,
+		Validator:         blockData.Validator,
+		CreatedAt:         time.Now(),
+	}
+	snapshot.SignatureBytes, err = blockData.Validator.Sign(privateKey, snapshot.Commitment())
+	if err != nil {
+		s.logger.Error("Failed signing state snapshot.",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	// Defensive code: Never publish a snapshot clients will reject.
+	if err := snapshot.Verify(blockData.Header, blockData.Hash, blockData.Validator); err != nil {
+		s.logger.Error("Failed verifying state snapshot.",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 4:
+	// Save the snapshot and delete the older ones.
+	//
+
+	if err := s.upsertStateSnapshotUseCase.Execute(ctx, snapshot); err != nil {
+		s.logger.Error("Failed saving state snapshot.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if err := s.pruneStateSnapshotsUseCase.Execute(ctx, chainID, stateSnapshotsToKeep); err != nil {
+		s.logger.Error("Failed pruning old state snapshots.",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Info("Exported state snapshot",
+		slog.Any("block_number", snapshot.BlockNumberString),
+		slog.Any("block_hash", snapshot.BlockHash),
+		slog.Int("accounts", len(accounts)),
+		slog.Int("tokens", len(tokens)))
+
+	return snapshot, nil
+}
@@ -0,0 +1,43 @@
+package statesnapshot
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_statesnapshot "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statesnapshot"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetLatestStateSnapshotService interface {
+	Execute(ctx context.Context) (*domain.StateSnapshot, error)
+}
+
+type getLatestStateSnapshotServiceImpl struct {
+	config                        *config.Configuration
+	logger                        *slog.Logger
+	getLatestStateSnapshotUseCase uc_statesnapshot.GetLatestStateSnapshotUseCase
+}
+
+func NewGetLatestStateSnapshotService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc uc_statesnapshot.GetLatestStateSnapshotUseCase,
+) GetLatestStateSnapshotService {
+	return &getLatestStateSnapshotServiceImpl{cfg, logger, uc}
+}
+
+func (s *getLatestStateSnapshotServiceImpl) Execute(ctx context.Context) (*domain.StateSnapshot, error) {
+	snapshot, err := s.getLatestStateSnapshotUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting latest state snapshot", slog.Any("error", err))
+		return nil, err
+	}
+	if snapshot == nil {
+		errStr := "No state snapshot has been exported yet"
+		s.logger.Warn("Failed getting latest state snapshot", slog.Any("error", errStr))
+		return nil, httperror.NewForNotFoundWithSingleField("state_snapshot", errStr)
+	}
+	return snapshot, nil
+}
@@ -6,7 +6,6 @@ import (
 	"log/slog"
 	"math/big"
 	"strings"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -15,6 +14,7 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
 	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
 	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
@@ -45,6 +45,7 @@ type tokenBurnServiceImpl struct {
 	getBlockDataUseCase                       uc_blockdata.GetBlockDataUseCase
 	getTokenUseCase                           uc_token.GetTokenUseCase
 	mempoolTransactionCreateUseCase           uc_mempooltx.MempoolTransactionCreateUseCase
+	getAccountUseCase                         uc_account.GetAccountUseCase
 	proofOfAuthorityConsensusMechanismService sv_poa.ProofOfAuthorityConsensusMechanismService
 }
 
@@ -59,6 +60,7 @@ func NewTokenBurnService(
 	uc4 uc_blockdata.GetBlockDataUseCase,
 	uc5 uc_token.GetTokenUseCase,
 	uc6 uc_mempooltx.MempoolTransactionCreateUseCase,
+	uc7 uc_account.GetAccountUseCase,
 	poaService sv_poa.ProofOfAuthorityConsensusMechanismService,
 ) TokenBurnService {
 	return &tokenBurnServiceImpl{
@@ -72,6 +74,7 @@ func NewTokenBurnService(
 		uc4,
 		uc5,
 		uc6,
+		uc7,
 		poaService,
 	}
 }
@@ -131,6 +134,19 @@ func (s *tokenBurnServiceImpl) Execute(
 		return fmt.Errorf("failed getting wallet key: %s", err)
 	}
 
+	account, err := s.getAccountUseCase.Execute(ctx, tokenOwnerAddress)
+	if err != nil {
+		s.logger.Error("Failed getting account.",
+			slog.Any("address", tokenOwnerAddress),
+			slog.Any("error", err))
+		return err
+	}
+	if account == nil {
+		s.logger.Error("Account does not exist.",
+			slog.Any("address", tokenOwnerAddress))
+		return fmt.Errorf("Account does not exist for address: %v", tokenOwnerAddress)
+	}
+
 	recentBlockData, err := s.getBlockDataUseCase.ExecuteByHash(ctx, blockchainState.LatestHash)
 	if err != nil {
 		s.logger.Error("Failed getting latest block block.",
@@ -188,7 +204,7 @@ func (s *tokenBurnServiceImpl) Execute(
 
 	tx := &domain.Transaction{
 		ChainID:          s.config.Blockchain.ChainID,
-		NonceBytes:       big.NewInt(time.Now().Unix()).Bytes(),
+		NonceBytes:       account.NextNonce().Bytes(),
 		From:             tokenOwnerAddress,
 		To:               &burnAddress,
 		Value:            s.config.Blockchain.TransactionFee, // Transaction fee gets reclaimed by the authority
@@ -197,6 +213,7 @@ func (s *tokenBurnServiceImpl) Execute(
 		TokenIDBytes:     token.IDBytes,
 		TokenMetadataURI: token.MetadataURI,
 		TokenNonceBytes:  nonce.Bytes(), // Burned tokens must increment nonce
+		Version:          domain.TransactionVersion,
 	}
 
 	stx, signingErr := tx.Sign(privateKey)
@@ -232,7 +249,7 @@ func (s *tokenBurnServiceImpl) Execute(
 	// STEP 6: Submit directly to PoA consensus mechanism instead of adding to mempool
 	//
 
-	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, mempoolTx); err != nil {
+	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, []*domain.MempoolTransaction{mempoolTx}); err != nil {
 		s.logger.Error("Failed to process transaction through consensus mechanism",
 			slog.Any("error", err))
 		return err
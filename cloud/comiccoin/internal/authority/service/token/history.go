@@ -0,0 +1,65 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_statedelta "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/statedelta"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetTokenHistoryService returns the ownership chain of a token: the mint,
+// every transfer and the burn with the block they happened in.
+type GetTokenHistoryService interface {
+	Execute(ctx context.Context, id *big.Int) (*domain.TokenHistory, error)
+}
+
+type getTokenHistoryServiceImpl struct {
+	config                               *config.Configuration
+	logger                               *slog.Logger
+	listTokenStateDeltasByTokenIDUseCase uc_statedelta.ListTokenStateDeltasByTokenIDUseCase
+}
+
+func NewGetTokenHistoryService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_statedelta.ListTokenStateDeltasByTokenIDUseCase,
+) GetTokenHistoryService {
+	return &getTokenHistoryServiceImpl{cfg, logger, uc1}
+}
+
+func (s *getTokenHistoryServiceImpl) Execute(ctx context.Context, id *big.Int) (*domain.TokenHistory, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id == nil {
+		e["id"] = "missing value"
+	} else if id.Sign() < 0 {
+		e["id"] = "cannot be negative"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List every delta of the token.
+	//
+
+	deltas, err := s.listTokenStateDeltasByTokenIDUseCase.Execute(ctx, s.config.Blockchain.ChainID, id)
+	if err != nil {
+		s.logger.Error("Failed listing token state deltas",
+			slog.Any("id", id),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if len(deltas) == 0 {
+		return nil, httperror.NewForNotFoundWithSingleField("id", fmt.Sprintf("Token %v does not exist", id))
+	}
+	return domain.NewTokenHistory(s.config.Blockchain.ChainID, id, deltas), nil
+}
@@ -2,10 +2,10 @@ package token
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"log/slog"
 	"math/big"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -13,16 +13,27 @@ import (
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	sv_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/account"
 	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
 	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	uc_collection "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collection"
+	uc_collectionedition "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/collectionedition"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
 )
 
 type TokenMintService interface {
-	Execute(ctx context.Context, walletAddress *common.Address, metadataURI string) (*big.Int, error)
+	// Execute mints the token to the wallet, the optional royalty records the
+	// creator paid on every transfer of the token made with a coin payment.
+	Execute(ctx context.Context, walletAddress *common.Address, metadataURI string, royalty *domain.TokenRoyalty) (*big.Int, error)
+
+	// ExecuteForCollection mints one edition of the collection to the wallet
+	// per metadata URI, all in the same block. Empty metadata URIs use the
+	// base metadata of the collection and a royalty without a creator pays
+	// the creator of the collection.
+	ExecuteForCollection(ctx context.Context, walletAddress *common.Address, collectionID *big.Int, metadataURIs []string, royalty *domain.TokenRoyalty) ([]*domain.CollectionEdition, error)
 }
 
 type tokenMintServiceImpl struct {
@@ -36,7 +47,11 @@ type tokenMintServiceImpl struct {
 	getLatestTokenIDUseCase                   uc_blockdata.GetLatestTokenIDUseCase
 	getBlockDataUseCase                       uc_blockdata.GetBlockDataUseCase
 	mempoolTransactionCreateUseCase           uc_mempooltx.MempoolTransactionCreateUseCase
+	getAccountNextNonceService                sv_account.GetAccountNextNonceService
 	proofOfAuthorityConsensusMechanismService sv_poa.ProofOfAuthorityConsensusMechanismService
+	getCollectionUseCase                      uc_collection.GetCollectionUseCase
+	upsertCollectionUseCase                   uc_collection.UpsertCollectionUseCase
+	upsertCollectionEditionUseCase            uc_collectionedition.UpsertCollectionEditionUseCase
 }
 
 func NewTokenMintService(
@@ -50,7 +65,11 @@ func NewTokenMintService(
 	uc3 uc_blockdata.GetLatestTokenIDUseCase,
 	uc4 uc_blockdata.GetBlockDataUseCase,
 	uc5 uc_mempooltx.MempoolTransactionCreateUseCase,
+	s2 sv_account.GetAccountNextNonceService,
 	poaService sv_poa.ProofOfAuthorityConsensusMechanismService,
+	uc7 uc_collection.GetCollectionUseCase,
+	uc8 uc_collection.UpsertCollectionUseCase,
+	uc9 uc_collectionedition.UpsertCollectionEditionUseCase,
 ) TokenMintService {
 	return &tokenMintServiceImpl{
 		cfg,
@@ -63,7 +82,11 @@ func NewTokenMintService(
 		uc3,
 		uc4,
 		uc5,
+		s2,
 		poaService,
+		uc7,
+		uc8,
+		uc9,
 	}
 }
 
@@ -71,6 +94,7 @@ func (s *tokenMintServiceImpl) Execute(
 	ctx context.Context,
 	walletAddress *common.Address,
 	metadataURI string,
+	royalty *domain.TokenRoyalty,
 ) (*big.Int, error) {
 	// Lock the mining service until it has completed executing (or errored).
 	s.dmutex.Acquire(ctx, "TokenMintService")
@@ -84,12 +108,181 @@ func (s *tokenMintServiceImpl) Execute(
 	if metadataURI == "" {
 		e["metadata_uri"] = "missing value"
 	}
+	if royalty != nil {
+		if err := royalty.Validate(); err != nil {
+			e["royalty"] = err.Error()
+		}
+	}
 	if len(e) != 0 {
 		s.logger.Warn("Failed validating token mint parameters",
 			slog.Any("error", e))
 		return nil, httperror.NewForBadRequest(&e)
 	}
 
+	//
+	// STEP 2: Mint the token.
+	//
+
+	tokenIDs, err := s.mint(ctx, walletAddress, []string{metadataURI}, royalty, nil)
+	if err != nil {
+		return nil, err
+	}
+	return tokenIDs[0], nil
+}
+
+func (s *tokenMintServiceImpl) ExecuteForCollection(
+	ctx context.Context,
+	walletAddress *common.Address,
+	collectionID *big.Int,
+	metadataURIs []string,
+	royalty *domain.TokenRoyalty,
+) ([]*domain.CollectionEdition, error) {
+	// Lock the mining service until it has completed executing (or errored).
+	s.dmutex.Acquire(ctx, "TokenMintService")
+	defer s.dmutex.Release(ctx, "TokenMintService")
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if walletAddress == nil {
+		e["wallet_address"] = "missing value"
+	}
+	if collectionID == nil {
+		e["collection_id"] = "missing value"
+	}
+	if len(metadataURIs) == 0 {
+		e["editions"] = "missing value"
+	} else if s.config.Blockchain.TransPerBlock > 0 && len(metadataURIs) > int(s.config.Blockchain.TransPerBlock) {
+		e["editions"] = fmt.Sprintf("cannot mint more than %v editions at once", s.config.Blockchain.TransPerBlock)
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating collection token mint parameters",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Get the collection and enforce its supply cap.
+	//
+
+	collection, err := s.getCollectionUseCase.Execute(ctx, collectionID)
+	if err != nil {
+		s.logger.Error("Failed getting collection.",
+			slog.Any("collection_id", collectionID),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if collection == nil || collection.ChainID != s.config.Blockchain.ChainID {
+		return nil, httperror.NewForNotFoundWithSingleField("collection_id", fmt.Sprintf("Collection %v does not exist", collectionID))
+	}
+	if _, err := collection.NextEditions(uint64(len(metadataURIs))); err != nil {
+		return nil, httperror.NewForBadRequestWithSingleField("editions", err.Error())
+	}
+
+	resolvedMetadataURIs := make([]string, 0, len(metadataURIs))
+	for i, metadataURI := range metadataURIs {
+		metadataURI = collection.EditionMetadataURI(metadataURI)
+		if metadataURI == "" {
+			e[fmt.Sprintf("editions[%v].metadata_uri", i)] = "missing value and collection has no base metadata"
+		}
+		resolvedMetadataURIs = append(resolvedMetadataURIs, metadataURI)
+	}
+	if royalty != nil {
+		if royalty.Creator == nil {
+			royalty = &domain.TokenRoyalty{Creator: collection.Creator, BasisPoints: royalty.BasisPoints}
+		}
+		if err := royalty.Validate(); err != nil {
+			e["royalty"] = err.Error()
+		}
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 3:
+	// Mint the editions and, in the same database transaction as the block,
+	// record an edition for every mint which was sealed and the new supply
+	// of the collection. Mints rejected by the consensus mechanism never
+	// become editions.
+	//
+
+	var editions []*domain.CollectionEdition
+	recordEditions := func(sessCtx mongo.SessionContext, sealedTxs []*domain.MempoolTransaction) error {
+		editions = nil // The hook is called again if the transaction is retried.
+		if len(sealedTxs) == 0 {
+			return nil
+		}
+
+		// Get the collection again inside the transaction so the supply
+		// we increment is the one saved with our block.
+		collection, err := s.getCollectionUseCase.Execute(sessCtx, collectionID)
+		if err != nil {
+			return err
+		}
+		if collection == nil {
+			return fmt.Errorf("Collection %v does not exist", collectionID)
+		}
+		editionNumbers, err := collection.NextEditions(uint64(len(sealedTxs)))
+		if err != nil {
+			return err
+		}
+		for i, sealedTx := range sealedTxs {
+			edition := &domain.CollectionEdition{
+				ChainID:           collection.ChainID,
+				CollectionIDBytes: collection.IDBytes,
+				TokenIDBytes:      sealedTx.GetTokenID().Bytes(),
+				Edition:           editionNumbers[i],
+				MaxSupply:         collection.MaxSupply,
+				MetadataURI:       sealedTx.TokenMetadataURI,
+			}
+			if err := s.upsertCollectionEditionUseCase.Execute(sessCtx, edition); err != nil {
+				s.logger.Error("Failed saving collection edition.",
+					slog.Any("collection_id", collectionID),
+					slog.Any("token_id", sealedTx.GetTokenID()),
+					slog.Any("error", err))
+				return err
+			}
+			editions = append(editions, edition)
+		}
+
+		collection.Supply = editionNumbers[len(editionNumbers)-1]
+		if err := s.upsertCollectionUseCase.Execute(sessCtx, collection); err != nil {
+			s.logger.Error("Failed saving collection supply.",
+				slog.Any("collection_id", collectionID),
+				slog.Any("error", err))
+			return err
+		}
+		return nil
+	}
+
+	if _, err := s.mint(ctx, walletAddress, resolvedMetadataURIs, royalty, recordEditions); err != nil {
+		return nil, err
+	}
+	if len(editions) < len(resolvedMetadataURIs) {
+		s.logger.Warn("Some collection editions were rejected",
+			slog.Any("collection_id", collectionID),
+			slog.Int("requested", len(resolvedMetadataURIs)),
+			slog.Int("minted", len(editions)))
+	}
+
+	s.logger.Info("Collection editions minted",
+		slog.Any("collection_id", collectionID),
+		slog.Int("editions", len(editions)),
+		slog.Any("max_supply", collection.MaxSupply))
+
+	return editions, nil
+}
+
+// mint creates, signs and submits one token mint transaction per metadata
+// URI directly to our PoA consensus mechanism so they are sealed in the same
+// block, returning the new token IDs in the same order. Every token is minted
+// with the royalty, if any, and the optional hook is called with the mints
+// which were sealed. Callers must hold the `TokenMintService` lock.
+func (s *tokenMintServiceImpl) mint(ctx context.Context, walletAddress *common.Address, metadataURIs []string, royalty *domain.TokenRoyalty, hook sv_poa.SealedTransactionsHook) ([]*big.Int, error) {
 	//
 	// STEP 2: Get related records.
 	//
@@ -116,6 +309,16 @@ func (s *tokenMintServiceImpl) Execute(
 		return nil, fmt.Errorf("Proof of authority private key does not exist")
 	}
 
+	// The nonce must skip past any transactions from our authority account
+	// which are still waiting in the mempool.
+	nextNonce, err := s.getAccountNextNonceService.Execute(ctx, s.config.Blockchain.ProofOfAuthorityAccountAddress)
+	if err != nil {
+		s.logger.Error("Failed getting next nonce.",
+			slog.Any("address", s.config.Blockchain.ProofOfAuthorityAccountAddress),
+			slog.Any("error", err))
+		return nil, err
+	}
+
 	recentBlockData, err := s.getBlockDataUseCase.ExecuteByHash(ctx, blockchainState.LatestHash)
 	if err != nil {
 		s.logger.Error("Failed getting latest block block.",
@@ -139,26 +342,70 @@ func (s *tokenMintServiceImpl) Execute(
 	)
 
 	//
-	// STEP 3: Generate the new token ID by incrementing the latest
+	// STEP 3: Create and sign the transactions
 	//
 
-	latestTokenID.Add(latestTokenID, big.NewInt(1))
+	tokenIDs := make([]*big.Int, 0, len(metadataURIs))
+	mempoolTxs := make([]*domain.MempoolTransaction, 0, len(metadataURIs))
+	for i, metadataURI := range metadataURIs {
+		// Generate the new token ID by incrementing the latest
+		latestTokenID = new(big.Int).Add(latestTokenID, big.NewInt(1))
+		nonce := new(big.Int).Add(nextNonce, big.NewInt(int64(i)))
+
+		mempoolTx, err := s.newMintMempoolTransaction(proofOfAuthorityPrivateKey, walletAddress, nonce, latestTokenID, metadataURI, royalty)
+		if err != nil {
+			return nil, err
+		}
+		tokenIDs = append(tokenIDs, latestTokenID)
+		mempoolTxs = append(mempoolTxs, mempoolTx)
+	}
 
 	//
-	// STEP 4: Create and sign the transaction
+	// STEP 4: Submit directly to PoA consensus mechanism instead of adding to mempool
 	//
 
+	if err := s.proofOfAuthorityConsensusMechanismService.ExecuteWithSealedHook(ctx, mempoolTxs, hook); err != nil {
+		s.logger.Error("Failed to process transaction through consensus mechanism",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Info("Token mint transaction successfully processed through PoA consensus",
+		slog.Any("tx_token_ids", tokenIDs))
+
+	return tokenIDs, nil
+}
+
+// newMintMempoolTransaction returns the signed token mint transaction.
+func (s *tokenMintServiceImpl) newMintMempoolTransaction(
+	proofOfAuthorityPrivateKey *ecdsa.PrivateKey,
+	walletAddress *common.Address,
+	nonce *big.Int,
+	tokenID *big.Int,
+	metadataURI string,
+	royalty *domain.TokenRoyalty,
+) (*domain.MempoolTransaction, error) {
+	// The royalty is recorded on chain in the data of the mint transaction.
+	data := make([]byte, 0)
+	if royalty != nil {
+		var err error
+		if data, err = royalty.Serialize(); err != nil {
+			return nil, err
+		}
+	}
+
 	tx := &domain.Transaction{
 		ChainID:          s.config.Blockchain.ChainID,
-		NonceBytes:       big.NewInt(time.Now().Unix()).Bytes(),
+		NonceBytes:       nonce.Bytes(),
 		From:             s.config.Blockchain.ProofOfAuthorityAccountAddress,
 		To:               walletAddress,
 		Value:            s.config.Blockchain.TransactionFee, // Transaction fee gets reclaimed by the authority
-		Data:             make([]byte, 0),
+		Data:             data,
 		Type:             domain.TransactionTypeToken,
-		TokenIDBytes:     latestTokenID.Bytes(),
+		TokenIDBytes:     tokenID.Bytes(),
 		TokenMetadataURI: metadataURI,
 		TokenNonceBytes:  big.NewInt(0).Bytes(), // Newly minted tokens always have their nonce start at zero
+		Version:          domain.TransactionVersion,
 	}
 
 	s.logger.Debug("Created transaction",
@@ -182,8 +429,8 @@ func (s *tokenMintServiceImpl) Execute(
 	// Defensive Coding: Validate the signed transaction
 	if err := stx.Validate(s.config.Blockchain.ChainID, true); err != nil {
 		s.logger.Debug("Failed to validate signature of the signed transaction",
-			slog.Any("error", signingErr))
-		return nil, signingErr
+			slog.Any("error", err))
+		return nil, err
 	}
 
 	s.logger.Debug("Token mint transaction signed successfully",
@@ -197,22 +444,9 @@ func (s *tokenMintServiceImpl) Execute(
 	// Defensive Coding: Validate the mempool transaction
 	if err := mempoolTx.Validate(s.config.Blockchain.ChainID, true); err != nil {
 		s.logger.Debug("Failed to validate signature of mempool transaction",
-			slog.Any("error", signingErr))
-		return nil, signingErr
-	}
-
-	//
-	// STEP 5: Submit directly to PoA consensus mechanism instead of adding to mempool
-	//
-
-	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, mempoolTx); err != nil {
-		s.logger.Error("Failed to process transaction through consensus mechanism",
 			slog.Any("error", err))
 		return nil, err
 	}
 
-	s.logger.Info("Token mint transaction successfully processed through PoA consensus",
-		slog.Any("tx_token_id", stx.GetTokenID()))
-
-	return latestTokenID, nil
+	return mempoolTx, nil
 }
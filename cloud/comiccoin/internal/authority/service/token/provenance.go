@@ -0,0 +1,180 @@
+package token
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	s_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	uc_validatorset "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/validatorset"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetTokenProvenanceService returns the signed chain of custody of a token
+// built from every token transaction of the token, with the merkle inclusion
+// proof of every transaction so collectors can verify it offline.
+type GetTokenProvenanceService interface {
+	Execute(ctx context.Context, id *big.Int) (*domain.TokenProvenance, error)
+}
+
+type getTokenProvenanceServiceImpl struct {
+	config                               *config.Configuration
+	logger                               *slog.Logger
+	getProofOfAuthorityPrivateKeyService s_poa.GetProofOfAuthorityPrivateKeyService
+	getBlockchainStateUseCase            uc_blockchainstate.GetBlockchainStateUseCase
+	getBlockDataUseCase                  uc_blockdata.GetBlockDataUseCase
+	listBlockDataByTokenIDUseCase        uc_blockdata.ListBlockDataByTokenIDUseCase
+	listValidatorSetUseCase              uc_validatorset.ListValidatorSetUseCase
+}
+
+func NewGetTokenProvenanceService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	s1 s_poa.GetProofOfAuthorityPrivateKeyService,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_blockdata.GetBlockDataUseCase,
+	uc3 uc_blockdata.ListBlockDataByTokenIDUseCase,
+	uc4 uc_validatorset.ListValidatorSetUseCase,
+) GetTokenProvenanceService {
+	return &getTokenProvenanceServiceImpl{cfg, logger, s1, uc1, uc2, uc3, uc4}
+}
+
+func (s *getTokenProvenanceServiceImpl) Execute(ctx context.Context, id *big.Int) (*domain.TokenProvenance, error) {
+	chainID := s.config.Blockchain.ChainID
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id == nil {
+		e["id"] = "missing value"
+	} else if id.Sign() < 0 {
+		e["id"] = "cannot be negative"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Get the proof of every token transaction of the token.
+	//
+
+	blockDatas, err := s.listBlockDataByTokenIDUseCase.Execute(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed listing block data by token ID",
+			slog.Any("id", id),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	proofs := make([]*domain.BlockTransactionProof, 0)
+	for _, blockData := range blockDatas {
+		for i, blockTx := range blockData.Trans {
+			if !blockTx.MovesToken() || blockTx.GetTokenID().Cmp(id) != 0 {
+				continue
+			}
+			proof, err := domain.NewBlockTransactionProof(blockData, i)
+			if err != nil {
+				s.logger.Error("Failed creating block transaction proof",
+					slog.String("block_hash", blockData.Hash),
+					slog.Int("transaction_index", i),
+					slog.Any("error", err))
+				return nil, err
+			}
+			proofs = append(proofs, proof)
+		}
+	}
+	if len(proofs) == 0 {
+		return nil, httperror.NewForNotFoundWithSingleField("id", fmt.Sprintf("Token %v does not exist", id))
+	}
+
+	provenance, err := domain.NewTokenProvenance(chainID, id, proofs)
+	if err != nil {
+		s.logger.Error("Failed creating token provenance",
+			slog.Any("id", id),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 3:
+	// Sign the provenance with our proof of authority identity.
+	//
+
+	privateKey, err := s.getProofOfAuthorityPrivateKeyService.Execute(ctx)
+	if err != nil {
+		s.logger.Error("Failed getting proof of authority private key.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if privateKey == nil {
+		return nil, fmt.Errorf("Proof of authority private key does not exist")
+	}
+	validator, err := s.getValidator(ctx, crypto.FromECDSAPub(&privateKey.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	provenance.Validator = validator
+	provenance.CreatedAt = time.Now()
+	provenance.SignatureBytes, err = validator.Sign(privateKey, provenance.Commitment())
+	if err != nil {
+		s.logger.Error("Failed signing token provenance.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	return provenance, nil
+}
+
+// getValidator returns our validator from the validator set. Blockchains
+// which never changed their validator set only have the validator which
+// sealed every block, including the latest one.
+func (s *getTokenProvenanceServiceImpl) getValidator(ctx context.Context, publicKeyBytes []byte) (*domain.Validator, error) {
+	chainID := s.config.Blockchain.ChainID
+
+	validators, err := s.listValidatorSetUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed listing validator set.",
+			slog.Any("error", err))
+		return nil, err
+	}
+	if len(validators) == 0 {
+		blockchainState, err := s.getBlockchainStateUseCase.Execute(ctx, chainID)
+		if err != nil {
+			s.logger.Error("Failed getting blockchain state.",
+				slog.Any("error", err))
+			return nil, err
+		}
+		if blockchainState == nil {
+			return nil, fmt.Errorf("Blockchain state does not exist for chain ID: %v", chainID)
+		}
+		blockData, err := s.getBlockDataUseCase.ExecuteByHash(ctx, blockchainState.LatestHash)
+		if err != nil {
+			s.logger.Error("Failed getting latest block data.",
+				slog.Any("error", err))
+			return nil, err
+		}
+		if blockData == nil {
+			return nil, fmt.Errorf("Latest block data does not exist for hash: %v", blockchainState.LatestHash)
+		}
+		validators = []*domain.Validator{blockData.Validator}
+	}
+
+	for _, validator := range validators {
+		if validator != nil && bytes.Equal(validator.PublicKeyBytes, publicKeyBytes) {
+			return validator, nil
+		}
+	}
+	return nil, fmt.Errorf("Proof of authority private key does not belong to a validator of chain ID: %v", chainID)
+}
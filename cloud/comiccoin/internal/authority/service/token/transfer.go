@@ -6,7 +6,6 @@ import (
 	"log/slog"
 	"math/big"
 	"strings"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -15,6 +14,7 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
 	sv_poa "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/poa"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
 	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
 	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
@@ -46,6 +46,7 @@ type tokenTransferServiceImpl struct {
 	getBlockDataUseCase                       uc_blockdata.GetBlockDataUseCase
 	getTokenUseCase                           uc_token.GetTokenUseCase
 	mempoolTransactionCreateUseCase           uc_mempooltx.MempoolTransactionCreateUseCase
+	getAccountUseCase                         uc_account.GetAccountUseCase
 	proofOfAuthorityConsensusMechanismService sv_poa.ProofOfAuthorityConsensusMechanismService
 }
 
@@ -60,6 +61,7 @@ func NewTokenTransferService(
 	uc4 uc_blockdata.GetBlockDataUseCase,
 	uc5 uc_token.GetTokenUseCase,
 	uc6 uc_mempooltx.MempoolTransactionCreateUseCase,
+	uc7 uc_account.GetAccountUseCase,
 	poaService sv_poa.ProofOfAuthorityConsensusMechanismService,
 ) TokenTransferService {
 	return &tokenTransferServiceImpl{
@@ -73,6 +75,7 @@ func NewTokenTransferService(
 		uc4,
 		uc5,
 		uc6,
+		uc7,
 		poaService,
 	}
 }
@@ -140,6 +143,19 @@ func (s *tokenTransferServiceImpl) Execute(
 		return fmt.Errorf("failed getting wallet private key: %s", err)
 	}
 
+	account, err := s.getAccountUseCase.Execute(ctx, tokenOwnerAddress)
+	if err != nil {
+		s.logger.Error("Failed getting account.",
+			slog.Any("address", tokenOwnerAddress),
+			slog.Any("error", err))
+		return err
+	}
+	if account == nil {
+		s.logger.Error("Account does not exist.",
+			slog.Any("address", tokenOwnerAddress))
+		return fmt.Errorf("Account does not exist for address: %v", tokenOwnerAddress)
+	}
+
 	recentBlockData, err := s.getBlockDataUseCase.ExecuteByHash(initialCtx, blockchainState.LatestHash)
 	if err != nil {
 		s.logger.Error("Failed getting latest block.",
@@ -194,7 +210,7 @@ func (s *tokenTransferServiceImpl) Execute(
 
 	tx := &domain.Transaction{
 		ChainID:          s.config.Blockchain.ChainID,
-		NonceBytes:       big.NewInt(time.Now().Unix()).Bytes(),
+		NonceBytes:       account.NextNonce().Bytes(),
 		From:             tokenOwnerAddress,
 		To:               recipientAddress,
 		Value:            s.config.Blockchain.TransactionFee, // Transaction fee gets reclaimed by the authority
@@ -203,6 +219,7 @@ func (s *tokenTransferServiceImpl) Execute(
 		TokenIDBytes:     token.IDBytes,
 		TokenMetadataURI: token.MetadataURI,
 		TokenNonceBytes:  nonce.Bytes(), // Transferred tokens must increment nonce
+		Version:          domain.TransactionVersion,
 	}
 
 	stx, signingErr := tx.Sign(privateKey)
@@ -238,7 +255,7 @@ func (s *tokenTransferServiceImpl) Execute(
 	// STEP 6: Submit directly to the PoA consensus mechanism instead of mempool
 	//
 
-	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, mempoolTx); err != nil {
+	if err := s.proofOfAuthorityConsensusMechanismService.Execute(ctx, []*domain.MempoolTransaction{mempoolTx}); err != nil {
 		s.logger.Error("Failed to process transaction through consensus mechanism",
 			slog.Any("error", err))
 		return err
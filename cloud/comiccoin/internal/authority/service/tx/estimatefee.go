@@ -0,0 +1,98 @@
+package blockchainstate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
+	uc_blockdata "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockdata"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// EstimateFeeBlocksSampled is the number of recent blocks the fee estimate
+// is based on.
+const EstimateFeeBlocksSampled = 20
+
+type EstimateFeeService interface {
+	Execute(ctx context.Context) (*domain.FeeEstimate, error)
+}
+
+type estimateFeeServiceImpl struct {
+	config                                  *config.Configuration
+	logger                                  *slog.Logger
+	getBlockchainStateUseCase               uc_blockchainstate.GetBlockchainStateUseCase
+	listBlockDataInHeaderNumberRangeUseCase uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase
+	mempoolTransactionListByChainIDUseCase  uc_mempooltx.MempoolTransactionListByChainIDUseCase
+}
+
+func NewEstimateFeeService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc2 uc_blockdata.ListBlockDataInHeaderNumberRangeUseCase,
+	uc3 uc_mempooltx.MempoolTransactionListByChainIDUseCase,
+) EstimateFeeService {
+	return &estimateFeeServiceImpl{cfg, logger, uc1, uc2, uc3}
+}
+
+func (s *estimateFeeServiceImpl) Execute(ctx context.Context) (*domain.FeeEstimate, error) {
+	//
+	// STEP 1: Get related records.
+	//
+
+	blkchState, err := s.getBlockchainStateUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting blockchain state", slog.Any("error", err))
+		return nil, err
+	}
+	if blkchState == nil {
+		errStr := fmt.Sprintf("Blockchain state does not exist for chain ID: %v", s.config.Blockchain.ChainID)
+		s.logger.Error("Failed getting blockchain state", slog.Any("error", errStr))
+		return nil, httperror.NewForNotFoundWithSingleField("chain_id", errStr)
+	}
+
+	// DEVELOPERS NOTE:
+	// We skip the genesis block as it does not reflect the network usage.
+	to := blkchState.GetLatestBlockNumber()
+	from := new(big.Int).Sub(to, big.NewInt(EstimateFeeBlocksSampled-1))
+	if from.Cmp(big.NewInt(1)) < 0 {
+		from = big.NewInt(1)
+	}
+	recentBlocks := make([]*domain.BlockData, 0)
+	if to.Cmp(from) >= 0 {
+		recentBlocks, err = s.listBlockDataInHeaderNumberRangeUseCase.Execute(ctx, from, to)
+		if err != nil {
+			s.logger.Error("Failed listing recent blocks", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	pendingTxs, err := s.mempoolTransactionListByChainIDUseCase.Execute(ctx, s.config.Blockchain.ChainID)
+	if err != nil {
+		s.logger.Error("Failed listing mempool transactions", slog.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 2: Estimate the fee.
+	//
+
+	nextBlockNumber := new(big.Int).Add(to, big.NewInt(1))
+	estimate := domain.EstimateFee(recentBlocks, len(pendingTxs), s.config.Blockchain.TransPerBlock, s.config.Blockchain.TransactionFee)
+	estimate.ChainID = s.config.Blockchain.ChainID
+	estimate.FeeMarketActivationHeight = s.config.Blockchain.FeeMarketActivationHeight
+	estimate.FeeMarketActive = domain.IsFeeMarketActive(s.config.Blockchain.FeeMarketActivationHeight, nextBlockNumber)
+
+	s.logger.Debug("Estimated transaction fee",
+		slog.Any("suggested_fee", estimate.SuggestedFee),
+		slog.Any("fast_fee", estimate.FastFee),
+		slog.Any("block_utilization", estimate.BlockUtilization),
+		slog.Bool("fee_market_active", estimate.FeeMarketActive))
+
+	return estimate, nil
+}
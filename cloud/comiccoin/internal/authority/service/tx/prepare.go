@@ -6,9 +6,10 @@ import (
 	"log/slog"
 	"math/big"
 	"strings"
-	"time"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	sv_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/service/account"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/blockchainstate"
 	uc_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/token"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
@@ -34,6 +35,10 @@ type PrepareTransactionRequestIDO struct {
 
 	TokenIDString    string `json:"token_id_string"`
 	TokenMetadataURI string `json:"token_metadata_uri"`
+
+	// Fee is the optional fee paid to the authority on top of the value once
+	// the fee market is active, defaults to the minimum fee.
+	Fee uint64 `json:"fee"`
 }
 
 type PrepareTransactionResponseIDO struct {
@@ -51,6 +56,8 @@ type PrepareTransactionResponseIDO struct {
 	TokenMetadataURI string          `bson:"token_metadata_uri" json:"token_metadata_uri"` // ComicCoin: URI pointing to Token metadata file (if this transaciton is an Token).
 	TokenNonceBytes  []byte          `bson:"token_nonce_bytes" json:"token_nonce_bytes"`   // ComicCoin: For every transaction action (mint, transfer, burn, etc), increment token nonce by value of 1.
 	TokenNonceString string          `bson:"-" json:"token_nonce_string"`                  // Read-only response in string format - will not be saved in database, only returned via API.
+	Fee              uint64          `bson:"fee,omitempty" json:"fee,omitempty"`           // ComicCoin: Fee paid to the authority on top of the value once the fee market is active.
+	Version          uint8           `bson:"version,omitempty" json:"version,omitempty"`   // ComicCoin: The signing version the transaction must be signed with.
 }
 
 type PrepareTransactionService interface {
@@ -58,10 +65,11 @@ type PrepareTransactionService interface {
 }
 
 type prepareTransactionServiceImpl struct {
-	config                    *config.Configuration
-	logger                    *slog.Logger
-	getBlockchainStateUseCase uc_blockchainstate.GetBlockchainStateUseCase
-	getTokenUseCase           uc_token.GetTokenUseCase
+	config                     *config.Configuration
+	logger                     *slog.Logger
+	getBlockchainStateUseCase  uc_blockchainstate.GetBlockchainStateUseCase
+	getTokenUseCase            uc_token.GetTokenUseCase
+	getAccountNextNonceService sv_account.GetAccountNextNonceService
 }
 
 func NewPrepareTransactionService(
@@ -69,8 +77,9 @@ func NewPrepareTransactionService(
 	logger *slog.Logger,
 	uc1 uc_blockchainstate.GetBlockchainStateUseCase,
 	uc2 uc_token.GetTokenUseCase,
+	s1 sv_account.GetAccountNextNonceService,
 ) PrepareTransactionService {
-	return &prepareTransactionServiceImpl{cfg, logger, uc1, uc2}
+	return &prepareTransactionServiceImpl{cfg, logger, uc1, uc2, s1}
 }
 
 func (s *prepareTransactionServiceImpl) Execute(ctx context.Context, req *PrepareTransactionRequestIDO) (*PrepareTransactionResponseIDO, error) {
@@ -128,6 +137,22 @@ func (s *prepareTransactionServiceImpl) Execute(ctx context.Context, req *Prepar
 		return nil, httperror.NewForNotFoundWithSingleField("chain_id", errStr)
 	}
 
+	// Transactions may only carry their own fee once the block they will be
+	// sealed in reached the activation height of the fee market.
+	nextBlockNumber := blkchState.GetLatestBlockNumber()
+	nextBlockNumber.Add(nextBlockNumber, big.NewInt(1))
+	feeMarketActive := domain.IsFeeMarketActive(s.config.Blockchain.FeeMarketActivationHeight, nextBlockNumber)
+	if feeMarketActive && req.Fee != 0 && req.Fee < s.config.Blockchain.TransactionFee {
+		errStr := fmt.Sprintf("Fee must be at least %v", s.config.Blockchain.TransactionFee)
+		s.logger.Warn("Failed validating prepare transaction fee", slog.Any("error", errStr))
+		return nil, httperror.NewForBadRequestWithSingleField("fee", errStr)
+	}
+	if !feeMarketActive && req.Fee != 0 {
+		errStr := "Fee is not accepted until the fee market is active"
+		s.logger.Warn("Failed validating prepare transaction fee", slog.Any("error", errStr))
+		return nil, httperror.NewForBadRequestWithSingleField("fee", errStr)
+	}
+
 	toAddr := common.HexToAddress(strings.ToLower(req.RecipientAddress))
 	senderAddr := common.HexToAddress(strings.ToLower(req.SenderAccountAddress))
 
@@ -135,17 +160,35 @@ func (s *prepareTransactionServiceImpl) Execute(ctx context.Context, req *Prepar
 	// STEP 3: Create our unsigned transaction to send back to the user.
 	//
 
-	nonceBigInt := big.NewInt(time.Now().Unix())
+	// DEVELOPERS NOTE:
+	// The authority only accepts a transaction if its nonce is the next nonce
+	// of the sender's account, therefore we hand out the next nonce available
+	// after any transactions the sender already has waiting in the mempool.
+	nonceBigInt, err := s.getAccountNextNonceService.Execute(ctx, &senderAddr)
+	if err != nil {
+		s.logger.Error("Failed getting next nonce for sender account",
+			slog.Any("sender", senderAddr),
+			slog.Any("error", err))
+		return nil, err
+	}
 	nonceBytes := nonceBigInt.Bytes()
 
 	preparedTx := &PrepareTransactionResponseIDO{
-		ChainID:    s.config.Blockchain.ChainID,
-		NonceBytes: nonceBytes,
-		From:       &senderAddr,
-		To:         &toAddr,
-		Value:      req.Value + s.config.Blockchain.TransactionFee, // Note: The transaction fee gets reclaimed by the us, so it's fully recirculating when authority calls this.
-		Data:       []byte(req.Data),
-		Type:       req.Type,
+		ChainID:     s.config.Blockchain.ChainID,
+		NonceBytes:  nonceBytes,
+		NonceString: nonceBigInt.String(),
+		From:        &senderAddr,
+		To:          &toAddr,
+		Value:       req.Value + s.config.Blockchain.TransactionFee, // Note: The transaction fee gets reclaimed by the us, so it's fully recirculating when authority calls this.
+		Data:        []byte(req.Data),
+		Type:        req.Type,
+	}
+	if feeMarketActive {
+		// Once the fee market is active the fee is paid on top of the value
+		// instead of being taken out of it.
+		preparedTx.Value = req.Value
+		preparedTx.Fee = max(req.Fee, s.config.Blockchain.TransactionFee)
+		preparedTx.Version = domain.TransactionVersionFee
 	}
 
 	// Just before returning the prepared transaction
@@ -156,6 +199,7 @@ func (s *prepareTransactionServiceImpl) Execute(ctx context.Context, req *Prepar
 		slog.Any("from", preparedTx.From.Hex()),
 		slog.Any("to", preparedTx.To.Hex()),
 		slog.Any("value", preparedTx.Value),
+		slog.Any("fee", preparedTx.Fee),
 		slog.Any("data_hex", hexutil.Encode(preparedTx.Data)),
 		slog.String("type", preparedTx.Type))
 
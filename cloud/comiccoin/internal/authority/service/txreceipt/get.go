@@ -0,0 +1,71 @@
+package txreceipt
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_txreceipt "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/txreceipt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+// GetTransactionReceiptService returns the receipt of a signed transaction
+// so clients can tell whether their transaction is pending, was included in
+// a block or was rejected.
+type GetTransactionReceiptService interface {
+	Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error)
+}
+
+type getTransactionReceiptServiceImpl struct {
+	config                       *config.Configuration
+	logger                       *slog.Logger
+	getTransactionReceiptUseCase uc_txreceipt.GetTransactionReceiptUseCase
+}
+
+func NewGetTransactionReceiptService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_txreceipt.GetTransactionReceiptUseCase,
+) GetTransactionReceiptService {
+	return &getTransactionReceiptServiceImpl{cfg, logger, uc1}
+}
+
+func (s *getTransactionReceiptServiceImpl) Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	// Defensive code: Hashes are always lowercase hex prefixed with `0x`.
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	if !strings.HasPrefix(hash, "0x") {
+		hash = "0x" + hash
+	}
+
+	e := make(map[string]string)
+	if len(hash) != 66 {
+		e["hash"] = "invalid transaction hash"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get the receipt.
+	//
+
+	receipt, err := s.getTransactionReceiptUseCase.Execute(ctx, hash)
+	if err != nil {
+		s.logger.Error("Failed getting transaction receipt",
+			slog.String("hash", hash),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, httperror.NewForNotFoundWithSingleField("hash", "transaction receipt does not exist")
+	}
+	return receipt, nil
+}
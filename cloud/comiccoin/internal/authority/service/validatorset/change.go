@@ -0,0 +1,170 @@
+package validatorset
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	uc_account "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/account"
+	uc_mempooltx "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/mempooltx"
+	uc_walletutil "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/usecase/walletutil"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
+)
+
+// ValidatorSetChangeService submits a `validator` transaction which adds or
+// removes a validator from the validator set.
+type ValidatorSetChangeService interface {
+	Execute(
+		ctx context.Context,
+		fromAccountAddress *common.Address,
+		accountWalletMnemonic *sstring.SecureString,
+		accountWalletPath string,
+		action string,
+		validator *domain.Validator,
+	) error
+}
+
+type validatorSetChangeServiceImpl struct {
+	config                          *config.Configuration
+	logger                          *slog.Logger
+	getAccountUseCase               uc_account.GetAccountUseCase
+	privateKeyFromHDWalletUseCase   uc_walletutil.PrivateKeyFromHDWalletUseCase
+	mempoolTransactionCreateUseCase uc_mempooltx.MempoolTransactionCreateUseCase
+}
+
+func NewValidatorSetChangeService(
+	cfg *config.Configuration,
+	logger *slog.Logger,
+	uc1 uc_account.GetAccountUseCase,
+	uc2 uc_walletutil.PrivateKeyFromHDWalletUseCase,
+	uc3 uc_mempooltx.MempoolTransactionCreateUseCase,
+) ValidatorSetChangeService {
+	return &validatorSetChangeServiceImpl{cfg, logger, uc1, uc2, uc3}
+}
+
+func (s *validatorSetChangeServiceImpl) Execute(
+	ctx context.Context,
+	fromAccountAddress *common.Address,
+	accountWalletMnemonic *sstring.SecureString,
+	accountWalletPath string,
+	action string,
+	validator *domain.Validator,
+) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if fromAccountAddress == nil {
+		e["from_account_address"] = "missing value"
+	}
+	if accountWalletMnemonic == nil {
+		e["account_wallet_mnemonic"] = "missing value"
+	}
+	if accountWalletPath == "" {
+		e["account_wallet_path"] = "missing value"
+	}
+	if action != domain.ValidatorSetChangeActionAdd && action != domain.ValidatorSetChangeActionRemove {
+		e["action"] = fmt.Sprintf("incorrect value: %v", action)
+	}
+	if validator == nil {
+		e["validator"] = "missing value"
+	} else {
+		if validator.ID == "" && action == domain.ValidatorSetChangeActionAdd {
+			e["validator_id"] = "missing value"
+		}
+		if _, err := crypto.UnmarshalPubkey(validator.PublicKeyBytes); err != nil {
+			e["validator_public_key"] = "invalid value"
+		}
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating validator set change parameters",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get the wallet and extract the wallet private/public key.
+	//
+
+	privateKey, err := s.privateKeyFromHDWalletUseCase.Execute(ctx, accountWalletMnemonic, accountWalletPath)
+	if err != nil {
+		s.logger.Error("failed getting wallet key",
+			slog.Any("error", err))
+		return fmt.Errorf("failed getting wallet key: %s", err)
+	}
+
+	account, err := s.getAccountUseCase.Execute(ctx, fromAccountAddress)
+	if err != nil {
+		s.logger.Error("failed getting account",
+			slog.Any("from_account_address", fromAccountAddress),
+			slog.Any("error", err))
+		return fmt.Errorf("failed getting account: %s", err)
+	}
+	if account == nil {
+		return fmt.Errorf("failed getting account: %s", "d.n.e.")
+	}
+
+	//
+	// STEP 3:
+	// Create our pending transaction and sign it with the accounts private key.
+	//
+
+	change := &domain.ValidatorSetChange{
+		Action:    action,
+		Validator: validator,
+	}
+	data, err := change.Serialize()
+	if err != nil {
+		return err
+	}
+
+	tx := &domain.Transaction{
+		ChainID:    s.config.Blockchain.ChainID,
+		NonceBytes: account.NextNonce().Bytes(),
+		From:       fromAccountAddress,
+		To:         fromAccountAddress, // Note: No coins are transfered, the administrator sends to itself.
+		Value:      0,
+		Data:       data,
+		Type:       domain.TransactionTypeValidator,
+		Version:    domain.TransactionVersion,
+	}
+
+	stx, err := tx.Sign(privateKey)
+	if err != nil {
+		s.logger.Debug("Failed to sign the transaction",
+			slog.Any("error", err))
+		return err
+	}
+
+	mempoolTx := &domain.MempoolTransaction{
+		ID:                primitive.NewObjectID(),
+		SignedTransaction: stx,
+	}
+
+	//
+	// STEP 4:
+	// Submit to our mempool. We do not seal the block directly because the
+	// block must be sealed by whichever validator is scheduled for it.
+	//
+
+	if err := s.mempoolTransactionCreateUseCase.Execute(ctx, mempoolTx); err != nil {
+		s.logger.Error("Failed to submit validator set change to mempool",
+			slog.Any("error", err))
+		return err
+	}
+
+	s.logger.Info("Validator set change submitted to mempool",
+		slog.String("action", action),
+		slog.String("validator_id", validator.ID),
+		slog.Any("tx_nonce", stx.GetNonce()))
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -42,6 +43,10 @@ func (m AccountRepository) HashStateByChainID(ctx context.Context, chainID uint1
 	return m.HashStateByChainIDResult, m.HashStateByChainIDError
 }
 
+func (m AccountRepository) ProveByAddress(ctx context.Context, chainID uint16, addr *common.Address) (*smt.Proof, error) {
+	return nil, nil
+}
+
 func (m AccountRepository) OpenTransaction() error {
 	return nil
 }
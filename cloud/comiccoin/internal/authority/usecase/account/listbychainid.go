@@ -0,0 +1,27 @@
+package account
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type ListAccountsByChainIDUseCase interface {
+	Execute(ctx context.Context, chainID uint16) ([]*domain.Account, error)
+}
+
+type listAccountsByChainIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.AccountRepository
+}
+
+func NewListAccountsByChainIDUseCase(config *config.Configuration, logger *slog.Logger, repo domain.AccountRepository) ListAccountsByChainIDUseCase {
+	return &listAccountsByChainIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listAccountsByChainIDUseCaseImpl) Execute(ctx context.Context, chainID uint16) ([]*domain.Account, error) {
+	return uc.repo.ListByChainID(ctx, chainID)
+}
@@ -0,0 +1,52 @@
+package account
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetAccountStateProofUseCase interface {
+	Execute(ctx context.Context, chainID uint16, address *common.Address) (*smt.Proof, error)
+}
+
+type getAccountStateProofUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.AccountRepository
+}
+
+func NewGetAccountStateProofUseCase(config *config.Configuration, logger *slog.Logger, repo domain.AccountRepository) GetAccountStateProofUseCase {
+	return &getAccountStateProofUseCaseImpl{config, logger, repo}
+}
+
+func (uc *getAccountStateProofUseCaseImpl) Execute(ctx context.Context, chainID uint16, address *common.Address) (*smt.Proof, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if address == nil {
+		e["address"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for getting account state proof",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Prove the account against the accounts trie.
+	//
+
+	return uc.repo.ProveByAddress(ctx, chainID, address)
+}
@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
@@ -14,6 +16,7 @@ type GetBlockDataUseCase interface {
 	ExecuteByHash(ctx context.Context, hash string) (*domain.BlockData, error)
 	ExecuteByHeaderNumber(ctx context.Context, headerNumber *big.Int) (*domain.BlockData, error)
 	ExecuteByTransactionNonce(ctx context.Context, txNonce *big.Int) (*domain.BlockData, error)
+	ExecuteByTransactionFromAddressAndNonce(ctx context.Context, from *common.Address, txNonce *big.Int) (*domain.BlockData, error)
 }
 
 type getBlockDataUseCaseImpl struct {
@@ -103,3 +106,28 @@ func (uc *getBlockDataUseCaseImpl) ExecuteByTransactionNonce(ctx context.Context
 
 	return uc.repo.GetByTransactionNonce(ctx, txNonce)
 }
+
+func (uc *getBlockDataUseCaseImpl) ExecuteByTransactionFromAddressAndNonce(ctx context.Context, from *common.Address, txNonce *big.Int) (*domain.BlockData, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if from == nil {
+		e["from"] = "From address is required"
+	}
+	if txNonce == nil {
+		e["transaction_nonce"] = "Transaction nonce is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByTransactionFromAddressAndNonce(ctx, from, txNonce)
+}
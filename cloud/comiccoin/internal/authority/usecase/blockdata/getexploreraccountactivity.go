@@ -0,0 +1,48 @@
+package blockdata
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetExplorerAccountActivityUseCase interface {
+	Execute(ctx context.Context, address *common.Address) (*domain.ExplorerAccountActivity, error)
+}
+
+type getExplorerAccountActivityUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.BlockDataRepository
+}
+
+func NewGetExplorerAccountActivityUseCase(config *config.Configuration, logger *slog.Logger, repo domain.BlockDataRepository) GetExplorerAccountActivityUseCase {
+	return &getExplorerAccountActivityUseCaseImpl{config, logger, repo}
+}
+
+func (uc *getExplorerAccountActivityUseCaseImpl) Execute(ctx context.Context, address *common.Address) (*domain.ExplorerAccountActivity, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if address == nil {
+		e["address"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetExplorerAccountActivityByAddress(ctx, uc.config.Blockchain.ChainID, address)
+}
@@ -0,0 +1,49 @@
+package blockdata
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListBlockDataByTokenIDUseCase interface {
+	Execute(ctx context.Context, tokenID *big.Int) ([]*domain.BlockData, error)
+}
+
+type listBlockDataByTokenIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.BlockDataRepository
+}
+
+func NewListBlockDataByTokenIDUseCase(config *config.Configuration, logger *slog.Logger, repo domain.BlockDataRepository) ListBlockDataByTokenIDUseCase {
+	return &listBlockDataByTokenIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listBlockDataByTokenIDUseCaseImpl) Execute(ctx context.Context, tokenID *big.Int) ([]*domain.BlockData, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if tokenID == nil {
+		e["token_id"] = "Token ID is required"
+	} else if tokenID.Sign() < 0 {
+		e["token_id"] = "Token ID cannot be negative"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.ListByTokenIDForChainID(ctx, uc.config.Blockchain.ChainID, tokenID)
+}
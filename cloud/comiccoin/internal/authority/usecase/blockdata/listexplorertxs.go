@@ -0,0 +1,56 @@
+package blockdata
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListExplorerTransactionsByFilterUseCase interface {
+	Execute(ctx context.Context, filter *domain.ExplorerTransactionFilter) (*domain.ExplorerTransactionListResult, error)
+}
+
+type listExplorerTransactionsByFilterUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.BlockDataRepository
+}
+
+func NewListExplorerTransactionsByFilterUseCase(config *config.Configuration, logger *slog.Logger, repo domain.BlockDataRepository) ListExplorerTransactionsByFilterUseCase {
+	return &listExplorerTransactionsByFilterUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listExplorerTransactionsByFilterUseCaseImpl) Execute(ctx context.Context, filter *domain.ExplorerTransactionFilter) (*domain.ExplorerTransactionListResult, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if filter == nil {
+		e["filter"] = "missing value"
+	} else {
+		if filter.Type != "" && filter.Type != domain.TransactionTypeCoin && filter.Type != domain.TransactionTypeToken && filter.Type != domain.TransactionTypeSwap && filter.Type != domain.TransactionTypeValidator {
+			e["type"] = "Type must be either `coin`, `token`, `swap` or `validator`"
+		}
+		if filter.TimeStampStart != 0 && filter.TimeStampEnd != 0 && filter.TimeStampEnd < filter.TimeStampStart {
+			e["timestamp_end"] = "Timestamp end cannot be before timestamp start"
+		}
+		if filter.TokenID != nil && filter.TokenID.Sign() < 0 {
+			e["token_id"] = "Token ID cannot be negative"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.ListExplorerTransactionsByFilter(ctx, filter)
+}
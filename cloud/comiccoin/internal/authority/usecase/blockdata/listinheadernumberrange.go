@@ -0,0 +1,60 @@
+package blockdata
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListBlockDataInHeaderNumberRangeUseCase interface {
+	Execute(ctx context.Context, from, to *big.Int) ([]*domain.BlockData, error)
+}
+
+type listBlockDataInHeaderNumberRangeUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.BlockDataRepository
+}
+
+func NewListBlockDataInHeaderNumberRangeUseCase(config *config.Configuration, logger *slog.Logger, repo domain.BlockDataRepository) ListBlockDataInHeaderNumberRangeUseCase {
+	return &listBlockDataInHeaderNumberRangeUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listBlockDataInHeaderNumberRangeUseCaseImpl) Execute(ctx context.Context, from, to *big.Int) ([]*domain.BlockData, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if from == nil {
+		e["from"] = "From header number is required"
+	} else if from.Sign() < 0 {
+		e["from"] = "From header number cannot be negative"
+	}
+	if to == nil {
+		e["to"] = "To header number is required"
+	} else if from != nil && to.Cmp(from) < 0 {
+		e["to"] = "To header number cannot be less than from header number"
+	}
+	if from != nil && to != nil {
+		size := new(big.Int).Sub(to, from)
+		if size.Cmp(big.NewInt(domain.BlockDataRangeMaxSize)) >= 0 {
+			e["to"] = "Range exceeds the maximum number of blocks"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.ListInHeaderNumberRangeForChainID(ctx, uc.config.Blockchain.ChainID, from, to)
+}
@@ -0,0 +1,28 @@
+package collection
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type GetCollectionUseCase interface {
+	Execute(ctx context.Context, id *big.Int) (*domain.Collection, error)
+}
+
+type getCollectionUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.CollectionRepository
+}
+
+func NewGetCollectionUseCase(config *config.Configuration, logger *slog.Logger, repo domain.CollectionRepository) GetCollectionUseCase {
+	return &getCollectionUseCaseImpl{config, logger, repo}
+}
+
+func (uc *getCollectionUseCaseImpl) Execute(ctx context.Context, id *big.Int) (*domain.Collection, error) {
+	return uc.repo.GetByID(ctx, id)
+}
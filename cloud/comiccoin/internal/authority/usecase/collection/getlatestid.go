@@ -0,0 +1,28 @@
+package collection
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type GetLatestCollectionIDUseCase interface {
+	ExecuteByChainID(ctx context.Context, chainID uint16) (*big.Int, error)
+}
+
+type getLatestCollectionIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.CollectionRepository
+}
+
+func NewGetLatestCollectionIDUseCase(config *config.Configuration, logger *slog.Logger, repo domain.CollectionRepository) GetLatestCollectionIDUseCase {
+	return &getLatestCollectionIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *getLatestCollectionIDUseCaseImpl) ExecuteByChainID(ctx context.Context, chainID uint16) (*big.Int, error) {
+	return uc.repo.GetLatestIDByChainID(ctx, chainID)
+}
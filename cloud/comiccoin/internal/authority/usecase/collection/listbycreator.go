@@ -0,0 +1,29 @@
+package collection
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type ListCollectionsByCreatorUseCase interface {
+	Execute(ctx context.Context, creator *common.Address) ([]*domain.Collection, error)
+}
+
+type listCollectionsByCreatorUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.CollectionRepository
+}
+
+func NewListCollectionsByCreatorUseCase(config *config.Configuration, logger *slog.Logger, repo domain.CollectionRepository) ListCollectionsByCreatorUseCase {
+	return &listCollectionsByCreatorUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listCollectionsByCreatorUseCaseImpl) Execute(ctx context.Context, creator *common.Address) ([]*domain.Collection, error) {
+	return uc.repo.ListByCreator(ctx, creator)
+}
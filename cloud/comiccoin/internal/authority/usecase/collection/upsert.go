@@ -0,0 +1,61 @@
+package collection
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type UpsertCollectionUseCase interface {
+	Execute(ctx context.Context, col *domain.Collection) error
+}
+
+type upsertCollectionUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.CollectionRepository
+}
+
+func NewUpsertCollectionUseCase(config *config.Configuration, logger *slog.Logger, repo domain.CollectionRepository) UpsertCollectionUseCase {
+	return &upsertCollectionUseCaseImpl{config, logger, repo}
+}
+
+func (uc *upsertCollectionUseCaseImpl) Execute(ctx context.Context, col *domain.Collection) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if col == nil {
+		e["collection"] = "missing value"
+	} else {
+		if col.ChainID == 0 {
+			e["chain_id"] = "missing value"
+		}
+		if len(col.IDBytes) == 0 {
+			e["id_bytes"] = "missing value"
+		}
+		if col.Creator == nil {
+			e["creator"] = "missing value"
+		}
+		if col.MaxSupply == 0 {
+			e["max_supply"] = "missing value"
+		} else if col.Supply > col.MaxSupply {
+			e["supply"] = "cannot exceed max supply"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Upsert(ctx, col)
+}
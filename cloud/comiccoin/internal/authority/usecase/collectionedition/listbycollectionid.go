@@ -0,0 +1,28 @@
+package collectionedition
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type ListCollectionEditionsByCollectionIDUseCase interface {
+	Execute(ctx context.Context, collectionID *big.Int) ([]*domain.CollectionEdition, error)
+}
+
+type listCollectionEditionsByCollectionIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.CollectionEditionRepository
+}
+
+func NewListCollectionEditionsByCollectionIDUseCase(config *config.Configuration, logger *slog.Logger, repo domain.CollectionEditionRepository) ListCollectionEditionsByCollectionIDUseCase {
+	return &listCollectionEditionsByCollectionIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listCollectionEditionsByCollectionIDUseCaseImpl) Execute(ctx context.Context, collectionID *big.Int) ([]*domain.CollectionEdition, error) {
+	return uc.repo.ListByCollectionID(ctx, collectionID)
+}
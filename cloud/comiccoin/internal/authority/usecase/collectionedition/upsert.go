@@ -0,0 +1,58 @@
+package collectionedition
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type UpsertCollectionEditionUseCase interface {
+	Execute(ctx context.Context, edition *domain.CollectionEdition) error
+}
+
+type upsertCollectionEditionUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.CollectionEditionRepository
+}
+
+func NewUpsertCollectionEditionUseCase(config *config.Configuration, logger *slog.Logger, repo domain.CollectionEditionRepository) UpsertCollectionEditionUseCase {
+	return &upsertCollectionEditionUseCaseImpl{config, logger, repo}
+}
+
+func (uc *upsertCollectionEditionUseCaseImpl) Execute(ctx context.Context, edition *domain.CollectionEdition) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if edition == nil {
+		e["edition"] = "missing value"
+	} else {
+		if len(edition.CollectionIDBytes) == 0 {
+			e["collection_id_bytes"] = "missing value"
+		}
+		if len(edition.TokenIDBytes) == 0 {
+			e["token_id_bytes"] = "missing value"
+		}
+		if edition.Edition == 0 {
+			e["edition"] = "missing value"
+		} else if edition.Edition > edition.MaxSupply {
+			e["edition"] = "cannot exceed max supply"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Upsert(ctx, edition)
+}
@@ -46,7 +46,7 @@ func (uc *mempoolTransactionCreateUseCaseImpl) Execute(ctx context.Context, memp
 		// DEVELOPERS NOTE:
 		// Only `coin` type transactions need their value verified while the
 		// `token` type transactions can have zero value.
-		if mempoolTx.Type == domain.TransactionTypeCoin {
+		if mempoolTx.Type == domain.TransactionTypeCoin || mempoolTx.Type == domain.TransactionTypeSwap {
 			e["value"] = "missing value"
 		}
 	}
@@ -64,6 +64,23 @@ func (uc *mempoolTransactionCreateUseCaseImpl) Execute(ctx context.Context, memp
 				e["token_metadata_uri"] = "missing value"
 			}
 		}
+		if mempoolTx.Type == domain.TransactionTypeSwap {
+			validType = true
+
+			if mempoolTx.TokenMetadataURI == "" {
+				e["token_metadata_uri"] = "missing value"
+			}
+			if !mempoolTx.IsCoSigned() {
+				e["counterparty_v_bytes"] = "missing value"
+			}
+		}
+		if mempoolTx.Type == domain.TransactionTypeValidator {
+			validType = true
+
+			if len(mempoolTx.Data) == 0 {
+				e["data"] = "missing value"
+			}
+		}
 		if validType == false {
 			e["type"] = fmt.Sprintf("incorrect value: %v", mempoolTx.Type)
 		}
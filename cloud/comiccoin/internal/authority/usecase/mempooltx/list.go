@@ -6,6 +6,7 @@ import (
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type MempoolTransactionListByChainIDUseCase interface {
@@ -25,3 +26,21 @@ func NewMempoolTransactionListByChainIDUseCase(config *config.Configuration, log
 func (uc *mempoolTransactionListByChainIDUseCaseImpl) Execute(ctx context.Context, chainID uint16) ([]*domain.MempoolTransaction, error) {
 	return uc.repo.ListByChainID(ctx, chainID)
 }
+
+type MempoolTransactionListByFromAddressUseCase interface {
+	Execute(ctx context.Context, addr *common.Address) ([]*domain.MempoolTransaction, error)
+}
+
+type mempoolTransactionListByFromAddressUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.MempoolTransactionRepository
+}
+
+func NewMempoolTransactionListByFromAddressUseCase(config *config.Configuration, logger *slog.Logger, repo domain.MempoolTransactionRepository) MempoolTransactionListByFromAddressUseCase {
+	return &mempoolTransactionListByFromAddressUseCaseImpl{config, logger, repo}
+}
+
+func (uc *mempoolTransactionListByFromAddressUseCaseImpl) Execute(ctx context.Context, addr *common.Address) ([]*domain.MempoolTransaction, error) {
+	return uc.repo.ListByFromAddress(ctx, addr)
+}
@@ -0,0 +1,93 @@
+package mempooltx
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type MempoolTransactionStatusUpsertUseCase interface {
+	Execute(ctx context.Context, status *domain.MempoolTransactionStatus) error
+}
+
+type mempoolTransactionStatusUpsertUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.MempoolTransactionStatusRepository
+}
+
+func NewMempoolTransactionStatusUpsertUseCase(config *config.Configuration, logger *slog.Logger, repo domain.MempoolTransactionStatusRepository) MempoolTransactionStatusUpsertUseCase {
+	return &mempoolTransactionStatusUpsertUseCaseImpl{config, logger, repo}
+}
+
+func (uc *mempoolTransactionStatusUpsertUseCaseImpl) Execute(ctx context.Context, status *domain.MempoolTransactionStatus) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if status == nil {
+		e["status"] = "missing value"
+	} else {
+		if status.ID.IsZero() {
+			e["id"] = "missing value"
+		}
+		if status.Status == "" {
+			e["status"] = "missing value"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for upsert",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Upsert into cache.
+	//
+
+	status.ModifiedAt = time.Now()
+	return uc.repo.Upsert(ctx, status)
+}
+
+type MempoolTransactionStatusGetUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) (*domain.MempoolTransactionStatus, error)
+}
+
+type mempoolTransactionStatusGetUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.MempoolTransactionStatusRepository
+}
+
+func NewMempoolTransactionStatusGetUseCase(config *config.Configuration, logger *slog.Logger, repo domain.MempoolTransactionStatusRepository) MempoolTransactionStatusGetUseCase {
+	return &mempoolTransactionStatusGetUseCaseImpl{config, logger, repo}
+}
+
+func (uc *mempoolTransactionStatusGetUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*domain.MempoolTransactionStatus, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for get",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from cache.
+	//
+
+	return uc.repo.GetByID(ctx, id)
+}
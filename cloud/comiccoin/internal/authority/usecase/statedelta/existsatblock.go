@@ -0,0 +1,46 @@
+package statedelta
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type AccountStateDeltaExistsAtBlockNumberUseCase interface {
+	Execute(ctx context.Context, chainID uint16, blockNumber uint64) (bool, error)
+}
+
+type accountStateDeltaExistsAtBlockNumberUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.AccountStateDeltaRepository
+}
+
+func NewAccountStateDeltaExistsAtBlockNumberUseCase(config *config.Configuration, logger *slog.Logger, repo domain.AccountStateDeltaRepository) AccountStateDeltaExistsAtBlockNumberUseCase {
+	return &accountStateDeltaExistsAtBlockNumberUseCaseImpl{config, logger, repo}
+}
+
+func (uc *accountStateDeltaExistsAtBlockNumberUseCaseImpl) Execute(ctx context.Context, chainID uint16, blockNumber uint64) (bool, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return false, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Count from database.
+	//
+
+	return uc.repo.ExistsAtBlockNumber(ctx, chainID, blockNumber)
+}
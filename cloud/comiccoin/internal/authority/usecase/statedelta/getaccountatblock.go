@@ -0,0 +1,51 @@
+package statedelta
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetAccountStateDeltaAtBlockNumberUseCase interface {
+	Execute(ctx context.Context, chainID uint16, address *common.Address, blockNumber uint64) (*domain.AccountStateDelta, error)
+}
+
+type getAccountStateDeltaAtBlockNumberUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.AccountStateDeltaRepository
+}
+
+func NewGetAccountStateDeltaAtBlockNumberUseCase(config *config.Configuration, logger *slog.Logger, repo domain.AccountStateDeltaRepository) GetAccountStateDeltaAtBlockNumberUseCase {
+	return &getAccountStateDeltaAtBlockNumberUseCaseImpl{config, logger, repo}
+}
+
+func (uc *getAccountStateDeltaAtBlockNumberUseCaseImpl) Execute(ctx context.Context, chainID uint16, address *common.Address, blockNumber uint64) (*domain.AccountStateDelta, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if address == nil {
+		e["address"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetLatestAtBlockNumber(ctx, chainID, address, blockNumber)
+}
@@ -0,0 +1,50 @@
+package statedelta
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListTokenStateDeltasByTokenIDUseCase interface {
+	Execute(ctx context.Context, chainID uint16, tokenID *big.Int) ([]*domain.TokenStateDelta, error)
+}
+
+type listTokenStateDeltasByTokenIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.TokenStateDeltaRepository
+}
+
+func NewListTokenStateDeltasByTokenIDUseCase(config *config.Configuration, logger *slog.Logger, repo domain.TokenStateDeltaRepository) ListTokenStateDeltasByTokenIDUseCase {
+	return &listTokenStateDeltasByTokenIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listTokenStateDeltasByTokenIDUseCaseImpl) Execute(ctx context.Context, chainID uint16, tokenID *big.Int) ([]*domain.TokenStateDelta, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if tokenID == nil {
+		e["token_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List from database.
+	//
+
+	return uc.repo.ListByTokenID(ctx, chainID, tokenID)
+}
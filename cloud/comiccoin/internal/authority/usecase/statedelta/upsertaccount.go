@@ -0,0 +1,56 @@
+package statedelta
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type UpsertAccountStateDeltaUseCase interface {
+	Execute(ctx context.Context, delta *domain.AccountStateDelta) error
+}
+
+type upsertAccountStateDeltaUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.AccountStateDeltaRepository
+}
+
+func NewUpsertAccountStateDeltaUseCase(config *config.Configuration, logger *slog.Logger, repo domain.AccountStateDeltaRepository) UpsertAccountStateDeltaUseCase {
+	return &upsertAccountStateDeltaUseCaseImpl{config, logger, repo}
+}
+
+func (uc *upsertAccountStateDeltaUseCaseImpl) Execute(ctx context.Context, delta *domain.AccountStateDelta) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if delta == nil {
+		e["delta"] = "missing value"
+	} else {
+		if delta.ChainID == 0 {
+			e["chain_id"] = "missing value"
+		}
+		if delta.Address == nil {
+			e["address"] = "missing value"
+		}
+		if delta.BlockHash == "" {
+			e["block_hash"] = "missing value"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Upsert our structure.
+	//
+
+	return uc.repo.Upsert(ctx, delta)
+}
@@ -0,0 +1,56 @@
+package statedelta
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type UpsertTokenStateDeltaUseCase interface {
+	Execute(ctx context.Context, delta *domain.TokenStateDelta) error
+}
+
+type upsertTokenStateDeltaUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.TokenStateDeltaRepository
+}
+
+func NewUpsertTokenStateDeltaUseCase(config *config.Configuration, logger *slog.Logger, repo domain.TokenStateDeltaRepository) UpsertTokenStateDeltaUseCase {
+	return &upsertTokenStateDeltaUseCaseImpl{config, logger, repo}
+}
+
+func (uc *upsertTokenStateDeltaUseCaseImpl) Execute(ctx context.Context, delta *domain.TokenStateDelta) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if delta == nil {
+		e["delta"] = "missing value"
+	} else {
+		if delta.ChainID == 0 {
+			e["chain_id"] = "missing value"
+		}
+		if delta.BlockHash == "" {
+			e["block_hash"] = "missing value"
+		}
+		if delta.Event == "" {
+			e["event"] = "missing value"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Upsert our structure.
+	//
+
+	return uc.repo.Upsert(ctx, delta)
+}
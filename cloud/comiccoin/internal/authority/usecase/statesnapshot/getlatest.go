@@ -0,0 +1,46 @@
+package statesnapshot
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetLatestStateSnapshotUseCase interface {
+	Execute(ctx context.Context, chainID uint16) (*domain.StateSnapshot, error)
+}
+
+type getLatestStateSnapshotUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.StateSnapshotRepository
+}
+
+func NewGetLatestStateSnapshotUseCase(config *config.Configuration, logger *slog.Logger, repo domain.StateSnapshotRepository) GetLatestStateSnapshotUseCase {
+	return &getLatestStateSnapshotUseCaseImpl{config, logger, repo}
+}
+
+func (uc *getLatestStateSnapshotUseCaseImpl) Execute(ctx context.Context, chainID uint16) (*domain.StateSnapshot, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetLatestByChainID(ctx, chainID)
+}
@@ -0,0 +1,49 @@
+package statesnapshot
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type PruneStateSnapshotsUseCase interface {
+	Execute(ctx context.Context, chainID uint16, keep int64) error
+}
+
+type pruneStateSnapshotsUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.StateSnapshotRepository
+}
+
+func NewPruneStateSnapshotsUseCase(config *config.Configuration, logger *slog.Logger, repo domain.StateSnapshotRepository) PruneStateSnapshotsUseCase {
+	return &pruneStateSnapshotsUseCaseImpl{config, logger, repo}
+}
+
+func (uc *pruneStateSnapshotsUseCaseImpl) Execute(ctx context.Context, chainID uint16, keep int64) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if keep < 1 {
+		e["keep"] = "must keep at least one snapshot"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteAllExceptLatestByChainID(ctx, chainID, keep)
+}
@@ -0,0 +1,68 @@
+package statesnapshot
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type UpsertStateSnapshotUseCase interface {
+	Execute(ctx context.Context, snapshot *domain.StateSnapshot) error
+}
+
+type upsertStateSnapshotUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.StateSnapshotRepository
+}
+
+func NewUpsertStateSnapshotUseCase(config *config.Configuration, logger *slog.Logger, repo domain.StateSnapshotRepository) UpsertStateSnapshotUseCase {
+	return &upsertStateSnapshotUseCaseImpl{config, logger, repo}
+}
+
+func (uc *upsertStateSnapshotUseCaseImpl) Execute(ctx context.Context, snapshot *domain.StateSnapshot) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if snapshot == nil {
+		e["snapshot"] = "missing value"
+	} else {
+		if snapshot.ChainID == 0 {
+			e["chain_id"] = "missing value"
+		}
+		if snapshot.BlockHash == "" {
+			e["block_hash"] = "missing value"
+		}
+		if snapshot.AccountHashState == "" {
+			e["account_hash_state"] = "missing value"
+		}
+		if snapshot.TokenHashState == "" {
+			e["token_hash_state"] = "missing value"
+		}
+		if snapshot.ContentHash == "" {
+			e["content_hash"] = "missing value"
+		}
+		if len(snapshot.SignatureBytes) == 0 {
+			e["signature_bytes"] = "missing value"
+		}
+		if snapshot.Validator == nil {
+			e["validator"] = "missing value"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Upsert(ctx, snapshot)
+}
@@ -0,0 +1,27 @@
+package token
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+)
+
+type ListTokensByChainIDUseCase interface {
+	Execute(ctx context.Context, chainID uint16) ([]*domain.Token, error)
+}
+
+type listTokensByChainIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.TokenRepository
+}
+
+func NewListTokensByChainIDUseCase(config *config.Configuration, logger *slog.Logger, repo domain.TokenRepository) ListTokensByChainIDUseCase {
+	return &listTokensByChainIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listTokensByChainIDUseCaseImpl) Execute(ctx context.Context, chainID uint16) ([]*domain.Token, error) {
+	return uc.repo.ListByChainID(ctx, chainID)
+}
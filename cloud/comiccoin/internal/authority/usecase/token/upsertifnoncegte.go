@@ -18,6 +18,7 @@ type UpsertTokenIfPreviousTokenNonceGTEUseCase interface {
 		owner *common.Address,
 		metadataURI string,
 		nonce *big.Int,
+		royalty *domain.TokenRoyalty,
 	) error
 }
 
@@ -31,6 +32,9 @@ type UpsertTokenIfPreviousTokenNonceGTEUseCase interface {
 // transactions have higher nonce values) and therefore ignore the previous
 // transactions. We do this because the `token` database only shows the most
 // recent tokens and their current owners, not the history of ownership.
+//
+// The royalty is only recorded when the token is inserted, it is recorded
+// at mint time and the royalty of an existing token never changes.
 type upsertTokenIfPreviousTokenNonceGTEUseCaseImpl struct {
 	config *config.Configuration
 	logger *slog.Logger
@@ -47,6 +51,7 @@ func (uc *upsertTokenIfPreviousTokenNonceGTEUseCaseImpl) Execute(
 	owner *common.Address,
 	metadataURI string,
 	nonce *big.Int,
+	royalty *domain.TokenRoyalty,
 ) error {
 	//
 	// STEP 1:
@@ -89,6 +94,10 @@ func (uc *upsertTokenIfPreviousTokenNonceGTEUseCaseImpl) Execute(
 			MetadataURI: metadataURI,
 			NonceBytes:  nonce.Bytes(),
 		}
+		if royalty != nil {
+			token.Creator = royalty.Creator
+			token.RoyaltyBasisPoints = royalty.BasisPoints
+		}
 		return uc.repo.Upsert(ctx, token)
 	}
 
@@ -114,11 +123,13 @@ func (uc *upsertTokenIfPreviousTokenNonceGTEUseCaseImpl) Execute(
 	//
 
 	token := &domain.Token{
-		ChainID:     uc.config.Blockchain.ChainID,
-		IDBytes:     id.Bytes(),
-		Owner:       owner,
-		MetadataURI: metadataURI,
-		NonceBytes:  nonce.Bytes(),
+		ChainID:            uc.config.Blockchain.ChainID,
+		IDBytes:            id.Bytes(),
+		Owner:              owner,
+		MetadataURI:        metadataURI,
+		NonceBytes:         nonce.Bytes(),
+		Creator:            previousToken.Creator,
+		RoyaltyBasisPoints: previousToken.RoyaltyBasisPoints,
 	}
 	return uc.repo.Upsert(ctx, token)
 }
@@ -0,0 +1,46 @@
+package txreceipt
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type GetTransactionReceiptUseCase interface {
+	Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error)
+}
+
+type getTransactionReceiptUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.TransactionReceiptRepository
+}
+
+func NewGetTransactionReceiptUseCase(config *config.Configuration, logger *slog.Logger, repo domain.TransactionReceiptRepository) GetTransactionReceiptUseCase {
+	return &getTransactionReceiptUseCaseImpl{config, logger, repo}
+}
+
+func (uc *getTransactionReceiptUseCaseImpl) Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if hash == "" {
+		e["hash"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for get",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByHash(ctx, hash)
+}
@@ -0,0 +1,90 @@
+package txreceipt
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type UpsertTransactionReceiptUseCase interface {
+	Execute(ctx context.Context, receipt *domain.TransactionReceipt) error
+}
+
+type upsertTransactionReceiptUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.TransactionReceiptRepository
+}
+
+func NewUpsertTransactionReceiptUseCase(config *config.Configuration, logger *slog.Logger, repo domain.TransactionReceiptRepository) UpsertTransactionReceiptUseCase {
+	return &upsertTransactionReceiptUseCaseImpl{config, logger, repo}
+}
+
+func (uc *upsertTransactionReceiptUseCaseImpl) Execute(ctx context.Context, receipt *domain.TransactionReceipt) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if receipt == nil {
+		e["receipt"] = "missing value"
+	} else {
+		if receipt.Hash == "" {
+			e["hash"] = "missing value"
+		}
+		switch receipt.Status {
+		case domain.TransactionReceiptStatusPending, domain.TransactionReceiptStatusRejected:
+			// Do nothing.
+		case domain.TransactionReceiptStatusIncluded:
+			if receipt.BlockHash == "" {
+				e["block_hash"] = "missing value"
+			}
+			if receipt.BlockNumberBytes == nil {
+				e["block_number_bytes"] = "missing value"
+			}
+		case "":
+			e["status"] = "missing value"
+		default:
+			e["status"] = "invalid value"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for upsert",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Receipts of included transactions are final. Resubmitting the same
+	// signed transaction must never overwrite the proof that it was included.
+	//
+
+	existing, err := uc.repo.GetByHash(ctx, receipt.Hash)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if existing.Status == domain.TransactionReceiptStatusIncluded && receipt.Status != domain.TransactionReceiptStatusIncluded {
+			uc.logger.Warn("Ignored receipt update for included transaction",
+				slog.String("hash", receipt.Hash),
+				slog.String("status", receipt.Status))
+			return nil
+		}
+		receipt.CreatedAt = existing.CreatedAt
+	}
+
+	//
+	// STEP 3: Upsert into database.
+	//
+
+	receipt.ModifiedAt = time.Now()
+	if receipt.CreatedAt.IsZero() {
+		receipt.CreatedAt = receipt.ModifiedAt
+	}
+	return uc.repo.Upsert(ctx, receipt)
+}
@@ -0,0 +1,49 @@
+package validatorset
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type DeleteValidatorSetMemberUseCase interface {
+	Execute(ctx context.Context, chainID uint16, publicKeyBytes []byte) error
+}
+
+type deleteValidatorSetMemberUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.ValidatorSetRepository
+}
+
+func NewDeleteValidatorSetMemberUseCase(config *config.Configuration, logger *slog.Logger, repo domain.ValidatorSetRepository) DeleteValidatorSetMemberUseCase {
+	return &deleteValidatorSetMemberUseCaseImpl{config, logger, repo}
+}
+
+func (uc *deleteValidatorSetMemberUseCaseImpl) Execute(ctx context.Context, chainID uint16, publicKeyBytes []byte) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if len(publicKeyBytes) == 0 {
+		e["public_key_bytes"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteByPublicKey(ctx, chainID, publicKeyBytes)
+}
@@ -0,0 +1,55 @@
+package validatorset
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type ListValidatorSetUseCase interface {
+	Execute(ctx context.Context, chainID uint16) ([]*domain.Validator, error)
+}
+
+type listValidatorSetUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.ValidatorSetRepository
+}
+
+func NewListValidatorSetUseCase(config *config.Configuration, logger *slog.Logger, repo domain.ValidatorSetRepository) ListValidatorSetUseCase {
+	return &listValidatorSetUseCaseImpl{config, logger, repo}
+}
+
+func (uc *listValidatorSetUseCaseImpl) Execute(ctx context.Context, chainID uint16) ([]*domain.Validator, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	members, err := uc.repo.ListByChainID(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]*domain.Validator, 0, len(members))
+	for _, member := range members {
+		validator := member.Validator
+		validators = append(validators, &validator)
+	}
+	return validators, nil
+}
@@ -0,0 +1,54 @@
+package validatorset
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/authority/domain"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+)
+
+type UpsertValidatorSetMemberUseCase interface {
+	Execute(ctx context.Context, chainID uint16, validator *domain.Validator) error
+}
+
+type upsertValidatorSetMemberUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   domain.ValidatorSetRepository
+}
+
+func NewUpsertValidatorSetMemberUseCase(config *config.Configuration, logger *slog.Logger, repo domain.ValidatorSetRepository) UpsertValidatorSetMemberUseCase {
+	return &upsertValidatorSetMemberUseCaseImpl{config, logger, repo}
+}
+
+func (uc *upsertValidatorSetMemberUseCaseImpl) Execute(ctx context.Context, chainID uint16, validator *domain.Validator) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if validator == nil {
+		e["validator"] = "missing value"
+	} else if len(validator.PublicKeyBytes) == 0 {
+		e["public_key_bytes"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Upsert(ctx, &domain.ValidatorSetMember{
+		ChainID:   chainID,
+		Validator: *validator,
+	})
+}
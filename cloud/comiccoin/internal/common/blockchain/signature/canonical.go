@@ -0,0 +1,87 @@
+package signature
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// The signing versions decide which bytes get signed for a value.
+//
+// VersionLegacy signs the JSON of the value with the empty values removed,
+// see `stamp`. The result depends on the field names, Go's JSON number
+// handling and map ordering, therefore it is only kept so the signatures
+// which were made before the canonical encoding still verify.
+//
+// VersionCanonical and later versions sign the deterministic CBOR (RFC 8949
+// section 4.2.1) encoding of an array holding the version followed by the
+// fields of the value in a fixed order: integers are unsigned integers,
+// strings are text strings and bytes, big numbers and addresses are byte
+// strings (empty when missing). This is fully specified so clients written
+// in any language can produce the exact same bytes. Later versions may add
+// fields, the fields of a version never change once released.
+const (
+	VersionLegacy    uint8 = 0
+	VersionCanonical uint8 = 1
+)
+
+// ErrUnsupportedVersion is returned when a value uses a signing version
+// which this build does not know about.
+var ErrUnsupportedVersion = errors.New("unsupported signing version")
+
+// CanonicalEncoder is implemented by the values which support the versioned
+// canonical encoding.
+type CanonicalEncoder interface {
+	// SigningVersion returns the signing version of the value.
+	SigningVersion() uint8
+
+	// CanonicalFields returns the fields of the value in their fixed order
+	// for its signing version, the version must not be included. Numbers
+	// stored as bytes must be in their minimal big-endian form and missing
+	// addresses must be nil. Values must return `ErrUnsupportedVersion` for
+	// versions they do not know about.
+	CanonicalFields() ([]any, error)
+}
+
+var canonicalEncMode cbor.EncMode
+
+func init() {
+	var err error
+	if canonicalEncMode, err = cbor.CoreDetEncOptions().EncMode(); err != nil {
+		panic(err)
+	}
+}
+
+// CanonicalBytes returns the canonical encoding of the value and its signing
+// version, or `VersionLegacy` and no bytes if the value uses the legacy
+// signing version.
+func CanonicalBytes(value any) ([]byte, uint8, error) {
+	encoder, ok := value.(CanonicalEncoder)
+	if !ok {
+		return nil, VersionLegacy, nil
+	}
+	version := encoder.SigningVersion()
+	if version == VersionLegacy {
+		return nil, VersionLegacy, nil
+	}
+	fields, err := encoder.CanonicalFields()
+	if err != nil {
+		return nil, VersionLegacy, err
+	}
+	fields = append([]any{version}, fields...)
+	for i, field := range fields {
+		// DEVELOPERS NOTE:
+		// A nil byte slice would encode as `null` and an empty one as an
+		// empty byte string, we always use the latter so the encoding does
+		// not depend on how the value was loaded.
+		if b, ok := field.([]byte); ok && b == nil {
+			fields[i] = []byte{}
+		}
+	}
+	data, err := canonicalEncMode.Marshal(fields)
+	if err != nil {
+		return nil, VersionLegacy, fmt.Errorf("failed canonical encoding: %v", err)
+	}
+	return data, version, nil
+}
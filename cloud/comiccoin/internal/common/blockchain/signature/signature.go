@@ -288,6 +288,16 @@ func HashWithComicCoinStamp(value any) ([]byte, error) {
 func stamp(value any) ([]byte, error) {
 	log.Printf("stamp: Starting stamping process for value type: %T", value)
 
+	canonical, version, err := CanonicalBytes(value)
+	if err != nil {
+		log.Printf("stamp: Error in canonical encoding: %v", err)
+		return nil, err
+	}
+	if version != VersionLegacy {
+		stamp := []byte(fmt.Sprintf("\x19ComicCoin Signed Message v%d:\n%d", version, len(canonical)))
+		return crypto.Keccak256(stamp, canonical), nil
+	}
+
 	v, err := json.Marshal(value)
 	if err != nil {
 		log.Printf("stamp: Error in initial JSON marshal: %v", err)
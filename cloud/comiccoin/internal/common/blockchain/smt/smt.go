@@ -0,0 +1,323 @@
+// Package smt implements a sparse merkle tree with 256-bit keys. Every
+// possible key has a leaf, leaves which were never set are empty, so the
+// tree only needs to store the nodes which are not empty. This lets us
+// update a single leaf in O(256) instead of re-hashing every leaf and
+// prove the value (or absence) of a single key against the root.
+package smt
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	// Depth is the number of levels between the root and the leaves.
+	Depth = 256
+
+	// KeySize is the size of the keys in bytes.
+	KeySize = Depth / 8
+)
+
+// ErrInvalidKey is returned when a key is not `KeySize` bytes long.
+var ErrInvalidKey = errors.New("smt: invalid key size")
+
+var (
+	leafPrefix = []byte{0x00}
+	nodePrefix = []byte{0x01}
+
+	// emptyHashes[h] is the hash of an empty subtree of height `h`, where
+	// height zero is a leaf and height `Depth` is the root.
+	emptyHashes [Depth + 1][]byte
+)
+
+func init() {
+	emptyHashes[0] = make([]byte, sha256.Size)
+	for h := 1; h <= Depth; h++ {
+		emptyHashes[h] = hashNode(emptyHashes[h-1], emptyHashes[h-1])
+	}
+}
+
+// Key returns the key of the leaf for the particular data, for example an
+// account address or a token ID.
+func Key(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// LeafHash returns the hash of the leaf which stores the value for the key.
+func LeafHash(key, value []byte) []byte {
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write(key)
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+// EmptyRoot returns the root of a tree without any leaves.
+func EmptyRoot() []byte {
+	return append([]byte(nil), emptyHashes[Depth]...)
+}
+
+func hashNode(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(nodePrefix)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// bit returns the bit of the key at the depth, the most significant bit
+// decides the first step from the root.
+func bit(key []byte, depth int) byte {
+	return (key[depth/8] >> (7 - uint(depth%8))) & 1
+}
+
+// NodeID returns the identifier of the node at the depth on the path of the
+// key, this is the depth followed by the first `depth` bits of the key.
+func NodeID(depth int, key []byte) string {
+	prefix := make([]byte, KeySize)
+	copy(prefix, key)
+	for i := depth; i < Depth; i++ {
+		prefix[i/8] &^= 1 << (7 - uint(i%8))
+	}
+	return fmt.Sprintf("%d:%x", depth, prefix)
+}
+
+// siblingID returns the identifier of the sibling of the node at the depth
+// on the path of the key.
+func siblingID(depth int, key []byte) string {
+	sibling := make([]byte, KeySize)
+	copy(sibling, key)
+	sibling[(depth-1)/8] ^= 1 << (7 - uint((depth-1)%8))
+	return NodeID(depth, sibling)
+}
+
+// RootID is the identifier of the root node.
+var RootID = NodeID(0, make([]byte, KeySize))
+
+// Store persists the nodes of the tree which are not empty.
+type Store interface {
+	// GetNodes returns the hashes of the nodes which exist, nodes which do
+	// not exist are empty and must be left out of the result.
+	GetNodes(ctx context.Context, ids []string) (map[string][]byte, error)
+
+	// PutNodes saves the hashes of the nodes, a nil hash means the node is
+	// now empty and can be deleted.
+	PutNodes(ctx context.Context, nodes map[string][]byte) error
+}
+
+// Tree is a sparse merkle tree whose nodes are persisted in a store.
+type Tree struct {
+	store Store
+}
+
+// New returns the tree stored in the store.
+func New(store Store) *Tree {
+	return &Tree{store: store}
+}
+
+// Root returns the root of the tree or nil if the tree was never saved.
+func (t *Tree) Root(ctx context.Context) ([]byte, error) {
+	nodes, err := t.store.GetNodes(ctx, []string{RootID})
+	if err != nil {
+		return nil, err
+	}
+	return nodes[RootID], nil
+}
+
+// Update sets the leaf hash of the key and re-hashes the path to the root,
+// a nil leaf hash removes the leaf. Returns the new root.
+func (t *Tree) Update(ctx context.Context, key, leafHash []byte) ([]byte, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	siblings, err := t.siblings(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string][]byte, Depth+1)
+	current := leafHash
+	if current == nil {
+		current = emptyHashes[0]
+	}
+	for depth := Depth; depth > 0; depth-- {
+		height := Depth - depth
+		if bytes.Equal(current, emptyHashes[height]) {
+			nodes[NodeID(depth, key)] = nil
+		} else {
+			nodes[NodeID(depth, key)] = current
+		}
+		if bit(key, depth-1) == 0 {
+			current = hashNode(current, siblings[depth-1])
+		} else {
+			current = hashNode(siblings[depth-1], current)
+		}
+	}
+
+	// DEVELOPERS NOTE:
+	// The root is always saved, even if the tree is empty, so callers can
+	// tell an empty tree apart from a tree which was never built.
+	nodes[RootID] = current
+
+	if err := t.store.PutNodes(ctx, nodes); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// Prove returns the proof for the value, or absence, of the key.
+func (t *Tree) Prove(ctx context.Context, key []byte) (*Proof, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	siblings, err := t.siblings(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only include the siblings which are not empty, the bitmap records
+	// which ones were included.
+	proof := &Proof{
+		Key:    key,
+		Bitmap: make([]byte, KeySize),
+	}
+	for depth := Depth; depth > 0; depth-- {
+		sibling := siblings[depth-1]
+		if bytes.Equal(sibling, emptyHashes[Depth-depth]) {
+			continue
+		}
+		proof.Bitmap[(depth-1)/8] |= 1 << (7 - uint((depth-1)%8))
+		proof.Siblings = append(proof.Siblings, sibling)
+	}
+	return proof, nil
+}
+
+// siblings returns the hashes of the siblings on the path of the key, the
+// sibling at index `i` is the sibling of the node at depth `i+1`.
+func (t *Tree) siblings(ctx context.Context, key []byte) ([][]byte, error) {
+	ids := make([]string, Depth)
+	for depth := Depth; depth > 0; depth-- {
+		ids[depth-1] = siblingID(depth, key)
+	}
+	nodes, err := t.store.GetNodes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings := make([][]byte, Depth)
+	for depth := Depth; depth > 0; depth-- {
+		if hash, ok := nodes[ids[depth-1]]; ok {
+			siblings[depth-1] = hash
+		} else {
+			siblings[depth-1] = emptyHashes[Depth-depth]
+		}
+	}
+	return siblings, nil
+}
+
+// Leaf is a key and the hash of its leaf.
+type Leaf struct {
+	Key      []byte
+	LeafHash []byte
+}
+
+// Build computes the tree of the leaves from scratch and returns the root
+// and the nodes which are not empty, ready to be saved in a store.
+func Build(leaves []Leaf) ([]byte, map[string][]byte, error) {
+	sorted := make([]Leaf, len(leaves))
+	copy(sorted, leaves)
+	for _, leaf := range sorted {
+		if len(leaf.Key) != KeySize {
+			return nil, nil, ErrInvalidKey
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Key, sorted[j].Key) < 0
+	})
+
+	nodes := make(map[string][]byte)
+	root := build(sorted, 0, nodes)
+	nodes[RootID] = root
+	return root, nodes, nil
+}
+
+// Root computes the root of the leaves from scratch.
+func Root(leaves []Leaf) ([]byte, error) {
+	root, _, err := Build(leaves)
+	return root, err
+}
+
+// build returns the hash of the subtree at the depth which contains the
+// sorted leaves and saves the nodes which are not empty.
+func build(leaves []Leaf, depth int, nodes map[string][]byte) []byte {
+	if len(leaves) == 0 {
+		return emptyHashes[Depth-depth]
+	}
+	if depth == Depth {
+		// If a key was set more than once then the last leaf wins.
+		hash := leaves[len(leaves)-1].LeafHash
+		if hash == nil {
+			return emptyHashes[0]
+		}
+		nodes[NodeID(depth, leaves[0].Key)] = hash
+		return hash
+	}
+
+	split := sort.Search(len(leaves), func(i int) bool {
+		return bit(leaves[i].Key, depth) == 1
+	})
+	hash := hashNode(build(leaves[:split], depth+1, nodes), build(leaves[split:], depth+1, nodes))
+	if !bytes.Equal(hash, emptyHashes[Depth-depth]) {
+		nodes[NodeID(depth, leaves[0].Key)] = hash
+	}
+	return hash
+}
+
+// Proof proves the leaf hash of a key against the root of the tree.
+type Proof struct {
+	// Key is the key of the leaf being proven.
+	Key []byte `json:"key"`
+
+	// Bitmap has a bit set for every depth whose sibling is not empty,
+	// bit `i` (most significant first) is for the sibling at depth `i+1`.
+	Bitmap []byte `json:"bitmap"`
+
+	// Siblings are the hashes of the non-empty siblings ordered from the
+	// leaf up to the root.
+	Siblings [][]byte `json:"siblings"`
+}
+
+// Verify checks the leaf hash of the key hashes up to the root, a nil leaf
+// hash proves the key does not exist in the tree.
+func (p *Proof) Verify(root, leafHash []byte) bool {
+	if p == nil || len(p.Key) != KeySize || len(p.Bitmap) != KeySize {
+		return false
+	}
+
+	current := leafHash
+	if current == nil {
+		current = emptyHashes[0]
+	}
+	next := 0
+	for depth := Depth; depth > 0; depth-- {
+		sibling := emptyHashes[Depth-depth]
+		if bit(p.Bitmap, depth-1) == 1 {
+			if next >= len(p.Siblings) {
+				return false
+			}
+			sibling = p.Siblings[next]
+			next++
+		}
+		if bit(p.Key, depth-1) == 0 {
+			current = hashNode(current, sibling)
+		} else {
+			current = hashNode(sibling, current)
+		}
+	}
+	return next == len(p.Siblings) && bytes.Equal(current, root)
+}
@@ -0,0 +1,123 @@
+package smt_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/smt"
+)
+
+// memoryStore keeps the nodes of the tree in memory.
+type memoryStore struct {
+	nodes map[string][]byte
+}
+
+func (s *memoryStore) GetNodes(ctx context.Context, ids []string) (map[string][]byte, error) {
+	res := make(map[string][]byte)
+	for _, id := range ids {
+		if hash, ok := s.nodes[id]; ok {
+			res[id] = hash
+		}
+	}
+	return res, nil
+}
+
+func (s *memoryStore) PutNodes(ctx context.Context, nodes map[string][]byte) error {
+	for id, hash := range nodes {
+		if hash == nil {
+			delete(s.nodes, id)
+		} else {
+			s.nodes[id] = hash
+		}
+	}
+	return nil
+}
+
+func testLeaves(n int) []smt.Leaf {
+	leaves := make([]smt.Leaf, 0, n)
+	for i := 0; i < n; i++ {
+		key := smt.Key([]byte(fmt.Sprintf("account-%d", i)))
+		leaves = append(leaves, smt.Leaf{Key: key, LeafHash: smt.LeafHash(key, []byte(fmt.Sprintf("balance-%d", i)))})
+	}
+	return leaves
+}
+
+func TestUpdateMatchesBuild(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryStore{nodes: make(map[string][]byte)}
+	tree := smt.New(store)
+	leaves := testLeaves(20)
+
+	var root []byte
+	for _, leaf := range leaves {
+		var err error
+		if root, err = tree.Update(ctx, leaf.Key, leaf.LeafHash); err != nil {
+			t.Fatalf("failed updating tree: %v", err)
+		}
+	}
+
+	built, nodes, err := smt.Build(leaves)
+	if err != nil {
+		t.Fatalf("failed building tree: %v", err)
+	}
+	if !bytes.Equal(root, built) {
+		t.Fatalf("incremental root %x does not match built root %x", root, built)
+	}
+	if len(nodes) != len(store.nodes) {
+		t.Fatalf("built %d nodes but store has %d", len(nodes), len(store.nodes))
+	}
+
+	// Removing every leaf must result in the empty tree.
+	for _, leaf := range leaves {
+		if root, err = tree.Update(ctx, leaf.Key, nil); err != nil {
+			t.Fatalf("failed removing leaf: %v", err)
+		}
+	}
+	if !bytes.Equal(root, smt.EmptyRoot()) {
+		t.Fatalf("expected empty root but got %x", root)
+	}
+	if len(store.nodes) != 1 {
+		t.Fatalf("expected only the root to remain but got %d nodes", len(store.nodes))
+	}
+}
+
+func TestProof(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryStore{nodes: make(map[string][]byte)}
+	tree := smt.New(store)
+	leaves := testLeaves(5)
+
+	var root []byte
+	for _, leaf := range leaves {
+		var err error
+		if root, err = tree.Update(ctx, leaf.Key, leaf.LeafHash); err != nil {
+			t.Fatalf("failed updating tree: %v", err)
+		}
+	}
+
+	proof, err := tree.Prove(ctx, leaves[2].Key)
+	if err != nil {
+		t.Fatalf("failed proving leaf: %v", err)
+	}
+	if !proof.Verify(root, leaves[2].LeafHash) {
+		t.Fatal("expected proof to verify")
+	}
+	if proof.Verify(root, leaves[3].LeafHash) {
+		t.Fatal("expected proof with the wrong leaf to fail")
+	}
+	if proof.Verify(root, nil) {
+		t.Fatal("expected proof of absence of an existing key to fail")
+	}
+
+	// Keys which were never set can be proven to be absent.
+	missing := smt.Key([]byte("missing"))
+	proof, err = tree.Prove(ctx, missing)
+	if err != nil {
+		t.Fatalf("failed proving missing leaf: %v", err)
+	}
+	if !proof.Verify(root, nil) {
+		t.Fatal("expected proof of absence to verify")
+	}
+}
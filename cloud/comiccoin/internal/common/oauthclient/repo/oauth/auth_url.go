@@ -7,12 +7,13 @@ import (
 )
 
 func (impl *oauthClientImpl) GetAuthorizationURL(state string) string {
-	authURL := fmt.Sprintf("%s/gateway/ui/v1/authorize-or-login", impl.Config.OAuth.ServerURL)
+	authURL := fmt.Sprintf("%s/iam/api/v1/oauth/authorize", impl.Config.OAuth.ServerURL)
 
 	params := url.Values{}
 	params.Add("response_type", "code")
 	params.Add("client_id", impl.Config.OAuth.ClientID)
 	params.Add("redirect_uri", impl.Config.OAuth.ClientRedirectURI)
+	params.Add("scope", "profile email")
 	params.Add("cancel_url", impl.Config.OAuth.ClientAuthorizeOrLoginCancelURI)
 	params.Add("success_uri", impl.Config.OAuth.ClientAuthorizeOrLoginSuccessURI)
 	params.Add("state", state)
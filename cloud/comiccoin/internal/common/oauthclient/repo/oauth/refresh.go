@@ -16,7 +16,7 @@ import (
 )
 
 func (impl *oauthClientImpl) RefreshToken(ctx context.Context, refreshToken string) (*dom_oauth.TokenResponse, error) {
-	tokenURL := fmt.Sprintf("%s/gateway/api/v1/oauth/refresh", impl.Config.OAuth.ServerURL) // Notice we're using /oauth/refresh now
+	tokenURL := fmt.Sprintf("%s/iam/api/v1/oauth/token", impl.Config.OAuth.ServerURL) // Refresh uses the token endpoint with the `refresh_token` grant.
 
 	impl.Logger.Debug("preparing token refresh request",
 		slog.String("url", tokenURL),
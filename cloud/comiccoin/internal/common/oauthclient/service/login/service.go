@@ -80,8 +80,19 @@ func (s *loginServiceImpl) ProcessLogin(ctx context.Context, req *LoginRequest)
 		return nil, err
 	}
 
+	// DEVELOPERS NOTE:
+	// When the account has 2FA enabled we must not exchange tokens on behalf
+	// of the user, instead we fall back to the manual flow so the user
+	// finishes logging in, including submitting their code, with the
+	// identity provider.
+	requiresOTP := federatedidentity.OTPEnabled && !federatedidentity.OTPValidated
+	if requiresOTP && req.AuthFlow == "auto" {
+		s.logger.Debug("automatic auth flow not allowed with 2FA, using manual flow",
+			slog.String("email", req.Email))
+	}
+
 	// For automatic auth flow
-	if req.AuthFlow == "auto" {
+	if req.AuthFlow == "auto" && !requiresOTP {
 		// Get authorization URL with state parameter
 		authState := "login:" + federatedidentity.ID.Hex() // Simple state format, could be more complex
 		authURL, err := s.getAuthURLUseCase.Execute(ctx, authState)
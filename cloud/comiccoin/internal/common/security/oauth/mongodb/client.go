@@ -0,0 +1,129 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/mongodb/client.go
+package mongodb
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+)
+
+// MongoClientService implements ClientService using mongodb as the client
+// registry. Client secrets are saved hashed.
+type MongoClientService struct {
+	logger     *slog.Logger
+	collection *mongo.Collection
+}
+
+// NewMongoClientService creates a new instance of MongoClientService
+func NewMongoClientService(logger *slog.Logger, dbClient *mongo.Client, databaseName string) *MongoClientService {
+	c := dbClient.Database(databaseName).Collection("oauth_clients")
+	_, err := c.Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "client_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+	return &MongoClientService{
+		logger:     logger,
+		collection: c,
+	}
+}
+
+// ValidateClient implements ClientService
+func (s *MongoClientService) ValidateClient(clientID, redirectURI string) (bool, error) {
+	client, err := s.GetClient(clientID)
+	if err != nil {
+		if err == oauth.ErrInvalidClient {
+			return false, nil
+		}
+		return false, err
+	}
+	return client.RedirectURI == redirectURI, nil
+}
+
+// ValidateClientCredentials implements ClientService. Public clients have no
+// secret and therefore never pass this check.
+func (s *MongoClientService) ValidateClientCredentials(clientID, clientSecret string) (bool, error) {
+	client, err := s.GetClient(clientID)
+	if err != nil {
+		if err == oauth.ErrInvalidClient {
+			return false, nil
+		}
+		return false, err
+	}
+	if client.Public || client.Secret == "" {
+		return false, nil
+	}
+	hash := oauth.HashSecret(clientSecret)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(client.Secret)) == 1, nil
+}
+
+// GetClient implements ClientService. The returned `Secret` is the hash.
+func (s *MongoClientService) GetClient(clientID string) (*oauth.Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	var client oauth.Client
+	if err := s.collection.FindOne(ctx, bson.M{"client_id": clientID}).Decode(&client); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, oauth.ErrInvalidClient
+		}
+		s.logger.Error("database failed get client error", slog.Any("error", err))
+		return nil, err
+	}
+	return &client, nil
+}
+
+// CreateClient saves the client, the `Secret` field is expected in plain
+// text and will be hashed before it is saved.
+func (s *MongoClientService) CreateClient(ctx context.Context, client *oauth.Client) error {
+	doc := *client
+	if doc.Secret != "" {
+		doc.Secret = oauth.HashSecret(doc.Secret)
+	}
+	if _, err := s.collection.InsertOne(ctx, doc); err != nil {
+		s.logger.Error("database failed create client error", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// ListClients returns all the registered clients.
+func (s *MongoClientService) ListClients(ctx context.Context) ([]*oauth.Client, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		s.logger.Error("database failed list clients error", slog.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	clients := make([]*oauth.Client, 0)
+	if err := cursor.All(ctx, &clients); err != nil {
+		s.logger.Error("database failed decode clients error", slog.Any("error", err))
+		return nil, err
+	}
+	return clients, nil
+}
+
+// DeleteClient removes the client from the registry.
+func (s *MongoClientService) DeleteClient(ctx context.Context, clientID string) error {
+	res, err := s.collection.DeleteOne(ctx, bson.M{"client_id": clientID})
+	if err != nil {
+		s.logger.Error("database failed delete client error", slog.Any("error", err))
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return oauth.ErrInvalidClient
+	}
+	return nil
+}
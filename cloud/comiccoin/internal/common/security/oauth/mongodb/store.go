@@ -0,0 +1,163 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/mongodb/store.go
+package mongodb
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+)
+
+// queryTimeout is how long we wait for mongodb since the `oauth` interfaces
+// do not pass a context.
+const queryTimeout = 10 * time.Second
+
+// pendingAuthDocument wraps the pending authorization with its lookup key.
+type pendingAuthDocument struct {
+	AuthID                     string `bson:"_id"`
+	oauth.PendingAuthorization `bson:",inline"`
+}
+
+// authorizationCodeDocument wraps the authorization code with the hash of
+// the code as the lookup key, the code itself is never saved.
+type authorizationCodeDocument struct {
+	CodeHash                string `bson:"_id"`
+	oauth.AuthorizationCode `bson:",inline"`
+}
+
+// MongoStore implements AuthorizationStore using mongodb
+type MongoStore struct {
+	logger                      *slog.Logger
+	pendingAuthCollection       *mongo.Collection
+	authorizationCodeCollection *mongo.Collection
+}
+
+// NewMongoStore creates a new instance of MongoStore
+func NewMongoStore(logger *slog.Logger, dbClient *mongo.Client, databaseName string) *MongoStore {
+	db := dbClient.Database(databaseName)
+	s := &MongoStore{
+		logger:                      logger,
+		pendingAuthCollection:       db.Collection("oauth_pending_authorizations"),
+		authorizationCodeCollection: db.Collection("oauth_authorization_codes"),
+	}
+
+	// Expired records are automatically deleted by mongodb, we still check
+	// the expiry on read as the TTL monitor only runs every minute.
+	for _, c := range []*mongo.Collection{s.pendingAuthCollection, s.authorizationCodeCollection} {
+		if _, err := c.Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		}); err != nil {
+			// It is important that we crash the app on startup to meet the
+			// requirements of `google/wire` framework.
+			log.Fatal(err)
+		}
+	}
+	return s
+}
+
+// StorePendingAuth implements AuthorizationStore
+func (s *MongoStore) StorePendingAuth(authID string, auth oauth.PendingAuthorization) error {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	doc := pendingAuthDocument{AuthID: authID, PendingAuthorization: auth}
+	if _, err := s.pendingAuthCollection.InsertOne(ctx, doc); err != nil {
+		s.logger.Error("database failed create pending authorization error", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// GetPendingAuth implements AuthorizationStore
+func (s *MongoStore) GetPendingAuth(authID string) (oauth.PendingAuthorization, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	var doc pendingAuthDocument
+	if err := s.pendingAuthCollection.FindOne(ctx, bson.M{"_id": authID}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return oauth.PendingAuthorization{}, oauth.ErrAuthorizationNotFound
+		}
+		s.logger.Error("database failed get pending authorization error", slog.Any("error", err))
+		return oauth.PendingAuthorization{}, err
+	}
+	if time.Now().After(doc.ExpiresAt) {
+		return oauth.PendingAuthorization{}, oauth.ErrAuthorizationNotFound
+	}
+	return doc.PendingAuthorization, nil
+}
+
+// DeletePendingAuth implements AuthorizationStore
+func (s *MongoStore) DeletePendingAuth(authID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	res, err := s.pendingAuthCollection.DeleteOne(ctx, bson.M{"_id": authID})
+	if err != nil {
+		s.logger.Error("database failed delete pending authorization error", slog.Any("error", err))
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return oauth.ErrAuthorizationNotFound
+	}
+	return nil
+}
+
+// StoreAuthorizationCode implements AuthorizationStore
+func (s *MongoStore) StoreAuthorizationCode(code string, auth oauth.AuthorizationCode) error {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	auth.Code = ""
+	doc := authorizationCodeDocument{CodeHash: oauth.HashSecret(code), AuthorizationCode: auth}
+	if _, err := s.authorizationCodeCollection.InsertOne(ctx, doc); err != nil {
+		s.logger.Error("database failed create authorization code error", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// GetAuthorizationCode implements AuthorizationStore
+func (s *MongoStore) GetAuthorizationCode(code string) (oauth.AuthorizationCode, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	var doc authorizationCodeDocument
+	if err := s.authorizationCodeCollection.FindOne(ctx, bson.M{"_id": oauth.HashSecret(code)}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return oauth.AuthorizationCode{}, oauth.ErrAuthorizationNotFound
+		}
+		s.logger.Error("database failed get authorization code error", slog.Any("error", err))
+		return oauth.AuthorizationCode{}, err
+	}
+	if time.Now().After(doc.ExpiresAt) {
+		return oauth.AuthorizationCode{}, oauth.ErrAuthorizationNotFound
+	}
+	doc.AuthorizationCode.Code = code
+	return doc.AuthorizationCode, nil
+}
+
+// DeleteAuthorizationCode implements AuthorizationStore. Only one caller can
+// successfully delete a code, so callers must delete the code before they
+// exchange it to guarantee the code is only used once.
+func (s *MongoStore) DeleteAuthorizationCode(code string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	res, err := s.authorizationCodeCollection.DeleteOne(ctx, bson.M{"_id": oauth.HashSecret(code)})
+	if err != nil {
+		s.logger.Error("database failed delete authorization code error", slog.Any("error", err))
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return oauth.ErrAuthorizationNotFound
+	}
+	return nil
+}
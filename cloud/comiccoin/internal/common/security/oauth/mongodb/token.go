@@ -0,0 +1,113 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/mongodb/token.go
+package mongodb
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+)
+
+// tokenDocument wraps the token with the hash of the token as the lookup
+// key, the token itself is never saved.
+type tokenDocument struct {
+	TokenHash   string `bson:"_id"`
+	oauth.Token `bson:",inline"`
+}
+
+// MongoTokenStore implements TokenStore using mongodb
+type MongoTokenStore struct {
+	logger     *slog.Logger
+	collection *mongo.Collection
+}
+
+// NewMongoTokenStore creates a new instance of MongoTokenStore
+func NewMongoTokenStore(logger *slog.Logger, dbClient *mongo.Client, databaseName string) *MongoTokenStore {
+	c := dbClient.Database(databaseName).Collection("oauth_tokens")
+	_, err := c.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "federatedidentity_id", Value: 1}}},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+	return &MongoTokenStore{
+		logger:     logger,
+		collection: c,
+	}
+}
+
+// StoreToken implements TokenStore
+func (s *MongoTokenStore) StoreToken(token *oauth.Token) error {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	doc := tokenDocument{TokenHash: oauth.HashSecret(token.TokenID), Token: *token}
+	doc.Token.TokenID = ""
+	if _, err := s.collection.InsertOne(ctx, doc); err != nil {
+		s.logger.Error("database failed create token error", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// GetToken implements TokenStore. Revoked and expired tokens are returned
+// as-is so the caller can decide how to treat them.
+func (s *MongoTokenStore) GetToken(tokenID string) (*oauth.Token, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	var doc tokenDocument
+	if err := s.collection.FindOne(ctx, bson.M{"_id": oauth.HashSecret(tokenID)}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, oauth.ErrTokenNotFound
+		}
+		s.logger.Error("database failed get token error", slog.Any("error", err))
+		return nil, err
+	}
+	doc.Token.TokenID = tokenID
+	return &doc.Token, nil
+}
+
+// RevokeToken implements TokenStore
+func (s *MongoTokenStore) RevokeToken(tokenID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	res, err := s.collection.UpdateOne(ctx,
+		bson.M{"_id": oauth.HashSecret(tokenID)},
+		bson.M{"$set": bson.M{"is_revoked": true}})
+	if err != nil {
+		s.logger.Error("database failed revoke token error", slog.Any("error", err))
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return oauth.ErrTokenNotFound
+	}
+	return nil
+}
+
+// RevokeAllFederatedIdentityTokens implements TokenStore
+func (s *MongoTokenStore) RevokeAllFederatedIdentityTokens(federatedidentityID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	_, err := s.collection.UpdateMany(ctx,
+		bson.M{"federatedidentity_id": federatedidentityID},
+		bson.M{"$set": bson.M{"is_revoked": true}})
+	if err != nil {
+		s.logger.Error("database failed revoke tokens error", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/pkce.go
+package oauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+)
+
+// CodeChallengeMethodS256 is the only PKCE method we support, the "plain"
+// method offers no protection if the authorization request leaks.
+const CodeChallengeMethodS256 = "S256"
+
+// codeVerifierPattern is the allowed format of a code verifier, see
+// RFC 7636 Section 4.1.
+var codeVerifierPattern = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
+// NewCodeChallenge returns the S256 code challenge of the code verifier.
+func NewCodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// IsValidCodeChallenge returns true if the challenge can be the output of
+// `NewCodeChallenge`, which is 43 characters of base64url without padding.
+func IsValidCodeChallenge(codeChallenge string) bool {
+	if len(codeChallenge) != 43 {
+		return false
+	}
+	_, err := base64.RawURLEncoding.DecodeString(codeChallenge)
+	return err == nil
+}
+
+// VerifyCodeChallenge returns true if the code verifier sent to the token
+// endpoint matches the code challenge sent to the authorize endpoint.
+func VerifyCodeChallenge(codeVerifier, codeChallenge, method string) bool {
+	if method != CodeChallengeMethodS256 || !codeVerifierPattern.MatchString(codeVerifier) {
+		return false
+	}
+	expected := NewCodeChallenge(codeVerifier)
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(codeChallenge)) == 1
+}
+
+// HashSecret returns the value we store for client secrets and tokens so a
+// leaked database does not leak working credentials. The values are long
+// random strings so a fast hash is enough.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,75 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/pkce_test.go
+package oauth
+
+import (
+	"strings"
+	"testing"
+)
+
+// Example from RFC 7636 Appendix B.
+const (
+	rfcCodeVerifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	rfcCodeChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+)
+
+func TestNewCodeChallenge(t *testing.T) {
+	if got := NewCodeChallenge(rfcCodeVerifier); got != rfcCodeChallenge {
+		t.Errorf("NewCodeChallenge() = %q, want %q", got, rfcCodeChallenge)
+	}
+}
+
+func TestIsValidCodeChallenge(t *testing.T) {
+	tests := []struct {
+		name      string
+		challenge string
+		want      bool
+	}{
+		{"rfc example", rfcCodeChallenge, true},
+		{"empty", "", false},
+		{"too short", rfcCodeChallenge[:42], false},
+		{"padded", rfcCodeChallenge[:42] + "=", false},
+		{"not base64url", strings.Repeat("+", 43), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidCodeChallenge(tt.challenge); got != tt.want {
+				t.Errorf("IsValidCodeChallenge(%q) = %v, want %v", tt.challenge, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	tests := []struct {
+		name      string
+		verifier  string
+		challenge string
+		method    string
+		want      bool
+	}{
+		{"rfc example", rfcCodeVerifier, rfcCodeChallenge, CodeChallengeMethodS256, true},
+		{"wrong verifier", strings.Repeat("a", 43), rfcCodeChallenge, CodeChallengeMethodS256, false},
+		{"plain method", rfcCodeVerifier, rfcCodeVerifier, "plain", false},
+		{"empty method", rfcCodeVerifier, rfcCodeChallenge, "", false},
+		{"verifier too short", rfcCodeVerifier[:42], NewCodeChallenge(rfcCodeVerifier[:42]), CodeChallengeMethodS256, false},
+		{"verifier bad characters", rfcCodeVerifier[:42] + "!", NewCodeChallenge(rfcCodeVerifier[:42] + "!"), CodeChallengeMethodS256, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyCodeChallenge(tt.verifier, tt.challenge, tt.method); got != tt.want {
+				t.Errorf("VerifyCodeChallenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashSecret(t *testing.T) {
+	// SHA-256 of "abc" from FIPS 180-2.
+	want := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if got := HashSecret("abc"); got != want {
+		t.Errorf("HashSecret() = %q, want %q", got, want)
+	}
+	if HashSecret("abc") == HashSecret("abd") {
+		t.Error("HashSecret() returned the same hash for different secrets")
+	}
+}
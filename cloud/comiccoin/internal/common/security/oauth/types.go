@@ -11,34 +11,42 @@ var (
 	ErrAuthorizationNotFound = errors.New("authorization not found")
 	ErrInvalidClient         = errors.New("invalid client")
 	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrTokenNotFound         = errors.New("token not found")
 )
 
 // PendingAuthorization represents a pending OAuth authorization request
 // that is waiting for federatedidentity authentication.
 type PendingAuthorization struct {
-	ClientID    string    // The ID of the client application requesting authorization
-	RedirectURI string    // Where to send the federatedidentity after authorization
-	State       string    // CSRF protection token
-	Scope       string    // Requested permissions
-	ExpiresAt   time.Time // When this authorization request expires
+	ClientID            string    `bson:"client_id"`             // The ID of the client application requesting authorization
+	RedirectURI         string    `bson:"redirect_uri"`          // Where to send the federatedidentity after authorization
+	State               string    `bson:"state"`                 // CSRF protection token
+	Scope               string    `bson:"scope"`                 // Requested permissions
+	CodeChallenge       string    `bson:"code_challenge"`        // PKCE challenge, see RFC 7636
+	CodeChallengeMethod string    `bson:"code_challenge_method"` // PKCE challenge method, only "S256" is supported
+	ExpiresAt           time.Time `bson:"expires_at"`            // When this authorization request expires
 }
 
 // AuthorizationCode represents a code issued after successful federatedidentity authentication
 // that can be exchanged for an access token.
 type AuthorizationCode struct {
-	Code                string    // The authorization code itself
-	ClientID            string    // The client this code was issued to
-	RedirectURI         string    // The redirect URI used in the initial request
-	FederatedIdentityID string    // The ID of the federatedidentity who authorized the request
-	Scope               string    // The authorized scope
-	ExpiresAt           time.Time // When this code expires
+	Code                string    `bson:"code"`                  // The authorization code itself
+	ClientID            string    `bson:"client_id"`             // The client this code was issued to
+	RedirectURI         string    `bson:"redirect_uri"`          // The redirect URI used in the initial request
+	FederatedIdentityID string    `bson:"federatedidentity_id"`  // The ID of the federatedidentity who authorized the request
+	Scope               string    `bson:"scope"`                 // The authorized scope
+	CodeChallenge       string    `bson:"code_challenge"`        // PKCE challenge copied from the pending authorization
+	CodeChallengeMethod string    `bson:"code_challenge_method"` // PKCE challenge method copied from the pending authorization
+	ExpiresAt           time.Time `bson:"expires_at"`            // When this code expires
 }
 
 // Client represents an OAuth client application registered with our service.
 type Client struct {
-	ID          string // The client's unique identifier
-	Secret      string // The client's secret (should be hashed in production)
-	RedirectURI string // The allowed redirect URI for this client
+	ID          string    `bson:"client_id" json:"client_id"`       // The client's unique identifier
+	Secret      string    `bson:"secret" json:"-"`                  // The client's secret (should be hashed in production)
+	Name        string    `bson:"name" json:"name"`                 // The name shown to the federatedidentity when asked for consent
+	RedirectURI string    `bson:"redirect_uri" json:"redirect_uri"` // The allowed redirect URI for this client
+	Public      bool      `bson:"public" json:"public"`             // Public clients have no secret and must use PKCE
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
 }
 
 // TokenResponse represents the response sent to clients when they exchange
@@ -53,13 +61,14 @@ type TokenResponse struct {
 
 // Token represents a stored access or refresh token
 type Token struct {
-	TokenID             string    // The token itself
-	TokenType           string    // "access" or "refresh"
-	FederatedIdentityID string    // The federatedidentity this token belongs to
-	ClientID            string    // The client this token was issued to
-	Scope               string    // The token's authorized scope
-	ExpiresAt           time.Time // When this token expires
-	IsRevoked           bool      // Whether this token has been revoked
+	TokenID             string    `bson:"token_id"`             // The token itself
+	TokenType           string    `bson:"token_type"`           // "access" or "refresh"
+	FederatedIdentityID string    `bson:"federatedidentity_id"` // The federatedidentity this token belongs to
+	ClientID            string    `bson:"client_id"`            // The client this token was issued to
+	Scope               string    `bson:"scope"`                // The token's authorized scope
+	IssuedAt            time.Time `bson:"issued_at"`            // When this token was issued
+	ExpiresAt           time.Time `bson:"expires_at"`           // When this token expires
+	IsRevoked           bool      `bson:"is_revoked"`           // Whether this token has been revoked
 }
 
 // TokenStore manages access and refresh tokens
@@ -79,20 +88,24 @@ type TokenStore interface {
 
 // IntrospectionResponse represents the OAuth 2.0 token introspection response
 type IntrospectionResponse struct {
-	Active    bool   `json:"active"`              // Is the token active?
-	Scope     string `json:"scope,omitempty"`     // The token's scope
-	ClientID  string `json:"client_id,omitempty"` // Client ID the token was issued to
-	Username  string `json:"username,omitempty"`  // Username of the resource owner
-	ExpiresAt int64  `json:"exp,omitempty"`       // Token expiration timestamp
-	IssuedAt  int64  `json:"iat,omitempty"`       // When the token was issued
+	Active              bool   `json:"active"`                         // Is the token active?
+	Scope               string `json:"scope,omitempty"`                // The token's scope
+	ClientID            string `json:"client_id,omitempty"`            // Client ID the token was issued to
+	Username            string `json:"username,omitempty"`             // Username of the resource owner
+	ExpiresAt           int64  `json:"exp,omitempty"`                  // Token expiration timestamp
+	IssuedAt            int64  `json:"iat,omitempty"`                  // When the token was issued
+	FederatedIdentityID string `json:"federatedidentity_id,omitempty"` // The ID of the resource owner
+	Email               string `json:"email,omitempty"`
+	FirstName           string `json:"first_name,omitempty"`
+	LastName            string `json:"last_name,omitempty"`
 }
 
 // ValidationError represents an OAuth 2.0 protocol error
 // as defined in RFC 6749 Section 4.1.2.1
 type ValidationError struct {
-	ErrorCode        string // Standard OAuth error code
-	ErrorDescription string // Human-readable error description
-	State            string // State parameter from the original request
+	ErrorCode        string `json:"error"`                       // Standard OAuth error code
+	ErrorDescription string `json:"error_description,omitempty"` // Human-readable error description
+	State            string `json:"state,omitempty"`             // State parameter from the original request
 }
 
 func (e *ValidationError) Error() string {
@@ -0,0 +1,145 @@
+// Package totp implements time-based one-time passwords as specified in
+// RFC 6238 so users can protect their accounts with an authenticator app.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSecret = errors.New("the secret is not a valid base32 string")
+)
+
+const (
+	// secretLength is the number of random bytes in a secret, RFC 4226
+	// recommends 160 bits.
+	secretLength = 20
+
+	// digits is the length of the generated codes.
+	digits = 6
+
+	// period is how long each code is valid for.
+	period = 30 * time.Second
+
+	// skew is the number of periods before and after the current one which
+	// are still accepted to allow for clock drift.
+	skew = 1
+)
+
+type Provider interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(issuer, accountName, secret string) string
+	GenerateCode(secret string, t time.Time) (string, error)
+	ValidateCode(secret, code string, t time.Time) (bool, error)
+	ValidateCodeTimeStep(secret, code string, t time.Time) (uint64, bool, error)
+}
+
+type totpProvider struct{}
+
+func NewProvider() Provider {
+	return &totpProvider{}
+}
+
+// GenerateSecret returns a new random base32 encoded secret to be shared
+// with the authenticator app of the user.
+func (p *totpProvider) GenerateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
+
+// ProvisioningURI returns the `otpauth://` URI which authenticator apps
+// accept, usually scanned as a QR code.
+func (p *totpProvider) ProvisioningURI(issuer, accountName, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprintf("%d", digits))
+	v.Set("period", fmt.Sprintf("%d", int(period.Seconds())))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// GenerateCode returns the code for the period which contains `t`.
+func (p *totpProvider) GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generateCode(key, uint64(t.Unix())/uint64(period.Seconds())), nil
+}
+
+// ValidateCode checks the code against the period which contains `t` and
+// the neighbouring periods allowed by the skew.
+func (p *totpProvider) ValidateCode(secret, code string, t time.Time) (bool, error) {
+	_, ok, err := p.ValidateCodeTimeStep(secret, code, t)
+	return ok, err
+}
+
+// ValidateCodeTimeStep is like `ValidateCode` but also returns the time step
+// (the RFC 6238 counter) the code was generated for, so callers can reject
+// a code which was already used.
+func (p *totpProvider) ValidateCodeTimeStep(secret, code string, t time.Time) (uint64, bool, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != digits {
+		return 0, false, nil
+	}
+	counter := uint64(t.Unix()) / uint64(period.Seconds())
+	for i := -skew; i <= skew; i++ {
+		step := counter + uint64(i)
+		expected := generateCode(key, step)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.TrimRight(strings.TrimSpace(secret), "="))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return key, nil
+}
+
+// generateCode implements the HOTP algorithm from RFC 4226.
+func generateCode(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
@@ -0,0 +1,87 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCodeRFC6238(t *testing.T) {
+	// Test vectors from RFC 6238 Appendix B for SHA1, truncated to six digits.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	provider := NewProvider()
+
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, expected := range vectors {
+		code, err := provider.GenerateCode(secret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		require.Equal(t, expected, code, "time %d", unix)
+	}
+}
+
+func TestValidateCode(t *testing.T) {
+	provider := NewProvider()
+	secret, err := provider.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := provider.GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	ok, err := provider.ValidateCode(secret, code, now)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = provider.ValidateCode(secret, code, now.Add(period))
+	require.NoError(t, err)
+	require.True(t, ok, "code from the previous period should be accepted")
+
+	ok, err = provider.ValidateCode(secret, code, now.Add(5*period))
+	require.NoError(t, err)
+	require.False(t, ok, "code from an old period should be rejected")
+
+	ok, err = provider.ValidateCode(secret, "12345", now)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = provider.ValidateCode("not base32!", code, now)
+	require.ErrorIs(t, err, ErrInvalidSecret)
+}
+
+func TestValidateCodeTimeStep(t *testing.T) {
+	provider := NewProvider()
+	secret, err := provider.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	counter := uint64(now.Unix()) / uint64(period.Seconds())
+	code, err := provider.GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	step, ok, err := provider.ValidateCodeTimeStep(secret, code, now)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, counter, step)
+
+	step, ok, err = provider.ValidateCodeTimeStep(secret, code, now.Add(period))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, counter, step, "time step should be the one the code was generated for")
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := NewProvider().ProvisioningURI("ComicCoin", "jane@example.com", "JBSWY3DPEHPK3PXP")
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/ComicCoin:jane@example.com?"), uri)
+	require.Contains(t, uri, "secret=JBSWY3DPEHPK3PXP")
+	require.Contains(t, uri, "issuer=ComicCoin")
+}
@@ -50,6 +50,12 @@ type PublicWallet struct {
 	// VerifiedOn indicates the time when the user profile was verified by the ComicCoin Authority.
 	VerifiedOn time.Time `bson:"verified_on" json:"verified_on"`
 
+	// IsOwnerVerified indicates the owner proved they control the wallet address by signing a server-issued challenge.
+	IsOwnerVerified bool `bson:"is_owner_verified" json:"is_owner_verified"`
+
+	// OwnerVerifiedOn indicates the time when the owner last signed a challenge for the wallet address.
+	OwnerVerifiedOn time.Time `bson:"owner_verified_on" json:"owner_verified_on"`
+
 	Type int8 `bson:"type" json:"type"`
 
 	// The S3 key of the thumbnail image for the public wallet.
@@ -86,6 +92,7 @@ type PublicWalletFilter struct {
 	Status          int8               `bson:"status" json:"status"`
 	Type            *int8              `bson:"type,omitempty" json:"type,omitempty"`
 	IsVerified      *bool              `bson:"is_verified,omitempty" json:"is_verified,omitempty"`
+	IsOwnerVerified *bool              `bson:"is_owner_verified,omitempty" json:"is_owner_verified,omitempty"`
 	Location        *string            `json:"location,omitempty"`
 
 	// Pagination fields
@@ -0,0 +1,20 @@
+package session
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository Interface for the Session index in the database. The session
+// data itself lives in the cache, the index lets us find every session of
+// a user so we can list and revoke them.
+type Repository interface {
+	Create(ctx context.Context, m *Session) error
+	GetByID(ctx context.Context, id primitive.ObjectID) (*Session, error)
+	GetBySessionID(ctx context.Context, sessionID string) (*Session, error)
+	ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*Session, error)
+	UpdateByID(ctx context.Context, m *Session) error
+	DeleteByID(ctx context.Context, id primitive.ObjectID) error
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
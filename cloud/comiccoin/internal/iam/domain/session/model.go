@@ -0,0 +1,64 @@
+package session
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Session structure represents a logged in device of a user. The cache
+// stores the user under the `SessionID` key, deleting the key revokes the
+// access and refresh tokens issued for the session.
+type Session struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	UserID     primitive.ObjectID `bson:"user_id" json:"user_id"`       // The user ID that this session belongs to.
+	SessionID  string             `bson:"session_id" json:"-"`          // The cache key, never shared as it is inside the JWT tokens.
+	Device     string             `bson:"device" json:"device"`         // Human readable device derived from the user agent.
+	IPAddress  string             `bson:"ip_address" json:"ip_address"` // The IP address of the last login or token refresh.
+	UserAgent  string             `bson:"user_agent" json:"user_agent"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+	LastUsedAt time.Time          `bson:"last_used_at" json:"last_used_at"` // The time of the last login or token refresh.
+	ExpiresAt  time.Time          `bson:"expires_at" json:"expires_at"`
+}
+
+// DeviceFromUserAgent returns a short description of the browser and
+// operating system of the user agent, for example "Firefox on Linux".
+func DeviceFromUserAgent(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	// Order matters, for example every Chrome user agent mentions Safari.
+	browser := "Unknown browser"
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "opr/"), strings.Contains(ua, "opera"):
+		browser = "Opera"
+	case strings.Contains(ua, "firefox/"):
+		browser = "Firefox"
+	case strings.Contains(ua, "chrome/"), strings.Contains(ua, "crios/"):
+		browser = "Chrome"
+	case strings.Contains(ua, "safari/"):
+		browser = "Safari"
+	case strings.Contains(ua, "okhttp"), strings.Contains(ua, "go-http-client"), strings.Contains(ua, "cfnetwork"):
+		browser = "App"
+	}
+
+	os := "unknown device"
+	switch {
+	case strings.Contains(ua, "iphone"):
+		os = "iPhone"
+	case strings.Contains(ua, "ipad"):
+		os = "iPad"
+	case strings.Contains(ua, "android"):
+		os = "Android"
+	case strings.Contains(ua, "windows"):
+		os = "Windows"
+	case strings.Contains(ua, "mac os"), strings.Contains(ua, "macintosh"), strings.Contains(ua, "darwin"):
+		os = "macOS"
+	case strings.Contains(ua, "linux"):
+		os = "Linux"
+	}
+
+	return browser + " on " + os
+}
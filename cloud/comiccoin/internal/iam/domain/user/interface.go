@@ -14,6 +14,7 @@ type Repository interface {
 	GetByID(ctx context.Context, id primitive.ObjectID) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByVerificationCode(ctx context.Context, verificationCode string) (*User, error)
+	GetByUnlockCode(ctx context.Context, unlockCode string) (*User, error)
 	DeleteByID(ctx context.Context, id primitive.ObjectID) error
 	DeleteByEmail(ctx context.Context, email string) error
 	CheckIfExistsByEmail(ctx context.Context, email string) (bool, error)
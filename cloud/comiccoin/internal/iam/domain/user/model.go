@@ -58,6 +58,9 @@ type User struct {
 	EmailVerificationExpiry                        time.Time          `bson:"email_verification_expiry,omitempty" json:"email_verification_expiry,omitempty"`
 	PasswordResetVerificationCode                  string             `bson:"password_reset_verification_code,omitempty" json:"password_reset_verification_code,omitempty"`
 	PasswordResetVerificationExpiry                time.Time          `bson:"password_reset_verification_expiry,omitempty" json:"password_reset_verification_expiry,omitempty"`
+	UnlockCode                                     string             `bson:"unlock_code,omitempty" json:"-"`                 // Emailed to the user when the account gets locked.
+	UnlockCodeExpiry                               time.Time          `bson:"unlock_code_expiry,omitempty" json:"-"`          // After which the user must be unlocked by an administrator.
+	LockedAt                                       time.Time          `bson:"locked_at,omitempty" json:"locked_at,omitempty"` // When the account was locked by too many failed logins.
 	Phone                                          string             `bson:"phone" json:"phone,omitempty"`
 	Country                                        string             `bson:"country" json:"country,omitempty"`
 	Timezone                                       string             `bson:"timezone" json:"timezone"`
@@ -112,9 +115,19 @@ type User struct {
 	// OTPAuthURL is the URL used to share.
 	OTPAuthURL string `bson:"otp_auth_url" json:"-"`
 
+	// OTPLastUsedTimeStep is the time step of the last accepted code from the
+	// authenticator app, codes at or below it are rejected so they cannot be
+	// replayed.
+	OTPLastUsedTimeStep uint64 `bson:"otp_last_used_time_step" json:"-"`
+
 	// OTPBackupCodeHash is the one-time use backup code which resets the 2FA settings and allow the user to setup 2FA from scratch for the user.
 	OTPBackupCodeHash string `bson:"otp_backup_code_hash" json:"-"`
 
+	// OTPBackupCodeHashes are the hashes of the one-time use backup codes
+	// which can be used instead of the authenticator app during login, every
+	// code is removed once used.
+	OTPBackupCodeHashes []string `bson:"otp_backup_code_hashes" json:"-"`
+
 	// OTPBackupCodeHashAlgorithm tracks the hashing algorithm used.
 	OTPBackupCodeHashAlgorithm string `bson:"otp_backup_code_hash_algorithm" json:"-"`
 
@@ -0,0 +1,15 @@
+package walletchallenge
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository Interface for a WalletChallenge model in the database. Every
+// user has at most one outstanding challenge.
+type Repository interface {
+	Upsert(ctx context.Context, m *WalletChallenge) error
+	GetByUserID(ctx context.Context, userID primitive.ObjectID) (*WalletChallenge, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
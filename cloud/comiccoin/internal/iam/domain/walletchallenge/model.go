@@ -0,0 +1,87 @@
+package walletchallenge
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The purpose of a challenge is part of the signed message, so a signature
+// made for one flow cannot be used for another.
+const (
+	PurposeConnectWallet       = "connect_wallet"        // Connect the wallet address to the account of the user.
+	PurposePublishPublicWallet = "publish_public_wallet" // Create or re-address a public wallet in the directory.
+)
+
+// purposeDescriptions are the descriptions of the purposes shown to the user
+// by their wallet.
+var purposeDescriptions = map[string]string{
+	PurposeConnectWallet:       "Connect this wallet to your ComicCoin account",
+	PurposePublishPublicWallet: "Publish this wallet in the ComicCoin public wallet directory",
+}
+
+// IsValidPurpose returns true if the purpose is one of the known purposes.
+func IsValidPurpose(purpose string) bool {
+	_, ok := purposeDescriptions[purpose]
+	return ok
+}
+
+// WalletChallenge structure represents the message which the user must sign
+// with their wallet to prove they control the wallet address before we
+// connect it to their account. Every challenge can only be used once.
+type WalletChallenge struct {
+	ID            primitive.ObjectID `bson:"_id" json:"id"`
+	UserID        primitive.ObjectID `bson:"user_id" json:"user_id"` // The user ID that this challenge belongs to.
+	WalletAddress *common.Address    `bson:"wallet_address" json:"wallet_address"`
+	Domain        string             `bson:"domain" json:"domain"`   // The domain of the website which issued the challenge.
+	Purpose       string             `bson:"purpose" json:"purpose"` // The flow which the challenge can be used for, see `PurposeConnectWallet`.
+	Nonce         string             `bson:"nonce" json:"nonce"`
+	Message       string             `bson:"message" json:"message"`
+	ExpiresAt     time.Time          `bson:"expires_at" json:"expires_at"`
+	CreatedAt     time.Time          `bson:"created_at,omitempty" json:"created_at,omitempty"`
+}
+
+// SignedMessage is the value which the wallet signs with the ComicCoin stamp
+// (see `signature.Sign`), wallets must produce the exact same JSON.
+type SignedMessage struct {
+	Message string `json:"message"`
+}
+
+// NewMessage returns the human readable message of the challenge which is
+// shown to the user by their wallet before they sign it. The domain and the
+// purpose let the user see where and for what their signature is used.
+func NewMessage(domain string, purpose string, walletAddress *common.Address, nonce string, issuedAt time.Time, expiresAt time.Time) string {
+	return strings.Join([]string{
+		fmt.Sprintf("%s wants you to prove you own this ComicCoin wallet:", domain),
+		strings.ToLower(walletAddress.Hex()),
+		"",
+		fmt.Sprintf("Purpose: %s", purposeDescriptions[purpose]),
+		"",
+		fmt.Sprintf("Domain: %s", domain),
+		fmt.Sprintf("Nonce: %s", nonce),
+		fmt.Sprintf("Issued At: %s", issuedAt.UTC().Format(time.RFC3339)),
+		fmt.Sprintf("Expires At: %s", expiresAt.UTC().Format(time.RFC3339)),
+	}, "\n")
+}
+
+// DomainFromFrontendURL returns the host of the frontend, the frontend may
+// be configured with or without the scheme.
+func DomainFromFrontendURL(frontendURL string) string {
+	if !strings.Contains(frontendURL, "://") {
+		frontendURL = "https://" + frontendURL
+	}
+	u, err := url.Parse(frontendURL)
+	if err != nil || u.Host == "" {
+		return "ComicCoin"
+	}
+	return u.Host
+}
+
+// IsExpired returns true if the challenge can no longer be used.
+func (c *WalletChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
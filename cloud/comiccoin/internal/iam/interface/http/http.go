@@ -13,13 +13,13 @@ import (
 	mid "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/middleware"
 	http_system "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/system"
 
-	// http_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth"
 	// http_registration "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/registration"
 	// http_token "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/token"
 	http_dashboard "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/dashboard"
 	http_gateway "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/gateway"
 	http_hello "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/hello"
 	http_me "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/me"
+	http_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth"
 	http_publicwallet "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/publicwallet"
 	http_publicwalletdirectory "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/publicwalletdirectory"
 	http_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/user"
@@ -52,20 +52,35 @@ type httpServerImpl struct {
 	gatewayUserRegisterHTTPHandler   *http_gateway.GatewayUserRegisterHTTPHandler
 	gatewayVerifyEmailHTTPHandler    *http_gateway.GatewayVerifyEmailHTTPHandler
 	gatewayLoginHTTPHandler          *http_gateway.GatewayLoginHTTPHandler
+	gatewayLoginOTPHTTPHandler       *http_gateway.GatewayLoginOTPHTTPHandler
 	gatewayLogoutHTTPHandler         *http_gateway.GatewayLogoutHTTPHandler
 	gatewayRefreshTokenHTTPHandler   *http_gateway.GatewayRefreshTokenHTTPHandler
 	gatewayForgotPasswordHTTPHandler *http_gateway.GatewayForgotPasswordHTTPHandler
 	gatewayResetPasswordHTTPHandler  *http_gateway.GatewayResetPasswordHTTPHandler
+	gatewayUnlockAccountHTTPHandler  *http_gateway.GatewayUnlockAccountHTTPHandler
+
+	oauthAuthorizeHTTPHandler  *http_oauth.OAuthAuthorizeHTTPHandler
+	oauthConsentHTTPHandler    *http_oauth.OAuthConsentHTTPHandler
+	oauthTokenHTTPHandler      *http_oauth.OAuthTokenHTTPHandler
+	oauthIntrospectHTTPHandler *http_oauth.OAuthIntrospectHTTPHandler
+	oauthRevokeHTTPHandler     *http_oauth.OAuthRevokeHTTPHandler
 
 	getHelloHTTPHandler *http_hello.GetHelloHTTPHandler
 
-	getMeHTTPHandler               *http_me.GetMeHTTPHandler
-	postMeConnectWalletHTTPHandler *http_me.PostMeConnectWalletHTTPHandler
-	putUpdateMeHTTPHandler         *http_me.PutUpdateMeHTTPHandler
-	deleteMeHTTPHandler            *http_me.DeleteMeHTTPHandler
-	postVerifyProfileHTTPHandler   *http_me.PostVerifyProfileHTTPHandler
+	getMeHTTPHandler                        *http_me.GetMeHTTPHandler
+	postMeConnectWalletHTTPHandler          *http_me.PostMeConnectWalletHTTPHandler
+	postMeConnectWalletChallengeHTTPHandler *http_me.PostMeConnectWalletChallengeHTTPHandler
+	postMeOTPGenerateHTTPHandler            *http_me.PostMeOTPGenerateHTTPHandler
+	postMeOTPVerifyHTTPHandler              *http_me.PostMeOTPVerifyHTTPHandler
+	postMeOTPDisableHTTPHandler             *http_me.PostMeOTPDisableHTTPHandler
+	getMeSessionsHTTPHandler                *http_me.GetMeSessionsHTTPHandler
+	deleteMeSessionsHTTPHandler             *http_me.DeleteMeSessionsHTTPHandler
+	putUpdateMeHTTPHandler                  *http_me.PutUpdateMeHTTPHandler
+	deleteMeHTTPHandler                     *http_me.DeleteMeHTTPHandler
+	postVerifyProfileHTTPHandler            *http_me.PostVerifyProfileHTTPHandler
 
 	createPublicWalletHTTPHandler           http_publicwallet.CreatePublicWalletHTTPHandler
+	publicWalletChallengeHTTPHandler        http_publicwallet.PublicWalletChallengeHTTPHandler
 	createPublicWalletByAdminHTTPHandler    http_publicwallet.CreatePublicWalletByAdminHTTPHandler
 	getPublicWalletByIDHTTPHandler          http_publicwallet.GetPublicWalletByIDHTTPHandler
 	getPublicWalletByAddressHTTPHandler     http_publicwallet.GetPublicWalletByAddressHTTPHandler
@@ -87,6 +102,7 @@ type httpServerImpl struct {
 	updateUserHTTPHandler http_user.UpdateUserHTTPHandler
 	deleteUserHTTPHandler http_user.DeleteUserHTTPHandler
 	listUsersHTTPHandler  http_user.ListUsersHTTPHandler
+	userSessionsHandler   http_user.UserSessionsHTTPHandler
 }
 
 // NewHTTPServer creates a new HTTP server instance.
@@ -97,17 +113,31 @@ func NewHTTPServer(
 	gatewayUserRegisterHTTPHandler *http_gateway.GatewayUserRegisterHTTPHandler,
 	gatewayVerifyEmailHTTPHandler *http_gateway.GatewayVerifyEmailHTTPHandler,
 	gatewayLoginHTTPHandler *http_gateway.GatewayLoginHTTPHandler,
+	gatewayLoginOTPHTTPHandler *http_gateway.GatewayLoginOTPHTTPHandler,
 	gatewayLogoutHTTPHandler *http_gateway.GatewayLogoutHTTPHandler,
 	gatewayRefreshTokenHTTPHandler *http_gateway.GatewayRefreshTokenHTTPHandler,
 	gatewayForgotPasswordHTTPHandler *http_gateway.GatewayForgotPasswordHTTPHandler,
 	gatewayResetPasswordHTTPHandler *http_gateway.GatewayResetPasswordHTTPHandler,
+	gatewayUnlockAccountHTTPHandler *http_gateway.GatewayUnlockAccountHTTPHandler,
+	oauthAuthorizeHTTPHandler *http_oauth.OAuthAuthorizeHTTPHandler,
+	oauthConsentHTTPHandler *http_oauth.OAuthConsentHTTPHandler,
+	oauthTokenHTTPHandler *http_oauth.OAuthTokenHTTPHandler,
+	oauthIntrospectHTTPHandler *http_oauth.OAuthIntrospectHTTPHandler,
+	oauthRevokeHTTPHandler *http_oauth.OAuthRevokeHTTPHandler,
 	getHelloHTTPHandler *http_hello.GetHelloHTTPHandler,
 	getMeHTTPHandler *http_me.GetMeHTTPHandler,
 	postMeConnectWalletHTTPHandler *http_me.PostMeConnectWalletHTTPHandler,
+	postMeConnectWalletChallengeHTTPHandler *http_me.PostMeConnectWalletChallengeHTTPHandler,
+	postMeOTPGenerateHTTPHandler *http_me.PostMeOTPGenerateHTTPHandler,
+	postMeOTPVerifyHTTPHandler *http_me.PostMeOTPVerifyHTTPHandler,
+	postMeOTPDisableHTTPHandler *http_me.PostMeOTPDisableHTTPHandler,
+	getMeSessionsHTTPHandler *http_me.GetMeSessionsHTTPHandler,
+	deleteMeSessionsHTTPHandler *http_me.DeleteMeSessionsHTTPHandler,
 	putUpdateMeHTTPHandler *http_me.PutUpdateMeHTTPHandler,
 	deleteMeHTTPHandler *http_me.DeleteMeHTTPHandler,
 	postVerifyProfileHTTPHandler *http_me.PostVerifyProfileHTTPHandler,
 	createPublicWalletHTTPHandler http_publicwallet.CreatePublicWalletHTTPHandler,
+	publicWalletChallengeHTTPHandler http_publicwallet.PublicWalletChallengeHTTPHandler,
 	createPublicWalletByAdminHTTPHandler http_publicwallet.CreatePublicWalletByAdminHTTPHandler,
 	getPublicWalletByIDHTTPHandler http_publicwallet.GetPublicWalletByIDHTTPHandler,
 	getPublicWalletByAddressHTTPHandler http_publicwallet.GetPublicWalletByAddressHTTPHandler,
@@ -126,6 +156,7 @@ func NewHTTPServer(
 	updateUserHTTPHandler http_user.UpdateUserHTTPHandler,
 	deleteUserHTTPHandler http_user.DeleteUserHTTPHandler,
 	listUsersHTTPHandler http_user.ListUsersHTTPHandler,
+	userSessionsHandler http_user.UserSessionsHTTPHandler,
 ) HTTPServer {
 
 	// Create a new HTTP server instance.
@@ -136,17 +167,31 @@ func NewHTTPServer(
 		gatewayUserRegisterHTTPHandler:                    gatewayUserRegisterHTTPHandler,
 		gatewayVerifyEmailHTTPHandler:                     gatewayVerifyEmailHTTPHandler,
 		gatewayLoginHTTPHandler:                           gatewayLoginHTTPHandler,
+		gatewayLoginOTPHTTPHandler:                        gatewayLoginOTPHTTPHandler,
 		gatewayLogoutHTTPHandler:                          gatewayLogoutHTTPHandler,
 		gatewayRefreshTokenHTTPHandler:                    gatewayRefreshTokenHTTPHandler,
 		gatewayForgotPasswordHTTPHandler:                  gatewayForgotPasswordHTTPHandler,
 		gatewayResetPasswordHTTPHandler:                   gatewayResetPasswordHTTPHandler,
+		gatewayUnlockAccountHTTPHandler:                   gatewayUnlockAccountHTTPHandler,
+		oauthAuthorizeHTTPHandler:                         oauthAuthorizeHTTPHandler,
+		oauthConsentHTTPHandler:                           oauthConsentHTTPHandler,
+		oauthTokenHTTPHandler:                             oauthTokenHTTPHandler,
+		oauthIntrospectHTTPHandler:                        oauthIntrospectHTTPHandler,
+		oauthRevokeHTTPHandler:                            oauthRevokeHTTPHandler,
 		getHelloHTTPHandler:                               getHelloHTTPHandler,
 		getMeHTTPHandler:                                  getMeHTTPHandler,
 		deleteMeHTTPHandler:                               deleteMeHTTPHandler,
 		postMeConnectWalletHTTPHandler:                    postMeConnectWalletHTTPHandler,
+		postMeConnectWalletChallengeHTTPHandler:           postMeConnectWalletChallengeHTTPHandler,
+		postMeOTPGenerateHTTPHandler:                      postMeOTPGenerateHTTPHandler,
+		postMeOTPVerifyHTTPHandler:                        postMeOTPVerifyHTTPHandler,
+		postMeOTPDisableHTTPHandler:                       postMeOTPDisableHTTPHandler,
+		getMeSessionsHTTPHandler:                          getMeSessionsHTTPHandler,
+		deleteMeSessionsHTTPHandler:                       deleteMeSessionsHTTPHandler,
 		putUpdateMeHTTPHandler:                            putUpdateMeHTTPHandler,
 		postVerifyProfileHTTPHandler:                      postVerifyProfileHTTPHandler,
 		createPublicWalletHTTPHandler:                     createPublicWalletHTTPHandler,
+		publicWalletChallengeHTTPHandler:                  publicWalletChallengeHTTPHandler,
 		createPublicWalletByAdminHTTPHandler:              createPublicWalletByAdminHTTPHandler,
 		getPublicWalletByIDHTTPHandler:                    getPublicWalletByIDHTTPHandler,
 		getPublicWalletByAddressHTTPHandler:               getPublicWalletByAddressHTTPHandler,
@@ -165,6 +210,7 @@ func NewHTTPServer(
 		updateUserHTTPHandler: updateUserHTTPHandler,
 		deleteUserHTTPHandler: deleteUserHTTPHandler,
 		listUsersHTTPHandler:  listUsersHTTPHandler,
+		userSessionsHandler:   userSessionsHandler,
 	}
 
 	return port
@@ -208,6 +254,8 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 			port.gatewayVerifyEmailHTTPHandler.Execute(w, r)
 		case n == 4 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "login" && r.Method == http.MethodPost:
 			port.gatewayLoginHTTPHandler.Execute(w, r)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "login" && p[4] == "otp" && r.Method == http.MethodPost:
+			port.gatewayLoginOTPHTTPHandler.Execute(w, r)
 		case n == 4 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "logout" && r.Method == http.MethodPost:
 			port.gatewayLogoutHTTPHandler.Execute(w, r)
 		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "token" && p[4] == "refresh" && r.Method == http.MethodPost:
@@ -216,6 +264,18 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 			port.gatewayForgotPasswordHTTPHandler.Execute(w, r)
 		case n == 4 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "reset-password" && r.Method == http.MethodPost:
 			port.gatewayResetPasswordHTTPHandler.Execute(w, r)
+		case n == 4 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "unlock-account" && r.Method == http.MethodPost:
+			port.gatewayUnlockAccountHTTPHandler.Execute(w, r)
+
+		// OAuth 2.0 (called by third-party apps)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "oauth" && p[4] == "authorize" && r.Method == http.MethodGet:
+			port.oauthAuthorizeHTTPHandler.Execute(w, r)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "oauth" && p[4] == "token" && r.Method == http.MethodPost:
+			port.oauthTokenHTTPHandler.Execute(w, r)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "oauth" && p[4] == "introspect" && r.Method == http.MethodPost:
+			port.oauthIntrospectHTTPHandler.Execute(w, r)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "oauth" && p[4] == "revoke" && r.Method == http.MethodPost:
+			port.oauthRevokeHTTPHandler.Execute(w, r)
 
 		// --- Protected endpoints ---
 
@@ -228,6 +288,20 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 			port.getMeHTTPHandler.Execute(w, r)
 		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "connect-wallet" && r.Method == http.MethodPost:
 			port.postMeConnectWalletHTTPHandler.Execute(w, r)
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "connect-wallet" && p[5] == "challenge" && r.Method == http.MethodPost:
+			port.postMeConnectWalletChallengeHTTPHandler.Execute(w, r)
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "otp" && p[5] == "generate" && r.Method == http.MethodPost:
+			port.postMeOTPGenerateHTTPHandler.Execute(w, r)
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "otp" && p[5] == "verify" && r.Method == http.MethodPost:
+			port.postMeOTPVerifyHTTPHandler.Execute(w, r)
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "otp" && p[5] == "disable" && r.Method == http.MethodPost:
+			port.postMeOTPDisableHTTPHandler.Execute(w, r)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "sessions" && r.Method == http.MethodGet:
+			port.getMeSessionsHTTPHandler.Execute(w, r)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "sessions" && r.Method == http.MethodDelete:
+			port.deleteMeSessionsHTTPHandler.Execute(w, r)
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "sessions" && r.Method == http.MethodDelete:
+			port.deleteMeSessionsHTTPHandler.ExecuteByID(w, r, p[5])
 		case n == 4 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && r.Method == http.MethodPut:
 			port.putUpdateMeHTTPHandler.Execute(w, r)
 		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "delete" && r.Method == http.MethodPost:
@@ -235,6 +309,12 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "me" && p[4] == "verify-profile" && r.Method == http.MethodPost:
 			port.postVerifyProfileHTTPHandler.Execute(w, r)
 
+		// OAuth 2.0 consent
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "oauth" && p[4] == "authorize" && r.Method == http.MethodGet:
+			port.oauthConsentHTTPHandler.HandleGet(w, r, p[5])
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "oauth" && p[4] == "authorize" && r.Method == http.MethodPost:
+			port.oauthConsentHTTPHandler.HandleDecision(w, r, p[5])
+
 		// Public Wallet
 		case n == 4 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "public-wallets" && r.Method == http.MethodGet:
 			port.listPublicWalletsByFilterHTTPHandler.Handle(w, r)
@@ -242,6 +322,8 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 			port.createPublicWalletHTTPHandler.Handle(w, r)
 		case n == 4 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "public-wallets-by-admin" && r.Method == http.MethodPost:
 			port.createPublicWalletByAdminHTTPHandler.Handle(w, r)
+		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "public-wallets" && p[4] == "challenge" && r.Method == http.MethodPost:
+			port.publicWalletChallengeHTTPHandler.Handle(w, r)
 		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "public-wallets" && r.Method == http.MethodGet:
 			port.getPublicWalletByAddressHTTPHandler.Handle(w, r, p[4])
 		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "public-wallets" && r.Method == http.MethodPut:
@@ -270,6 +352,10 @@ func (port *httpServerImpl) HandleIncomingHTTPRequest(w http.ResponseWriter, r *
 			port.updateUserHTTPHandler.Handle(w, r, p[4])
 		case n == 5 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "users" && r.Method == http.MethodDelete:
 			port.deleteUserHTTPHandler.Handle(w, r, p[4])
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "users" && p[5] == "sessions" && r.Method == http.MethodGet:
+			port.userSessionsHandler.HandleList(w, r, p[4])
+		case n == 6 && p[0] == "iam" && p[1] == "api" && p[2] == "v1" && p[3] == "users" && p[5] == "sessions" && r.Method == http.MethodDelete:
+			port.userSessionsHandler.HandleRevokeAll(w, r, p[4])
 
 		// --- CATCH ALL: D.N.E. ---
 		default:
@@ -0,0 +1,126 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/me/otpdisable.go
+package me
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	svc_me "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/me"
+)
+
+type PostMeOTPDisableHTTPHandler struct {
+	config   *config.Configuration
+	logger   *slog.Logger
+	dbClient *mongo.Client
+	service  svc_me.MeOTPDisableService
+}
+
+func NewPostMeOTPDisableHTTPHandler(
+	config *config.Configuration,
+	logger *slog.Logger,
+	dbClient *mongo.Client,
+	service svc_me.MeOTPDisableService,
+) *PostMeOTPDisableHTTPHandler {
+	return &PostMeOTPDisableHTTPHandler{
+		config:   config,
+		logger:   logger,
+		dbClient: dbClient,
+		service:  service,
+	}
+}
+
+func (h *PostMeOTPDisableHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*svc_me.MeOTPDisableRequestDTO, error) {
+	// Initialize our array which will store all the results from the remote server.
+	var requestData svc_me.MeOTPDisableRequestDTO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang stuct else we need
+	// to send a `400 Bad Request` errror message back to the client,
+	err := json.NewDecoder(teeReader).Decode(&requestData) // [1]
+	if err != nil {
+		h.logger.Error("decoding error",
+			slog.Any("err", err),
+			slog.String("json", rawJSON.String()),
+		)
+		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+	}
+
+	// Defensive Code: For security purposes we need to remove all whitespaces from the code.
+	requestData.Code = strings.ReplaceAll(requestData.Code, " ", "")
+
+	return &requestData, nil
+}
+
+func (h *PostMeOTPDisableHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Set response content type
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	req, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	////
+	//// Start the transaction.
+	////
+
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error",
+			slog.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function with a series of operations
+	transactionFunc := func(sessCtx mongo.SessionContext) (interface{}, error) {
+
+		// Call service
+		response, err := h.service.Execute(sessCtx, req)
+		if err != nil {
+			h.logger.Error("failed to execute otp disable",
+				slog.Any("error", err))
+
+			return nil, err
+		}
+		return response, nil
+	}
+
+	// Start a transaction
+	result, txErr := session.WithTransaction(ctx, transactionFunc)
+	if txErr != nil {
+		h.logger.Error("session failed error",
+			slog.Any("error", txErr))
+		httperror.ResponseError(w, txErr)
+		return
+	}
+
+	// Encode response
+	resp := result.(*svc_me.MeOTPDisableResponseDTO)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("failed to encode response",
+			slog.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+}
@@ -0,0 +1,110 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/me/sessionrevoke.go
+package me
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	svc_me "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/me"
+)
+
+type DeleteMeSessionsHTTPHandler struct {
+	config   *config.Configuration
+	logger   *slog.Logger
+	dbClient *mongo.Client
+	service  svc_me.MeRevokeSessionsService
+}
+
+func NewDeleteMeSessionsHTTPHandler(
+	config *config.Configuration,
+	logger *slog.Logger,
+	dbClient *mongo.Client,
+	service svc_me.MeRevokeSessionsService,
+) *DeleteMeSessionsHTTPHandler {
+	return &DeleteMeSessionsHTTPHandler{
+		config:   config,
+		logger:   logger,
+		dbClient: dbClient,
+		service:  service,
+	}
+}
+
+// Execute revokes every session of the user except the current one.
+func (h *DeleteMeSessionsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	h.execute(w, r, func(sessCtx mongo.SessionContext) (*svc_me.MeRevokeSessionsResponseDTO, error) {
+		return h.service.ExecuteForOthers(sessCtx)
+	})
+}
+
+// ExecuteByID revokes a single session of the user.
+func (h *DeleteMeSessionsHTTPHandler) ExecuteByID(w http.ResponseWriter, r *http.Request, idStr string) {
+	// Set response content type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Convert string ID to ObjectID
+	id, err := primitive.ObjectIDFromHex(idStr)
+	if err != nil {
+		h.logger.Error("invalid ID format",
+			slog.Any("error", err))
+		httperror.ResponseError(w, httperror.NewForSingleField(http.StatusBadRequest, "id", "Invalid ID format"))
+		return
+	}
+
+	h.execute(w, r, func(sessCtx mongo.SessionContext) (*svc_me.MeRevokeSessionsResponseDTO, error) {
+		return h.service.ExecuteByID(sessCtx, id)
+	})
+}
+
+func (h *DeleteMeSessionsHTTPHandler) execute(w http.ResponseWriter, r *http.Request, fn func(sessCtx mongo.SessionContext) (*svc_me.MeRevokeSessionsResponseDTO, error)) {
+	// Set response content type
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	////
+	//// Start the transaction.
+	////
+
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error",
+			slog.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function with a series of operations
+	transactionFunc := func(sessCtx mongo.SessionContext) (interface{}, error) {
+		response, err := fn(sessCtx)
+		if err != nil {
+			h.logger.Error("failed to revoke sessions",
+				slog.Any("error", err))
+			return nil, err
+		}
+		return response, nil
+	}
+
+	// Start a transaction
+	result, txErr := session.WithTransaction(ctx, transactionFunc)
+	if txErr != nil {
+		h.logger.Error("session failed error",
+			slog.Any("error", txErr))
+		httperror.ResponseError(w, txErr)
+		return
+	}
+
+	resp := result.(*svc_me.MeRevokeSessionsResponseDTO)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("failed to encode response",
+			slog.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+}
@@ -19,9 +19,10 @@ func (mid *middleware) IPAddressMiddleware(fn http.HandlerFunc) http.HandlerFunc
 			IPAddress = r.RemoteAddr
 		}
 
-		// Save our IP address to the context.
+		// Save our IP address and user agent to the context.
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, constants.SessionIPAddress, IPAddress)
+		ctx = context.WithValue(ctx, constants.SessionUserAgent, r.UserAgent())
 		fn(w, r.WithContext(ctx)) // Flow to the next middleware.
 	}
 }
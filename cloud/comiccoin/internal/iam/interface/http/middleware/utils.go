@@ -18,19 +18,25 @@ var (
 func init() {
 	// Exact matches
 	exactPaths = map[string]bool{
-		"/iam/api/v1/say-hello":               true,
-		"/iam/api/v1/token/introspect":        true,
-		"/iam/api/v1/profile":                 true,
-		"/iam/api/v1/me":                      true,
-		"/iam/api/v1/me/connect-wallet":       true,
-		"/iam/api/v1/me/delete":               true,
-		"/iam/api/v1/dashboard":               true,
-		"/iam/api/v1/claim-coins":             true,
-		"/iam/api/v1/transactions":            true,
-		"/iam/api/v1/me/verify-profile":       true,
-		"/iam/api/v1/public-wallets":          true,
-		"/iam/api/v1/public-wallets-by-admin": true,
-		"/iam/api/v1/users":                   true,
+		"/iam/api/v1/say-hello":                   true,
+		"/iam/api/v1/token/introspect":            true,
+		"/iam/api/v1/profile":                     true,
+		"/iam/api/v1/me":                          true,
+		"/iam/api/v1/me/connect-wallet":           true,
+		"/iam/api/v1/me/connect-wallet/challenge": true,
+		"/iam/api/v1/me/otp/generate":             true,
+		"/iam/api/v1/me/otp/verify":               true,
+		"/iam/api/v1/me/otp/disable":              true,
+		"/iam/api/v1/me/sessions":                 true,
+		"/iam/api/v1/me/delete":                   true,
+		"/iam/api/v1/dashboard":                   true,
+		"/iam/api/v1/claim-coins":                 true,
+		"/iam/api/v1/transactions":                true,
+		"/iam/api/v1/me/verify-profile":           true,
+		"/iam/api/v1/public-wallets":              true,
+		"/iam/api/v1/public-wallets-by-admin":     true,
+		"/iam/api/v1/public-wallets/challenge":    true,
+		"/iam/api/v1/users":                       true,
 	}
 
 	// Pattern matches
@@ -39,6 +45,9 @@ func init() {
 		"^/iam/api/v1/wallet/[0-9a-f]+$",                 // Regex designed for mongodb ids.
 		"^/iam/api/v1/public-wallets/0x[0-9a-fA-F]{40}$", // Regex designed for ethereum addresses.
 		"^/iam/api/v1/users/[0-9a-f]+$",                  // Regex designed for mongodb ids.
+		"^/iam/api/v1/users/[0-9a-f]+/sessions$",         // Regex designed for mongodb ids.
+		"^/iam/api/v1/me/sessions/[0-9a-f]+$",            // Regex designed for mongodb ids.
+		"^/iam/api/v1/oauth/authorize/[0-9a-f]+$",        // Regex designed for oauth authorization request ids.
 	}
 
 	// Precompile patterns
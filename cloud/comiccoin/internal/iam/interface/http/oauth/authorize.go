@@ -0,0 +1,45 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth/authorize.go
+package oauth
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	svc_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/oauth"
+)
+
+type OAuthAuthorizeHTTPHandler struct {
+	logger  *slog.Logger
+	service svc_oauth.OAuthAuthorizeService
+}
+
+func NewOAuthAuthorizeHTTPHandler(
+	logger *slog.Logger,
+	service svc_oauth.OAuthAuthorizeService,
+) *OAuthAuthorizeHTTPHandler {
+	return &OAuthAuthorizeHTTPHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *OAuthAuthorizeHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &svc_oauth.OAuthAuthorizeRequestIDO{
+		ResponseType:        q.Get("response_type"),
+		ClientID:            q.Get("client_id"),
+		RedirectURI:         q.Get("redirect_uri"),
+		State:               q.Get("state"),
+		Scope:               q.Get("scope"),
+		CodeChallenge:       q.Get("code_challenge"),
+		CodeChallengeMethod: q.Get("code_challenge_method"),
+	}
+
+	redirectURL, err := h.service.Execute(r.Context(), req)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
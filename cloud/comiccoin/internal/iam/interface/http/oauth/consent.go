@@ -0,0 +1,64 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth/consent.go
+package oauth
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	svc_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/oauth"
+)
+
+// OAuthConsentHTTPHandler is called by our frontend, unlike the rest of the
+// package, so it uses our JSON API style.
+type OAuthConsentHTTPHandler struct {
+	logger  *slog.Logger
+	service svc_oauth.OAuthConsentService
+}
+
+func NewOAuthConsentHTTPHandler(
+	logger *slog.Logger,
+	service svc_oauth.OAuthConsentService,
+) *OAuthConsentHTTPHandler {
+	return &OAuthConsentHTTPHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *OAuthConsentHTTPHandler) HandleGet(w http.ResponseWriter, r *http.Request, authID string) {
+	w.Header().Set("Content-Type", "application/json")
+
+	resp, err := h.service.Get(r.Context(), authID)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *OAuthConsentHTTPHandler) HandleDecision(w http.ResponseWriter, r *http.Request, authID string) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req svc_oauth.OAuthConsentRequestIDO
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("decoding error", slog.Any("err", err))
+		httperror.ResponseError(w, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"))
+		return
+	}
+
+	resp, err := h.service.Execute(r.Context(), authID, &req)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,44 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth/introspect.go
+package oauth
+
+import (
+	"log/slog"
+	"net/http"
+
+	svc_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/oauth"
+)
+
+type OAuthIntrospectHTTPHandler struct {
+	logger  *slog.Logger
+	service svc_oauth.OAuthIntrospectService
+}
+
+func NewOAuthIntrospectHTTPHandler(
+	logger *slog.Logger,
+	service svc_oauth.OAuthIntrospectService,
+) *OAuthIntrospectHTTPHandler {
+	return &OAuthIntrospectHTTPHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *OAuthIntrospectHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	if err := parseForm(r); err != nil {
+		writeError(w, h.logger, err)
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	req := &svc_oauth.OAuthIntrospectRequestIDO{
+		Token:        r.PostForm.Get("token"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}
+
+	resp, err := h.service.Execute(r.Context(), req)
+	if err != nil {
+		writeError(w, h.logger, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,43 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth/revoke.go
+package oauth
+
+import (
+	"log/slog"
+	"net/http"
+
+	svc_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/oauth"
+)
+
+type OAuthRevokeHTTPHandler struct {
+	logger  *slog.Logger
+	service svc_oauth.OAuthRevokeService
+}
+
+func NewOAuthRevokeHTTPHandler(
+	logger *slog.Logger,
+	service svc_oauth.OAuthRevokeService,
+) *OAuthRevokeHTTPHandler {
+	return &OAuthRevokeHTTPHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *OAuthRevokeHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	if err := parseForm(r); err != nil {
+		writeError(w, h.logger, err)
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	req := &svc_oauth.OAuthRevokeRequestIDO{
+		Token:        r.PostForm.Get("token"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}
+
+	if err := h.service.Execute(r.Context(), req); err != nil {
+		writeError(w, h.logger, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,49 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth/token.go
+package oauth
+
+import (
+	"log/slog"
+	"net/http"
+
+	svc_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/oauth"
+)
+
+type OAuthTokenHTTPHandler struct {
+	logger  *slog.Logger
+	service svc_oauth.OAuthTokenService
+}
+
+func NewOAuthTokenHTTPHandler(
+	logger *slog.Logger,
+	service svc_oauth.OAuthTokenService,
+) *OAuthTokenHTTPHandler {
+	return &OAuthTokenHTTPHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *OAuthTokenHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	if err := parseForm(r); err != nil {
+		writeError(w, h.logger, err)
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	req := &svc_oauth.OAuthTokenRequestIDO{
+		GrantType:    r.PostForm.Get("grant_type"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		Scope:        r.PostForm.Get("scope"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}
+
+	resp, err := h.service.Execute(r.Context(), req)
+	if err != nil {
+		writeError(w, h.logger, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,72 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth/utils.go
+package oauth
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+)
+
+// DEVELOPERS NOTE:
+// The endpoints in this package are called by third-party apps and not our
+// frontend, therefore they speak the OAuth 2.0 protocol (form encoded
+// requests and RFC 6749 Section 5.2 errors) instead of our JSON API style.
+// The `security/oauth` stores do not take part in mongodb transactions so
+// these handlers do not start a session.
+
+// clientCredentials returns the client credentials from the basic auth
+// header or, if not set, from the form body as per RFC 6749 Section 2.3.1.
+func clientCredentials(r *http.Request) (string, string) {
+	if id, secret, ok := r.BasicAuth(); ok {
+		// Basic auth values are form encoded before being base64 encoded.
+		if decoded, err := url.QueryUnescape(id); err == nil {
+			id = decoded
+		}
+		if decoded, err := url.QueryUnescape(secret); err == nil {
+			secret = decoded
+		}
+		return id, secret
+	}
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+}
+
+// writeJSON writes a response which must never be cached since it may
+// contain tokens.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// writeError converts the error into an RFC 6749 error response.
+func writeError(w http.ResponseWriter, logger *slog.Logger, err error) {
+	var verr *sec_oauth.ValidationError
+	if !errors.As(err, &verr) {
+		logger.Error("oauth request failed", slog.Any("error", err))
+		verr = &sec_oauth.ValidationError{ErrorCode: "server_error"}
+		writeJSON(w, http.StatusInternalServerError, verr)
+		return
+	}
+	if verr.ErrorCode == "invalid_client" {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		writeJSON(w, http.StatusUnauthorized, verr)
+		return
+	}
+	writeJSON(w, http.StatusBadRequest, verr)
+}
+
+// parseForm parses the form encoded body of a back-channel request.
+func parseForm(r *http.Request) error {
+	if err := r.ParseForm(); err != nil {
+		return &sec_oauth.ValidationError{ErrorCode: "invalid_request", ErrorDescription: "Request body is malformed"}
+	}
+	return nil
+}
@@ -124,6 +124,17 @@ func (h *listPublicWalletsByFilterHTTPHandlerImpl) Handle(w http.ResponseWriter,
 		filter.IsVerified = &isVerified
 	}
 
+	// Parse is_owner_verified if provided
+	isOwnerVerifiedStr := r.URL.Query().Get("is_owner_verified")
+	if isOwnerVerifiedStr != "" {
+		isOwnerVerified, err := strconv.ParseBool(isOwnerVerifiedStr)
+		if err != nil {
+			httperror.ResponseError(w, err)
+			return
+		}
+		filter.IsOwnerVerified = &isOwnerVerified
+	}
+
 	// Parse location if provided (NEW)
 	location := r.URL.Query().Get("location")
 	if location != "" {
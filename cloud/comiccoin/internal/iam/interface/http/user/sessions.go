@@ -0,0 +1,107 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/user/sessions.go
+package user
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	svc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/user"
+)
+
+type UserSessionsHTTPHandler interface {
+	HandleList(w http.ResponseWriter, r *http.Request, idStr string)
+	HandleRevokeAll(w http.ResponseWriter, r *http.Request, idStr string)
+}
+
+type userSessionsHTTPHandlerImpl struct {
+	config   *config.Configuration
+	logger   *slog.Logger
+	dbClient *mongo.Client
+	service  svc_user.UserSessionsService
+}
+
+func NewUserSessionsHTTPHandler(
+	config *config.Configuration,
+	logger *slog.Logger,
+	dbClient *mongo.Client,
+	service svc_user.UserSessionsService,
+) UserSessionsHTTPHandler {
+	return &userSessionsHTTPHandlerImpl{
+		config:   config,
+		logger:   logger,
+		dbClient: dbClient,
+		service:  service,
+	}
+}
+
+func (h *userSessionsHTTPHandlerImpl) HandleList(w http.ResponseWriter, r *http.Request, idStr string) {
+	h.handle(w, r, idStr, func(sessCtx mongo.SessionContext, id primitive.ObjectID) (interface{}, error) {
+		return h.service.List(sessCtx, id)
+	})
+}
+
+func (h *userSessionsHTTPHandlerImpl) HandleRevokeAll(w http.ResponseWriter, r *http.Request, idStr string) {
+	h.handle(w, r, idStr, func(sessCtx mongo.SessionContext, id primitive.ObjectID) (interface{}, error) {
+		return h.service.RevokeAll(sessCtx, id)
+	})
+}
+
+func (h *userSessionsHTTPHandlerImpl) handle(w http.ResponseWriter, r *http.Request, idStr string, fn func(sessCtx mongo.SessionContext, id primitive.ObjectID) (interface{}, error)) {
+	// Set response content type
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	// Convert string ID to ObjectID
+	id, err := primitive.ObjectIDFromHex(idStr)
+	if err != nil {
+		h.logger.Error("invalid ID format",
+			slog.Any("error", err))
+		httperror.ResponseError(w, httperror.NewForSingleField(http.StatusBadRequest, "id", "Invalid ID format"))
+		return
+	}
+
+	// Start a MongoDB session for transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error",
+			slog.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define the transaction
+	transactionFunc := func(sessCtx mongo.SessionContext) (interface{}, error) {
+		response, err := fn(sessCtx, id)
+		if err != nil {
+			h.logger.Error("failed user sessions request",
+				slog.Any("error", err))
+			return nil, err
+		}
+		return response, nil
+	}
+
+	// Execute the transaction
+	result, txErr := session.WithTransaction(ctx, transactionFunc)
+	if txErr != nil {
+		h.logger.Error("session failed error",
+			slog.Any("error", txErr))
+		httperror.ResponseError(w, txErr)
+		return
+	}
+
+	// Return success response
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		h.logger.Error("failed to encode response",
+			slog.Any("error", err))
+		return
+	}
+}
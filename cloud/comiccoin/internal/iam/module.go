@@ -12,7 +12,9 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/blacklist"
 	ipcb "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/ipcountryblocker"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
+	oauth_mongodb "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth/mongodb"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/totp"
 	mongodb_cache "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
 	redis_cache "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/memory/redis"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http"
@@ -22,25 +24,32 @@ import (
 	http_hello "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/hello"
 	http_me "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/me"
 	httpmiddle "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/middleware"
+	http_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/oauth"
 	http_publicwallet "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/publicwallet"
 	http_publicwalletdirectory "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/publicwalletdirectory"
 	http_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/http/user"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/interface/task"
 	r_banip "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/bannedipaddress"
 	r_publicwallet "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/publicwallet"
+	r_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/session"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/templatedemailer"
 	r_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/user"
+	r_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/walletchallenge"
 	sv_dashboard "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/dashboard"
 	svc_gateway "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/gateway"
 	svc_hello "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/hello"
 	svc_me "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/me"
+	svc_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/oauth"
 	svc_publicwallet "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/publicwallet"
 	svc_publicwalletdirectory "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/publicwalletdirectory"
 	svc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/user"
 	uc_bannedipaddress "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/bannedipaddress"
 	uc_emailer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/emailer"
+	uc_otp "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/otp"
 	uc_publicwallet "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/publicwallet"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+	uc_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/walletchallenge"
 )
 
 type IAMModule struct {
@@ -77,6 +86,7 @@ func NewModule(
 
 	mongodbCacheConfigurationProvider := mongodb_cache.NewCacheConfigurationProvider(cfg.DB.IAMName)
 	mongodbCacheProvider := mongodb_cache.NewCache(mongodbCacheConfigurationProvider, logger, dbClient)
+	totpp := totp.NewProvider()
 
 	mailgunConfigurationProvider := mailgun.NewMailgunConfigurationProvider(
 		cfg.IAMEmailer.SenderEmail,
@@ -90,6 +100,10 @@ func NewModule(
 	emailer := mailgun.NewEmailer(mailgunConfigurationProvider, logger)
 	templatedEmailer := templatedemailer.NewTemplatedEmailer(logger, emailer)
 
+	oauthAuthorizationStore := oauth_mongodb.NewMongoStore(logger, dbClient, cfg.DB.IAMName)
+	oauthClientService := oauth_mongodb.NewMongoClientService(logger, dbClient, cfg.DB.IAMName)
+	oauthTokenStore := oauth_mongodb.NewMongoTokenStore(logger, dbClient, cfg.DB.IAMName)
+
 	////
 	//// Repository
 	////
@@ -97,6 +111,8 @@ func NewModule(
 	banIPAddrRepo := r_banip.NewRepository(cfg, logger, dbClient)
 	userRepo := r_user.NewRepository(cfg, logger, dbClient)
 	publicWalletRepo := r_publicwallet.NewRepository(cfg, logger, dbClient)
+	walletChallengeRepo := r_walletchallenge.NewRepository(cfg, logger, dbClient)
+	sessionRepo := r_session.NewRepository(cfg, logger, dbClient)
 
 	////
 	//// Use-case
@@ -114,6 +130,11 @@ func NewModule(
 		logger,
 		templatedEmailer,
 	)
+	sendUserAccountUnlockEmailUseCase := uc_emailer.NewSendUserAccountUnlockEmailUseCase(
+		cfg,
+		logger,
+		templatedEmailer,
+	)
 
 	// --- Banned IP Addresses ---
 
@@ -140,6 +161,11 @@ func NewModule(
 		logger,
 		userRepo,
 	)
+	userGetByUnlockCodeUseCase := uc_user.NewUserGetByUnlockCodeUseCase(
+		cfg,
+		logger,
+		userRepo,
+	)
 	userGetByIDUseCase := uc_user.NewUserGetByIDUseCase(
 		cfg,
 		logger,
@@ -166,6 +192,72 @@ func NewModule(
 		userRepo,
 	)
 
+	// --- Wallet Challenges ---
+	walletChallengeIssueUseCase := uc_walletchallenge.NewWalletChallengeIssueUseCase(
+		cfg,
+		logger,
+		passp,
+		walletChallengeRepo,
+	)
+	walletChallengeVerifyUseCase := uc_walletchallenge.NewWalletChallengeVerifyUseCase(
+		cfg,
+		logger,
+		walletChallengeRepo,
+	)
+
+	// --- Sessions ---
+	sessionCreateUseCase := uc_session.NewSessionCreateUseCase(
+		cfg,
+		logger,
+		sessionRepo,
+	)
+	sessionGetByIDUseCase := uc_session.NewSessionGetByIDUseCase(
+		cfg,
+		logger,
+		sessionRepo,
+	)
+	sessionGetBySessionIDUseCase := uc_session.NewSessionGetBySessionIDUseCase(
+		cfg,
+		logger,
+		sessionRepo,
+	)
+	sessionListByUserIDUseCase := uc_session.NewSessionListByUserIDUseCase(
+		cfg,
+		logger,
+		sessionRepo,
+	)
+	sessionUpdateUseCase := uc_session.NewSessionUpdateUseCase(
+		cfg,
+		logger,
+		sessionRepo,
+	)
+	sessionRevokeUseCase := uc_session.NewSessionRevokeUseCase(
+		cfg,
+		logger,
+		mongodbCacheProvider,
+		sessionRepo,
+	)
+	sessionRevokeAllByUserIDUseCase := uc_session.NewSessionRevokeAllByUserIDUseCase(
+		cfg,
+		logger,
+		mongodbCacheProvider,
+		sessionRepo,
+		oauthTokenStore,
+	)
+
+	// --- OTP ---
+	otpGenerateBackupCodesUseCase := uc_otp.NewOTPGenerateBackupCodesUseCase(
+		cfg,
+		logger,
+		passp,
+	)
+	otpVerifyUseCase := uc_otp.NewOTPVerifyUseCase(
+		cfg,
+		logger,
+		totpp,
+		passp,
+	)
+
 	// --- Public Wallets ---
 	publicWalletCreateUseCase := uc_publicwallet.NewPublicWalletCreateUseCase(
 		cfg,
@@ -261,6 +353,7 @@ func NewModule(
 		passp,
 		userGetByIDUseCase,
 		userUpdateUseCase,
+		sessionRevokeAllByUserIDUseCase,
 	)
 
 	deleteUserService := svc_user.NewDeleteUserService(
@@ -270,6 +363,13 @@ func NewModule(
 		userDeleteByIDUseCase,
 	)
 
+	userSessionsService := svc_user.NewUserSessionsService(
+		cfg,
+		logger,
+		sessionListByUserIDUseCase,
+		sessionRevokeAllByUserIDUseCase,
+	)
+
 	userCountByFilterUseCase := uc_user.NewUserCountByFilterUseCase(
 		cfg,
 		logger,
@@ -305,6 +405,47 @@ func NewModule(
 		userGetByIDUseCase,
 		userUpdateUseCase,
 		userGetByWalletAddressUseCase,
+		walletChallengeVerifyUseCase,
+	)
+	meConnectWalletChallengeService := svc_me.NewMeConnectWalletChallengeService(
+		cfg,
+		logger,
+		walletChallengeIssueUseCase,
+	)
+	meOTPGenerateService := svc_me.NewMeOTPGenerateService(
+		cfg,
+		logger,
+		totpp,
+		userGetByIDUseCase,
+		userUpdateUseCase,
+	)
+	meOTPVerifyService := svc_me.NewMeOTPVerifyService(
+		cfg,
+		logger,
+		passp,
+		userGetByIDUseCase,
+		userUpdateUseCase,
+		otpVerifyUseCase,
+		otpGenerateBackupCodesUseCase,
+	)
+	meOTPDisableService := svc_me.NewMeOTPDisableService(
+		cfg,
+		logger,
+		userGetByIDUseCase,
+		userUpdateUseCase,
+		otpVerifyUseCase,
+	)
+	meListSessionsService := svc_me.NewMeListSessionsService(
+		cfg,
+		logger,
+		sessionListByUserIDUseCase,
+	)
+	meRevokeSessionsService := svc_me.NewMeRevokeSessionsService(
+		cfg,
+		logger,
+		sessionGetByIDUseCase,
+		sessionListByUserIDUseCase,
+		sessionRevokeUseCase,
 	)
 	updateMeService := svc_me.NewUpdateMeService(
 		cfg,
@@ -359,22 +500,41 @@ func NewModule(
 		userUpdateUseCase,
 	)
 	gatewayLoginService := svc_gateway.NewGatewayLoginService(
+		cfg,
 		logger,
 		passp,
 		mongodbCacheProvider,
+		dmutex,
 		jwtp,
 		userGetByEmailUseCase,
 		userUpdateUseCase,
+		sendUserAccountUnlockEmailUseCase,
+		sessionCreateUseCase,
+		sessionRevokeAllByUserIDUseCase,
+	)
+	gatewayLoginOTPService := svc_gateway.NewGatewayLoginOTPService(
+		logger,
+		mongodbCacheProvider,
+		jwtp,
+		userGetByIDUseCase,
+		userUpdateUseCase,
+		otpVerifyUseCase,
+		sessionCreateUseCase,
 	)
 	gatewayLogoutService := svc_gateway.NewGatewayLogoutService(
 		logger,
 		mongodbCacheProvider,
+		sessionGetBySessionIDUseCase,
+		sessionRevokeUseCase,
 	)
 	gatewayRefreshTokenService := svc_gateway.NewGatewayRefreshTokenService(
 		logger,
 		mongodbCacheProvider,
 		jwtp,
 		userGetByEmailUseCase,
+		sessionGetBySessionIDUseCase,
+		sessionCreateUseCase,
+		sessionUpdateUseCase,
 	)
 	gatewayForgotPasswordService := svc_gateway.NewGatewayForgotPasswordService(
 		logger,
@@ -392,6 +552,50 @@ func NewModule(
 		jwtp,
 		userGetByEmailUseCase,
 		userUpdateUseCase,
+		sessionRevokeAllByUserIDUseCase,
+	)
+	gatewayUnlockAccountService := svc_gateway.NewGatewayUnlockAccountService(
+		logger,
+		userGetByUnlockCodeUseCase,
+		userUpdateUseCase,
+	)
+
+	// --- OAuth ---
+	oauthAuthorizeService := svc_oauth.NewOAuthAuthorizeService(
+		cfg,
+		logger,
+		passp,
+		oauthClientService,
+		oauthAuthorizationStore,
+	)
+	oauthConsentService := svc_oauth.NewOAuthConsentService(
+		cfg,
+		logger,
+		passp,
+		oauthClientService,
+		oauthAuthorizationStore,
+	)
+	oauthTokenService := svc_oauth.NewOAuthTokenService(
+		cfg,
+		logger,
+		passp,
+		oauthClientService,
+		oauthAuthorizationStore,
+		oauthTokenStore,
+		userGetByIDUseCase,
+	)
+	oauthIntrospectService := svc_oauth.NewOAuthIntrospectService(
+		cfg,
+		logger,
+		oauthClientService,
+		oauthTokenStore,
+		userGetByIDUseCase,
+	)
+	oauthRevokeService := svc_oauth.NewOAuthRevokeService(
+		cfg,
+		logger,
+		oauthClientService,
+		oauthTokenStore,
 	)
 
 	// --- Public Wallet ---
@@ -402,6 +606,12 @@ func NewModule(
 		publicWalletGetByAddressUseCase,
 		userGetByIDUseCase,
 		userUpdateUseCase,
+		walletChallengeVerifyUseCase,
+	)
+	publicWalletChallengeService := svc_publicwallet.NewPublicWalletChallengeService(
+		cfg,
+		logger,
+		walletChallengeIssueUseCase,
 	)
 	createPublicWalletByAdmin := svc_publicwallet.NewCreatePublicWalletByAdminService(
 		cfg,
@@ -425,9 +635,11 @@ func NewModule(
 		cfg,
 		logger,
 		publicWalletGetByIDUseCase,
+		publicWalletGetByAddressUseCase,
 		publicWalletUpdateByIDUseCase,
 		userGetByIDUseCase,
 		userUpdateUseCase,
+		walletChallengeVerifyUseCase,
 	)
 	updatePublicWalletByAddressService := svc_publicwallet.NewUpdatePublicWalletByAddressService(
 		cfg,
@@ -436,6 +648,7 @@ func NewModule(
 		publicWalletUpdateByAddressUseCase,
 		userGetByIDUseCase,
 		userUpdateUseCase,
+		walletChallengeVerifyUseCase,
 	)
 	deletePublicWalletByIDService := svc_publicwallet.NewDeletePublicWalletByIDService(
 		cfg,
@@ -507,6 +720,11 @@ func NewModule(
 		dbClient,
 		gatewayLoginService,
 	)
+	gatewayLoginOTPHTTPHandler := http_gateway.NewGatewayLoginOTPHTTPHandler(
+		logger,
+		dbClient,
+		gatewayLoginOTPService,
+	)
 	gatewayLogoutHTTPHandler := http_gateway.NewGatewayLogoutHTTPHandler(
 		logger,
 		dbClient,
@@ -527,6 +745,34 @@ func NewModule(
 		dbClient,
 		gatewayResetPasswordService,
 	)
+	gatewayUnlockAccountHTTPHandler := http_gateway.NewGatewayUnlockAccountHTTPHandler(
+		logger,
+		dbClient,
+		gatewayUnlockAccountService,
+	)
+
+	// --- OAuth ---
+
+	oauthAuthorizeHTTPHandler := http_oauth.NewOAuthAuthorizeHTTPHandler(
+		logger,
+		oauthAuthorizeService,
+	)
+	oauthConsentHTTPHandler := http_oauth.NewOAuthConsentHTTPHandler(
+		logger,
+		oauthConsentService,
+	)
+	oauthTokenHTTPHandler := http_oauth.NewOAuthTokenHTTPHandler(
+		logger,
+		oauthTokenService,
+	)
+	oauthIntrospectHTTPHandler := http_oauth.NewOAuthIntrospectHTTPHandler(
+		logger,
+		oauthIntrospectService,
+	)
+	oauthRevokeHTTPHandler := http_oauth.NewOAuthRevokeHTTPHandler(
+		logger,
+		oauthRevokeService,
+	)
 
 	// --- Hello ---
 
@@ -552,6 +798,48 @@ func NewModule(
 		meConnectWalletService,
 	)
 
+	postMeConnectWalletChallengeHTTPHandler := http_me.NewPostMeConnectWalletChallengeHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		meConnectWalletChallengeService,
+	)
+
+	postMeOTPGenerateHTTPHandler := http_me.NewPostMeOTPGenerateHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		meOTPGenerateService,
+	)
+
+	postMeOTPVerifyHTTPHandler := http_me.NewPostMeOTPVerifyHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		meOTPVerifyService,
+	)
+
+	postMeOTPDisableHTTPHandler := http_me.NewPostMeOTPDisableHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		meOTPDisableService,
+	)
+
+	getMeSessionsHTTPHandler := http_me.NewGetMeSessionsHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		meListSessionsService,
+	)
+
+	deleteMeSessionsHTTPHandler := http_me.NewDeleteMeSessionsHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		meRevokeSessionsService,
+	)
+
 	putUpdateMeHTTPHandler := http_me.NewPutUpdateMeHTTPHandler(
 		cfg,
 		logger,
@@ -574,6 +862,12 @@ func NewModule(
 		dbClient,
 		createPublicWalletService,
 	)
+	publicWalletChallengeHTTPHandler := http_publicwallet.NewPublicWalletChallengeHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		publicWalletChallengeService,
+	)
 	createPublicWalletByAdminHTTPHandler := http_publicwallet.NewCreatePublicWalletByAdminHTTPHandler(
 		cfg,
 		logger,
@@ -706,6 +1000,13 @@ func NewModule(
 		listUsersService,
 	)
 
+	userSessionsHTTPHandler := http_user.NewUserSessionsHTTPHandler(
+		cfg,
+		logger,
+		dbClient,
+		userSessionsService,
+	)
+
 	// --- HTTP Middleware ---
 
 	httpMiddleware := httpmiddle.NewMiddleware(
@@ -726,17 +1027,31 @@ func NewModule(
 		gatewayUserRegisterHTTPHandler,
 		gatewayVerifyEmailHTTPHandler,
 		gatewayLoginHTTPHandler,
+		gatewayLoginOTPHTTPHandler,
 		gatewayLogoutHTTPHandler,
 		gatewayRefreshTokenHTTPHandler,
 		gatewayForgotPasswordHTTPHandler,
 		gatewayResetPasswordHTTPHandler,
+		gatewayUnlockAccountHTTPHandler,
+		oauthAuthorizeHTTPHandler,
+		oauthConsentHTTPHandler,
+		oauthTokenHTTPHandler,
+		oauthIntrospectHTTPHandler,
+		oauthRevokeHTTPHandler,
 		getHelloHTTPHandler,
 		getMeHTTPHandler,
 		postMeConnectWalletHTTPHandler,
+		postMeConnectWalletChallengeHTTPHandler,
+		postMeOTPGenerateHTTPHandler,
+		postMeOTPVerifyHTTPHandler,
+		postMeOTPDisableHTTPHandler,
+		getMeSessionsHTTPHandler,
+		deleteMeSessionsHTTPHandler,
 		putUpdateMeHTTPHandler,
 		deleteMeHTTPHandler,
 		postVerifyProfileHTTPHandler,
 		createPublicWalletHTTPHandler,
+		publicWalletChallengeHTTPHandler,
 		createPublicWalletByAdminHTTPHandler,
 		getPublicWalletByIDHTTPHandler,
 		getPublicWalletByAddressHTTPHandler,
@@ -755,6 +1070,7 @@ func NewModule(
 		updateUserHTTPHandler,
 		deleteUserHTTPHandler,
 		listUsersHTTPHandler,
+		userSessionsHTTPHandler,
 	)
 
 	// --- Tasks ---
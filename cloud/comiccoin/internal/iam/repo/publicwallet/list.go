@@ -141,6 +141,11 @@ func (impl publicWalletImpl) buildMatchStage(filter *dom.PublicWalletFilter) bso
 		match["is_verified"] = *filter.IsVerified
 	}
 
+	// Filter by owner verification status
+	if filter.IsOwnerVerified != nil {
+		match["is_owner_verified"] = *filter.IsOwnerVerified
+	}
+
 	// Handle text search and location filters
 	orConditions := []bson.M{}
 	locationOrConditions := []bson.M{}
@@ -0,0 +1,23 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+func (impl sessionImpl) Create(ctx context.Context, m *dom_session.Session) error {
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	if _, err := impl.Collection.InsertOne(ctx, m); err != nil {
+		impl.Logger.Error("database failed create error",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (impl sessionImpl) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
+	_, err := impl.Collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		impl.Logger.Error("database failed deletion error",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+func (impl sessionImpl) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := impl.Collection.DeleteMany(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		impl.Logger.Error("database failed deletion error",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
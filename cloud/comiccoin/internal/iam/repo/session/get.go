@@ -0,0 +1,34 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+func (impl sessionImpl) GetByID(ctx context.Context, id primitive.ObjectID) (*dom_session.Session, error) {
+	return impl.getByFilter(ctx, bson.M{"_id": id})
+}
+
+func (impl sessionImpl) GetBySessionID(ctx context.Context, sessionID string) (*dom_session.Session, error) {
+	return impl.getByFilter(ctx, bson.M{"session_id": sessionID})
+}
+
+func (impl sessionImpl) getByFilter(ctx context.Context, filter bson.M) (*dom_session.Session, error) {
+	var result dom_session.Session
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get error", slog.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,30 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+func (impl sessionImpl) ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*dom_session.Session, error) {
+	// Most recently used sessions first.
+	opts := options.Find().SetSort(bson.D{{Key: "last_used_at", Value: -1}})
+	cursor, err := impl.Collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		impl.Logger.Error("database list by user id error", slog.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := make([]*dom_session.Session, 0)
+	if err := cursor.All(ctx, &results); err != nil {
+		impl.Logger.Error("database decode error", slog.Any("error", err))
+		return nil, err
+	}
+	return results, nil
+}
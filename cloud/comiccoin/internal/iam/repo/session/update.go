@@ -0,0 +1,21 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+func (impl sessionImpl) UpdateByID(ctx context.Context, m *dom_session.Session) error {
+	filter := bson.M{"_id": m.ID}
+	update := bson.M{"$set": m}
+	if _, err := impl.Collection.UpdateOne(ctx, filter, update); err != nil {
+		impl.Logger.Error("database update error",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
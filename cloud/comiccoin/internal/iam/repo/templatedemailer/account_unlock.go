@@ -0,0 +1,58 @@
+package templatedemailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"text/template"
+
+	"log/slog"
+)
+
+func (impl *templatedEmailer) SendUserAccountUnlockEmail(ctx context.Context, email, unlockCode, firstName string) error {
+	impl.Logger.Debug("sending account unlock email...",
+		slog.String("email", email),
+		slog.String("first_name", firstName),
+	)
+
+	fp := path.Join("templates", "iam/account_unlock.html")
+	tmpl, err := template.ParseFiles(fp)
+	if err != nil {
+		impl.Logger.Error("user account unlock parsing error", slog.Any("error", err))
+		return err
+	}
+
+	// The frontend domain may be configured with or without the scheme.
+	frontendURL := impl.GetFrontendDomainName()
+	if !strings.HasPrefix(frontendURL, "http://") && !strings.HasPrefix(frontendURL, "https://") {
+		frontendURL = "https://" + frontendURL
+	}
+
+	var processed bytes.Buffer
+
+	// Render the HTML template with our data.
+	data := struct {
+		Email      string
+		UnlockLink string
+		FirstName  string
+	}{
+		Email:      email,
+		UnlockLink: fmt.Sprintf("%s/unlock-account?code=%s", strings.TrimSuffix(frontendURL, "/"), url.QueryEscape(unlockCode)),
+		FirstName:  firstName,
+	}
+	if err := tmpl.Execute(&processed, data); err != nil {
+		impl.Logger.Error("user account unlock template execution error", slog.Any("error", err))
+		return err
+	}
+	body := processed.String() // DEVELOPERS NOTE: Convert our long sequence of data into a string.
+
+	if err := impl.Emailer.Send(ctx, impl.Emailer.GetSenderEmail(), "Unlock Your Account", email, body); err != nil {
+		impl.Logger.Error("sending user account unlock error", slog.Any("error", err))
+		return err
+	}
+	impl.Logger.Debug("user account unlock email sent")
+	return nil
+}
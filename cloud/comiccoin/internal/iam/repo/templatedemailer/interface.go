@@ -15,6 +15,7 @@ type TemplatedEmailer interface {
 	SendUserVerificationEmail(ctx context.Context, email, verificationCode, firstName string) error
 	// SendNewUserTemporaryPasswordEmail(email, firstName, temporaryPassword string) error
 	SendUserPasswordResetEmail(ctx context.Context, email, verificationCode, firstName string) error
+	SendUserAccountUnlockEmail(ctx context.Context, email, unlockCode, firstName string) error
 	// SendNewComicSubmissionEmailToStaff(staffEmails []string, submissionID string, storeName string, item string, cpsrn string, serviceTypeName string) error
 	// SendNewComicSubmissionEmailToRetailers(retailerEmails []string, submissionID string, storeName string, item string, cpsrn string, serviceTypeName string) error
 	// SendNewStoreEmailToStaff(staffEmails []string, storeID string) error
@@ -61,6 +61,22 @@ func (impl userStorerImpl) GetByVerificationCode(ctx context.Context, verificati
 	return &result, nil
 }
 
+func (impl userStorerImpl) GetByUnlockCode(ctx context.Context, unlockCode string) (*dom_user.User, error) {
+	filter := bson.M{"unlock_code": unlockCode}
+
+	var result dom_user.User
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by unlock code error", slog.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
+
 func (impl userStorerImpl) GetByWalletAddress(ctx context.Context, walletAddress *common.Address) (*dom_user.User, error) {
 	filter := bson.M{"wallet_address": walletAddress}
 
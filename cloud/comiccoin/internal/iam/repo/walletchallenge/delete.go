@@ -0,0 +1,19 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (impl walletChallengeImpl) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := impl.Collection.DeleteOne(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		impl.Logger.Error("database failed deletion error",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
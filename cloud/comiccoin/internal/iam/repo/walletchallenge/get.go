@@ -0,0 +1,28 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+func (impl walletChallengeImpl) GetByUserID(ctx context.Context, userID primitive.ObjectID) (*dom_walletchallenge.WalletChallenge, error) {
+	filter := bson.M{"user_id": userID}
+
+	var result dom_walletchallenge.WalletChallenge
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by user id error", slog.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,29 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+func (impl walletChallengeImpl) Upsert(ctx context.Context, m *dom_walletchallenge.WalletChallenge) error {
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	// Replace any outstanding challenge of the user so only the latest one
+	// can be used.
+	filter := bson.M{"user_id": m.UserID}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := impl.Collection.ReplaceOne(ctx, filter, m, opts); err != nil {
+		impl.Logger.Error("database failed upsert error",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
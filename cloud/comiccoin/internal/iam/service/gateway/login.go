@@ -1,20 +1,28 @@
 package gateway
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
+	"net/http"
 	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
 	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
 	domain "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_emailer "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/emailer"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
 )
 
@@ -23,23 +31,61 @@ type GatewayLoginService interface {
 }
 
 type gatewayLoginServiceImpl struct {
-	logger                *slog.Logger
-	passwordProvider      password.Provider
-	cache                 mongodbcache.Cacher
-	jwtProvider           jwt.Provider
-	userGetByEmailUseCase uc_user.UserGetByEmailUseCase
-	userUpdateUseCase     uc_user.UserUpdateUseCase
+	config                            *config.Configuration
+	logger                            *slog.Logger
+	passwordProvider                  password.Provider
+	cache                             mongodbcache.Cacher
+	dmutex                            distributedmutex.Adapter
+	jwtProvider                       jwt.Provider
+	userGetByEmailUseCase             uc_user.UserGetByEmailUseCase
+	userUpdateUseCase                 uc_user.UserUpdateUseCase
+	sendUserAccountUnlockEmailUseCase uc_emailer.SendUserAccountUnlockEmailUseCase
+	sessionCreateUseCase              uc_session.SessionCreateUseCase
+	sessionRevokeAllByUserIDUseCase   uc_session.SessionRevokeAllByUserIDUseCase
+
+	// dummyPasswordHash is compared against when the email is unknown so
+	// that the response takes as long as for a known email, otherwise the
+	// timing reveals which email addresses have accounts.
+	dummyPasswordHash string
 }
 
 func NewGatewayLoginService(
+	cfg *config.Configuration,
 	logger *slog.Logger,
 	pp password.Provider,
 	cach mongodbcache.Cacher,
+	dmutex distributedmutex.Adapter,
 	jwtp jwt.Provider,
 	uc1 uc_user.UserGetByEmailUseCase,
 	uc2 uc_user.UserUpdateUseCase,
+	uc3 uc_emailer.SendUserAccountUnlockEmailUseCase,
+	uc4 uc_session.SessionCreateUseCase,
+	uc5 uc_session.SessionRevokeAllByUserIDUseCase,
 ) GatewayLoginService {
-	return &gatewayLoginServiceImpl{logger, pp, cach, jwtp, uc1, uc2}
+	return &gatewayLoginServiceImpl{cfg, logger, pp, cach, dmutex, jwtp, uc1, uc2, uc3, uc4, uc5, newDummyPasswordHash(logger, pp)}
+}
+
+// newDummyPasswordHash returns the hash of a random password generated by
+// the same provider, and therefore with the same cost, as the hashes of the
+// passwords of our users.
+func newDummyPasswordHash(logger *slog.Logger, pp password.Provider) string {
+	randomPassword, err := pp.GenerateSecureRandomString(32)
+	if err != nil {
+		logger.Error("failed generating dummy password", slog.Any("err", err))
+		return ""
+	}
+	securePassword, err := sstring.NewSecureString(randomPassword)
+	if err != nil {
+		logger.Error("failed securing dummy password", slog.Any("err", err))
+		return ""
+	}
+	defer securePassword.Wipe()
+	hash, err := pp.GenerateHashFromPassword(securePassword)
+	if err != nil {
+		logger.Error("failed hashing dummy password", slog.Any("err", err))
+		return ""
+	}
+	return hash
 }
 
 type GatewayLoginRequestIDO struct {
@@ -53,6 +99,13 @@ type GatewayLoginResponseIDO struct {
 	AccessTokenExpiryTime  time.Time    `json:"access_token_expiry_time"`
 	RefreshToken           string       `json:"refresh_token"`
 	RefreshTokenExpiryTime time.Time    `json:"refresh_token_expiry_time"`
+
+	// OTPRequired is set when the user has 2FA enabled, in which case no
+	// session is started until the `OTPToken` is posted together with a code
+	// to `GatewayLoginOTPService`.
+	OTPRequired        bool      `json:"otp_required,omitempty"`
+	OTPToken           string    `json:"otp_token,omitempty"`
+	OTPTokenExpiryTime time.Time `json:"otp_token_expiry_time,omitempty"`
 }
 
 func (s *gatewayLoginServiceImpl) Execute(sessCtx mongo.SessionContext, req *GatewayLoginRequestIDO) (*GatewayLoginResponseIDO, error) {
@@ -88,7 +141,19 @@ func (s *gatewayLoginServiceImpl) Execute(sessCtx mongo.SessionContext, req *Gat
 	}
 
 	//
-	// STEP 3:
+	// STEP 3: Throttle by IP address.
+	//
+
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+	ipKey := loginFailuresIPCacheKey(ipAddress)
+	if getLoginFailures(sessCtx, s.cache, ipKey).Count >= s.config.IAM.LoginMaxFailedAttemptsPerIP {
+		s.logger.Warn("too many failed logins from ip address",
+			slog.String("ip_address", ipAddress))
+		return nil, httperror.New(http.StatusTooManyRequests, &map[string]string{"non_field_error": "Too many failed login attempts, please try again later"})
+	}
+
+	//
+	// STEP 4:
 	//
 
 	// Lookup the user in our database, else return a `400 Bad Request` error.
@@ -99,14 +164,6 @@ func (s *gatewayLoginServiceImpl) Execute(sessCtx mongo.SessionContext, req *Gat
 			slog.Any("err", err))
 		return nil, err
 	}
-	if u == nil {
-		s.logger.Warn("user does not exist validation error",
-			slog.String("email", req.Email))
-		return nil, httperror.NewForBadRequestWithSingleField("email", "Email address does not exist")
-	}
-
-	s.logger.Debug("attempting to confirm correct password submission for the existing user...",
-		slog.String("email", req.Email))
 
 	securePassword, err := sstring.NewSecureString(req.Password)
 	if err != nil {
@@ -117,6 +174,22 @@ func (s *gatewayLoginServiceImpl) Execute(sessCtx mongo.SessionContext, req *Gat
 	}
 	defer securePassword.Wipe()
 
+	if u == nil {
+		// Pay for the same password hashing as for an existing user so the
+		// response time does not reveal the email address is unknown.
+		_, _ = s.passwordProvider.ComparePasswordAndHash(securePassword, s.dummyPasswordHash)
+
+		s.logger.Warn("user does not exist validation error",
+			slog.String("email", req.Email))
+		if _, err := recordLoginFailure(sessCtx, s.cache, s.dmutex, ipKey, s.config.IAM.LoginFailedAttemptsWindow); err != nil {
+			s.logger.Error("failed recording login failure", slog.Any("err", err))
+		}
+		return nil, errLoginFailed()
+	}
+
+	s.logger.Debug("attempting to confirm correct password submission for the existing user...",
+		slog.String("email", req.Email))
+
 	s.logger.Debug("attempting to compare password hashes...",
 		slog.String("email", req.Email))
 
@@ -125,7 +198,21 @@ func (s *gatewayLoginServiceImpl) Execute(sessCtx mongo.SessionContext, req *Gat
 	if passwordMatch == false {
 		s.logger.Warn("password check validation error",
 			slog.String("email", req.Email))
-		return nil, httperror.NewForBadRequestWithSingleField("password", "Password does not match with record")
+		if err := s.recordPasswordFailure(sessCtx, u, ipKey); err != nil {
+			return nil, err
+		}
+		return nil, errLoginFailed()
+	}
+
+	// Only tell the user about the lock once they proved they know the
+	// password, otherwise the lock reveals the account exists.
+	if u.Status == domain.UserStatusLocked {
+		s.logger.Warn("locked account login attempt",
+			slog.String("email", req.Email))
+		return nil, httperror.NewForLockedWithSingleField("non_field_error", "Your account is locked after too many failed login attempts, please follow the unlock link we emailed you")
+	}
+	if err := s.cache.Delete(sessCtx, loginFailuresUserCacheKey(u.ID)); err != nil {
+		s.logger.Error("failed clearing login failures", slog.Any("err", err))
 	}
 
 	s.logger.Debug("attempting to confirm existing user has a verified email address...",
@@ -153,17 +240,113 @@ func (s *gatewayLoginServiceImpl) Execute(sessCtx mongo.SessionContext, req *Gat
 				slog.Any("err", err))
 			return nil, err
 		}
+
+		return s.requireOTP(sessCtx, u)
 	}
 
-	return s.loginWithUser(sessCtx, u)
+	return loginWithUser(sessCtx, s.logger, s.cache, s.jwtProvider, s.sessionCreateUseCase, u)
 }
 
-func (s *gatewayLoginServiceImpl) loginWithUser(sessCtx mongo.SessionContext, u *domain.User) (*GatewayLoginResponseIDO, error) {
-	uBin, err := json.Marshal(u)
+// recordPasswordFailure counts the wrong password against the user and the
+// IP address and locks the account once the user reaches the threshold.
+func (s *gatewayLoginServiceImpl) recordPasswordFailure(sessCtx mongo.SessionContext, u *domain.User, ipKey string) error {
+	if _, err := recordLoginFailure(sessCtx, s.cache, s.dmutex, ipKey, s.config.IAM.LoginFailedAttemptsWindow); err != nil {
+		s.logger.Error("failed recording login failure", slog.Any("err", err))
+	}
+	if u.Status == domain.UserStatusLocked {
+		return nil
+	}
+	userKey := loginFailuresUserCacheKey(u.ID)
+	count, err := recordLoginFailure(sessCtx, s.cache, s.dmutex, userKey, s.config.IAM.LoginFailedAttemptsWindow)
+	if err != nil {
+		s.logger.Error("failed recording login failure", slog.Any("err", err))
+		return nil
+	}
+	if count < s.config.IAM.LoginMaxFailedAttempts {
+		return nil
+	}
+
+	//
+	// Lock the account.
+	//
+
+	unlockCode, err := s.passwordProvider.GenerateSecureRandomString(32)
+	if err != nil {
+		s.logger.Error("failed generating unlock code", slog.Any("err", err))
+		return err
+	}
+	now := time.Now()
+	u.Status = domain.UserStatusLocked
+	u.LockedAt = now
+	u.UnlockCode = unlockCode
+	u.UnlockCodeExpiry = now.Add(unlockCodeExpiry)
+	u.ModifiedAt = now
+
+	// DEVELOPERS NOTE:
+	// The login fails so the mongodb transaction of the request gets aborted,
+	// therefore we must lock the account outside of the transaction.
+	ctx := context.Background()
+	if err := s.userUpdateUseCase.Execute(ctx, u); err != nil {
+		s.logger.Error("failed locking user",
+			slog.String("email", u.Email),
+			slog.Any("err", err))
+		return err
+	}
+	if err := s.cache.Delete(ctx, userKey); err != nil {
+		s.logger.Error("failed clearing login failures", slog.Any("err", err))
+	}
+	if _, err := s.sessionRevokeAllByUserIDUseCase.Execute(ctx, u.ID); err != nil {
+		s.logger.Error("failed revoking sessions of locked user", slog.Any("err", err))
+		return err
+	}
+	s.logger.Warn("locked user after too many failed logins",
+		slog.String("email", u.Email),
+		slog.Int("failed_attempts", count))
+
+	if err := s.sendUserAccountUnlockEmailUseCase.Execute(ctx, u); err != nil {
+		s.logger.Error("failed sending account unlock email", slog.Any("err", err))
+		// Skip any error handling, an administrator can still unlock the user.
+	}
+	return nil
+}
+
+// requireOTP issues the short-lived token which the user must submit with
+// their code from their authenticator app to finish logging in.
+func (s *gatewayLoginServiceImpl) requireOTP(sessCtx mongo.SessionContext, u *domain.User) (*GatewayLoginResponseIDO, error) {
+	otpToken, err := s.passwordProvider.GenerateSecureRandomString(32)
+	if err != nil {
+		s.logger.Error("failed generating otp token", slog.Any("err", err))
+		return nil, err
+	}
+	pending := &pendingOTPLogin{UserID: u.ID, ExpiresAt: time.Now().Add(otpLoginExpiry)}
+	pendingBin, err := json.Marshal(pending)
 	if err != nil {
 		s.logger.Error("marshalling error", slog.Any("err", err))
 		return nil, err
 	}
+	if err := s.cache.SetWithExpiry(sessCtx, otpLoginCacheKey(otpToken), pendingBin, otpLoginExpiry); err != nil {
+		s.logger.Error("cache set with expiry error", slog.Any("err", err))
+		return nil, err
+	}
+
+	s.logger.Debug("login requires otp",
+		slog.String("email", u.Email))
+
+	return &GatewayLoginResponseIDO{
+		OTPRequired:        true,
+		OTPToken:           otpToken,
+		OTPTokenExpiryTime: pending.ExpiresAt,
+	}, nil
+}
+
+// loginWithUser starts the session of the user and returns the access and
+// refresh tokens of the session.
+func loginWithUser(sessCtx mongo.SessionContext, logger *slog.Logger, cache mongodbcache.Cacher, jwtProvider jwt.Provider, sessionCreateUseCase uc_session.SessionCreateUseCase, u *domain.User) (*GatewayLoginResponseIDO, error) {
+	uBin, err := json.Marshal(u)
+	if err != nil {
+		logger.Error("marshalling error", slog.Any("err", err))
+		return nil, err
+	}
 
 	// Set expiry duration.
 	atExpiry := 5 * time.Minute     // 5 minutes
@@ -172,21 +355,41 @@ func (s *gatewayLoginServiceImpl) loginWithUser(sessCtx mongo.SessionContext, u
 	// Start our session using an access and refresh token.
 	sessionUUID := primitive.NewObjectID().Hex()
 
-	err = s.cache.SetWithExpiry(sessCtx, sessionUUID, uBin, rtExpiry)
+	err = cache.SetWithExpiry(sessCtx, sessionUUID, uBin, rtExpiry)
 	if err != nil {
-		s.logger.Error("cache set with expiry error", slog.Any("err", err))
+		logger.Error("cache set with expiry error", slog.Any("err", err))
+		return nil, err
+	}
+
+	// Index the session so the user can see where they are logged in.
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+	userAgent, _ := sessCtx.Value(constants.SessionUserAgent).(string)
+	now := time.Now()
+	sess := &dom_session.Session{
+		ID:         primitive.NewObjectID(),
+		UserID:     u.ID,
+		SessionID:  sessionUUID,
+		Device:     dom_session.DeviceFromUserAgent(userAgent),
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(rtExpiry),
+	}
+	if err := sessionCreateUseCase.Execute(sessCtx, sess); err != nil {
+		logger.Error("session create error", slog.Any("err", err))
 		return nil, err
 	}
 
 	// Generate our JWT token.
-	accessToken, accessTokenExpiry, refreshToken, refreshTokenExpiry, err := s.jwtProvider.GenerateJWTTokenPair(sessionUUID, atExpiry, rtExpiry)
+	accessToken, accessTokenExpiry, refreshToken, refreshTokenExpiry, err := jwtProvider.GenerateJWTTokenPair(sessionUUID, atExpiry, rtExpiry)
 	if err != nil {
-		s.logger.Error("jwt generate pairs error", slog.Any("err", err))
+		logger.Error("jwt generate pairs error", slog.Any("err", err))
 		return nil, err
 	}
 
 	// For debugging purposes we want to print the wallet address.
-	s.logger.Debug("login successfull",
+	logger.Debug("login successfull",
 		slog.Any("wallet_address", u.WalletAddress))
 
 	// Return our auth keys.
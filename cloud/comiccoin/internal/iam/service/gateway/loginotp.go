@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+	uc_otp "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/otp"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+const (
+	// otpLoginExpiry is how long the user has to submit their code after
+	// submitting their password.
+	otpLoginExpiry = 5 * time.Minute
+
+	// otpLoginMaxAttempts is how many wrong codes are accepted before the
+	// user must submit their password again.
+	otpLoginMaxAttempts = 5
+)
+
+// pendingOTPLogin is saved in the cache between the password step and the
+// code step of logging in.
+type pendingOTPLogin struct {
+	UserID    primitive.ObjectID `json:"user_id"`
+	Attempts  int                `json:"attempts"`
+	ExpiresAt time.Time          `json:"expires_at"`
+}
+
+func otpLoginCacheKey(otpToken string) string {
+	return "otp_login:" + otpToken
+}
+
+type GatewayLoginOTPService interface {
+	Execute(sessCtx mongo.SessionContext, req *GatewayLoginOTPRequestIDO) (*GatewayLoginResponseIDO, error)
+}
+
+type gatewayLoginOTPServiceImpl struct {
+	logger               *slog.Logger
+	cache                mongodbcache.Cacher
+	jwtProvider          jwt.Provider
+	userGetByIDUseCase   uc_user.UserGetByIDUseCase
+	userUpdateUseCase    uc_user.UserUpdateUseCase
+	otpVerifyUseCase     uc_otp.OTPVerifyUseCase
+	sessionCreateUseCase uc_session.SessionCreateUseCase
+}
+
+func NewGatewayLoginOTPService(
+	logger *slog.Logger,
+	cach mongodbcache.Cacher,
+	jwtp jwt.Provider,
+	uc1 uc_user.UserGetByIDUseCase,
+	uc2 uc_user.UserUpdateUseCase,
+	uc3 uc_otp.OTPVerifyUseCase,
+	uc4 uc_session.SessionCreateUseCase,
+) GatewayLoginOTPService {
+	return &gatewayLoginOTPServiceImpl{logger, cach, jwtp, uc1, uc2, uc3, uc4}
+}
+
+type GatewayLoginOTPRequestIDO struct {
+	OTPToken string `json:"otp_token"`
+
+	// Code is either a code from the authenticator app or a backup code.
+	Code string `json:"code"`
+}
+
+func (s *gatewayLoginOTPServiceImpl) Execute(sessCtx mongo.SessionContext, req *GatewayLoginOTPRequestIDO) (*GatewayLoginResponseIDO, error) {
+	//
+	// STEP 1: Sanization and validation of input.
+	//
+
+	req.OTPToken = strings.TrimSpace(req.OTPToken)
+	req.Code = strings.ReplaceAll(strings.TrimSpace(req.Code), " ", "")
+
+	e := make(map[string]string)
+	if req.OTPToken == "" {
+		e["otp_token"] = "OTP token is required"
+	}
+	if req.Code == "" {
+		e["code"] = "Code is required"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validation login otp",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Lookup the pending login.
+	//
+
+	key := otpLoginCacheKey(req.OTPToken)
+	pendingBin, err := s.cache.Get(sessCtx, key)
+	if err != nil || len(pendingBin) == 0 {
+		s.logger.Warn("otp token does not exist or expired")
+		return nil, httperror.NewForBadRequestWithSingleField("otp_token", "OTP token expired, please log in again")
+	}
+	var pending pendingOTPLogin
+	if err := json.Unmarshal(pendingBin, &pending); err != nil {
+		s.logger.Error("unmarshalling error", slog.Any("err", err))
+		return nil, err
+	}
+	if time.Now().After(pending.ExpiresAt) {
+		_ = s.cache.Delete(sessCtx, key)
+		return nil, httperror.NewForBadRequestWithSingleField("otp_token", "OTP token expired, please log in again")
+	}
+
+	u, err := s.userGetByIDUseCase.Execute(sessCtx, pending.UserID)
+	if err != nil {
+		s.logger.Error("database error", slog.Any("err", err))
+		return nil, err
+	}
+	if u == nil || !u.OTPEnabled {
+		// The account was deleted or 2FA was turned off in the meantime.
+		_ = s.cache.Delete(sessCtx, key)
+		return nil, httperror.NewForBadRequestWithSingleField("otp_token", "OTP token expired, please log in again")
+	}
+
+	//
+	// STEP 3: Verify the code.
+	//
+
+	match, err := s.otpVerifyUseCase.Execute(sessCtx, u, req.Code, true)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		// DEVELOPERS NOTE:
+		// The cache is not part of the mongodb transaction so the attempt
+		// is counted even though this request fails.
+		pending.Attempts++
+		if pending.Attempts >= otpLoginMaxAttempts {
+			_ = s.cache.Delete(sessCtx, key)
+			s.logger.Warn("too many wrong otp codes",
+				slog.String("email", u.Email))
+			return nil, httperror.NewForBadRequestWithSingleField("otp_token", "Too many incorrect codes, please log in again")
+		}
+		if pendingBin, err = json.Marshal(&pending); err == nil {
+			_ = s.cache.SetWithExpiry(sessCtx, key, pendingBin, time.Until(pending.ExpiresAt))
+		}
+		s.logger.Warn("wrong otp code",
+			slog.String("email", u.Email))
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Code is incorrect or expired")
+	}
+
+	//
+	// STEP 4: Finish logging in.
+	//
+
+	if err := s.cache.Delete(sessCtx, key); err != nil {
+		return nil, err
+	}
+
+	// Save the validation and, if a backup code was used, remove it.
+	u.OTPValidated = true
+	u.ModifiedAt = time.Now()
+	if err := s.userUpdateUseCase.Execute(sessCtx, u); err != nil {
+		s.logger.Error("failed updating user during login",
+			slog.String("email", u.Email),
+			slog.Any("err", err))
+		return nil, err
+	}
+
+	return loginWithUser(sessCtx, s.logger, s.cache, s.jwtProvider, s.sessionCreateUseCase, u)
+}
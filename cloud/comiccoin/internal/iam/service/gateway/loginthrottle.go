@@ -0,0 +1,85 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/distributedmutex"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+)
+
+// unlockCodeExpiry is how long the emailed unlock link works, afterwards an
+// administrator must unlock the account.
+const unlockCodeExpiry = 24 * time.Hour
+
+// loginFailures counts the failed logins of a user or of an IP address.
+//
+// DEVELOPERS NOTE:
+// The cache is not part of the mongodb transaction of the request so the
+// failures are remembered even though the login request fails.
+type loginFailures struct {
+	Count     int       `json:"count"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func loginFailuresUserCacheKey(userID primitive.ObjectID) string {
+	return "login_failures:user:" + userID.Hex()
+}
+
+func loginFailuresIPCacheKey(ipAddress string) string {
+	return "login_failures:ip:" + ipAddress
+}
+
+// errLoginFailed is the one error returned for an unknown email and for a
+// wrong password so the login cannot be used to find out which email
+// addresses have accounts.
+func errLoginFailed() error {
+	return httperror.NewForBadRequestWithSingleField("non_field_error", "Incorrect email or password")
+}
+
+// getLoginFailures returns the failures saved under the key, or none if
+// nothing was saved or the failures expired.
+func getLoginFailures(ctx context.Context, cache mongodbcache.Cacher, key string) *loginFailures {
+	bin, err := cache.Get(ctx, key)
+	if err != nil || len(bin) == 0 {
+		return &loginFailures{}
+	}
+	var failures loginFailures
+	if err := json.Unmarshal(bin, &failures); err != nil || time.Now().After(failures.ExpiresAt) {
+		return &loginFailures{}
+	}
+	return &failures
+}
+
+// recordLoginFailure adds a failure under the key and returns the number of
+// failures within the window, the window starts with the first failure.
+//
+// DEVELOPERS NOTE:
+// Our cache does not support an atomic increment so this is a get-then-set.
+// Without the lock every parallel failed login for the key would read the
+// same count and write back the same count plus one, so an attacker could
+// make as many guesses as requests they run in parallel before the lockout.
+// The lock is held across our instances so the failures are counted one
+// after the other.
+func recordLoginFailure(ctx context.Context, cache mongodbcache.Cacher, dmutex distributedmutex.Adapter, key string, window time.Duration) (int, error) {
+	dmutex.Acquire(ctx, key)
+	defer dmutex.Release(ctx, key)
+
+	failures := getLoginFailures(ctx, cache, key)
+	if failures.Count == 0 {
+		failures.ExpiresAt = time.Now().Add(window)
+	}
+	failures.Count++
+	bin, err := json.Marshal(failures)
+	if err != nil {
+		return 0, err
+	}
+	if err := cache.SetWithExpiry(ctx, key, bin, time.Until(failures.ExpiresAt)); err != nil {
+		return 0, err
+	}
+	return failures.Count, nil
+}
@@ -0,0 +1,304 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	sbytes "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securebytes"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+// fakeMutex holds a lock per key within the test process, like the
+// distributed mutex does across our instances.
+type fakeMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newFakeMutex() *fakeMutex {
+	return &fakeMutex{locks: make(map[string]*sync.Mutex)}
+}
+
+func (m *fakeMutex) lock(key string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.locks[key]; !ok {
+		m.locks[key] = &sync.Mutex{}
+	}
+	return m.locks[key]
+}
+
+func (m *fakeMutex) Acquire(ctx context.Context, key string) { m.lock(key).Lock() }
+
+func (m *fakeMutex) Acquiref(ctx context.Context, format string, a ...any) {
+	m.Acquire(ctx, fmt.Sprintf(format, a...))
+}
+
+func (m *fakeMutex) Release(ctx context.Context, key string) { m.lock(key).Unlock() }
+
+func (m *fakeMutex) Releasef(ctx context.Context, format string, a ...any) {
+	m.Release(ctx, fmt.Sprintf(format, a...))
+}
+
+type fakeSendUserAccountUnlockEmailUseCase struct {
+	mu          sync.Mutex
+	unlockCodes []string
+}
+
+func (uc *fakeSendUserAccountUnlockEmailUseCase) Execute(ctx context.Context, user *dom_user.User) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.unlockCodes = append(uc.unlockCodes, user.UnlockCode)
+	return nil
+}
+
+type fakeSessionCreateUseCase struct{ *fakeSessionRepository }
+
+func (uc fakeSessionCreateUseCase) Execute(ctx context.Context, session *dom_session.Session) error {
+	uc.sessions = append(uc.sessions, session)
+	return nil
+}
+
+type fakeUserGetByUnlockCodeUseCase struct{ *fakeUsers }
+
+func (uc fakeUserGetByUnlockCodeUseCase) Execute(ctx context.Context, unlockCode string) (*dom_user.User, error) {
+	return uc.find(func(u *dom_user.User) bool { return u.UnlockCode == unlockCode }), nil
+}
+
+const (
+	testLoginEmail    = "reader@example.com"
+	testLoginPassword = "the-correct-password-123"
+)
+
+// loginTestEnv holds the login service and the fakes behind it.
+type loginTestEnv struct {
+	cfg         *config.Configuration
+	users       *fakeUsers
+	userID      primitive.ObjectID
+	sessionRepo *fakeSessionRepository
+	emailer     *fakeSendUserAccountUnlockEmailUseCase
+	login       GatewayLoginService
+	unlock      GatewayUnlockAccountService
+}
+
+func newLoginTestEnv(t *testing.T, maxFailedAttempts, maxFailedAttemptsPerIP int) *loginTestEnv {
+	t.Helper()
+	logger := newTestLogger()
+	passp := password.NewProvider()
+
+	securePassword, err := sstring.NewSecureString(testLoginPassword)
+	if err != nil {
+		t.Fatalf("failed securing password: %v", err)
+	}
+	defer securePassword.Wipe()
+	passwordHash, err := passp.GenerateHashFromPassword(securePassword)
+	if err != nil {
+		t.Fatalf("failed hashing password: %v", err)
+	}
+	hmacSecret, err := sbytes.NewSecureBytes([]byte("a-secret-for-signing-test-tokens"))
+	if err != nil {
+		t.Fatalf("failed securing hmac secret: %v", err)
+	}
+
+	cfg := &config.Configuration{}
+	cfg.App.AdministrationHMACSecret = hmacSecret
+	cfg.IAM.LoginMaxFailedAttempts = maxFailedAttempts
+	cfg.IAM.LoginMaxFailedAttemptsPerIP = maxFailedAttemptsPerIP
+	cfg.IAM.LoginFailedAttemptsWindow = 15 * time.Minute
+
+	env := &loginTestEnv{
+		cfg:     cfg,
+		userID:  primitive.NewObjectID(),
+		emailer: &fakeSendUserAccountUnlockEmailUseCase{},
+	}
+	env.users = &fakeUsers{users: []*dom_user.User{{
+		ID:               env.userID,
+		Email:            testLoginEmail,
+		PasswordHash:     passwordHash,
+		WasEmailVerified: true,
+		Status:           dom_user.UserStatusActive,
+	}}}
+	env.sessionRepo = &fakeSessionRepository{}
+
+	cache := newFakeCache()
+	revokeAll := uc_session.NewSessionRevokeAllByUserIDUseCase(cfg, logger, cache, env.sessionRepo, newFakeTokenStore())
+	env.login = NewGatewayLoginService(
+		cfg,
+		logger,
+		passp,
+		cache,
+		newFakeMutex(),
+		jwt.NewProvider(cfg),
+		fakeUserGetByEmailUseCase{env.users},
+		fakeUserUpdateUseCase{env.users},
+		env.emailer,
+		fakeSessionCreateUseCase{env.sessionRepo},
+		revokeAll,
+	)
+	env.unlock = NewGatewayUnlockAccountService(
+		logger,
+		fakeUserGetByUnlockCodeUseCase{env.users},
+		fakeUserUpdateUseCase{env.users},
+	)
+	return env
+}
+
+func newTestSessionContextFromIP(ipAddress string) mongo.SessionContext {
+	return &testSessionContext{Context: context.WithValue(context.Background(), constants.SessionIPAddress, ipAddress)}
+}
+
+func (env *loginTestEnv) loginFrom(ipAddress, email, password string) (*GatewayLoginResponseIDO, error) {
+	return env.login.Execute(newTestSessionContextFromIP(ipAddress), &GatewayLoginRequestIDO{
+		Email:    email,
+		Password: password,
+	})
+}
+
+func (env *loginTestEnv) user() *dom_user.User {
+	return env.users.find(func(u *dom_user.User) bool { return u.ID == env.userID })
+}
+
+func requireHTTPErrorCode(t *testing.T, err error, code int) {
+	t.Helper()
+	var httpErr httperror.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Code != code {
+		t.Fatalf("expected a %d error but got %v", code, err)
+	}
+}
+
+func TestRecordLoginFailureCountsParallelFailures(t *testing.T) {
+	cache := newFakeCache()
+	dmutex := newFakeMutex()
+	key := loginFailuresUserCacheKey(primitive.NewObjectID())
+
+	const parallel = 50
+	var wg sync.WaitGroup
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := recordLoginFailure(context.Background(), cache, dmutex, key, time.Minute); err != nil {
+				t.Errorf("failed recording login failure: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count := getLoginFailures(context.Background(), cache, key).Count; count != parallel {
+		t.Fatalf("expected %d failures but got %d", parallel, count)
+	}
+}
+
+func TestGatewayLoginLocksAccountAfterMaxFailedAttempts(t *testing.T) {
+	env := newLoginTestEnv(t, 3, 100)
+	env.sessionRepo.sessions = []*dom_session.Session{{ID: primitive.NewObjectID(), UserID: env.userID, SessionID: "session-1"}}
+
+	for i := 0; i < 3; i++ {
+		_, err := env.loginFrom("203.0.113.7", testLoginEmail, "a-wrong-password")
+		requireHTTPErrorCode(t, err, http.StatusBadRequest)
+	}
+
+	u := env.user()
+	if u.Status != dom_user.UserStatusLocked {
+		t.Fatalf("expected the user to be locked but the status is %v", u.Status)
+	}
+	if len(env.emailer.unlockCodes) != 1 || env.emailer.unlockCodes[0] != u.UnlockCode {
+		t.Fatalf("expected one unlock email with the unlock code but got %v", env.emailer.unlockCodes)
+	}
+	if len(env.sessionRepo.sessions) != 0 {
+		t.Fatalf("expected the sessions to be revoked but %d remain", len(env.sessionRepo.sessions))
+	}
+
+	// Even the correct password must not log in to the locked account.
+	_, err := env.loginFrom("203.0.113.7", testLoginEmail, testLoginPassword)
+	requireHTTPErrorCode(t, err, http.StatusLocked)
+}
+
+func TestGatewayLoginThrottlesIPAddress(t *testing.T) {
+	env := newLoginTestEnv(t, 100, 3)
+
+	for i := 0; i < 3; i++ {
+		_, err := env.loginFrom("203.0.113.7", fmt.Sprintf("unknown%d@example.com", i), "a-wrong-password")
+		requireHTTPErrorCode(t, err, http.StatusBadRequest)
+	}
+
+	// The IP address is throttled even for the correct password...
+	_, err := env.loginFrom("203.0.113.7", testLoginEmail, testLoginPassword)
+	requireHTTPErrorCode(t, err, http.StatusTooManyRequests)
+
+	// ...while other IP addresses may still log in.
+	if _, err := env.loginFrom("198.51.100.1", testLoginEmail, testLoginPassword); err != nil {
+		t.Fatalf("expected the login from another ip address to succeed but got %v", err)
+	}
+	if u := env.user(); u.Status != dom_user.UserStatusActive {
+		t.Fatalf("expected the ip address limit to leave the user active but the status is %v", u.Status)
+	}
+}
+
+func TestGatewayUnlockAccountByEmailedCode(t *testing.T) {
+	env := newLoginTestEnv(t, 3, 100)
+	for i := 0; i < 3; i++ {
+		if _, err := env.loginFrom("203.0.113.7", testLoginEmail, "a-wrong-password"); err == nil {
+			t.Fatal("expected the login to fail")
+		}
+	}
+	if len(env.emailer.unlockCodes) != 1 {
+		t.Fatalf("expected one unlock email but got %d", len(env.emailer.unlockCodes))
+	}
+
+	_, err := env.unlock.Execute(newTestSessionContext(), &GatewayUnlockAccountRequestIDO{Code: "not-the-emailed-code"})
+	requireHTTPErrorCode(t, err, http.StatusBadRequest)
+
+	if _, err := env.unlock.Execute(newTestSessionContext(), &GatewayUnlockAccountRequestIDO{Code: env.emailer.unlockCodes[0]}); err != nil {
+		t.Fatalf("failed unlocking account: %v", err)
+	}
+	u := env.user()
+	if u.Status != dom_user.UserStatusActive || u.UnlockCode != "" {
+		t.Fatalf("expected the user to be unlocked but got status %v", u.Status)
+	}
+
+	// The unlock code only works once.
+	_, err = env.unlock.Execute(newTestSessionContext(), &GatewayUnlockAccountRequestIDO{Code: env.emailer.unlockCodes[0]})
+	requireHTTPErrorCode(t, err, http.StatusBadRequest)
+
+	res, err := env.loginFrom("203.0.113.7", testLoginEmail, testLoginPassword)
+	if err != nil || res.AccessToken == "" {
+		t.Fatalf("expected the login to succeed after unlocking but got %v", err)
+	}
+}
+
+func TestGatewayUnlockAccountRejectsExpiredCode(t *testing.T) {
+	env := newLoginTestEnv(t, 3, 100)
+	for i := 0; i < 3; i++ {
+		if _, err := env.loginFrom("203.0.113.7", testLoginEmail, "a-wrong-password"); err == nil {
+			t.Fatal("expected the login to fail")
+		}
+	}
+	u := env.user()
+	u.UnlockCodeExpiry = time.Now().Add(-time.Minute)
+	if err := (fakeUserUpdateUseCase{env.users}).Execute(context.Background(), u); err != nil {
+		t.Fatalf("failed updating user: %v", err)
+	}
+
+	_, err := env.unlock.Execute(newTestSessionContext(), &GatewayUnlockAccountRequestIDO{Code: u.UnlockCode})
+	requireHTTPErrorCode(t, err, http.StatusBadRequest)
+	if u := env.user(); u.Status != dom_user.UserStatusLocked {
+		t.Fatalf("expected the user to stay locked but the status is %v", u.Status)
+	}
+}
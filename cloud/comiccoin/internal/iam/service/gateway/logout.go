@@ -7,6 +7,7 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
 )
 
 type GatewayLogoutService interface {
@@ -14,15 +15,19 @@ type GatewayLogoutService interface {
 }
 
 type gatewayLogoutServiceImpl struct {
-	logger *slog.Logger
-	cache  mongodbcache.Cacher
+	logger                       *slog.Logger
+	cache                        mongodbcache.Cacher
+	sessionGetBySessionIDUseCase uc_session.SessionGetBySessionIDUseCase
+	sessionRevokeUseCase         uc_session.SessionRevokeUseCase
 }
 
 func NewGatewayLogoutService(
 	logger *slog.Logger,
 	cach mongodbcache.Cacher,
+	uc1 uc_session.SessionGetBySessionIDUseCase,
+	uc2 uc_session.SessionRevokeUseCase,
 ) GatewayLogoutService {
-	return &gatewayLogoutServiceImpl{logger, cach}
+	return &gatewayLogoutServiceImpl{logger, cach, uc1, uc2}
 }
 
 func (s *gatewayLogoutServiceImpl) Execute(ctx context.Context) error {
@@ -33,6 +38,16 @@ func (s *gatewayLogoutServiceImpl) Execute(ctx context.Context) error {
 		return httperror.NewForBadRequestWithSingleField("session_id", "not logged in")
 	}
 
+	// Remove the session from the index as well, if it was indexed.
+	sess, err := s.sessionGetBySessionIDUseCase.Execute(ctx, sessionID)
+	if err != nil {
+		s.logger.Error("session get error", slog.Any("err", err))
+		return err
+	}
+	if sess != nil {
+		return s.sessionRevokeUseCase.Execute(ctx, sess)
+	}
+
 	if err := s.cache.Delete(ctx, sessionID); err != nil {
 		s.logger.Error("cache delete error", slog.Any("err", err))
 		return err
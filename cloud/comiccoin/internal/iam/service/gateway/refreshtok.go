@@ -9,9 +9,12 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/jwt"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
 	domain "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
 )
 
@@ -27,6 +30,10 @@ type gatewayRefreshTokenServiceImpl struct {
 	cache                 mongodbcache.Cacher
 	jwtProvider           jwt.Provider
 	userGetByEmailUseCase uc_user.UserGetByEmailUseCase
+
+	sessionGetBySessionIDUseCase uc_session.SessionGetBySessionIDUseCase
+	sessionCreateUseCase         uc_session.SessionCreateUseCase
+	sessionUpdateUseCase         uc_session.SessionUpdateUseCase
 }
 
 func NewGatewayRefreshTokenService(
@@ -34,8 +41,11 @@ func NewGatewayRefreshTokenService(
 	cach mongodbcache.Cacher,
 	jwtp jwt.Provider,
 	uc1 uc_user.UserGetByEmailUseCase,
+	uc2 uc_session.SessionGetBySessionIDUseCase,
+	uc3 uc_session.SessionCreateUseCase,
+	uc4 uc_session.SessionUpdateUseCase,
 ) GatewayRefreshTokenService {
-	return &gatewayRefreshTokenServiceImpl{logger, cach, jwtp, uc1}
+	return &gatewayRefreshTokenServiceImpl{logger, cach, jwtp, uc1, uc2, uc3, uc4}
 }
 
 type GatewayRefreshTokenRequestIDO struct {
@@ -100,6 +110,18 @@ func (s *gatewayRefreshTokenServiceImpl) Execute(
 		return nil, err
 	}
 
+	// DEVELOPERS NOTE:
+	// The old session data is deleted so every refresh token can only be
+	// used once, otherwise revoking the session would leave the older
+	// refresh tokens working.
+	if err := s.cache.Delete(sessCtx, sessionID); err != nil {
+		s.logger.Error("cache delete error", slog.Any("err", err))
+		return nil, err
+	}
+	if err := s.rotateSession(sessCtx, u, sessionID, newSessionUUID, rtExpiry); err != nil {
+		return nil, err
+	}
+
 	// Generate our JWT token.
 	accessToken, accessTokenExpiry, refreshToken, refreshTokenExpiry, err := s.jwtProvider.GenerateJWTTokenPair(newSessionUUID, atExpiry, rtExpiry)
 	if err != nil {
@@ -118,3 +140,49 @@ func (s *gatewayRefreshTokenServiceImpl) Execute(
 	// Return our auth keys.
 	return ido, nil
 }
+
+// rotateSession points the session index to the new session data, sessions
+// started before we kept an index get indexed now.
+func (s *gatewayRefreshTokenServiceImpl) rotateSession(sessCtx mongo.SessionContext, u *domain.User, oldSessionID string, newSessionID string, expiry time.Duration) error {
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+	userAgent, _ := sessCtx.Value(constants.SessionUserAgent).(string)
+	now := time.Now()
+
+	sess, err := s.sessionGetBySessionIDUseCase.Execute(sessCtx, oldSessionID)
+	if err != nil {
+		s.logger.Error("session get error", slog.Any("err", err))
+		return err
+	}
+	if sess == nil {
+		sess = &dom_session.Session{
+			ID:         primitive.NewObjectID(),
+			UserID:     u.ID,
+			SessionID:  newSessionID,
+			Device:     dom_session.DeviceFromUserAgent(userAgent),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+			CreatedAt:  now,
+			LastUsedAt: now,
+			ExpiresAt:  now.Add(expiry),
+		}
+		if err := s.sessionCreateUseCase.Execute(sessCtx, sess); err != nil {
+			s.logger.Error("session create error", slog.Any("err", err))
+			return err
+		}
+		return nil
+	}
+
+	sess.SessionID = newSessionID
+	sess.IPAddress = ipAddress
+	if userAgent != "" {
+		sess.UserAgent = userAgent
+		sess.Device = dom_session.DeviceFromUserAgent(userAgent)
+	}
+	sess.LastUsedAt = now
+	sess.ExpiresAt = now.Add(expiry)
+	if err := s.sessionUpdateUseCase.Execute(sessCtx, sess); err != nil {
+		s.logger.Error("session update error", slog.Any("err", err))
+		return err
+	}
+	return nil
+}
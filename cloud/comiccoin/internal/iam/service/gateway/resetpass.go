@@ -14,6 +14,7 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
 	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
 )
 
@@ -28,6 +29,8 @@ type gatewayResetPasswordServiceImpl struct {
 	jwtProvider           jwt.Provider
 	userGetByEmailUseCase uc_user.UserGetByEmailUseCase
 	userUpdateUseCase     uc_user.UserUpdateUseCase
+
+	sessionRevokeAllByUserIDUseCase uc_session.SessionRevokeAllByUserIDUseCase
 }
 
 func NewGatewayResetPasswordService(
@@ -37,8 +40,9 @@ func NewGatewayResetPasswordService(
 	jwtp jwt.Provider,
 	uc1 uc_user.UserGetByEmailUseCase,
 	uc2 uc_user.UserUpdateUseCase,
+	uc3 uc_session.SessionRevokeAllByUserIDUseCase,
 ) GatewayResetPasswordService {
-	return &gatewayResetPasswordServiceImpl{logger, pp, cach, jwtp, uc1, uc2}
+	return &gatewayResetPasswordServiceImpl{logger, pp, cach, jwtp, uc1, uc2, uc3}
 }
 
 type GatewayResetPasswordRequestIDO struct {
@@ -152,6 +156,12 @@ func (s *gatewayResetPasswordServiceImpl) Execute(sessCtx mongo.SessionContext,
 		return nil, err
 	}
 
+	// Log out everywhere as whoever knew the old password may be logged in.
+	if _, err := s.sessionRevokeAllByUserIDUseCase.Execute(sessCtx, u.ID); err != nil {
+		s.logger.Error("revoke sessions error", slog.Any("error", err))
+		return nil, err
+	}
+
 	//
 	// STEP 5: Done
 	//
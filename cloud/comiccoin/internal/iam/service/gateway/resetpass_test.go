@@ -0,0 +1,273 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	svc_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/oauth"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+func newTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+// testSessionContext lets the services run without a mongodb server, the
+// services only use the session context as a context.
+type testSessionContext struct {
+	context.Context
+	mongo.Session
+}
+
+func newTestSessionContext() mongo.SessionContext {
+	return &testSessionContext{Context: context.Background()}
+}
+
+type fakeCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{values: make(map[string][]byte)}
+}
+
+func (c *fakeCache) Shutdown(context.Context) {}
+
+func (c *fakeCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values[key], nil
+}
+
+func (c *fakeCache) Set(ctx context.Context, key string, val []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = val
+	return nil
+}
+
+func (c *fakeCache) SetWithExpiry(ctx context.Context, key string, val []byte, expiry time.Duration) error {
+	return c.Set(ctx, key, val)
+}
+
+func (c *fakeCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+type fakeSessionRepository struct {
+	dom_session.Repository
+	sessions []*dom_session.Session
+}
+
+func (r *fakeSessionRepository) ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*dom_session.Session, error) {
+	var result []*dom_session.Session
+	for _, s := range r.sessions {
+		if s.UserID == userID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeSessionRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	var kept []*dom_session.Session
+	for _, s := range r.sessions {
+		if s.UserID != userID {
+			kept = append(kept, s)
+		}
+	}
+	r.sessions = kept
+	return nil
+}
+
+type fakeTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]*sec_oauth.Token
+}
+
+func newFakeTokenStore() *fakeTokenStore {
+	return &fakeTokenStore{tokens: make(map[string]*sec_oauth.Token)}
+}
+
+func (s *fakeTokenStore) StoreToken(token *sec_oauth.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *token
+	s.tokens[token.TokenID] = &copied
+	return nil
+}
+
+func (s *fakeTokenStore) GetToken(tokenID string) (*sec_oauth.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[tokenID]
+	if !ok {
+		return nil, sec_oauth.ErrTokenNotFound
+	}
+	copied := *token
+	return &copied, nil
+}
+
+func (s *fakeTokenStore) RevokeToken(tokenID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[tokenID]
+	if !ok {
+		return sec_oauth.ErrTokenNotFound
+	}
+	token.IsRevoked = true
+	return nil
+}
+
+func (s *fakeTokenStore) RevokeAllFederatedIdentityTokens(federatedidentityID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, token := range s.tokens {
+		if token.FederatedIdentityID == federatedidentityID {
+			token.IsRevoked = true
+		}
+	}
+	return nil
+}
+
+type fakeClientService struct {
+	sec_oauth.ClientService
+	client *sec_oauth.Client
+}
+
+func (s *fakeClientService) GetClient(clientID string) (*sec_oauth.Client, error) {
+	if clientID != s.client.ID {
+		return nil, sec_oauth.ErrInvalidClient
+	}
+	return s.client, nil
+}
+
+// fakeUsers implements the user use cases the gateway services need.
+type fakeUsers struct {
+	mu    sync.Mutex
+	users []*dom_user.User
+}
+
+func (f *fakeUsers) find(match func(u *dom_user.User) bool) *dom_user.User {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, u := range f.users {
+		if match(u) {
+			copied := *u
+			return &copied
+		}
+	}
+	return nil
+}
+
+type fakeUserGetByEmailUseCase struct{ *fakeUsers }
+
+func (uc fakeUserGetByEmailUseCase) Execute(ctx context.Context, email string) (*dom_user.User, error) {
+	return uc.find(func(u *dom_user.User) bool { return u.Email == email }), nil
+}
+
+type fakeUserGetByIDUseCase struct{ *fakeUsers }
+
+func (uc fakeUserGetByIDUseCase) Execute(ctx context.Context, id primitive.ObjectID) (*dom_user.User, error) {
+	return uc.find(func(u *dom_user.User) bool { return u.ID == id }), nil
+}
+
+type fakeUserUpdateUseCase struct{ *fakeUsers }
+
+func (uc fakeUserUpdateUseCase) Execute(ctx context.Context, user *dom_user.User) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	for i, u := range uc.users {
+		if u.ID == user.ID {
+			copied := *user
+			uc.users[i] = &copied
+			return nil
+		}
+	}
+	return errors.New("user does not exist")
+}
+
+func TestGatewayResetPasswordRevokesOAuthTokens(t *testing.T) {
+	logger := newTestLogger()
+	passp := password.NewProvider()
+	cache := newFakeCache()
+	tokenStore := newFakeTokenStore()
+
+	userID := primitive.NewObjectID()
+	users := &fakeUsers{users: []*dom_user.User{{
+		ID:                              userID,
+		Email:                           "reader@example.com",
+		Status:                          dom_user.UserStatusActive,
+		PasswordResetVerificationCode:   "123456",
+		PasswordResetVerificationExpiry: time.Now().Add(time.Hour),
+	}}}
+	sessionRepo := &fakeSessionRepository{sessions: []*dom_session.Session{{
+		ID:        primitive.NewObjectID(),
+		UserID:    userID,
+		SessionID: "session-1",
+	}}}
+	if err := cache.Set(context.Background(), "session-1", []byte("{}")); err != nil {
+		t.Fatalf("failed setting session: %v", err)
+	}
+
+	client := &sec_oauth.Client{ID: "client-1", Public: true}
+	tokenService := svc_oauth.NewOAuthTokenService(nil, logger, passp, &fakeClientService{client: client}, nil, tokenStore, fakeUserGetByIDUseCase{users})
+
+	// The application the user authorized before the reset holds a refresh
+	// token for the user.
+	refreshToken := "refresh-token-before-reset"
+	if err := tokenStore.StoreToken(&sec_oauth.Token{
+		TokenID:             refreshToken,
+		TokenType:           "refresh",
+		FederatedIdentityID: userID.Hex(),
+		ClientID:            client.ID,
+		Scope:               "profile",
+		IssuedAt:            time.Now(),
+		ExpiresAt:           time.Now().Add(time.Hour),
+	}); err != nil {
+		t.Fatalf("failed storing token: %v", err)
+	}
+
+	revokeAll := uc_session.NewSessionRevokeAllByUserIDUseCase(nil, logger, cache, sessionRepo, tokenStore)
+	resetService := NewGatewayResetPasswordService(logger, passp, cache, nil, fakeUserGetByEmailUseCase{users}, fakeUserUpdateUseCase{users}, revokeAll)
+	if _, err := resetService.Execute(newTestSessionContext(), &GatewayResetPasswordRequestIDO{
+		Code:            "123456",
+		Email:           "reader@example.com",
+		Password:        "a-new-password-123",
+		PasswordConfirm: "a-new-password-123",
+	}); err != nil {
+		t.Fatalf("failed resetting password: %v", err)
+	}
+
+	_, err := tokenService.Execute(context.Background(), &svc_oauth.OAuthTokenRequestIDO{
+		GrantType:    "refresh_token",
+		RefreshToken: refreshToken,
+		ClientID:     client.ID,
+	})
+	var oauthErr *sec_oauth.ValidationError
+	if !errors.As(err, &oauthErr) || oauthErr.ErrorCode != "invalid_grant" {
+		t.Fatalf("expected the refresh token to be rejected after the reset but got %v", err)
+	}
+	if len(sessionRepo.sessions) != 0 {
+		t.Fatalf("expected the sessions to be revoked but %d remain", len(sessionRepo.sessions))
+	}
+	if bin, _ := cache.Get(context.Background(), "session-1"); bin != nil {
+		t.Fatal("expected the session data to be deleted")
+	}
+}
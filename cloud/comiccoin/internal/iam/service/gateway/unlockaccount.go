@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	domain "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+type GatewayUnlockAccountService interface {
+	Execute(sessCtx mongo.SessionContext, req *GatewayUnlockAccountRequestIDO) (*GatewayUnlockAccountResponseIDO, error)
+}
+
+type gatewayUnlockAccountServiceImpl struct {
+	logger                     *slog.Logger
+	userGetByUnlockCodeUseCase uc_user.UserGetByUnlockCodeUseCase
+	userUpdateUseCase          uc_user.UserUpdateUseCase
+}
+
+func NewGatewayUnlockAccountService(
+	logger *slog.Logger,
+	uc1 uc_user.UserGetByUnlockCodeUseCase,
+	uc2 uc_user.UserUpdateUseCase,
+) GatewayUnlockAccountService {
+	return &gatewayUnlockAccountServiceImpl{logger, uc1, uc2}
+}
+
+type GatewayUnlockAccountRequestIDO struct {
+	Code string `json:"code"`
+}
+
+type GatewayUnlockAccountResponseIDO struct {
+	Message string `json:"message"`
+}
+
+func (s *gatewayUnlockAccountServiceImpl) Execute(sessCtx mongo.SessionContext, req *GatewayUnlockAccountRequestIDO) (*GatewayUnlockAccountResponseIDO, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	if req.Code == "" {
+		s.logger.Warn("Failed validation unlock account")
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Unlock code is required")
+	}
+
+	//
+	// STEP 2: Lookup the locked user.
+	//
+
+	u, err := s.userGetByUnlockCodeUseCase.Execute(sessCtx, req.Code)
+	if err != nil {
+		s.logger.Error("database error", slog.Any("err", err))
+		return nil, err
+	}
+	if u == nil || u.Status != domain.UserStatusLocked {
+		s.logger.Warn("user does not exist validation error")
+		return nil, httperror.NewForBadRequestWithSingleField("code", "does not exist")
+	}
+	if time.Now().After(u.UnlockCodeExpiry) {
+		s.logger.Warn("unlock code expired",
+			slog.String("email", u.Email))
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Unlock code has expired, please contact support")
+	}
+
+	//
+	// STEP 3: Unlock the user.
+	//
+
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+
+	u.Status = domain.UserStatusActive
+	u.UnlockCode = ""
+	u.UnlockCodeExpiry = time.Time{}
+	u.LockedAt = time.Time{}
+	u.ModifiedAt = time.Now()
+	u.ModifiedFromIPAddress = ipAddress
+	if err := s.userUpdateUseCase.Execute(sessCtx, u); err != nil {
+		s.logger.Error("update error", slog.Any("err", err))
+		return nil, err
+	}
+
+	s.logger.Debug("user unlocked",
+		slog.String("email", u.Email))
+
+	return &GatewayUnlockAccountResponseIDO{
+		Message: "Your account is unlocked. You may log in now.",
+	}, nil
+}
@@ -13,12 +13,18 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+	uc_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/walletchallenge"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 type MeConnectWalletRequestDTO struct {
 	WalletAddress string `bson:"wallet_address" json:"wallet_address"`
+
+	// Signature is the hex signature of the challenge message issued by
+	// `MeConnectWalletChallengeService`, see `signature.SignatureString`.
+	Signature string `bson:"signature" json:"signature"`
 }
 
 type MeConnectWalletService interface {
@@ -31,6 +37,7 @@ type meConnectWalletServiceImpl struct {
 	userGetByIDUseCase            uc_user.UserGetByIDUseCase
 	userUpdateUseCase             uc_user.UserUpdateUseCase
 	userGetByWalletAddressUseCase uc_user.UserGetByWalletAddressUseCase
+	walletChallengeVerifyUseCase  uc_walletchallenge.WalletChallengeVerifyUseCase
 }
 
 func NewMeConnectWalletService(
@@ -39,6 +46,7 @@ func NewMeConnectWalletService(
 	userGetByIDUseCase uc_user.UserGetByIDUseCase,
 	userUpdateUseCase uc_user.UserUpdateUseCase,
 	userGetByWalletAddressUseCase uc_user.UserGetByWalletAddressUseCase,
+	walletChallengeVerifyUseCase uc_walletchallenge.WalletChallengeVerifyUseCase,
 ) MeConnectWalletService {
 	return &meConnectWalletServiceImpl{
 		config:                        config,
@@ -46,6 +54,7 @@ func NewMeConnectWalletService(
 		userGetByIDUseCase:            userGetByIDUseCase,
 		userUpdateUseCase:             userUpdateUseCase,
 		userGetByWalletAddressUseCase: userGetByWalletAddressUseCase,
+		walletChallengeVerifyUseCase:  walletChallengeVerifyUseCase,
 	}
 }
 
@@ -72,6 +81,7 @@ func (s *meConnectWalletServiceImpl) Execute(sessCtx mongo.SessionContext, req *
 
 	// Sanitization
 	req.WalletAddress = strings.TrimSpace(req.WalletAddress)
+	req.Signature = strings.TrimSpace(req.Signature)
 
 	e := make(map[string]string)
 	if req.WalletAddress == "" {
@@ -82,6 +92,9 @@ func (s *meConnectWalletServiceImpl) Execute(sessCtx mongo.SessionContext, req *
 			e["wallet_address"] = "Wallet address cannot be burn address"
 		}
 	}
+	if req.Signature == "" {
+		e["signature"] = "Signature is required"
+	}
 	if len(e) != 0 {
 		s.logger.Warn("Failed validation",
 			slog.Any("error", e))
@@ -109,7 +122,15 @@ func (s *meConnectWalletServiceImpl) Execute(sessCtx mongo.SessionContext, req *
 	walletAddress := common.HexToAddress(strings.ToLower(req.WalletAddress))
 
 	//
-	// STEP 4: Check if the wallet address is already used by another user
+	// STEP 4: Verify the user controls the wallet address.
+	//
+
+	if err := s.walletChallengeVerifyUseCase.Execute(sessCtx, userID, &walletAddress, dom_walletchallenge.PurposeConnectWallet, req.Signature); err != nil {
+		return nil, err
+	}
+
+	//
+	// STEP 5: Check if the wallet address is already used by another user
 	//
 
 	// Lookup if another user already owns this wallet address.
@@ -143,7 +164,7 @@ func (s *meConnectWalletServiceImpl) Execute(sessCtx mongo.SessionContext, req *
 	}
 
 	//
-	// STEP 5: Update database.
+	// STEP 6: Update database.
 	//
 
 	user.WalletAddress = &walletAddress
@@ -156,7 +177,7 @@ func (s *meConnectWalletServiceImpl) Execute(sessCtx mongo.SessionContext, req *
 		slog.Any("user_id", userID.Hex()))
 
 	//
-	// STEP 6: Retur results
+	// STEP 7: Retur results
 	//
 
 	s.logger.Debug("Successfully updated",
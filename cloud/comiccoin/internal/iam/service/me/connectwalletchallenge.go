@@ -0,0 +1,116 @@
+package me
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+	uc_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/walletchallenge"
+)
+
+type MeConnectWalletChallengeRequestDTO struct {
+	WalletAddress string `bson:"wallet_address" json:"wallet_address"`
+}
+
+type MeConnectWalletChallengeResponseDTO struct {
+	WalletAddress *common.Address `json:"wallet_address"`
+	Message       string          `json:"message"`
+	ExpiresAt     time.Time       `json:"expires_at"`
+}
+
+// MeConnectWalletChallengeService issues the message which the user must
+// sign with their wallet before `MeConnectWalletService` connects the wallet
+// address to their account.
+type MeConnectWalletChallengeService interface {
+	Execute(sessCtx mongo.SessionContext, req *MeConnectWalletChallengeRequestDTO) (*MeConnectWalletChallengeResponseDTO, error)
+}
+
+type meConnectWalletChallengeServiceImpl struct {
+	config                      *config.Configuration
+	logger                      *slog.Logger
+	walletChallengeIssueUseCase uc_walletchallenge.WalletChallengeIssueUseCase
+}
+
+func NewMeConnectWalletChallengeService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	walletChallengeIssueUseCase uc_walletchallenge.WalletChallengeIssueUseCase,
+) MeConnectWalletChallengeService {
+	return &meConnectWalletChallengeServiceImpl{
+		config:                      config,
+		logger:                      logger,
+		walletChallengeIssueUseCase: walletChallengeIssueUseCase,
+	}
+}
+
+func (s *meConnectWalletChallengeServiceImpl) Execute(sessCtx mongo.SessionContext, req *MeConnectWalletChallengeRequestDTO) (*MeConnectWalletChallengeResponseDTO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+
+	//
+	// STEP 2: Validation
+	//
+
+	if req == nil {
+		s.logger.Warn("Failed validation with nothing received")
+		return nil, httperror.NewForBadRequestWithSingleField("non_field_error", "Wallet address is required in submission")
+	}
+
+	// Sanitization
+	req.WalletAddress = strings.TrimSpace(req.WalletAddress)
+
+	e := make(map[string]string)
+	if req.WalletAddress == "" {
+		e["wallet_address"] = "Wallet address is required"
+	} else if !common.IsHexAddress(req.WalletAddress) {
+		e["wallet_address"] = "Wallet address is invalid"
+	} else {
+		walletAddress := common.HexToAddress(strings.ToLower(req.WalletAddress))
+		if walletAddress.Hex() == "0x0000000000000000000000000000000000000000" {
+			e["wallet_address"] = "Wallet address cannot be burn address"
+		}
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validation",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 3: Create the challenge, replacing any outstanding challenge.
+	//
+
+	walletAddress := common.HexToAddress(strings.ToLower(req.WalletAddress))
+	challenge, err := s.walletChallengeIssueUseCase.Execute(sessCtx, userID, &walletAddress, dom_walletchallenge.PurposeConnectWallet)
+	if err != nil {
+		s.logger.Error("Failed issuing wallet challenge", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Debug("Issued wallet challenge",
+		slog.Any("user_id", userID.Hex()),
+		slog.String("wallet_address", walletAddress.Hex()))
+
+	return &MeConnectWalletChallengeResponseDTO{
+		WalletAddress: challenge.WalletAddress,
+		Message:       challenge.Message,
+		ExpiresAt:     challenge.ExpiresAt,
+	}, nil
+}
@@ -60,8 +60,8 @@ type MeResponseDTO struct {
 	Status int8 `bson:"status" json:"status"`
 	// PaymentProcessorName                            string             `bson:"payment_processor_name" json:"payment_processor_name"`
 	// PaymentProcessorCustomerID                      string             `bson:"payment_processor_customer_id" json:"payment_processor_customer_id"`
-	// OTPEnabled                                      bool               `bson:"otp_enabled" json:"otp_enabled"`
-	// OTPVerified                                     bool               `bson:"otp_verified" json:"otp_verified"`
+	OTPEnabled  bool `bson:"otp_enabled" json:"otp_enabled"`
+	OTPVerified bool `bson:"otp_verified" json:"otp_verified"`
 	// OTPValidated                                    bool               `bson:"otp_validated" json:"otp_validated"`
 	// OTPSecret                                       string             `bson:"otp_secret" json:"-"`
 	// OTPAuthURL                                      string             `bson:"otp_auth_url" json:"-"`
@@ -161,5 +161,7 @@ func (svc *getMeServiceImpl) Execute(sessCtx mongo.SessionContext) (*MeResponseD
 		WebsiteURL:                user.WebsiteURL,
 		Description:               user.Description,
 		ComicBookStoreName:        user.ComicBookStoreName,
+		OTPEnabled:                user.OTPEnabled,
+		OTPVerified:               user.OTPVerified,
 	}, nil
 }
@@ -0,0 +1,124 @@
+package me
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	uc_otp "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/otp"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+type MeOTPDisableRequestDTO struct {
+	// Code is either a code from the authenticator app or a backup code.
+	Code string `json:"code"`
+}
+
+type MeOTPDisableResponseDTO struct {
+	OTPEnabled bool `json:"otp_enabled"`
+}
+
+// MeOTPDisableService turns off 2FA and forgets the secret and the backup
+// codes of the user.
+type MeOTPDisableService interface {
+	Execute(sessCtx mongo.SessionContext, req *MeOTPDisableRequestDTO) (*MeOTPDisableResponseDTO, error)
+}
+
+type meOTPDisableServiceImpl struct {
+	config             *config.Configuration
+	logger             *slog.Logger
+	userGetByIDUseCase uc_user.UserGetByIDUseCase
+	userUpdateUseCase  uc_user.UserUpdateUseCase
+	otpVerifyUseCase   uc_otp.OTPVerifyUseCase
+}
+
+func NewMeOTPDisableService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	userGetByIDUseCase uc_user.UserGetByIDUseCase,
+	userUpdateUseCase uc_user.UserUpdateUseCase,
+	otpVerifyUseCase uc_otp.OTPVerifyUseCase,
+) MeOTPDisableService {
+	return &meOTPDisableServiceImpl{
+		config:             config,
+		logger:             logger,
+		userGetByIDUseCase: userGetByIDUseCase,
+		userUpdateUseCase:  userUpdateUseCase,
+		otpVerifyUseCase:   otpVerifyUseCase,
+	}
+}
+
+func (s *meOTPDisableServiceImpl) Execute(sessCtx mongo.SessionContext, req *MeOTPDisableRequestDTO) (*MeOTPDisableResponseDTO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+
+	//
+	// STEP 2: Validation
+	//
+
+	if req == nil || req.Code == "" {
+		s.logger.Warn("Failed validation with nothing received")
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Code is required")
+	}
+
+	user, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		s.logger.Error("Failed getting me", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		err := fmt.Errorf("User does not exist for id: %v", userID.Hex())
+		s.logger.Error("Failed getting me", slog.Any("error", err))
+		return nil, err
+	}
+	if !user.OTPEnabled {
+		return nil, httperror.NewForBadRequestWithSingleField("message", "Two-factor authentication is not enabled")
+	}
+
+	// Require a code so a stolen session cannot turn off 2FA.
+	match, err := s.otpVerifyUseCase.Execute(sessCtx, user, req.Code, true)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		s.logger.Warn("Wrong otp code", slog.Any("user_id", userID.Hex()))
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Code is incorrect or expired")
+	}
+
+	//
+	// STEP 3: Disable 2FA.
+	//
+
+	user.OTPEnabled = false
+	user.OTPVerified = false
+	user.OTPValidated = false
+	user.OTPSecret = ""
+	user.OTPAuthURL = ""
+	user.OTPLastUsedTimeStep = 0
+	user.OTPBackupCodeHashes = nil
+	user.OTPBackupCodeHashAlgorithm = ""
+	user.ModifiedAt = time.Now()
+	if err := s.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+		s.logger.Error("Failed updating user", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Debug("Disabled 2FA", slog.Any("user_id", userID.Hex()))
+
+	return &MeOTPDisableResponseDTO{OTPEnabled: false}, nil
+}
@@ -0,0 +1,116 @@
+package me
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/totp"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+// otpIssuer is the name the authenticator app of the user displays for
+// our accounts.
+const otpIssuer = "ComicCoin"
+
+type MeOTPGenerateResponseDTO struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// MeOTPGenerateService creates a new secret for the authenticator app of
+// the user. 2FA is not enabled until the user proves their app works by
+// submitting a code to `MeOTPVerifyService`.
+type MeOTPGenerateService interface {
+	Execute(sessCtx mongo.SessionContext) (*MeOTPGenerateResponseDTO, error)
+}
+
+type meOTPGenerateServiceImpl struct {
+	config             *config.Configuration
+	logger             *slog.Logger
+	totpProvider       totp.Provider
+	userGetByIDUseCase uc_user.UserGetByIDUseCase
+	userUpdateUseCase  uc_user.UserUpdateUseCase
+}
+
+func NewMeOTPGenerateService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	totpProvider totp.Provider,
+	userGetByIDUseCase uc_user.UserGetByIDUseCase,
+	userUpdateUseCase uc_user.UserUpdateUseCase,
+) MeOTPGenerateService {
+	return &meOTPGenerateServiceImpl{
+		config:             config,
+		logger:             logger,
+		totpProvider:       totpProvider,
+		userGetByIDUseCase: userGetByIDUseCase,
+		userUpdateUseCase:  userUpdateUseCase,
+	}
+}
+
+func (s *meOTPGenerateServiceImpl) Execute(sessCtx mongo.SessionContext) (*MeOTPGenerateResponseDTO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+
+	//
+	// STEP 2: Get user and make sure 2FA is not already on.
+	//
+
+	user, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		s.logger.Error("Failed getting me", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		err := fmt.Errorf("User does not exist for id: %v", userID.Hex())
+		s.logger.Error("Failed getting me", slog.Any("error", err))
+		return nil, err
+	}
+	if user.OTPEnabled {
+		s.logger.Warn("2FA already enabled", slog.Any("user_id", userID.Hex()))
+		return nil, httperror.NewForBadRequestWithSingleField("message", "Two-factor authentication is already enabled, disable it first")
+	}
+
+	//
+	// STEP 3: Generate and save the secret, replacing any unverified one.
+	//
+
+	secret, err := s.totpProvider.GenerateSecret()
+	if err != nil {
+		s.logger.Error("Failed generating otp secret", slog.Any("error", err))
+		return nil, err
+	}
+	user.OTPSecret = secret
+	user.OTPAuthURL = s.totpProvider.ProvisioningURI(otpIssuer, user.Email, secret)
+	user.OTPLastUsedTimeStep = 0
+	user.OTPVerified = false
+	user.OTPValidated = false
+	user.ModifiedAt = time.Now()
+	if err := s.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+		s.logger.Error("Failed updating user", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Debug("Generated otp secret", slog.Any("user_id", userID.Hex()))
+
+	return &MeOTPGenerateResponseDTO{
+		Secret:          user.OTPSecret,
+		ProvisioningURI: user.OTPAuthURL,
+	}, nil
+}
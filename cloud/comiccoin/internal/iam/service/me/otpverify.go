@@ -0,0 +1,141 @@
+package me
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	uc_otp "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/otp"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+type MeOTPVerifyRequestDTO struct {
+	Code string `json:"code"`
+}
+
+type MeOTPVerifyResponseDTO struct {
+	OTPEnabled bool `json:"otp_enabled"`
+
+	// BackupCodes are only returned once, the user must store them somewhere
+	// safe to log in if they lose their authenticator app.
+	BackupCodes []string `json:"backup_codes"`
+}
+
+// MeOTPVerifyService enables 2FA once the user submits the first code from
+// their authenticator app.
+type MeOTPVerifyService interface {
+	Execute(sessCtx mongo.SessionContext, req *MeOTPVerifyRequestDTO) (*MeOTPVerifyResponseDTO, error)
+}
+
+type meOTPVerifyServiceImpl struct {
+	config                        *config.Configuration
+	logger                        *slog.Logger
+	passwordProvider              password.Provider
+	userGetByIDUseCase            uc_user.UserGetByIDUseCase
+	userUpdateUseCase             uc_user.UserUpdateUseCase
+	otpVerifyUseCase              uc_otp.OTPVerifyUseCase
+	otpGenerateBackupCodesUseCase uc_otp.OTPGenerateBackupCodesUseCase
+}
+
+func NewMeOTPVerifyService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	passwordProvider password.Provider,
+	userGetByIDUseCase uc_user.UserGetByIDUseCase,
+	userUpdateUseCase uc_user.UserUpdateUseCase,
+	otpVerifyUseCase uc_otp.OTPVerifyUseCase,
+	otpGenerateBackupCodesUseCase uc_otp.OTPGenerateBackupCodesUseCase,
+) MeOTPVerifyService {
+	return &meOTPVerifyServiceImpl{
+		config:                        config,
+		logger:                        logger,
+		passwordProvider:              passwordProvider,
+		userGetByIDUseCase:            userGetByIDUseCase,
+		userUpdateUseCase:             userUpdateUseCase,
+		otpVerifyUseCase:              otpVerifyUseCase,
+		otpGenerateBackupCodesUseCase: otpGenerateBackupCodesUseCase,
+	}
+}
+
+func (s *meOTPVerifyServiceImpl) Execute(sessCtx mongo.SessionContext, req *MeOTPVerifyRequestDTO) (*MeOTPVerifyResponseDTO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+
+	//
+	// STEP 2: Validation
+	//
+
+	if req == nil || req.Code == "" {
+		s.logger.Warn("Failed validation with nothing received")
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Code is required")
+	}
+
+	user, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		s.logger.Error("Failed getting me", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		err := fmt.Errorf("User does not exist for id: %v", userID.Hex())
+		s.logger.Error("Failed getting me", slog.Any("error", err))
+		return nil, err
+	}
+	if user.OTPEnabled {
+		return nil, httperror.NewForBadRequestWithSingleField("message", "Two-factor authentication is already enabled")
+	}
+	if user.OTPSecret == "" {
+		return nil, httperror.NewForBadRequestWithSingleField("message", "Two-factor authentication was not set up, generate a secret first")
+	}
+
+	// Backup codes do not exist yet and would not prove the app works.
+	match, err := s.otpVerifyUseCase.Execute(sessCtx, user, req.Code, false)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		s.logger.Warn("Wrong otp code", slog.Any("user_id", userID.Hex()))
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Code is incorrect or expired")
+	}
+
+	//
+	// STEP 3: Enable 2FA with new backup codes.
+	//
+
+	codes, hashes, err := s.otpGenerateBackupCodesUseCase.Execute(sessCtx)
+	if err != nil {
+		return nil, err
+	}
+	user.OTPEnabled = true
+	user.OTPVerified = true
+	user.OTPValidated = true
+	user.OTPBackupCodeHashes = hashes
+	user.OTPBackupCodeHashAlgorithm = s.passwordProvider.AlgorithmName()
+	user.ModifiedAt = time.Now()
+	if err := s.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+		s.logger.Error("Failed updating user", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Debug("Enabled 2FA", slog.Any("user_id", userID.Hex()))
+
+	return &MeOTPVerifyResponseDTO{
+		OTPEnabled:  true,
+		BackupCodes: codes,
+	}, nil
+}
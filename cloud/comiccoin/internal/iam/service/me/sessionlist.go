@@ -0,0 +1,93 @@
+package me
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+type MeSessionResponseDTO struct {
+	ID         primitive.ObjectID `json:"id"`
+	Device     string             `json:"device"`
+	IPAddress  string             `json:"ip_address"`
+	UserAgent  string             `json:"user_agent"`
+	CreatedAt  time.Time          `json:"created_at"`
+	LastUsedAt time.Time          `json:"last_used_at"`
+	ExpiresAt  time.Time          `json:"expires_at"`
+	Current    bool               `json:"current"` // True for the session making this request.
+}
+
+type MeSessionListResponseDTO struct {
+	Sessions []*MeSessionResponseDTO `json:"sessions"`
+}
+
+// MeListSessionsService lists everywhere the user is logged in.
+type MeListSessionsService interface {
+	Execute(sessCtx mongo.SessionContext) (*MeSessionListResponseDTO, error)
+}
+
+type meListSessionsServiceImpl struct {
+	config                     *config.Configuration
+	logger                     *slog.Logger
+	sessionListByUserIDUseCase uc_session.SessionListByUserIDUseCase
+}
+
+func NewMeListSessionsService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	sessionListByUserIDUseCase uc_session.SessionListByUserIDUseCase,
+) MeListSessionsService {
+	return &meListSessionsServiceImpl{
+		config:                     config,
+		logger:                     logger,
+		sessionListByUserIDUseCase: sessionListByUserIDUseCase,
+	}
+}
+
+func (s *meListSessionsServiceImpl) Execute(sessCtx mongo.SessionContext) (*MeSessionListResponseDTO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+	sessionID, _ := sessCtx.Value(constants.SessionID).(string)
+
+	//
+	// STEP 2: List the sessions.
+	//
+
+	sessions, err := s.sessionListByUserIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		s.logger.Error("Failed listing sessions", slog.Any("error", err))
+		return nil, err
+	}
+
+	res := &MeSessionListResponseDTO{
+		Sessions: make([]*MeSessionResponseDTO, 0, len(sessions)),
+	}
+	for _, sess := range sessions {
+		res.Sessions = append(res.Sessions, &MeSessionResponseDTO{
+			ID:         sess.ID,
+			Device:     sess.Device,
+			IPAddress:  sess.IPAddress,
+			UserAgent:  sess.UserAgent,
+			CreatedAt:  sess.CreatedAt,
+			LastUsedAt: sess.LastUsedAt,
+			ExpiresAt:  sess.ExpiresAt,
+			Current:    sess.SessionID == sessionID,
+		})
+	}
+	return res, nil
+}
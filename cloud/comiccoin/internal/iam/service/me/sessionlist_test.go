@@ -0,0 +1,186 @@
+package me
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+func newTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+// testSessionContext lets the services run without a mongodb server, the
+// services only use the session context as a context.
+type testSessionContext struct {
+	context.Context
+	mongo.Session
+}
+
+// newTestSessionContextForUser returns the context of a request made by the
+// user from the session.
+func newTestSessionContextForUser(userID primitive.ObjectID, sessionID string) mongo.SessionContext {
+	ctx := context.WithValue(context.Background(), constants.SessionUserID, userID)
+	ctx = context.WithValue(ctx, constants.SessionID, sessionID)
+	return &testSessionContext{Context: ctx}
+}
+
+type fakeCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (c *fakeCache) Shutdown(context.Context) {}
+
+func (c *fakeCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values[key], nil
+}
+
+func (c *fakeCache) Set(ctx context.Context, key string, val []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = val
+	return nil
+}
+
+func (c *fakeCache) SetWithExpiry(ctx context.Context, key string, val []byte, expiry time.Duration) error {
+	return c.Set(ctx, key, val)
+}
+
+func (c *fakeCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+type fakeSessionRepository struct {
+	dom_session.Repository
+	sessions []*dom_session.Session
+}
+
+func (r *fakeSessionRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*dom_session.Session, error) {
+	for _, s := range r.sessions {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeSessionRepository) ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*dom_session.Session, error) {
+	var result []*dom_session.Session
+	for _, s := range r.sessions {
+		if s.UserID == userID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeSessionRepository) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
+	var kept []*dom_session.Session
+	for _, s := range r.sessions {
+		if s.ID != id {
+			kept = append(kept, s)
+		}
+	}
+	r.sessions = kept
+	return nil
+}
+
+// sessionTestEnv is a user logged in on two devices next to another user.
+type sessionTestEnv struct {
+	cache          *fakeCache
+	repo           *fakeSessionRepository
+	userID         primitive.ObjectID
+	current, other *dom_session.Session
+	otherUsers     *dom_session.Session
+}
+
+func newSessionTestEnv(t *testing.T) *sessionTestEnv {
+	t.Helper()
+	env := &sessionTestEnv{
+		cache:  &fakeCache{values: make(map[string][]byte)},
+		userID: primitive.NewObjectID(),
+	}
+	now := time.Now()
+	env.current = &dom_session.Session{ID: primitive.NewObjectID(), UserID: env.userID, SessionID: "session-laptop", Device: "Firefox on Linux", IPAddress: "203.0.113.1", CreatedAt: now, LastUsedAt: now, ExpiresAt: now.Add(time.Hour)}
+	env.other = &dom_session.Session{ID: primitive.NewObjectID(), UserID: env.userID, SessionID: "session-phone", Device: "Safari on iPhone", IPAddress: "203.0.113.2", CreatedAt: now, LastUsedAt: now, ExpiresAt: now.Add(time.Hour)}
+	env.otherUsers = &dom_session.Session{ID: primitive.NewObjectID(), UserID: primitive.NewObjectID(), SessionID: "session-someone-else", CreatedAt: now, LastUsedAt: now, ExpiresAt: now.Add(time.Hour)}
+	env.repo = &fakeSessionRepository{sessions: []*dom_session.Session{env.current, env.other, env.otherUsers}}
+	for _, sess := range env.repo.sessions {
+		env.cache.values[sess.SessionID] = []byte("{}")
+	}
+	return env
+}
+
+// isLoggedIn returns true if the session is still indexed and its data,
+// which its tokens are checked against, is still in the cache.
+func (env *sessionTestEnv) isLoggedIn(sess *dom_session.Session) bool {
+	_, inCache := env.cache.values[sess.SessionID]
+	return inCache && env.isIndexed(sess)
+}
+
+// isRevoked returns true if nothing of the session remains.
+func (env *sessionTestEnv) isRevoked(sess *dom_session.Session) bool {
+	_, inCache := env.cache.values[sess.SessionID]
+	return !inCache && !env.isIndexed(sess)
+}
+
+func (env *sessionTestEnv) isIndexed(sess *dom_session.Session) bool {
+	for _, s := range env.repo.sessions {
+		if s.ID == sess.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMeListSessions(t *testing.T) {
+	env := newSessionTestEnv(t)
+	service := NewMeListSessionsService(nil, newTestLogger(), uc_session.NewSessionListByUserIDUseCase(nil, newTestLogger(), env.repo))
+
+	res, err := service.Execute(newTestSessionContextForUser(env.userID, env.current.SessionID))
+	if err != nil {
+		t.Fatalf("failed listing sessions: %v", err)
+	}
+	if len(res.Sessions) != 2 {
+		t.Fatalf("expected the two sessions of the user, got %d", len(res.Sessions))
+	}
+	for _, sess := range res.Sessions {
+		switch sess.ID {
+		case env.current.ID:
+			if !sess.Current || sess.Device != env.current.Device || sess.IPAddress != env.current.IPAddress {
+				t.Fatalf("unexpected current session: %+v", sess)
+			}
+		case env.other.ID:
+			if sess.Current || sess.Device != env.other.Device {
+				t.Fatalf("unexpected other session: %+v", sess)
+			}
+		default:
+			t.Fatalf("expected only the sessions of the user, got %v", sess.ID.Hex())
+		}
+	}
+}
+
+func TestMeListSessionsRequiresUser(t *testing.T) {
+	env := newSessionTestEnv(t)
+	service := NewMeListSessionsService(nil, newTestLogger(), uc_session.NewSessionListByUserIDUseCase(nil, newTestLogger(), env.repo))
+
+	if _, err := service.Execute(&testSessionContext{Context: context.Background()}); err == nil {
+		t.Fatal("expected listing without a logged in user to fail")
+	}
+}
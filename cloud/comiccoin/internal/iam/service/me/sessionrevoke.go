@@ -0,0 +1,121 @@
+package me
+
+import (
+	"errors"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+type MeRevokeSessionsResponseDTO struct {
+	Revoked int `json:"revoked"`
+}
+
+// MeRevokeSessionsService logs the user out of one or all of their other
+// sessions. The current session is ended with the logout endpoint.
+type MeRevokeSessionsService interface {
+	ExecuteByID(sessCtx mongo.SessionContext, id primitive.ObjectID) (*MeRevokeSessionsResponseDTO, error)
+	ExecuteForOthers(sessCtx mongo.SessionContext) (*MeRevokeSessionsResponseDTO, error)
+}
+
+type meRevokeSessionsServiceImpl struct {
+	config                     *config.Configuration
+	logger                     *slog.Logger
+	sessionGetByIDUseCase      uc_session.SessionGetByIDUseCase
+	sessionListByUserIDUseCase uc_session.SessionListByUserIDUseCase
+	sessionRevokeUseCase       uc_session.SessionRevokeUseCase
+}
+
+func NewMeRevokeSessionsService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	sessionGetByIDUseCase uc_session.SessionGetByIDUseCase,
+	sessionListByUserIDUseCase uc_session.SessionListByUserIDUseCase,
+	sessionRevokeUseCase uc_session.SessionRevokeUseCase,
+) MeRevokeSessionsService {
+	return &meRevokeSessionsServiceImpl{
+		config:                     config,
+		logger:                     logger,
+		sessionGetByIDUseCase:      sessionGetByIDUseCase,
+		sessionListByUserIDUseCase: sessionListByUserIDUseCase,
+		sessionRevokeUseCase:       sessionRevokeUseCase,
+	}
+}
+
+func (s *meRevokeSessionsServiceImpl) ExecuteByID(sessCtx mongo.SessionContext, id primitive.ObjectID) (*MeRevokeSessionsResponseDTO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+
+	//
+	// STEP 2: Get the session and make sure it belongs to the user.
+	//
+
+	sess, err := s.sessionGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		s.logger.Error("Failed getting session", slog.Any("error", err))
+		return nil, err
+	}
+	if sess == nil || sess.UserID != userID {
+		return nil, httperror.NewForNotFoundWithSingleField("id", "Session does not exist")
+	}
+
+	//
+	// STEP 3: Revoke.
+	//
+
+	if err := s.sessionRevokeUseCase.Execute(sessCtx, sess); err != nil {
+		s.logger.Error("Failed revoking session", slog.Any("error", err))
+		return nil, err
+	}
+	return &MeRevokeSessionsResponseDTO{Revoked: 1}, nil
+}
+
+func (s *meRevokeSessionsServiceImpl) ExecuteForOthers(sessCtx mongo.SessionContext) (*MeRevokeSessionsResponseDTO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+	sessionID, _ := sessCtx.Value(constants.SessionID).(string)
+
+	//
+	// STEP 2: Revoke every session except the current one.
+	//
+
+	sessions, err := s.sessionListByUserIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		s.logger.Error("Failed listing sessions", slog.Any("error", err))
+		return nil, err
+	}
+	res := &MeRevokeSessionsResponseDTO{}
+	for _, sess := range sessions {
+		if sess.SessionID == sessionID {
+			continue
+		}
+		if err := s.sessionRevokeUseCase.Execute(sessCtx, sess); err != nil {
+			s.logger.Error("Failed revoking session", slog.Any("error", err))
+			return nil, err
+		}
+		res.Revoked++
+	}
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package me
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+func newTestRevokeSessionsService(env *sessionTestEnv) MeRevokeSessionsService {
+	logger := newTestLogger()
+	return NewMeRevokeSessionsService(
+		nil,
+		logger,
+		uc_session.NewSessionGetByIDUseCase(nil, logger, env.repo),
+		uc_session.NewSessionListByUserIDUseCase(nil, logger, env.repo),
+		uc_session.NewSessionRevokeUseCase(nil, logger, env.cache, env.repo),
+	)
+}
+
+func TestMeRevokeSessionByID(t *testing.T) {
+	env := newSessionTestEnv(t)
+	service := newTestRevokeSessionsService(env)
+
+	res, err := service.ExecuteByID(newTestSessionContextForUser(env.userID, env.current.SessionID), env.other.ID)
+	if err != nil {
+		t.Fatalf("failed revoking session: %v", err)
+	}
+	if res.Revoked != 1 {
+		t.Fatalf("expected one revoked session, got %d", res.Revoked)
+	}
+	if !env.isRevoked(env.other) {
+		t.Fatal("expected the revoked session to be logged out")
+	}
+	if !env.isLoggedIn(env.current) || !env.isLoggedIn(env.otherUsers) {
+		t.Fatal("expected the other sessions to stay logged in")
+	}
+}
+
+func TestMeRevokeSessionByIDOfAnotherUser(t *testing.T) {
+	env := newSessionTestEnv(t)
+	service := newTestRevokeSessionsService(env)
+
+	_, err := service.ExecuteByID(newTestSessionContextForUser(env.userID, env.current.SessionID), env.otherUsers.ID)
+	var httpErr httperror.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusNotFound {
+		t.Fatalf("expected the session of another user to be not found, got %v", err)
+	}
+	if !env.isLoggedIn(env.otherUsers) {
+		t.Fatal("expected the session of the other user to stay logged in")
+	}
+}
+
+func TestMeRevokeOtherSessions(t *testing.T) {
+	env := newSessionTestEnv(t)
+	service := newTestRevokeSessionsService(env)
+
+	res, err := service.ExecuteForOthers(newTestSessionContextForUser(env.userID, env.current.SessionID))
+	if err != nil {
+		t.Fatalf("failed revoking sessions: %v", err)
+	}
+	if res.Revoked != 1 {
+		t.Fatalf("expected one revoked session, got %d", res.Revoked)
+	}
+	if !env.isRevoked(env.other) {
+		t.Fatal("expected the other session of the user to be logged out")
+	}
+	if !env.isLoggedIn(env.current) {
+		t.Fatal("expected the current session to stay logged in")
+	}
+	if !env.isLoggedIn(env.otherUsers) {
+		t.Fatal("expected the session of the other user to stay logged in")
+	}
+}
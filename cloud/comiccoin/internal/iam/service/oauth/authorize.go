@@ -0,0 +1,140 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+)
+
+// OAuthAuthorizeService starts the authorization code flow. It validates the
+// third-party app's request and returns where to send the browser next.
+type OAuthAuthorizeService interface {
+	Execute(ctx context.Context, req *OAuthAuthorizeRequestIDO) (string, error)
+}
+
+type oauthAuthorizeServiceImpl struct {
+	config             *config.Configuration
+	logger             *slog.Logger
+	passwordProvider   password.Provider
+	clientService      sec_oauth.ClientService
+	authorizationStore sec_oauth.AuthorizationStore
+}
+
+func NewOAuthAuthorizeService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	passp password.Provider,
+	clientService sec_oauth.ClientService,
+	authorizationStore sec_oauth.AuthorizationStore,
+) OAuthAuthorizeService {
+	return &oauthAuthorizeServiceImpl{config, logger, passp, clientService, authorizationStore}
+}
+
+type OAuthAuthorizeRequestIDO struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	State               string
+	Scope               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+func (s *oauthAuthorizeServiceImpl) Execute(ctx context.Context, req *OAuthAuthorizeRequestIDO) (string, error) {
+	//
+	// STEP 1: Validate the client and redirect URI. We must never redirect
+	// to an unverified URI so these errors are returned to the browser.
+	//
+
+	if req.ClientID == "" {
+		return "", httperror.NewForBadRequestWithSingleField("client_id", "Client ID is required")
+	}
+	client, err := s.clientService.GetClient(req.ClientID)
+	if err != nil {
+		if err == sec_oauth.ErrInvalidClient {
+			s.logger.Warn("unknown oauth client", slog.String("client_id", req.ClientID))
+			return "", httperror.NewForBadRequestWithSingleField("client_id", "Client does not exist")
+		}
+		s.logger.Error("failed getting oauth client", slog.Any("error", err))
+		return "", err
+	}
+	if req.RedirectURI == "" {
+		req.RedirectURI = client.RedirectURI
+	}
+	if req.RedirectURI != client.RedirectURI {
+		s.logger.Warn("oauth redirect uri mismatch",
+			slog.String("client_id", req.ClientID),
+			slog.String("redirect_uri", req.RedirectURI))
+		return "", httperror.NewForBadRequestWithSingleField("redirect_uri", "Redirect URI is not registered for this client")
+	}
+
+	//
+	// STEP 2: Validate the rest of the request. From here on errors are sent
+	// back to the client as described in RFC 6749 Section 4.1.2.1.
+	//
+
+	redirectWithError := func(code, description string) (string, error) {
+		return redirectURLWithParams(req.RedirectURI, map[string]string{
+			"error":             code,
+			"error_description": description,
+			"state":             req.State,
+		}), nil
+	}
+
+	if req.ResponseType != "code" {
+		return redirectWithError("unsupported_response_type", "Only the authorization code flow is supported")
+	}
+	if req.CodeChallenge == "" && client.Public {
+		return redirectWithError("invalid_request", "Public clients must use PKCE")
+	}
+	if req.CodeChallenge != "" {
+		if req.CodeChallengeMethod != sec_oauth.CodeChallengeMethodS256 {
+			return redirectWithError("invalid_request", "Only the S256 code challenge method is supported")
+		}
+		if !sec_oauth.IsValidCodeChallenge(req.CodeChallenge) {
+			return redirectWithError("invalid_request", "Code challenge is malformed")
+		}
+	}
+	scope, ok := normalizeScope(req.Scope)
+	if !ok {
+		return redirectWithError("invalid_scope", "Requested scope is not supported")
+	}
+
+	//
+	// STEP 3: Save the request until the user gives their consent.
+	//
+
+	authID, err := s.passwordProvider.GenerateSecureRandomString(32)
+	if err != nil {
+		s.logger.Error("failed generating auth id", slog.Any("error", err))
+		return "", err
+	}
+	pending := sec_oauth.PendingAuthorization{
+		ClientID:            client.ID,
+		RedirectURI:         req.RedirectURI,
+		State:               req.State,
+		Scope:               scope,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		ExpiresAt:           time.Now().Add(pendingAuthExpiry),
+	}
+	if err := s.authorizationStore.StorePendingAuth(authID, pending); err != nil {
+		s.logger.Error("failed saving pending authorization", slog.Any("error", err))
+		return "", err
+	}
+
+	s.logger.Debug("oauth authorization started",
+		slog.String("client_id", client.ID),
+		slog.String("scope", scope))
+
+	// The frontend will have the user log in if needed and then ask for
+	// consent before calling the consent endpoint with this id.
+	return fmt.Sprintf("%s/oauth/authorize?auth_id=%s", frontendURL(s.config), url.QueryEscape(authID)), nil
+}
@@ -0,0 +1,168 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+)
+
+// OAuthConsentService lets the logged in user see which app is asking for
+// access and then approve or deny it.
+type OAuthConsentService interface {
+	Get(ctx context.Context, authID string) (*OAuthConsentResponseIDO, error)
+	Execute(ctx context.Context, authID string, req *OAuthConsentRequestIDO) (*OAuthConsentDecisionResponseIDO, error)
+}
+
+type oauthConsentServiceImpl struct {
+	config             *config.Configuration
+	logger             *slog.Logger
+	passwordProvider   password.Provider
+	clientService      sec_oauth.ClientService
+	authorizationStore sec_oauth.AuthorizationStore
+}
+
+func NewOAuthConsentService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	passp password.Provider,
+	clientService sec_oauth.ClientService,
+	authorizationStore sec_oauth.AuthorizationStore,
+) OAuthConsentService {
+	return &oauthConsentServiceImpl{config, logger, passp, clientService, authorizationStore}
+}
+
+type OAuthConsentResponseIDO struct {
+	ClientID    string   `json:"client_id"`
+	ClientName  string   `json:"client_name"`
+	RedirectURI string   `json:"redirect_uri"`
+	Scopes      []string `json:"scopes"`
+}
+
+type OAuthConsentRequestIDO struct {
+	Approve bool `json:"approve"`
+}
+
+type OAuthConsentDecisionResponseIDO struct {
+	RedirectURL string `json:"redirect_url"`
+}
+
+func (s *oauthConsentServiceImpl) getPendingAuth(authID string) (sec_oauth.PendingAuthorization, *sec_oauth.Client, error) {
+	pending, err := s.authorizationStore.GetPendingAuth(authID)
+	if err != nil {
+		if err == sec_oauth.ErrAuthorizationNotFound {
+			return pending, nil, httperror.NewForNotFoundWithSingleField("auth_id", "Authorization request does not exist or has expired")
+		}
+		s.logger.Error("failed getting pending authorization", slog.Any("error", err))
+		return pending, nil, err
+	}
+	client, err := s.clientService.GetClient(pending.ClientID)
+	if err != nil {
+		if err == sec_oauth.ErrInvalidClient {
+			return pending, nil, httperror.NewForNotFoundWithSingleField("auth_id", "Client no longer exists")
+		}
+		s.logger.Error("failed getting oauth client", slog.Any("error", err))
+		return pending, nil, err
+	}
+	return pending, client, nil
+}
+
+func (s *oauthConsentServiceImpl) Get(ctx context.Context, authID string) (*OAuthConsentResponseIDO, error) {
+	pending, client, err := s.getPendingAuth(authID)
+	if err != nil {
+		return nil, err
+	}
+	return &OAuthConsentResponseIDO{
+		ClientID:    client.ID,
+		ClientName:  client.Name,
+		RedirectURI: pending.RedirectURI,
+		Scopes:      strings.Fields(pending.Scope),
+	}, nil
+}
+
+func (s *oauthConsentServiceImpl) Execute(ctx context.Context, authID string, req *OAuthConsentRequestIDO) (*OAuthConsentDecisionResponseIDO, error) {
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := ctx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+
+	//
+	// STEP 2: Consume the pending authorization so the decision can only be
+	// made once.
+	//
+
+	pending, client, err := s.getPendingAuth(authID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizationStore.DeletePendingAuth(authID); err != nil {
+		if err == sec_oauth.ErrAuthorizationNotFound {
+			return nil, httperror.NewForNotFoundWithSingleField("auth_id", "Authorization request does not exist or has expired")
+		}
+		s.logger.Error("failed deleting pending authorization", slog.Any("error", err))
+		return nil, err
+	}
+
+	if !req.Approve {
+		s.logger.Debug("oauth authorization denied",
+			slog.String("client_id", client.ID),
+			slog.Any("user_id", userID))
+		return &OAuthConsentDecisionResponseIDO{
+			RedirectURL: redirectURLWithParams(pending.RedirectURI, map[string]string{
+				"error":             "access_denied",
+				"error_description": "The user denied the request",
+				"state":             pending.State,
+			}),
+		}, nil
+	}
+
+	//
+	// STEP 3: Issue the authorization code.
+	//
+
+	code, err := s.passwordProvider.GenerateSecureRandomString(32)
+	if err != nil {
+		s.logger.Error("failed generating authorization code", slog.Any("error", err))
+		return nil, err
+	}
+	authCode := sec_oauth.AuthorizationCode{
+		Code:                code,
+		ClientID:            client.ID,
+		RedirectURI:         pending.RedirectURI,
+		FederatedIdentityID: userID.Hex(),
+		Scope:               pending.Scope,
+		CodeChallenge:       pending.CodeChallenge,
+		CodeChallengeMethod: pending.CodeChallengeMethod,
+		ExpiresAt:           time.Now().Add(authorizationCodeExpiry),
+	}
+	if err := s.authorizationStore.StoreAuthorizationCode(code, authCode); err != nil {
+		s.logger.Error("failed saving authorization code", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Debug("oauth authorization approved",
+		slog.String("client_id", client.ID),
+		slog.Any("user_id", userID))
+
+	return &OAuthConsentDecisionResponseIDO{
+		RedirectURL: redirectURLWithParams(pending.RedirectURI, map[string]string{
+			"code":  code,
+			"state": pending.State,
+		}),
+	}, nil
+}
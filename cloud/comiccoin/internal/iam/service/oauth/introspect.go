@@ -0,0 +1,108 @@
+package oauth
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+// OAuthIntrospectService implements RFC 7662 so third-party apps can find
+// out who an access token belongs to.
+type OAuthIntrospectService interface {
+	Execute(ctx context.Context, req *OAuthIntrospectRequestIDO) (*sec_oauth.IntrospectionResponse, error)
+}
+
+type oauthIntrospectServiceImpl struct {
+	config             *config.Configuration
+	logger             *slog.Logger
+	clientService      sec_oauth.ClientService
+	tokenStore         sec_oauth.TokenStore
+	userGetByIDUseCase uc_user.UserGetByIDUseCase
+}
+
+func NewOAuthIntrospectService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	clientService sec_oauth.ClientService,
+	tokenStore sec_oauth.TokenStore,
+	uc1 uc_user.UserGetByIDUseCase,
+) OAuthIntrospectService {
+	return &oauthIntrospectServiceImpl{config, logger, clientService, tokenStore, uc1}
+}
+
+type OAuthIntrospectRequestIDO struct {
+	Token        string
+	ClientID     string
+	ClientSecret string
+}
+
+func (s *oauthIntrospectServiceImpl) Execute(ctx context.Context, req *OAuthIntrospectRequestIDO) (*sec_oauth.IntrospectionResponse, error) {
+	//
+	// STEP 1: Only confidential clients may introspect tokens and only the
+	// tokens issued to them, so one app cannot learn about another app's users.
+	//
+
+	client, err := authenticateClient(s.clientService, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if client.Public {
+		return nil, newOAuthError("invalid_client", "Public clients cannot introspect tokens")
+	}
+	if req.Token == "" {
+		return nil, newOAuthError("invalid_request", "Token is required")
+	}
+
+	//
+	// STEP 2: Lookup the token. Any problem with the token is reported as
+	// an inactive token so we do not leak why it is not valid.
+	//
+
+	inactive := &sec_oauth.IntrospectionResponse{Active: false}
+
+	token, err := s.tokenStore.GetToken(req.Token)
+	if err != nil {
+		if err == sec_oauth.ErrTokenNotFound {
+			return inactive, nil
+		}
+		s.logger.Error("failed getting token", slog.Any("error", err))
+		return nil, err
+	}
+	if token.ClientID != client.ID || token.TokenType != tokenTypeAccess || token.IsRevoked || time.Now().After(token.ExpiresAt) {
+		return inactive, nil
+	}
+	u, err := getActiveUser(ctx, s.userGetByIDUseCase, token.FederatedIdentityID)
+	if err != nil {
+		s.logger.Error("failed getting user", slog.Any("error", err))
+		return nil, err
+	}
+	if u == nil {
+		return inactive, nil
+	}
+
+	//
+	// STEP 3: Only share what the user agreed to.
+	//
+
+	res := &sec_oauth.IntrospectionResponse{
+		Active:              true,
+		Scope:               token.Scope,
+		ClientID:            token.ClientID,
+		ExpiresAt:           token.ExpiresAt.Unix(),
+		IssuedAt:            token.IssuedAt.Unix(),
+		FederatedIdentityID: token.FederatedIdentityID,
+	}
+	if hasScope(token.Scope, "email") {
+		res.Username = u.Email
+		res.Email = u.Email
+	}
+	if hasScope(token.Scope, "profile") {
+		res.FirstName = u.FirstName
+		res.LastName = u.LastName
+	}
+	return res, nil
+}
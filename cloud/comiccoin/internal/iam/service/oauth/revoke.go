@@ -0,0 +1,70 @@
+package oauth
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+)
+
+// OAuthRevokeService implements RFC 7009 so third-party apps can log the
+// user out by revoking their tokens.
+type OAuthRevokeService interface {
+	Execute(ctx context.Context, req *OAuthRevokeRequestIDO) error
+}
+
+type oauthRevokeServiceImpl struct {
+	config        *config.Configuration
+	logger        *slog.Logger
+	clientService sec_oauth.ClientService
+	tokenStore    sec_oauth.TokenStore
+}
+
+func NewOAuthRevokeService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	clientService sec_oauth.ClientService,
+	tokenStore sec_oauth.TokenStore,
+) OAuthRevokeService {
+	return &oauthRevokeServiceImpl{config, logger, clientService, tokenStore}
+}
+
+type OAuthRevokeRequestIDO struct {
+	Token        string
+	ClientID     string
+	ClientSecret string
+}
+
+func (s *oauthRevokeServiceImpl) Execute(ctx context.Context, req *OAuthRevokeRequestIDO) error {
+	client, err := authenticateClient(s.clientService, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return err
+	}
+	if req.Token == "" {
+		return newOAuthError("invalid_request", "Token is required")
+	}
+
+	// Unknown tokens and tokens of other clients are not an error, see
+	// RFC 7009 Section 2.2, so we do not reveal which tokens exist.
+	token, err := s.tokenStore.GetToken(req.Token)
+	if err != nil {
+		if err == sec_oauth.ErrTokenNotFound {
+			return nil
+		}
+		s.logger.Error("failed getting token", slog.Any("error", err))
+		return err
+	}
+	if token.ClientID != client.ID || token.IsRevoked {
+		return nil
+	}
+	if err := s.tokenStore.RevokeToken(req.Token); err != nil {
+		s.logger.Error("failed revoking token", slog.Any("error", err))
+		return err
+	}
+
+	s.logger.Debug("oauth token revoked",
+		slog.String("client_id", client.ID),
+		slog.String("token_type", token.TokenType))
+	return nil
+}
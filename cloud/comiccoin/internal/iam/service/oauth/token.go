@@ -0,0 +1,206 @@
+package oauth
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+// OAuthTokenService is the token endpoint which supports the
+// `authorization_code` and `refresh_token` grants.
+type OAuthTokenService interface {
+	Execute(ctx context.Context, req *OAuthTokenRequestIDO) (*sec_oauth.TokenResponse, error)
+}
+
+type oauthTokenServiceImpl struct {
+	config             *config.Configuration
+	logger             *slog.Logger
+	passwordProvider   password.Provider
+	clientService      sec_oauth.ClientService
+	authorizationStore sec_oauth.AuthorizationStore
+	tokenStore         sec_oauth.TokenStore
+	userGetByIDUseCase uc_user.UserGetByIDUseCase
+}
+
+func NewOAuthTokenService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	passp password.Provider,
+	clientService sec_oauth.ClientService,
+	authorizationStore sec_oauth.AuthorizationStore,
+	tokenStore sec_oauth.TokenStore,
+	uc1 uc_user.UserGetByIDUseCase,
+) OAuthTokenService {
+	return &oauthTokenServiceImpl{config, logger, passp, clientService, authorizationStore, tokenStore, uc1}
+}
+
+type OAuthTokenRequestIDO struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scope        string
+	ClientID     string
+	ClientSecret string
+}
+
+func (s *oauthTokenServiceImpl) Execute(ctx context.Context, req *OAuthTokenRequestIDO) (*sec_oauth.TokenResponse, error) {
+	client, err := authenticateClient(s.clientService, req.ClientID, req.ClientSecret)
+	if err != nil {
+		s.logger.Warn("oauth client authentication failed",
+			slog.String("client_id", req.ClientID),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	switch req.GrantType {
+	case "authorization_code":
+		return s.exchangeAuthorizationCode(ctx, client, req)
+	case "refresh_token":
+		return s.refresh(ctx, client, req)
+	case "":
+		return nil, newOAuthError("invalid_request", "Grant type is required")
+	default:
+		return nil, newOAuthError("unsupported_grant_type", "Only the authorization_code and refresh_token grants are supported")
+	}
+}
+
+func (s *oauthTokenServiceImpl) exchangeAuthorizationCode(ctx context.Context, client *sec_oauth.Client, req *OAuthTokenRequestIDO) (*sec_oauth.TokenResponse, error) {
+	//
+	// STEP 1: Consume the code. Deleting first guarantees the code can only
+	// be exchanged once even if two requests race.
+	//
+
+	if req.Code == "" {
+		return nil, newOAuthError("invalid_request", "Code is required")
+	}
+	authCode, err := s.authorizationStore.GetAuthorizationCode(req.Code)
+	if err != nil {
+		if err == sec_oauth.ErrAuthorizationNotFound {
+			return nil, newOAuthError("invalid_grant", "Code is invalid or has expired")
+		}
+		s.logger.Error("failed getting authorization code", slog.Any("error", err))
+		return nil, err
+	}
+	if err := s.authorizationStore.DeleteAuthorizationCode(req.Code); err != nil {
+		if err == sec_oauth.ErrAuthorizationNotFound {
+			return nil, newOAuthError("invalid_grant", "Code is invalid or has expired")
+		}
+		s.logger.Error("failed deleting authorization code", slog.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 2: Verify the code belongs to this client and request.
+	//
+
+	if authCode.ClientID != client.ID {
+		s.logger.Warn("authorization code used by another client",
+			slog.String("client_id", client.ID))
+		return nil, newOAuthError("invalid_grant", "Code was not issued to this client")
+	}
+	if authCode.RedirectURI != req.RedirectURI {
+		return nil, newOAuthError("invalid_grant", "Redirect URI does not match the authorization request")
+	}
+	if authCode.CodeChallenge != "" {
+		if !sec_oauth.VerifyCodeChallenge(req.CodeVerifier, authCode.CodeChallenge, authCode.CodeChallengeMethod) {
+			return nil, newOAuthError("invalid_grant", "Code verifier does not match the code challenge")
+		}
+	} else if req.CodeVerifier != "" {
+		return nil, newOAuthError("invalid_grant", "Code verifier was sent but no code challenge was used")
+	}
+
+	u, err := getActiveUser(ctx, s.userGetByIDUseCase, authCode.FederatedIdentityID)
+	if err != nil {
+		s.logger.Error("failed getting user", slog.Any("error", err))
+		return nil, err
+	}
+	if u == nil {
+		return nil, newOAuthError("invalid_grant", "User is not active")
+	}
+
+	//
+	// STEP 3: Issue the tokens.
+	//
+
+	res, err := issueTokens(s.passwordProvider, s.tokenStore, client.ID, authCode.FederatedIdentityID, authCode.Scope)
+	if err != nil {
+		s.logger.Error("failed issuing tokens", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Debug("oauth authorization code exchanged",
+		slog.String("client_id", client.ID),
+		slog.String("email", u.Email))
+
+	return res, nil
+}
+
+func (s *oauthTokenServiceImpl) refresh(ctx context.Context, client *sec_oauth.Client, req *OAuthTokenRequestIDO) (*sec_oauth.TokenResponse, error) {
+	//
+	// STEP 1: Validate the refresh token.
+	//
+
+	if req.RefreshToken == "" {
+		return nil, newOAuthError("invalid_request", "Refresh token is required")
+	}
+	token, err := s.tokenStore.GetToken(req.RefreshToken)
+	if err != nil {
+		if err == sec_oauth.ErrTokenNotFound {
+			return nil, newOAuthError("invalid_grant", "Refresh token is invalid or has expired")
+		}
+		s.logger.Error("failed getting refresh token", slog.Any("error", err))
+		return nil, err
+	}
+	if token.TokenType != tokenTypeRefresh || token.IsRevoked || time.Now().After(token.ExpiresAt) {
+		return nil, newOAuthError("invalid_grant", "Refresh token is invalid or has expired")
+	}
+	if token.ClientID != client.ID {
+		s.logger.Warn("refresh token used by another client",
+			slog.String("client_id", client.ID))
+		return nil, newOAuthError("invalid_grant", "Refresh token was not issued to this client")
+	}
+
+	// The client may ask for less access than originally granted but never more.
+	scope := token.Scope
+	if req.Scope != "" {
+		for _, sc := range strings.Fields(req.Scope) {
+			if !hasScope(token.Scope, sc) {
+				return nil, newOAuthError("invalid_scope", "Requested scope exceeds the original grant")
+			}
+		}
+		scope = strings.Join(strings.Fields(req.Scope), " ")
+	}
+
+	u, err := getActiveUser(ctx, s.userGetByIDUseCase, token.FederatedIdentityID)
+	if err != nil {
+		s.logger.Error("failed getting user", slog.Any("error", err))
+		return nil, err
+	}
+	if u == nil {
+		return nil, newOAuthError("invalid_grant", "User is not active")
+	}
+
+	//
+	// STEP 2: Rotate the refresh token so a stolen token can only be used
+	// once before the legitimate client notices.
+	//
+
+	if err := s.tokenStore.RevokeToken(req.RefreshToken); err != nil {
+		s.logger.Error("failed revoking refresh token", slog.Any("error", err))
+		return nil, err
+	}
+	res, err := issueTokens(s.passwordProvider, s.tokenStore, client.ID, token.FederatedIdentityID, scope)
+	if err != nil {
+		s.logger.Error("failed issuing tokens", slog.Any("error", err))
+		return nil, err
+	}
+	return res, nil
+}
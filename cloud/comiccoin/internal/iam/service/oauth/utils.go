@@ -0,0 +1,194 @@
+package oauth
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+)
+
+const (
+	pendingAuthExpiry       = 10 * time.Minute
+	authorizationCodeExpiry = 10 * time.Minute
+	accessTokenExpiry       = 1 * time.Hour
+	refreshTokenExpiry      = 30 * 24 * time.Hour
+
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+
+	// defaultScope is granted when the client does not ask for a scope.
+	defaultScope = "profile"
+)
+
+// supportedScopes are what third-party apps may ask access for, everything
+// else is rejected with `invalid_scope`.
+var supportedScopes = map[string]bool{
+	"profile": true, // First and last name.
+	"email":   true, // Email address.
+}
+
+// newOAuthError is a shorthand for the RFC 6749 error response.
+func newOAuthError(code, description string) error {
+	return &sec_oauth.ValidationError{ErrorCode: code, ErrorDescription: description}
+}
+
+// normalizeScope returns the requested scope with duplicates removed or
+// false if any of the scopes are not supported.
+func normalizeScope(scope string) (string, bool) {
+	fields := strings.Fields(scope)
+	if len(fields) == 0 {
+		return defaultScope, true
+	}
+	seen := make(map[string]bool, len(fields))
+	normalized := make([]string, 0, len(fields))
+	for _, s := range fields {
+		if !supportedScopes[s] {
+			return "", false
+		}
+		if !seen[s] {
+			seen[s] = true
+			normalized = append(normalized, s)
+		}
+	}
+	return strings.Join(normalized, " "), true
+}
+
+// hasScope returns true if the space separated scope contains `want`.
+func hasScope(scope string, want string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
+
+// redirectURLWithParams appends the query parameters to the client's
+// redirect URI while keeping any query the client registered.
+func redirectURLWithParams(redirectURI string, params map[string]string) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	q := u.Query()
+	for k, v := range params {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// frontendURL returns the IAM frontend address with a scheme, the frontend
+// domain may be configured with or without the scheme.
+func frontendURL(cfg *config.Configuration) string {
+	u := cfg.IAMEmailer.FrontendDomain
+	if !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
+		u = "https://" + u
+	}
+	return strings.TrimSuffix(u, "/")
+}
+
+// authenticateClient identifies the client making a back-channel request.
+// Public clients only identify themselves while confidential clients must
+// also prove they know their secret.
+func authenticateClient(clientService sec_oauth.ClientService, clientID, clientSecret string) (*sec_oauth.Client, error) {
+	if clientID == "" {
+		return nil, newOAuthError("invalid_client", "Client authentication failed")
+	}
+	client, err := clientService.GetClient(clientID)
+	if err != nil {
+		if err == sec_oauth.ErrInvalidClient {
+			return nil, newOAuthError("invalid_client", "Client authentication failed")
+		}
+		return nil, err
+	}
+	if client.Public {
+		if clientSecret != "" {
+			return nil, newOAuthError("invalid_client", "Client authentication failed")
+		}
+		return client, nil
+	}
+	ok, err := clientService.ValidateClientCredentials(clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newOAuthError("invalid_client", "Client authentication failed")
+	}
+	return client, nil
+}
+
+// getActiveUser returns the user the tokens are issued for or nil if the
+// user no longer exists or is not allowed to log in.
+func getActiveUser(ctx context.Context, userGetByIDUseCase uc_user.UserGetByIDUseCase, federatedIdentityID string) (*dom_user.User, error) {
+	userID, err := primitive.ObjectIDFromHex(federatedIdentityID)
+	if err != nil {
+		return nil, nil
+	}
+	u, err := userGetByIDUseCase.Execute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || u.Status != dom_user.UserStatusActive {
+		return nil, nil
+	}
+	return u, nil
+}
+
+// issueTokens creates a new access and refresh token pair. Tokens are opaque
+// random strings, clients must use the introspection endpoint to learn who
+// the token belongs to.
+func issueTokens(passp password.Provider, tokenStore sec_oauth.TokenStore, clientID, federatedIdentityID, scope string) (*sec_oauth.TokenResponse, error) {
+	accessTokenID, err := passp.GenerateSecureRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	refreshTokenID, err := passp.GenerateSecureRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	accessToken := &sec_oauth.Token{
+		TokenID:             accessTokenID,
+		TokenType:           tokenTypeAccess,
+		FederatedIdentityID: federatedIdentityID,
+		ClientID:            clientID,
+		Scope:               scope,
+		IssuedAt:            now,
+		ExpiresAt:           now.Add(accessTokenExpiry),
+	}
+	if err := tokenStore.StoreToken(accessToken); err != nil {
+		return nil, err
+	}
+	refreshToken := &sec_oauth.Token{
+		TokenID:             refreshTokenID,
+		TokenType:           tokenTypeRefresh,
+		FederatedIdentityID: federatedIdentityID,
+		ClientID:            clientID,
+		Scope:               scope,
+		IssuedAt:            now,
+		ExpiresAt:           now.Add(refreshTokenExpiry),
+	}
+	if err := tokenStore.StoreToken(refreshToken); err != nil {
+		return nil, err
+	}
+
+	return &sec_oauth.TokenResponse{
+		AccessToken:  accessTokenID,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(accessTokenExpiry.Seconds()),
+		RefreshToken: refreshTokenID,
+		Scope:        scope,
+	}, nil
+}
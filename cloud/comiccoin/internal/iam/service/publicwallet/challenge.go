@@ -0,0 +1,130 @@
+// cloud/comiccoin/internal/iam/service/publicwallet/challenge.go
+package publicwallet
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+	uc_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/walletchallenge"
+)
+
+type PublicWalletChallengeRequestIDO struct {
+	// The public address of the account.
+	Address string `json:"address"`
+}
+
+type PublicWalletChallengeResponseIDO struct {
+	Address   *common.Address `json:"address"`
+	Message   string          `json:"message"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+// PublicWalletChallengeService issues the message which the user must sign
+// with the key of the public wallet before they can create the public wallet
+// or change its address.
+type PublicWalletChallengeService interface {
+	Execute(sessCtx mongo.SessionContext, req *PublicWalletChallengeRequestIDO) (*PublicWalletChallengeResponseIDO, error)
+}
+
+type publicWalletChallengeServiceImpl struct {
+	config                      *config.Configuration
+	logger                      *slog.Logger
+	walletChallengeIssueUseCase uc_walletchallenge.WalletChallengeIssueUseCase
+}
+
+func NewPublicWalletChallengeService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	walletChallengeIssueUseCase uc_walletchallenge.WalletChallengeIssueUseCase,
+) PublicWalletChallengeService {
+	return &publicWalletChallengeServiceImpl{
+		config:                      config,
+		logger:                      logger,
+		walletChallengeIssueUseCase: walletChallengeIssueUseCase,
+	}
+}
+
+func (svc *publicWalletChallengeServiceImpl) Execute(sessCtx mongo.SessionContext, req *PublicWalletChallengeRequestIDO) (*PublicWalletChallengeResponseIDO, error) {
+	//
+	// Extract authenticated user information from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local user id",
+			slog.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("user id not found in context")
+	}
+
+	// Developers note:
+	// Admins create public wallets without proving ownership, see
+	// `CreatePublicWalletByAdminService`.
+	sessionUserRole, _ := sessCtx.Value(constants.SessionUserRole).(int8)
+	if sessionUserRole == dom_user.UserRoleRoot {
+		svc.logger.Warn("admin is not allowed to run this service",
+			slog.Any("error", ""))
+		return nil, httperror.NewForForbiddenWithSingleField("message", "admins do not have permission to request public wallet challenges")
+	}
+
+	//
+	// Santize and validate input fields.
+	//
+
+	if req == nil {
+		svc.logger.Warn("Failed validation with nothing received")
+		return nil, httperror.NewForBadRequestWithSingleField("non_field_error", "Wallet address is required in submission")
+	}
+
+	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
+	req.Address = strings.ToLower(req.Address)
+	req.Address = strings.ReplaceAll(req.Address, " ", "")
+
+	e := make(map[string]string)
+	if req.Address == "" {
+		e["address"] = "Wallet address is required"
+	} else if !common.IsHexAddress(req.Address) {
+		e["address"] = "Wallet address is invalid"
+	} else {
+		walletAddress := common.HexToAddress(req.Address)
+		if walletAddress.Hex() == "0x0000000000000000000000000000000000000000" {
+			e["address"] = "Wallet address cannot be burn address"
+		}
+	}
+	if len(e) != 0 {
+		svc.logger.Warn("Failed validation",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// Issue the challenge, replacing any outstanding challenge.
+	//
+
+	walletAddress := common.HexToAddress(req.Address)
+	challenge, err := svc.walletChallengeIssueUseCase.Execute(sessCtx, userID, &walletAddress, dom_walletchallenge.PurposePublishPublicWallet)
+	if err != nil {
+		svc.logger.Error("Failed issuing wallet challenge", slog.Any("error", err))
+		return nil, err
+	}
+
+	svc.logger.Debug("Issued public wallet challenge",
+		slog.Any("user_id", userID.Hex()),
+		slog.String("address", walletAddress.Hex()))
+
+	return &PublicWalletChallengeResponseIDO{
+		Address:   challenge.WalletAddress,
+		Message:   challenge.Message,
+		ExpiresAt: challenge.ExpiresAt,
+	}, nil
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
 	dom "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/publicwallet"
 	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
 	uc "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/publicwallet"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+	uc_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/walletchallenge"
 )
 
 type CreatePublicWalletRequestIDO struct {
@@ -33,6 +35,11 @@ type CreatePublicWalletRequestIDO struct {
 
 	// The description of the public wallet's account.
 	Description string `json:"description"`
+
+	// Signature is the hex signature of the challenge message issued by
+	// `PublicWalletChallengeService` for the address, proving the user
+	// controls the key of the public wallet.
+	Signature string `json:"signature"`
 }
 
 type CreatePublicWalletResponseIDO struct {
@@ -50,6 +57,7 @@ type createPublicWalletServiceImpl struct {
 	publicWalletGetByAddressUseCase uc.PublicWalletGetByAddressUseCase
 	userGetByIDUseCase              uc_user.UserGetByIDUseCase
 	userUpdateUseCase               uc_user.UserUpdateUseCase
+	walletChallengeVerifyUseCase    uc_walletchallenge.WalletChallengeVerifyUseCase
 }
 
 func NewCreatePublicWalletService(
@@ -59,6 +67,7 @@ func NewCreatePublicWalletService(
 	uc2 uc.PublicWalletGetByAddressUseCase,
 	uc3 uc_user.UserGetByIDUseCase,
 	uc4 uc_user.UserUpdateUseCase,
+	uc5 uc_walletchallenge.WalletChallengeVerifyUseCase,
 ) CreatePublicWalletService {
 	return &createPublicWalletServiceImpl{
 		config:                          config,
@@ -67,6 +76,7 @@ func NewCreatePublicWalletService(
 		publicWalletGetByAddressUseCase: uc2,
 		userGetByIDUseCase:              uc3,
 		userUpdateUseCase:               uc4,
+		walletChallengeVerifyUseCase:    uc5,
 	}
 }
 
@@ -100,6 +110,7 @@ func (svc *createPublicWalletServiceImpl) Create(sessCtx mongo.SessionContext, r
 	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
 	req.Address = strings.ToLower(req.Address)
 	req.Address = strings.ReplaceAll(req.Address, " ", "")
+	req.Signature = strings.TrimSpace(req.Signature)
 
 	e := make(map[string]string)
 	if req.Name == "" {
@@ -125,6 +136,9 @@ func (svc *createPublicWalletServiceImpl) Create(sessCtx mongo.SessionContext, r
 			}
 		}
 	}
+	if req.Signature == "" {
+		e["signature"] = "Signature is required"
+	}
 	if req.ChainID == 0 {
 		e["chain_id"] = "Chain ID is required"
 	} else {
@@ -144,6 +158,18 @@ func (svc *createPublicWalletServiceImpl) Create(sessCtx mongo.SessionContext, r
 		slog.Any("fromAddress", req.Address),
 		slog.Any("toAddress", walletAddress))
 
+	//
+	// Verify the user controls the wallet address.
+	//
+
+	if err := svc.walletChallengeVerifyUseCase.Execute(sessCtx, userID, &walletAddress, dom_walletchallenge.PurposePublishPublicWallet, req.Signature); err != nil {
+		svc.logger.Warn("Failed verifying wallet challenge",
+			slog.Any("address", walletAddress),
+			slog.Any("error", err))
+		return nil, err
+	}
+	ownerVerifiedOn := time.Now()
+
 	//
 	// Retrieve related user information.
 	//
@@ -181,6 +207,8 @@ func (svc *createPublicWalletServiceImpl) Create(sessCtx mongo.SessionContext, r
 		AddressLine1:          user.AddressLine1,
 		AddressLine2:          user.AddressLine2,
 		IsVerified:            false,
+		IsOwnerVerified:       true,
+		OwnerVerifiedOn:       ownerVerifiedOn,
 		ThumbnailS3Key:        "",
 		ViewCount:             0,
 		UniqueViewCount:       0,
@@ -219,6 +247,7 @@ func (svc *createPublicWalletServiceImpl) Create(sessCtx mongo.SessionContext, r
 		slog.Any("AddressLine1", pw.AddressLine1),
 		slog.Any("AddressLine2", pw.AddressLine2),
 		slog.Any("IsVerified", pw.IsVerified),
+		slog.Any("IsOwnerVerified", pw.IsOwnerVerified),
 		slog.Any("ThumbnailS3Key", pw.ThumbnailS3Key),
 		slog.Any("ViewCount", pw.ViewCount),
 		slog.Any("UniqueViewCount", pw.UniqueViewCount),
@@ -16,8 +16,10 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
 	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
 	uc "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/publicwallet"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+	uc_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/walletchallenge"
 )
 
 type UpdatePublicWalletRequestIDO struct {
@@ -45,6 +47,12 @@ type UpdatePublicWalletRequestIDO struct {
 
 	// Status indicates that the someone from the ComicCoin Authority verified this user profile.
 	IsVerified bool `bson:"is_verified" json:"is_verified"`
+
+	// Signature is the hex signature of the challenge message issued by
+	// `PublicWalletChallengeService` for the address. It is required when
+	// the address changes and optional otherwise, in which case it renews
+	// the owner verification of the public wallet.
+	Signature string `json:"signature"`
 }
 
 type UpdatePublicWalletByAddressService interface {
@@ -58,6 +66,7 @@ type updatePublicWalletByAddressServiceImpl struct {
 	publicWalletUpdateByAddressUseCase uc.PublicWalletUpdateByAddressUseCase
 	userGetByIDUseCase                 uc_user.UserGetByIDUseCase
 	userUpdateUseCase                  uc_user.UserUpdateUseCase
+	walletChallengeVerifyUseCase       uc_walletchallenge.WalletChallengeVerifyUseCase
 }
 
 func NewUpdatePublicWalletByAddressService(
@@ -67,6 +76,7 @@ func NewUpdatePublicWalletByAddressService(
 	publicWalletUpdateByAddressUseCase uc.PublicWalletUpdateByAddressUseCase,
 	userGetByIDUseCase uc_user.UserGetByIDUseCase,
 	userUpdateUseCase uc_user.UserUpdateUseCase,
+	walletChallengeVerifyUseCase uc_walletchallenge.WalletChallengeVerifyUseCase,
 ) UpdatePublicWalletByAddressService {
 	return &updatePublicWalletByAddressServiceImpl{
 		config:                             config,
@@ -75,6 +85,7 @@ func NewUpdatePublicWalletByAddressService(
 		publicWalletUpdateByAddressUseCase: publicWalletUpdateByAddressUseCase,
 		userGetByIDUseCase:                 userGetByIDUseCase,
 		userUpdateUseCase:                  userUpdateUseCase,
+		walletChallengeVerifyUseCase:       walletChallengeVerifyUseCase,
 	}
 }
 
@@ -100,14 +111,7 @@ func (svc *updatePublicWalletByAddressServiceImpl) UpdateByAddress(sessCtx mongo
 	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
 	req.Address = strings.ToLower(req.Address)
 	req.Address = strings.ReplaceAll(req.Address, " ", "")
-
-	//
-	// Santize and validate input fields.
-	//
-
-	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
-	req.Address = strings.ToLower(req.Address)
-	req.Address = strings.ReplaceAll(req.Address, " ", "")
+	req.Signature = strings.TrimSpace(req.Signature)
 
 	e := make(map[string]string)
 	if req.Name == "" {
@@ -186,6 +190,24 @@ func (svc *updatePublicWalletByAddressServiceImpl) UpdateByAddress(sessCtx mongo
 		return httperror.NewForBadRequestWithSingleField("non_field_error", "User not found")
 	}
 
+	//
+	// Verify the owner controls the wallet address, if a signature was
+	// submitted. The address cannot change here so the signature is optional
+	// and lets owners verify public wallets created before signatures were
+	// required.
+	//
+
+	if req.Signature != "" && existingPublicWallet.CreatedByUserID == userID {
+		if err := svc.walletChallengeVerifyUseCase.Execute(sessCtx, userID, &walletAddress, dom_walletchallenge.PurposePublishPublicWallet, req.Signature); err != nil {
+			svc.logger.Warn("Failed verifying wallet challenge",
+				slog.Any("address", walletAddress),
+				slog.Any("error", err))
+			return err
+		}
+		existingPublicWallet.IsOwnerVerified = true
+		existingPublicWallet.OwnerVerifiedOn = time.Now().UTC()
+	}
+
 	//
 	// Update our record.
 	//
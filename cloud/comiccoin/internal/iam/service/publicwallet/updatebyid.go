@@ -5,13 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
 	uc "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/publicwallet"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
+	uc_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/walletchallenge"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -21,29 +26,35 @@ type UpdatePublicWalletByIDService interface {
 }
 
 type updatePublicWalletByIDServiceImpl struct {
-	config                        *config.Configuration
-	logger                        *slog.Logger
-	publicWalletGetByIDUseCase    uc.PublicWalletGetByIDUseCase
-	publicWalletUpdateByIDUseCase uc.PublicWalletUpdateByIDUseCase
-	userGetByIDUseCase            uc_user.UserGetByIDUseCase
-	userUpdateUseCase             uc_user.UserUpdateUseCase
+	config                          *config.Configuration
+	logger                          *slog.Logger
+	publicWalletGetByIDUseCase      uc.PublicWalletGetByIDUseCase
+	publicWalletGetByAddressUseCase uc.PublicWalletGetByAddressUseCase
+	publicWalletUpdateByIDUseCase   uc.PublicWalletUpdateByIDUseCase
+	userGetByIDUseCase              uc_user.UserGetByIDUseCase
+	userUpdateUseCase               uc_user.UserUpdateUseCase
+	walletChallengeVerifyUseCase    uc_walletchallenge.WalletChallengeVerifyUseCase
 }
 
 func NewUpdatePublicWalletByIDService(
 	config *config.Configuration,
 	logger *slog.Logger,
 	publicWalletGetByIDUseCase uc.PublicWalletGetByIDUseCase,
+	publicWalletGetByAddressUseCase uc.PublicWalletGetByAddressUseCase,
 	publicWalletUpdateByIDUseCase uc.PublicWalletUpdateByIDUseCase,
 	userGetByIDUseCase uc_user.UserGetByIDUseCase,
 	userUpdateUseCase uc_user.UserUpdateUseCase,
+	walletChallengeVerifyUseCase uc_walletchallenge.WalletChallengeVerifyUseCase,
 ) UpdatePublicWalletByIDService {
 	return &updatePublicWalletByIDServiceImpl{
-		config:                        config,
-		logger:                        logger,
-		publicWalletGetByIDUseCase:    publicWalletGetByIDUseCase,
-		publicWalletUpdateByIDUseCase: publicWalletUpdateByIDUseCase,
-		userGetByIDUseCase:            userGetByIDUseCase,
-		userUpdateUseCase:             userUpdateUseCase,
+		config:                          config,
+		logger:                          logger,
+		publicWalletGetByIDUseCase:      publicWalletGetByIDUseCase,
+		publicWalletGetByAddressUseCase: publicWalletGetByAddressUseCase,
+		publicWalletUpdateByIDUseCase:   publicWalletUpdateByIDUseCase,
+		userGetByIDUseCase:              userGetByIDUseCase,
+		userUpdateUseCase:               userUpdateUseCase,
+		walletChallengeVerifyUseCase:    walletChallengeVerifyUseCase,
 	}
 }
 
@@ -65,6 +76,11 @@ func (svc *updatePublicWalletByIDServiceImpl) UpdateByID(sessCtx mongo.SessionCo
 	// Santize and validate input fields.
 	//
 
+	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
+	req.Address = strings.ToLower(req.Address)
+	req.Address = strings.ReplaceAll(req.Address, " ", "")
+	req.Signature = strings.TrimSpace(req.Signature)
+
 	e := make(map[string]string)
 	if req.Name == "" {
 		e["name"] = "Name is required"
@@ -75,6 +91,14 @@ func (svc *updatePublicWalletByIDServiceImpl) UpdateByID(sessCtx mongo.SessionCo
 	if req.ID.IsZero() {
 		e["id"] = "ID is required"
 	}
+	if req.Address != "" {
+		walletAddress := common.HexToAddress(req.Address)
+		if !common.IsHexAddress(req.Address) {
+			e["wallet_address"] = "Wallet address is invalid"
+		} else if walletAddress.Hex() == "0x0000000000000000000000000000000000000000" {
+			e["wallet_address"] = "Wallet address cannot be burn address"
+		}
+	}
 	if req.ChainID == 0 {
 		e["chain_id"] = "Chain ID is required"
 	} else {
@@ -119,6 +143,56 @@ func (svc *updatePublicWalletByIDServiceImpl) UpdateByID(sessCtx mongo.SessionCo
 		return httperror.NewForBadRequest(&e)
 	}
 
+	//
+	// Verify the owner controls the wallet address. Changing the address
+	// requires a signature by the new address, otherwise a signature is
+	// optional and lets owners verify public wallets created before
+	// signatures were required.
+	//
+
+	walletAddress := existingPublicWallet.Address
+	if req.Address != "" {
+		newWalletAddress := common.HexToAddress(req.Address)
+		walletAddress = &newWalletAddress
+	}
+	if walletAddress == nil {
+		e["wallet_address"] = "Wallet address is required"
+		svc.logger.Warn("Failed validation",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+	isAddressChanged := existingPublicWallet.Address == nil || *walletAddress != *existingPublicWallet.Address
+	if isAddressChanged {
+		if req.Signature == "" {
+			e["signature"] = "Signature is required when changing the wallet address"
+			svc.logger.Warn("Failed validation",
+				slog.Any("error", e))
+			return httperror.NewForBadRequest(&e)
+		}
+		otherPublicWallet, err := svc.publicWalletGetByAddressUseCase.Execute(sessCtx, walletAddress)
+		if err != nil {
+			svc.logger.Error("Failed getting public wallet by address", slog.Any("error", err))
+			return err
+		}
+		if otherPublicWallet != nil {
+			e["wallet_address"] = "Wallet address was already registered"
+			svc.logger.Warn("Failed validation",
+				slog.Any("error", e))
+			return httperror.NewForBadRequest(&e)
+		}
+	}
+	if req.Signature != "" {
+		if err := svc.walletChallengeVerifyUseCase.Execute(sessCtx, userID, walletAddress, dom_walletchallenge.PurposePublishPublicWallet, req.Signature); err != nil {
+			svc.logger.Warn("Failed verifying wallet challenge",
+				slog.Any("address", walletAddress),
+				slog.Any("error", err))
+			return err
+		}
+		existingPublicWallet.Address = walletAddress
+		existingPublicWallet.IsOwnerVerified = true
+		existingPublicWallet.OwnerVerifiedOn = time.Now().UTC()
+	}
+
 	user, err := svc.userGetByIDUseCase.Execute(sessCtx, userID)
 	if err != nil {
 		svc.logger.Error("Failed retrieving user", slog.Any("error", err))
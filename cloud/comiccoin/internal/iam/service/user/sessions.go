@@ -0,0 +1,110 @@
+// github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/service/user/sessions.go
+package user
+
+import (
+	"errors"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+type UserSessionListResponseDTO struct {
+	Sessions []*dom_session.Session `json:"sessions"`
+}
+
+type UserRevokeSessionsResponseDTO struct {
+	Revoked int `json:"revoked"`
+}
+
+// UserSessionsService lets administrators see where a user is logged in
+// and log a compromised user out everywhere.
+type UserSessionsService interface {
+	List(sessCtx mongo.SessionContext, userID primitive.ObjectID) (*UserSessionListResponseDTO, error)
+	RevokeAll(sessCtx mongo.SessionContext, userID primitive.ObjectID) (*UserRevokeSessionsResponseDTO, error)
+}
+
+// userSessionsServiceImpl implements the UserSessionsService interface
+type userSessionsServiceImpl struct {
+	config                          *config.Configuration
+	logger                          *slog.Logger
+	sessionListByUserIDUseCase      uc_session.SessionListByUserIDUseCase
+	sessionRevokeAllByUserIDUseCase uc_session.SessionRevokeAllByUserIDUseCase
+}
+
+// NewUserSessionsService creates a new instance of UserSessionsService
+func NewUserSessionsService(
+	config *config.Configuration,
+	logger *slog.Logger,
+	sessionListByUserIDUseCase uc_session.SessionListByUserIDUseCase,
+	sessionRevokeAllByUserIDUseCase uc_session.SessionRevokeAllByUserIDUseCase,
+) UserSessionsService {
+	return &userSessionsServiceImpl{
+		config:                          config,
+		logger:                          logger,
+		sessionListByUserIDUseCase:      sessionListByUserIDUseCase,
+		sessionRevokeAllByUserIDUseCase: sessionRevokeAllByUserIDUseCase,
+	}
+}
+
+// List returns the sessions of the user
+func (svc *userSessionsServiceImpl) List(sessCtx mongo.SessionContext, userID primitive.ObjectID) (*UserSessionListResponseDTO, error) {
+	if err := svc.checkAdministrator(sessCtx, userID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := svc.sessionListByUserIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		svc.logger.Error("Failed to list sessions",
+			slog.String("user_id", userID.Hex()),
+			slog.Any("error", err))
+		return nil, err
+	}
+	return &UserSessionListResponseDTO{Sessions: sessions}, nil
+}
+
+// RevokeAll logs the user out of every session
+func (svc *userSessionsServiceImpl) RevokeAll(sessCtx mongo.SessionContext, userID primitive.ObjectID) (*UserRevokeSessionsResponseDTO, error) {
+	if err := svc.checkAdministrator(sessCtx, userID); err != nil {
+		return nil, err
+	}
+
+	count, err := svc.sessionRevokeAllByUserIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		svc.logger.Error("Failed to revoke sessions",
+			slog.String("user_id", userID.Hex()),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	sessionUserID, _ := sessCtx.Value(constants.SessionUserID).(primitive.ObjectID)
+	svc.logger.Info("Admin revoked user sessions",
+		slog.String("admin_id", sessionUserID.Hex()),
+		slog.String("user_id", userID.Hex()),
+		slog.Int("revoked", count))
+
+	return &UserRevokeSessionsResponseDTO{Revoked: count}, nil
+}
+
+func (svc *userSessionsServiceImpl) checkAdministrator(sessCtx mongo.SessionContext, userID primitive.ObjectID) error {
+	sessionUserRole, _ := sessCtx.Value(constants.SessionUserRole).(int8)
+	if sessionUserRole != user.UserRoleRoot {
+		svc.logger.Error("Wrong user permission",
+			slog.Any("role", sessionUserRole),
+			slog.Any("error", "User is not root"))
+		return errors.New("user is not administration")
+	}
+
+	// Validate userID
+	if userID.IsZero() {
+		return httperror.NewForBadRequestWithSingleField("id", "User ID is required")
+	}
+	return nil
+}
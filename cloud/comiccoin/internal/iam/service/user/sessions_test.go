@@ -0,0 +1,185 @@
+package user
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config/constants"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
+)
+
+// testSessionContext lets the services run without a mongodb server, the
+// services only use the session context as a context.
+type testSessionContext struct {
+	context.Context
+	mongo.Session
+}
+
+func newTestSessionContextForRole(role int8) mongo.SessionContext {
+	ctx := context.WithValue(context.Background(), constants.SessionUserID, primitive.NewObjectID())
+	ctx = context.WithValue(ctx, constants.SessionUserRole, role)
+	return &testSessionContext{Context: ctx}
+}
+
+type fakeCache struct {
+	values map[string][]byte
+}
+
+func (c *fakeCache) Shutdown(context.Context) {}
+
+func (c *fakeCache) Get(ctx context.Context, key string) ([]byte, error) {
+	return c.values[key], nil
+}
+
+func (c *fakeCache) Set(ctx context.Context, key string, val []byte) error {
+	c.values[key] = val
+	return nil
+}
+
+func (c *fakeCache) SetWithExpiry(ctx context.Context, key string, val []byte, expiry time.Duration) error {
+	return c.Set(ctx, key, val)
+}
+
+func (c *fakeCache) Delete(ctx context.Context, key string) error {
+	delete(c.values, key)
+	return nil
+}
+
+type fakeSessionRepository struct {
+	dom_session.Repository
+	sessions []*dom_session.Session
+}
+
+func (r *fakeSessionRepository) ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*dom_session.Session, error) {
+	var result []*dom_session.Session
+	for _, s := range r.sessions {
+		if s.UserID == userID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeSessionRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	var kept []*dom_session.Session
+	for _, s := range r.sessions {
+		if s.UserID != userID {
+			kept = append(kept, s)
+		}
+	}
+	r.sessions = kept
+	return nil
+}
+
+type fakeTokenStore struct {
+	sec_oauth.TokenStore
+	revoked []string
+}
+
+func (s *fakeTokenStore) RevokeAllFederatedIdentityTokens(federatedidentityID string) error {
+	s.revoked = append(s.revoked, federatedidentityID)
+	return nil
+}
+
+type userSessionsTestEnv struct {
+	cache      *fakeCache
+	repo       *fakeSessionRepository
+	tokenStore *fakeTokenStore
+	service    UserSessionsService
+	userID     primitive.ObjectID
+	otherUser  primitive.ObjectID
+}
+
+// newUserSessionsTestEnv returns a user logged in on two devices next to
+// another user logged in on one.
+func newUserSessionsTestEnv() *userSessionsTestEnv {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	env := &userSessionsTestEnv{
+		cache:      &fakeCache{values: make(map[string][]byte)},
+		tokenStore: &fakeTokenStore{},
+		userID:     primitive.NewObjectID(),
+		otherUser:  primitive.NewObjectID(),
+	}
+	env.repo = &fakeSessionRepository{sessions: []*dom_session.Session{
+		{ID: primitive.NewObjectID(), UserID: env.userID, SessionID: "session-laptop"},
+		{ID: primitive.NewObjectID(), UserID: env.userID, SessionID: "session-phone"},
+		{ID: primitive.NewObjectID(), UserID: env.otherUser, SessionID: "session-someone-else"},
+	}}
+	for _, sess := range env.repo.sessions {
+		env.cache.values[sess.SessionID] = []byte("{}")
+	}
+	env.service = NewUserSessionsService(
+		nil,
+		logger,
+		uc_session.NewSessionListByUserIDUseCase(nil, logger, env.repo),
+		uc_session.NewSessionRevokeAllByUserIDUseCase(nil, logger, env.cache, env.repo, env.tokenStore),
+	)
+	return env
+}
+
+func TestUserSessionsList(t *testing.T) {
+	env := newUserSessionsTestEnv()
+
+	res, err := env.service.List(newTestSessionContextForRole(user.UserRoleRoot), env.userID)
+	if err != nil {
+		t.Fatalf("failed listing sessions: %v", err)
+	}
+	if len(res.Sessions) != 2 {
+		t.Fatalf("expected the two sessions of the user, got %d", len(res.Sessions))
+	}
+	for _, sess := range res.Sessions {
+		if sess.UserID != env.userID {
+			t.Fatalf("expected only the sessions of the user, got a session of %v", sess.UserID.Hex())
+		}
+	}
+}
+
+func TestUserSessionsRevokeAll(t *testing.T) {
+	env := newUserSessionsTestEnv()
+
+	res, err := env.service.RevokeAll(newTestSessionContextForRole(user.UserRoleRoot), env.userID)
+	if err != nil {
+		t.Fatalf("failed revoking sessions: %v", err)
+	}
+	if res.Revoked != 2 {
+		t.Fatalf("expected two revoked sessions, got %d", res.Revoked)
+	}
+	for _, key := range []string{"session-laptop", "session-phone"} {
+		if _, ok := env.cache.values[key]; ok {
+			t.Fatalf("expected the data of session %v to be deleted", key)
+		}
+	}
+	if len(env.repo.sessions) != 1 || env.repo.sessions[0].UserID != env.otherUser {
+		t.Fatalf("expected only the session of the other user to remain, got %d sessions", len(env.repo.sessions))
+	}
+	if _, ok := env.cache.values["session-someone-else"]; !ok {
+		t.Fatal("expected the other user to stay logged in")
+	}
+	if len(env.tokenStore.revoked) != 1 || env.tokenStore.revoked[0] != env.userID.Hex() {
+		t.Fatalf("expected the OAuth tokens of the user to be revoked, got %v", env.tokenStore.revoked)
+	}
+}
+
+func TestUserSessionsRequireRoot(t *testing.T) {
+	env := newUserSessionsTestEnv()
+	sessCtx := newTestSessionContextForRole(user.UserRoleIndividual)
+
+	if _, err := env.service.List(sessCtx, env.userID); err == nil {
+		t.Fatal("expected listing by a non administrator to fail")
+	}
+	if _, err := env.service.RevokeAll(sessCtx, env.userID); err == nil {
+		t.Fatal("expected revoking by a non administrator to fail")
+	}
+	if len(env.repo.sessions) != 3 || len(env.cache.values) != 3 || len(env.tokenStore.revoked) != 0 {
+		t.Fatal("expected nothing to be revoked")
+	}
+}
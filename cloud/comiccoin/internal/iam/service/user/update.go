@@ -18,6 +18,7 @@ import (
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
 	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	uc_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/session"
 	uc_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/usecase/user"
 )
 
@@ -33,6 +34,8 @@ type updateUserServiceImpl struct {
 	passwordProvider   password.Provider
 	userGetByIDUseCase uc_user.UserGetByIDUseCase
 	userUpdateUseCase  uc_user.UserUpdateUseCase
+
+	sessionRevokeAllByUserIDUseCase uc_session.SessionRevokeAllByUserIDUseCase
 }
 
 // NewUpdateUserService creates a new instance of UpdateUserService
@@ -42,13 +45,15 @@ func NewUpdateUserService(
 	passwordProvider password.Provider,
 	userGetByIDUseCase uc_user.UserGetByIDUseCase,
 	userUpdateUseCase uc_user.UserUpdateUseCase,
+	sessionRevokeAllByUserIDUseCase uc_session.SessionRevokeAllByUserIDUseCase,
 ) UpdateUserService {
 	return &updateUserServiceImpl{
-		config:             config,
-		logger:             logger,
-		passwordProvider:   passwordProvider,
-		userGetByIDUseCase: userGetByIDUseCase,
-		userUpdateUseCase:  userUpdateUseCase,
+		config:                          config,
+		logger:                          logger,
+		passwordProvider:                passwordProvider,
+		userGetByIDUseCase:              userGetByIDUseCase,
+		userUpdateUseCase:               userUpdateUseCase,
+		sessionRevokeAllByUserIDUseCase: sessionRevokeAllByUserIDUseCase,
 	}
 }
 
@@ -244,7 +249,9 @@ func (svc *updateUserServiceImpl) Execute(sessCtx mongo.SessionContext, userID p
 	}
 
 	// Update status if provided
+	wasLocked := false
 	if req.Status != nil {
+		wasLocked = existingUser.Status != user.UserStatusLocked && *req.Status == user.UserStatusLocked
 		existingUser.Status = *req.Status
 	}
 
@@ -272,6 +279,16 @@ func (svc *updateUserServiceImpl) Execute(sessCtx mongo.SessionContext, userID p
 		return nil, err
 	}
 
+	// Locking the user logs them out everywhere.
+	if wasLocked {
+		if _, err := svc.sessionRevokeAllByUserIDUseCase.Execute(sessCtx, existingUser.ID); err != nil {
+			svc.logger.Error("Failed to revoke sessions of locked user",
+				slog.String("user_id", userID.Hex()),
+				slog.Any("error", err))
+			return nil, err
+		}
+	}
+
 	svc.logger.Info("Admin updated user",
 		slog.String("admin_id", sessionUserID.Hex()),
 		slog.String("updated_user_id", existingUser.ID.Hex()),
@@ -0,0 +1,56 @@
+package emailer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	domain "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/repo/templatedemailer"
+)
+
+type SendUserAccountUnlockEmailUseCase interface {
+	Execute(ctx context.Context, user *domain.User) error
+}
+type sendUserAccountUnlockEmailUseCaseImpl struct {
+	config  *config.Configuration
+	logger  *slog.Logger
+	emailer templatedemailer.TemplatedEmailer
+}
+
+func NewSendUserAccountUnlockEmailUseCase(config *config.Configuration, logger *slog.Logger, emailer templatedemailer.TemplatedEmailer) SendUserAccountUnlockEmailUseCase {
+	return &sendUserAccountUnlockEmailUseCaseImpl{config, logger, emailer}
+}
+
+func (uc *sendUserAccountUnlockEmailUseCaseImpl) Execute(ctx context.Context, user *domain.User) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if user == nil {
+		e["user"] = "User is missing value"
+	} else {
+		if user.FirstName == "" {
+			e["first_name"] = "First name is required"
+		}
+		if user.Email == "" {
+			e["email"] = "Email is required"
+		}
+		if user.UnlockCode == "" {
+			e["unlock_code"] = "Unlock code is required"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for upsert",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Send email
+	//
+
+	return uc.emailer.SendUserAccountUnlockEmail(ctx, user.Email, user.UnlockCode, user.FirstName)
+}
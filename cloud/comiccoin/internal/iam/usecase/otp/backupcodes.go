@@ -0,0 +1,68 @@
+package otp
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
+)
+
+const (
+	// backupCodeCount is how many backup codes are issued at once.
+	backupCodeCount = 10
+
+	// backupCodeLength is the number of random bytes per backup code, the
+	// code is hex encoded so the user gets twice as many characters.
+	backupCodeLength = 5
+)
+
+// OTPGenerateBackupCodesUseCase creates new one-time use backup codes. The
+// plaintext codes must be shown to the user once and only the hashes stored.
+type OTPGenerateBackupCodesUseCase interface {
+	Execute(ctx context.Context) (codes []string, hashes []string, err error)
+}
+
+type otpGenerateBackupCodesUseCaseImpl struct {
+	config           *config.Configuration
+	logger           *slog.Logger
+	passwordProvider password.Provider
+}
+
+func NewOTPGenerateBackupCodesUseCase(
+	config *config.Configuration,
+	logger *slog.Logger,
+	passwordProvider password.Provider,
+) OTPGenerateBackupCodesUseCase {
+	return &otpGenerateBackupCodesUseCaseImpl{config, logger, passwordProvider}
+}
+
+func (uc *otpGenerateBackupCodesUseCaseImpl) Execute(ctx context.Context) ([]string, []string, error) {
+	codes := make([]string, 0, backupCodeCount)
+	hashes := make([]string, 0, backupCodeCount)
+	for i := 0; i < backupCodeCount; i++ {
+		code, err := uc.passwordProvider.GenerateSecureRandomString(backupCodeLength)
+		if err != nil {
+			uc.logger.Error("Failed generating backup code", slog.Any("error", err))
+			return nil, nil, err
+		}
+		hash, err := uc.hash(code)
+		if err != nil {
+			uc.logger.Error("Failed hashing backup code", slog.Any("error", err))
+			return nil, nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, hash)
+	}
+	return codes, hashes, nil
+}
+
+func (uc *otpGenerateBackupCodesUseCaseImpl) hash(code string) (string, error) {
+	secureCode, err := sstring.NewSecureString(code)
+	if err != nil {
+		return "", err
+	}
+	defer secureCode.Wipe()
+	return uc.passwordProvider.GenerateHashFromPassword(secureCode)
+}
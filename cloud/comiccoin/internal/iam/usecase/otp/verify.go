@@ -0,0 +1,96 @@
+package otp
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/securestring"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/totp"
+	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+)
+
+// OTPVerifyUseCase checks the code from the authenticator app of the user
+// or, if `allowBackupCode` is set, one of their backup codes. A matching
+// backup code is removed from the user and a matching authenticator app code
+// is recorded as used, it is the responsibility of the caller to save the
+// user afterwards.
+type OTPVerifyUseCase interface {
+	Execute(ctx context.Context, user *dom_user.User, code string, allowBackupCode bool) (bool, error)
+}
+
+type otpVerifyUseCaseImpl struct {
+	config           *config.Configuration
+	logger           *slog.Logger
+	totpProvider     totp.Provider
+	passwordProvider password.Provider
+}
+
+func NewOTPVerifyUseCase(
+	config *config.Configuration,
+	logger *slog.Logger,
+	totpProvider totp.Provider,
+	passwordProvider password.Provider,
+) OTPVerifyUseCase {
+	return &otpVerifyUseCaseImpl{config, logger, totpProvider, passwordProvider}
+}
+
+func (uc *otpVerifyUseCaseImpl) Execute(ctx context.Context, user *dom_user.User, code string, allowBackupCode bool) (bool, error) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if user == nil || user.OTPSecret == "" || code == "" {
+		return false, nil
+	}
+
+	//
+	// STEP 1: Check the authenticator app code.
+	//
+
+	step, ok, err := uc.totpProvider.ValidateCodeTimeStep(user.OTPSecret, code, time.Now())
+	if err != nil {
+		uc.logger.Error("Failed validating otp code",
+			slog.Any("user_id", user.ID.Hex()),
+			slog.Any("error", err))
+		return false, err
+	}
+	if ok {
+		// Codes are valid for a few periods so reject any code from a time
+		// step which was already used, else it could be replayed.
+		if step <= user.OTPLastUsedTimeStep {
+			uc.logger.Warn("Rejected replayed otp code",
+				slog.Any("user_id", user.ID.Hex()),
+				slog.Any("time_step", step))
+			return false, nil
+		}
+		user.OTPLastUsedTimeStep = step
+		return true, nil
+	}
+	if !allowBackupCode {
+		return false, nil
+	}
+
+	//
+	// STEP 2: Check the backup codes.
+	//
+
+	secureCode, err := sstring.NewSecureString(strings.ToLower(code))
+	if err != nil {
+		return false, err
+	}
+	defer secureCode.Wipe()
+
+	for i, hash := range user.OTPBackupCodeHashes {
+		match, _ := uc.passwordProvider.ComparePasswordAndHash(secureCode, hash)
+		if match {
+			// Backup codes can only be used once.
+			user.OTPBackupCodeHashes = append(user.OTPBackupCodeHashes[:i:i], user.OTPBackupCodeHashes[i+1:]...)
+			uc.logger.Debug("Used otp backup code",
+				slog.Any("user_id", user.ID.Hex()),
+				slog.Int("remaining", len(user.OTPBackupCodeHashes)))
+			return true, nil
+		}
+	}
+	return false, nil
+}
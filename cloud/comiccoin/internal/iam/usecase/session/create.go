@@ -0,0 +1,53 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+type SessionCreateUseCase interface {
+	Execute(ctx context.Context, session *dom_session.Session) error
+}
+
+type sessionCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_session.Repository
+}
+
+func NewSessionCreateUseCase(config *config.Configuration, logger *slog.Logger, repo dom_session.Repository) SessionCreateUseCase {
+	return &sessionCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *sessionCreateUseCaseImpl) Execute(ctx context.Context, session *dom_session.Session) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if session == nil {
+		e["session"] = "missing value"
+	} else {
+		if session.UserID.IsZero() {
+			e["user_id"] = "missing value"
+		}
+		if session.SessionID == "" {
+			e["session_id"] = "missing value"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for create",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, session)
+}
@@ -0,0 +1,48 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+type SessionGetByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) (*dom_session.Session, error)
+}
+
+type sessionGetByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_session.Repository
+}
+
+func NewSessionGetByIDUseCase(config *config.Configuration, logger *slog.Logger, repo dom_session.Repository) SessionGetByIDUseCase {
+	return &sessionGetByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *sessionGetByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*dom_session.Session, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for get",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByID(ctx, id)
+}
@@ -0,0 +1,46 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+type SessionGetBySessionIDUseCase interface {
+	Execute(ctx context.Context, sessionID string) (*dom_session.Session, error)
+}
+
+type sessionGetBySessionIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_session.Repository
+}
+
+func NewSessionGetBySessionIDUseCase(config *config.Configuration, logger *slog.Logger, repo dom_session.Repository) SessionGetBySessionIDUseCase {
+	return &sessionGetBySessionIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *sessionGetBySessionIDUseCaseImpl) Execute(ctx context.Context, sessionID string) (*dom_session.Session, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if sessionID == "" {
+		e["session_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for get",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetBySessionID(ctx, sessionID)
+}
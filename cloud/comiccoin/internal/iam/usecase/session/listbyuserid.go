@@ -0,0 +1,48 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+type SessionListByUserIDUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID) ([]*dom_session.Session, error)
+}
+
+type sessionListByUserIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_session.Repository
+}
+
+func NewSessionListByUserIDUseCase(config *config.Configuration, logger *slog.Logger, repo dom_session.Repository) SessionListByUserIDUseCase {
+	return &sessionListByUserIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *sessionListByUserIDUseCaseImpl) Execute(ctx context.Context, userID primitive.ObjectID) ([]*dom_session.Session, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for list",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List from database.
+	//
+
+	return uc.repo.ListByUserID(ctx, userID)
+}
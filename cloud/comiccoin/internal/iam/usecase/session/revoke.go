@@ -0,0 +1,55 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+// SessionRevokeUseCase logs out a single session by deleting its data from
+// the cache, which invalidates its access and refresh tokens, and by
+// removing it from the index.
+type SessionRevokeUseCase interface {
+	Execute(ctx context.Context, session *dom_session.Session) error
+}
+
+type sessionRevokeUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	cache  mongodbcache.Cacher
+	repo   dom_session.Repository
+}
+
+func NewSessionRevokeUseCase(config *config.Configuration, logger *slog.Logger, ca mongodbcache.Cacher, repo dom_session.Repository) SessionRevokeUseCase {
+	return &sessionRevokeUseCaseImpl{config, logger, ca, repo}
+}
+
+func (uc *sessionRevokeUseCaseImpl) Execute(ctx context.Context, session *dom_session.Session) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if session == nil {
+		e["session"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for revoke",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete the session data and then the index.
+	//
+
+	if err := uc.cache.Delete(ctx, session.SessionID); err != nil {
+		uc.logger.Error("cache delete error", slog.Any("err", err))
+		return err
+	}
+	return uc.repo.DeleteByID(ctx, session.ID)
+}
@@ -0,0 +1,83 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	sec_oauth "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/oauth"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/storage/database/mongodbcache"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+// SessionRevokeAllByUserIDUseCase logs out every session of the user and
+// revokes the OAuth tokens issued for the user, used when the user's
+// credentials can no longer be trusted.
+type SessionRevokeAllByUserIDUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID) (int, error)
+}
+
+type sessionRevokeAllByUserIDUseCaseImpl struct {
+	config     *config.Configuration
+	logger     *slog.Logger
+	cache      mongodbcache.Cacher
+	repo       dom_session.Repository
+	tokenStore sec_oauth.TokenStore
+}
+
+func NewSessionRevokeAllByUserIDUseCase(config *config.Configuration, logger *slog.Logger, ca mongodbcache.Cacher, repo dom_session.Repository, tokenStore sec_oauth.TokenStore) SessionRevokeAllByUserIDUseCase {
+	return &sessionRevokeAllByUserIDUseCaseImpl{config, logger, ca, repo, tokenStore}
+}
+
+func (uc *sessionRevokeAllByUserIDUseCaseImpl) Execute(ctx context.Context, userID primitive.ObjectID) (int, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for revoke all",
+			slog.Any("error", e))
+		return 0, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete the session data of every session and then the index.
+	//
+
+	sessions, err := uc.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	for _, session := range sessions {
+		if err := uc.cache.Delete(ctx, session.SessionID); err != nil {
+			uc.logger.Error("cache delete error", slog.Any("err", err))
+			return 0, err
+		}
+	}
+	if err := uc.repo.DeleteByUserID(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	//
+	// STEP 3: Revoke the OAuth tokens as well, otherwise the applications the
+	// user authorized keep access and can refresh their tokens forever.
+	//
+
+	if err := uc.tokenStore.RevokeAllFederatedIdentityTokens(userID.Hex()); err != nil {
+		uc.logger.Error("oauth tokens revoke error", slog.Any("err", err))
+		return 0, err
+	}
+
+	uc.logger.Debug("revoked all sessions of user",
+		slog.String("user_id", userID.Hex()),
+		slog.Int("count", len(sessions)))
+
+	return len(sessions), nil
+}
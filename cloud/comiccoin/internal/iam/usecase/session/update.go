@@ -0,0 +1,48 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_session "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/session"
+)
+
+type SessionUpdateUseCase interface {
+	Execute(ctx context.Context, session *dom_session.Session) error
+}
+
+type sessionUpdateUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_session.Repository
+}
+
+func NewSessionUpdateUseCase(config *config.Configuration, logger *slog.Logger, repo dom_session.Repository) SessionUpdateUseCase {
+	return &sessionUpdateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *sessionUpdateUseCaseImpl) Execute(ctx context.Context, session *dom_session.Session) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if session == nil {
+		e["session"] = "missing value"
+	} else if session.SessionID == "" {
+		e["session_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for update",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Update in database.
+	//
+
+	return uc.repo.UpdateByID(ctx, session)
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_user "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/user"
+)
+
+type UserGetByUnlockCodeUseCase interface {
+	Execute(ctx context.Context, unlockCode string) (*dom_user.User, error)
+}
+
+type userGetByUnlockCodeUseCaseImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_user.Repository
+}
+
+func NewUserGetByUnlockCodeUseCase(config *config.Configuration, logger *slog.Logger, repo dom_user.Repository) UserGetByUnlockCodeUseCase {
+	return &userGetByUnlockCodeUseCaseImpl{config, logger, repo}
+}
+
+func (uc *userGetByUnlockCodeUseCaseImpl) Execute(ctx context.Context, unlockCode string) (*dom_user.User, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if unlockCode == "" {
+		e["unlock_code"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for get by unlock code",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 3: Get from database.
+	//
+
+	return uc.repo.GetByUnlockCode(ctx, unlockCode)
+}
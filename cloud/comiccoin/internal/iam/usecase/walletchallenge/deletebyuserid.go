@@ -0,0 +1,48 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+type WalletChallengeDeleteByUserIDUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type walletChallengeDeleteByUserIDImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_walletchallenge.Repository
+}
+
+func NewWalletChallengeDeleteByUserIDUseCase(config *config.Configuration, logger *slog.Logger, repo dom_walletchallenge.Repository) WalletChallengeDeleteByUserIDUseCase {
+	return &walletChallengeDeleteByUserIDImpl{config, logger, repo}
+}
+
+func (uc *walletChallengeDeleteByUserIDImpl) Execute(ctx context.Context, userID primitive.ObjectID) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for delete",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteByUserID(ctx, userID)
+}
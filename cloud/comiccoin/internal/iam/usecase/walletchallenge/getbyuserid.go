@@ -0,0 +1,48 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+type WalletChallengeGetByUserIDUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID) (*dom_walletchallenge.WalletChallenge, error)
+}
+
+type walletChallengeGetByUserIDImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_walletchallenge.Repository
+}
+
+func NewWalletChallengeGetByUserIDUseCase(config *config.Configuration, logger *slog.Logger, repo dom_walletchallenge.Repository) WalletChallengeGetByUserIDUseCase {
+	return &walletChallengeGetByUserIDImpl{config, logger, repo}
+}
+
+func (uc *walletChallengeGetByUserIDImpl) Execute(ctx context.Context, userID primitive.ObjectID) (*dom_walletchallenge.WalletChallenge, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for get",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByUserID(ctx, userID)
+}
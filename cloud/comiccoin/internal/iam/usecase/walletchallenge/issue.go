@@ -0,0 +1,94 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+// walletChallengeExpiry is how long the user has to sign the challenge with
+// their wallet.
+const walletChallengeExpiry = 5 * time.Minute
+
+// WalletChallengeIssueUseCase creates the message which the user must sign
+// with their wallet to prove they control the wallet address, replacing any
+// outstanding challenge of the user. The challenge can only be verified for
+// the same purpose, see `dom_walletchallenge.PurposeConnectWallet`.
+type WalletChallengeIssueUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID, walletAddress *common.Address, purpose string) (*dom_walletchallenge.WalletChallenge, error)
+}
+
+type walletChallengeIssueImpl struct {
+	config           *config.Configuration
+	logger           *slog.Logger
+	passwordProvider password.Provider
+	repo             dom_walletchallenge.Repository
+}
+
+func NewWalletChallengeIssueUseCase(config *config.Configuration, logger *slog.Logger, passp password.Provider, repo dom_walletchallenge.Repository) WalletChallengeIssueUseCase {
+	return &walletChallengeIssueImpl{config, logger, passp, repo}
+}
+
+func (uc *walletChallengeIssueImpl) Execute(ctx context.Context, userID primitive.ObjectID, walletAddress *common.Address, purpose string) (*dom_walletchallenge.WalletChallenge, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if walletAddress == nil {
+		e["wallet_address"] = "missing value"
+	}
+	if !dom_walletchallenge.IsValidPurpose(purpose) {
+		e["purpose"] = "invalid value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for issue",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Create the challenge.
+	//
+
+	nonce, err := uc.passwordProvider.GenerateSecureRandomString(16)
+	if err != nil {
+		uc.logger.Error("Failed generating nonce", slog.Any("error", err))
+		return nil, err
+	}
+	now := time.Now()
+	expiresAt := now.Add(walletChallengeExpiry)
+	domain := dom_walletchallenge.DomainFromFrontendURL(uc.config.IAMEmailer.FrontendDomain)
+	challenge := &dom_walletchallenge.WalletChallenge{
+		ID:            primitive.NewObjectID(),
+		UserID:        userID,
+		WalletAddress: walletAddress,
+		Domain:        domain,
+		Purpose:       purpose,
+		Nonce:         nonce,
+		Message:       dom_walletchallenge.NewMessage(domain, purpose, walletAddress, nonce, now, expiresAt),
+		ExpiresAt:     expiresAt,
+		CreatedAt:     now,
+	}
+
+	//
+	// STEP 3: Upsert into database.
+	//
+
+	if err := uc.repo.Upsert(ctx, challenge); err != nil {
+		uc.logger.Error("Failed saving wallet challenge", slog.Any("error", err))
+		return nil, err
+	}
+	return challenge, nil
+}
@@ -0,0 +1,62 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+type WalletChallengeUpsertUseCase interface {
+	Execute(ctx context.Context, challenge *dom_walletchallenge.WalletChallenge) error
+}
+
+type walletChallengeUpsertImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_walletchallenge.Repository
+}
+
+func NewWalletChallengeUpsertUseCase(config *config.Configuration, logger *slog.Logger, repo dom_walletchallenge.Repository) WalletChallengeUpsertUseCase {
+	return &walletChallengeUpsertImpl{config, logger, repo}
+}
+
+func (uc *walletChallengeUpsertImpl) Execute(ctx context.Context, challenge *dom_walletchallenge.WalletChallenge) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if challenge == nil {
+		e["challenge"] = "Challenge is required"
+	} else {
+		if challenge.UserID.IsZero() {
+			e["user_id"] = "User ID is required"
+		}
+		if challenge.WalletAddress == nil {
+			e["wallet_address"] = "Wallet address is required"
+		}
+		if challenge.Nonce == "" {
+			e["nonce"] = "Nonce is required"
+		}
+		if challenge.Message == "" {
+			e["message"] = "Message is required"
+		}
+		if challenge.ExpiresAt.IsZero() {
+			e["expires_at"] = "Expiry is required"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for upsert",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Upsert(ctx, challenge)
+}
@@ -0,0 +1,117 @@
+package walletchallenge
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+// WalletChallengeVerifyUseCase recovers the signer of the outstanding
+// challenge of the user and makes sure it is the wallet address and that
+// the challenge was issued for the purpose. The challenge is deleted so the
+// signature cannot be replayed.
+//
+// DEVELOPERS NOTE:
+// Callers run inside the mongodb transaction of the request, therefore the
+// challenge is only deleted if the rest of the request succeeds.
+type WalletChallengeVerifyUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID, walletAddress *common.Address, purpose string, sig string) error
+}
+
+type walletChallengeVerifyImpl struct {
+	config *config.Configuration
+	logger *slog.Logger
+	repo   dom_walletchallenge.Repository
+}
+
+func NewWalletChallengeVerifyUseCase(config *config.Configuration, logger *slog.Logger, repo dom_walletchallenge.Repository) WalletChallengeVerifyUseCase {
+	return &walletChallengeVerifyImpl{config, logger, repo}
+}
+
+func (uc *walletChallengeVerifyImpl) Execute(ctx context.Context, userID primitive.ObjectID, walletAddress *common.Address, purpose string, sig string) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if walletAddress == nil {
+		e["wallet_address"] = "missing value"
+	}
+	if !dom_walletchallenge.IsValidPurpose(purpose) {
+		e["purpose"] = "invalid value"
+	}
+	if sig == "" {
+		e["signature"] = "Signature is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for verify",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get the outstanding challenge.
+	//
+
+	challenge, err := uc.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("Failed getting wallet challenge", slog.Any("error", err))
+		return err
+	}
+	if challenge == nil || challenge.IsExpired(time.Now()) {
+		return httperror.NewForBadRequestWithSingleField("signature", "Wallet challenge does not exist or has expired, please request a new challenge")
+	}
+	if challenge.Purpose != purpose {
+		return httperror.NewForBadRequestWithSingleField("signature", "Wallet challenge was issued for another purpose, please request a new challenge")
+	}
+	if challenge.WalletAddress == nil || *challenge.WalletAddress != *walletAddress {
+		return httperror.NewForBadRequestWithSingleField("wallet_address", "Wallet address does not match the challenge")
+	}
+
+	//
+	// STEP 3: Recover the signer.
+	//
+
+	if !strings.HasPrefix(sig, "0x") || len(sig) != 132 {
+		return httperror.NewForBadRequestWithSingleField("signature", "Signature is invalid")
+	}
+	v, r, s, err := signature.ToVRSFromHexSignature(sig)
+	if err != nil {
+		return httperror.NewForBadRequestWithSingleField("signature", "Signature is invalid")
+	}
+	if err := signature.VerifySignature(v, r, s); err != nil {
+		return httperror.NewForBadRequestWithSingleField("signature", "Signature is invalid")
+	}
+	signer, err := signature.FromAddress(dom_walletchallenge.SignedMessage{Message: challenge.Message}, v, r, s)
+	if err != nil {
+		return httperror.NewForBadRequestWithSingleField("signature", "Signature is invalid")
+	}
+	if !strings.EqualFold(signer, walletAddress.Hex()) {
+		uc.logger.Warn("Wallet challenge was not signed by the wallet address",
+			slog.String("wallet_address", walletAddress.Hex()),
+			slog.String("signer", signer))
+		return httperror.NewForBadRequestWithSingleField("signature", "Signature was not signed by the wallet address")
+	}
+
+	//
+	// STEP 4: Consume the challenge.
+	//
+
+	if err := uc.repo.DeleteByUserID(ctx, userID); err != nil {
+		uc.logger.Error("Failed deleting wallet challenge", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package walletchallenge
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/config"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/blockchain/signature"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/common/security/password"
+	dom_walletchallenge "github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/iam/domain/walletchallenge"
+)
+
+// fakeRepository keeps the outstanding challenge of every user.
+type fakeRepository struct {
+	challenges map[primitive.ObjectID]*dom_walletchallenge.WalletChallenge
+}
+
+func (r *fakeRepository) Upsert(ctx context.Context, m *dom_walletchallenge.WalletChallenge) error {
+	copied := *m
+	r.challenges[m.UserID] = &copied
+	return nil
+}
+
+func (r *fakeRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) (*dom_walletchallenge.WalletChallenge, error) {
+	return r.challenges[userID], nil
+}
+
+func (r *fakeRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	delete(r.challenges, userID)
+	return nil
+}
+
+type walletChallengeTestEnv struct {
+	repo   *fakeRepository
+	issue  WalletChallengeIssueUseCase
+	verify WalletChallengeVerifyUseCase
+	userID primitive.ObjectID
+	key    *ecdsa.PrivateKey
+	wallet common.Address
+}
+
+func newWalletChallengeTestEnv(t *testing.T) *walletChallengeTestEnv {
+	t.Helper()
+	cfg := &config.Configuration{IAMEmailer: config.IAMMailgunConfig{FrontendDomain: "https://comiccoin.example.com/"}}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	repo := &fakeRepository{challenges: make(map[primitive.ObjectID]*dom_walletchallenge.WalletChallenge)}
+	return &walletChallengeTestEnv{
+		repo:   repo,
+		issue:  NewWalletChallengeIssueUseCase(cfg, logger, password.NewProvider(), repo),
+		verify: NewWalletChallengeVerifyUseCase(cfg, logger, repo),
+		userID: primitive.NewObjectID(),
+		key:    key,
+		wallet: crypto.PubkeyToAddress(key.PublicKey),
+	}
+}
+
+// sign signs the message of the challenge like the wallet of the user does.
+func sign(t *testing.T, key *ecdsa.PrivateKey, challenge *dom_walletchallenge.WalletChallenge) string {
+	t.Helper()
+	v, r, s, err := signature.Sign(dom_walletchallenge.SignedMessage{Message: challenge.Message}, key)
+	if err != nil {
+		t.Fatalf("failed signing challenge: %v", err)
+	}
+	return signature.SignatureString(v, r, s)
+}
+
+func requireBadRequest(t *testing.T, err error, field string) {
+	t.Helper()
+	var httpErr httperror.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+		t.Fatalf("expected a bad request, got %v", err)
+	}
+	if _, ok := (*httpErr.Errors)[field]; !ok {
+		t.Fatalf("expected an error for %v, got %v", field, *httpErr.Errors)
+	}
+}
+
+func TestWalletChallengeMessage(t *testing.T) {
+	env := newWalletChallengeTestEnv(t)
+
+	challenge, err := env.issue.Execute(context.Background(), env.userID, &env.wallet, dom_walletchallenge.PurposePublishPublicWallet)
+	if err != nil {
+		t.Fatalf("failed issuing challenge: %v", err)
+	}
+	if challenge.Domain != "comiccoin.example.com" || challenge.Purpose != dom_walletchallenge.PurposePublishPublicWallet {
+		t.Fatalf("unexpected domain %q and purpose %q", challenge.Domain, challenge.Purpose)
+	}
+	for _, line := range []string{
+		"comiccoin.example.com wants you to prove you own this ComicCoin wallet:",
+		strings.ToLower(env.wallet.Hex()),
+		"Purpose: Publish this wallet in the ComicCoin public wallet directory",
+		"Domain: comiccoin.example.com",
+		"Nonce: " + challenge.Nonce,
+	} {
+		if !strings.Contains(challenge.Message, line+"\n") {
+			t.Fatalf("expected the message to contain %q, got:\n%v", line, challenge.Message)
+		}
+	}
+
+	if _, err := env.issue.Execute(context.Background(), env.userID, &env.wallet, "unknown"); err == nil {
+		t.Fatal("expected a challenge for an unknown purpose to be rejected")
+	}
+}
+
+func TestWalletChallengeVerify(t *testing.T) {
+	ctx := context.Background()
+	env := newWalletChallengeTestEnv(t)
+
+	challenge, err := env.issue.Execute(ctx, env.userID, &env.wallet, dom_walletchallenge.PurposeConnectWallet)
+	if err != nil {
+		t.Fatalf("failed issuing challenge: %v", err)
+	}
+	sig := sign(t, env.key, challenge)
+	if err := env.verify.Execute(ctx, env.userID, &env.wallet, dom_walletchallenge.PurposeConnectWallet, sig); err != nil {
+		t.Fatalf("expected the signed challenge to verify: %v", err)
+	}
+
+	// The challenge was consumed so the signature cannot be replayed.
+	err = env.verify.Execute(ctx, env.userID, &env.wallet, dom_walletchallenge.PurposeConnectWallet, sig)
+	requireBadRequest(t, err, "signature")
+}
+
+func TestWalletChallengeVerifyRejects(t *testing.T) {
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	otherWallet := crypto.PubkeyToAddress(otherKey.PublicKey)
+
+	tests := []struct {
+		name string
+		// verify is called with the challenge issued to connect the wallet
+		// and returns the field of the expected error.
+		verify func(t *testing.T, env *walletChallengeTestEnv, challenge *dom_walletchallenge.WalletChallenge) (string, error)
+	}{
+		{"expired", func(t *testing.T, env *walletChallengeTestEnv, challenge *dom_walletchallenge.WalletChallenge) (string, error) {
+			env.repo.challenges[env.userID].ExpiresAt = time.Now().Add(-time.Second)
+			return "signature", env.verify.Execute(context.Background(), env.userID, &env.wallet, dom_walletchallenge.PurposeConnectWallet, sign(t, env.key, challenge))
+		}},
+		{"signed by another wallet", func(t *testing.T, env *walletChallengeTestEnv, challenge *dom_walletchallenge.WalletChallenge) (string, error) {
+			return "signature", env.verify.Execute(context.Background(), env.userID, &env.wallet, dom_walletchallenge.PurposeConnectWallet, sign(t, otherKey, challenge))
+		}},
+		{"verified for another wallet", func(t *testing.T, env *walletChallengeTestEnv, challenge *dom_walletchallenge.WalletChallenge) (string, error) {
+			return "wallet_address", env.verify.Execute(context.Background(), env.userID, &otherWallet, dom_walletchallenge.PurposeConnectWallet, sign(t, otherKey, challenge))
+		}},
+		{"verified for another purpose", func(t *testing.T, env *walletChallengeTestEnv, challenge *dom_walletchallenge.WalletChallenge) (string, error) {
+			return "signature", env.verify.Execute(context.Background(), env.userID, &env.wallet, dom_walletchallenge.PurposePublishPublicWallet, sign(t, env.key, challenge))
+		}},
+		{"verified by another user", func(t *testing.T, env *walletChallengeTestEnv, challenge *dom_walletchallenge.WalletChallenge) (string, error) {
+			return "signature", env.verify.Execute(context.Background(), primitive.NewObjectID(), &env.wallet, dom_walletchallenge.PurposeConnectWallet, sign(t, env.key, challenge))
+		}},
+		{"malformed signature", func(t *testing.T, env *walletChallengeTestEnv, challenge *dom_walletchallenge.WalletChallenge) (string, error) {
+			return "signature", env.verify.Execute(context.Background(), env.userID, &env.wallet, dom_walletchallenge.PurposeConnectWallet, "0x1234")
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newWalletChallengeTestEnv(t)
+			challenge, err := env.issue.Execute(context.Background(), env.userID, &env.wallet, dom_walletchallenge.PurposeConnectWallet)
+			if err != nil {
+				t.Fatalf("failed issuing challenge: %v", err)
+			}
+
+			field, err := tt.verify(t, env, challenge)
+			requireBadRequest(t, err, field)
+			if env.repo.challenges[env.userID] == nil {
+				t.Fatal("expected a rejected signature not to consume the challenge")
+			}
+		})
+	}
+}
@@ -1,6 +1,22 @@
 // github.com/comiccoin-network/monorepo/cloud/comiccoin/internal/publicfaucet/domain/remoteaccountbalance/model.go
 package remoteaccountbalance
 
+import "math/big"
+
 type RemoteAccountBalance struct {
 	Balance uint64 `bson:"balance" json:"balance"`
+
+	// NextNonceString is the nonce which the next transaction sent from this
+	// account must use, this is returned by the authority in string format.
+	NextNonceString string `bson:"next_nonce_string" json:"next_nonce_string"`
+}
+
+// GetNextNonce returns the next nonce as a big integer or nil if the
+// authority did not return a valid value.
+func (rab *RemoteAccountBalance) GetNextNonce() *big.Int {
+	nonce, ok := new(big.Int).SetString(rab.NextNonceString, 10)
+	if !ok {
+		return nil
+	}
+	return nonce
 }
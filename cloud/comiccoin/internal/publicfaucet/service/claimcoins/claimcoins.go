@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -241,7 +240,15 @@ func (svc *claimCoinsServiceImpl) Execute(sessCtx mongo.SessionContext) (*ClaimC
 	// Create our pending transaction and sign it with the faucet's private key.
 	//
 
-	nonceBigInt := big.NewInt(time.Now().Unix())
+	// DEVELOPERS NOTE:
+	// The authority only accepts transactions which use the next nonce of the
+	// sending account, this is safe to use because we hold the lock above.
+	nonceBigInt := remoteAccount.GetNextNonce()
+	if nonceBigInt == nil {
+		err := fmt.Errorf("next nonce d.n.e. for address: %v", svc.config.Blockchain.PublicFaucetAccountAddress)
+		svc.logger.Error("failed getting next nonce from authority", slog.Any("err", err))
+		return nil, err
+	}
 	nonceBytes := nonceBigInt.Bytes()
 
 	tx := &dom_auth_tx.Transaction{
@@ -252,6 +259,7 @@ func (svc *claimCoinsServiceImpl) Execute(sessCtx mongo.SessionContext) (*ClaimC
 		Value:      svc.config.Blockchain.PublicFaucetClaimCoinsReward + svc.config.Blockchain.TransactionFee, // Note: The transaction fee gets reclaimed by the Authority, so it's fully recirculating when authority calls this.
 		Data:       []byte{},
 		Type:       dom_auth_tx.TransactionTypeCoin,
+		Version:    dom_auth_tx.TransactionVersion,
 	}
 
 	stx, signingErr := tx.Sign(privateKey)
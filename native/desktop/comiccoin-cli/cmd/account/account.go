@@ -33,6 +33,7 @@ func AccountCmd() *cobra.Command {
 	cmd.AddCommand(GetAccountCmd())
 	cmd.AddCommand(ListAccountCmd())
 	cmd.AddCommand(ListBlockTransactionsCmd())
+	cmd.AddCommand(SignMessageCmd())
 	cmd.AddCommand(wallet.WalletCmd())
 
 	return cmd
@@ -0,0 +1,80 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/logger"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/repo"
+)
+
+var (
+	flagMessage     string
+	flagMessageFile string
+)
+
+func SignMessageCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "sign-message",
+		Short: "Sign a message with the account wallet to prove you own the account",
+		Long: `Signs the message with the private key of the account wallet and prints the
+signature, for example to answer the challenge given when connecting your
+wallet to your ComicCoin account. Use --message-file for multi-line messages.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunSignMessage()
+		},
+	}
+
+	cmd.Flags().StringVar(&flagAccountAddress, "address", "", "The address of the account to sign with")
+	cmd.MarkFlagRequired("address")
+
+	cmd.Flags().StringVar(&flagPassword, "wallet-password", "", "The password to decrypt the account wallet with")
+	cmd.MarkFlagRequired("wallet-password")
+
+	cmd.Flags().StringVar(&flagMessage, "message", "", "The message to sign")
+	cmd.Flags().StringVar(&flagMessageFile, "message-file", "", "The file containing the message to sign")
+
+	return cmd
+}
+
+func doRunSignMessage() {
+	logger := logger.NewProvider()
+
+	message := flagMessage
+	if flagMessageFile != "" {
+		data, err := os.ReadFile(flagMessageFile)
+		if err != nil {
+			log.Fatalf("Failed reading message file: %v\n", err)
+		}
+		message = strings.TrimRight(string(data), "\r\n")
+	}
+	if message == "" {
+		log.Fatal("Either `message` or `message-file` is required")
+	}
+
+	pass, err := sstring.NewSecureString(flagPassword)
+	if err != nil {
+		log.Fatalf("Failed secure password: %v", err)
+	}
+
+	comicCoincRPCClientRepoConfigurationProvider := repo.NewComicCoincRPCClientRepoConfigurationProvider("localhost", "2233")
+	rpcClient := repo.NewComicCoincRPCClientRepo(comicCoincRPCClientRepoConfigurationProvider, logger)
+
+	ctx := context.Background()
+
+	accountAddress := common.HexToAddress(strings.ToLower(flagAccountAddress))
+
+	sig, err := rpcClient.SignMessage(ctx, &accountAddress, pass, message)
+	if err != nil {
+		log.Fatalf("Failed to sign message: %v\n", err)
+	}
+
+	fmt.Println(sig)
+}
@@ -56,6 +56,9 @@ func doRunListBlockTransactions() {
 			slog.Any("From", tx.From),
 			slog.Any("To", tx.To),
 			slog.Any("Value", tx.Value),
+			slog.Any("Fee", tx.Fee),
+			slog.Any("RoyaltyRecipient", tx.RoyaltyRecipient),
+			slog.Any("Royalty", tx.Royalty),
 			slog.Any("Data", tx.Data),
 			slog.Any("TokenID", tx.GetTokenID()),
 			slog.Any("TokenMetadataURI", tx.TokenMetadataURI),
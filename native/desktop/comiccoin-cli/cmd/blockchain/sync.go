@@ -10,20 +10,23 @@ import (
 	inmemory "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage/memory/inmemory"
 	auth_repo "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/repo"
 	uc_blockchainstatedto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockchainstatedto"
-	uc_blockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockdatadto"
-	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/genesisblockdatadto"
 	"github.com/spf13/cobra"
 
 	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/repo"
 	service_blockchain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchain"
 	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
+	uc_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainreorgevent"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainstate"
 	uc_blockchainsyncstatus "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainsyncstatus"
 	uc_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdata"
+	uc_blockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdatadto"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdatadto"
 	uc_pstx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/pstx"
+	uc_statesnapshot "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/statesnapshot"
 	uc_storagetransaction "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/storagetransaction"
 	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
+	uc_validatorset "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/validatorset"
 )
 
 // Command line argument flags
@@ -32,6 +35,7 @@ var (
 	flagChainID           uint16
 	flagAuthorityAddress  string
 	flagNFTStorageAddress string
+	flagFastSync          bool
 )
 
 func BlockchainSyncCmd() *cobra.Command {
@@ -49,6 +53,7 @@ func BlockchainSyncCmd() *cobra.Command {
 	cmd.Flags().Uint16Var(&flagChainID, "chain-id", preferences.ChainID, "The blockchain to sync with")
 	cmd.Flags().StringVar(&flagAuthorityAddress, "authority-address", preferences.AuthorityAddress, "The BlockChain authority address to connect to")
 	cmd.Flags().StringVar(&flagNFTStorageAddress, "nftstorage-address", preferences.NFTStorageAddress, "The NFT storage service adress to connect to")
+	cmd.Flags().BoolVar(&flagFastSync, "fast-sync", preferences.FastSync, "Bootstrap an empty local blockchain from the latest state snapshot of the Authority")
 
 	return cmd
 }
@@ -69,6 +74,8 @@ func doRunBlockchainSyncCmd() error {
 	blockDataDB := disk.NewDiskStorage(flagDataDirectory, "block_data", logger)
 	tokenRepo := disk.NewDiskStorage(flagDataDirectory, "token", logger)
 	pstxDB := disk.NewDiskStorage(flagDataDirectory, "pending_signed_transaction", logger)
+	blockchainReorgEventDB := disk.NewDiskStorage(flagDataDirectory, "blockchain_reorg_event", logger)
+	validatorSetDB := disk.NewDiskStorage(flagDataDirectory, "validator_set", logger)
 
 	// ------------ Repo ------------
 
@@ -88,21 +95,31 @@ func doRunBlockchainSyncCmd() error {
 	blockchainStateDTORepo := auth_repo.NewBlockchainStateDTORepo(
 		blockchainStateDTORepoConfig,
 		logger)
-	genesisBlockDataDTORepoConfig := auth_repo.NewGenesisBlockDataDTOConfigurationProvider(flagAuthorityAddress)
-	genesisBlockDataDTORepo := auth_repo.NewGenesisBlockDataDTORepo(
+	genesisBlockDataDTORepoConfig := repo.NewGenesisBlockDataDTOConfigurationProvider(flagAuthorityAddress)
+	genesisBlockDataDTORepo := repo.NewGenesisBlockDataDTORepo(
 		genesisBlockDataDTORepoConfig,
 		logger)
 	blockDataRepo := repo.NewBlockDataRepo(
 		logger,
 		blockDataDB)
-	blockDataDTORepoConfig := auth_repo.NewBlockDataDTOConfigurationProvider(flagAuthorityAddress)
-	blockDataDTORepo := auth_repo.NewBlockDataDTORepo(
+	blockDataDTORepoConfig := repo.NewBlockDataDTOConfigurationProvider(flagAuthorityAddress)
+	blockDataDTORepo := repo.NewBlockDataDTORepo(
 		blockDataDTORepoConfig,
 		logger)
+	blockDataRangeDTORepoConfig := repo.NewBlockDataRangeDTOConfigurationProvider(flagAuthorityAddress)
+	blockDataRangeDTORepo := repo.NewBlockDataRangeDTORepository(
+		blockDataRangeDTORepoConfig,
+		logger)
+	stateSnapshotDTORepoConfig := repo.NewStateSnapshotDTOConfigurationProvider(flagAuthorityAddress)
+	stateSnapshotDTORepo := repo.NewStateSnapshotDTORepository(
+		stateSnapshotDTORepoConfig,
+		logger)
 	tokRepo := repo.NewTokenRepo(
 		logger,
 		tokenRepo)
 	pstxRepo := repo.NewPendingSignedTransactionRepo(logger, pstxDB)
+	blockchainReorgEventRepo := repo.NewBlockchainReorgEventRepo(logger, blockchainReorgEventDB)
+	validatorSetRepo := repo.NewValidatorSetRepo(logger, validatorSetDB)
 	blockchainSyncStatusRepo := repo.NewBlockchainSyncStatusRepo(logger, memDB)
 
 	// ------------ Use-Case ------------
@@ -116,7 +133,9 @@ func doRunBlockchainSyncCmd() error {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 	storageTransactionCommitUseCase := uc_storagetransaction.NewStorageTransactionCommitUseCase(
 		logger,
 		walletRepo,
@@ -125,7 +144,9 @@ func doRunBlockchainSyncCmd() error {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 	storageTransactionDiscardUseCase := uc_storagetransaction.NewStorageTransactionDiscardUseCase(
 		logger,
 		walletRepo,
@@ -134,7 +155,9 @@ func doRunBlockchainSyncCmd() error {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 
 	// Blockchain State
 	upsertBlockchainStateUseCase := uc_blockchainstate.NewUpsertBlockchainStateUseCase(
@@ -174,6 +197,14 @@ func doRunBlockchainSyncCmd() error {
 	getBlockDataDTOFromBlockchainAuthorityUseCase := uc_blockdatadto.NewGetBlockDataDTOFromBlockchainAuthorityUseCase(
 		logger,
 		blockDataDTORepo)
+	listBlockDataDTOInRangeFromBlockchainAuthorityUseCase := uc_blockdata.NewListBlockDataDTOInRangeFromBlockchainAuthorityUseCase(
+		logger,
+		blockDataRangeDTORepo)
+
+	// State Snapshot DTO
+	getLatestStateSnapshotDTOFromBlockchainAuthorityUseCase := uc_statesnapshot.NewGetLatestStateSnapshotDTOFromBlockchainAuthorityUseCase(
+		logger,
+		stateSnapshotDTORepo)
 
 	// Account
 	getAccountUseCase := uc_account.NewGetAccountUseCase(
@@ -181,6 +212,11 @@ func doRunBlockchainSyncCmd() error {
 		accountRepo,
 	)
 	upsertAccountUseCase := uc_account.NewUpsertAccountUseCase(
+		logger,
+		flagChainID,
+		accountRepo,
+	)
+	getAccountsHashStateUseCase := uc_account.NewGetAccountsHashStateUseCase(
 		logger,
 		accountRepo,
 	)
@@ -204,6 +240,25 @@ func doRunBlockchainSyncCmd() error {
 		logger,
 		pstxRepo)
 
+	// Validator Set
+	getValidatorSetUseCase := uc_validatorset.NewGetValidatorSetUseCase(
+		logger,
+		validatorSetRepo)
+	upsertValidatorSetUseCase := uc_validatorset.NewUpsertValidatorSetUseCase(
+		logger,
+		validatorSetRepo)
+
+	// Blockchain Reorg Event
+	createBlockchainReorgEventUseCase := uc_blockchainreorgevent.NewCreateBlockchainReorgEventUseCase(
+		logger,
+		blockchainReorgEventRepo)
+	deleteBlockDataUseCase := uc_blockdata.NewDeleteBlockDataUseCase(
+		logger,
+		blockDataRepo)
+	deleteTokenUseCase := uc_tok.NewDeleteTokenUseCase(
+		logger,
+		tokRepo)
+
 	// Blockchain Sync Status
 	setBlockchainSyncStatusUseCase := uc_blockchainsyncstatus.NewSetBlockchainSyncStatusUseCase(
 		logger,
@@ -231,11 +286,45 @@ func doRunBlockchainSyncCmd() error {
 		upsertAccountUseCase,
 		upsertTokenIfPreviousTokenNonceGTEUseCase,
 		deletePendingSignedTransactionUseCase,
+		getAccountsHashStateUseCase,
+		deleteBlockDataUseCase,
+		deleteTokenUseCase,
+		createBlockchainReorgEventUseCase,
+		listBlockDataDTOInRangeFromBlockchainAuthorityUseCase,
+		getValidatorSetUseCase,
+		upsertValidatorSetUseCase,
+	)
+
+	blockchainBootstrapService := service_blockchain.NewBlockchainBootstrapFromStateSnapshotService(
+		logger,
+		getGenesisBlockDataUseCase,
+		upsertGenesisBlockDataUseCase,
+		getGenesisBlockDataDTOFromBlockchainAuthorityUseCase,
+		getBlockchainStateUseCase,
+		upsertBlockchainStateUseCase,
+		upsertBlockDataUseCase,
+		getBlockDataDTOFromBlockchainAuthorityUseCase,
+		upsertAccountUseCase,
+		getAccountsHashStateUseCase,
+		upsertTokenIfPreviousTokenNonceGTEUseCase,
+		getLatestStateSnapshotDTOFromBlockchainAuthorityUseCase,
+		upsertValidatorSetUseCase,
+		storageTransactionOpenUseCase,
+		storageTransactionCommitUseCase,
+		storageTransactionDiscardUseCase,
 	)
 
 	// ------------ Execute ------------
 
 	ctx := context.Background()
+
+	// The bootstrap saves the state snapshot in its own storage transaction.
+	if flagFastSync {
+		if _, err := blockchainBootstrapService.Execute(ctx, flagChainID); err != nil {
+			log.Fatalf("Failed to bootstrap blockchain from state snapshot: %v\n", err)
+		}
+	}
+
 	if err := storageTransactionOpenUseCase.Execute(); err != nil {
 		storageTransactionDiscardUseCase.Execute()
 		log.Fatalf("Failed to open storage transaction: %v\n", err)
@@ -15,9 +15,6 @@ import (
 	inmemory "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage/memory/inmemory"
 	auth_repo "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/repo"
 	uc_blockchainstatedto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockchainstatedto"
-	uc_blockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockdatadto"
-	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/genesisblockdatadto"
-	uc_mempooltxdto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/mempooltxdto"
 	"github.com/spf13/cobra"
 
 	pref "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/preferences"
@@ -25,6 +22,7 @@ import (
 	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/repo"
 	service_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/account"
 	service_blockchain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchain"
+	service_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchainreorgevent"
 	service_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockdata"
 	service_blocktx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blocktx"
 	service_coin "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/coin"
@@ -32,15 +30,20 @@ import (
 	service_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/tok"
 	service_wallet "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/wallet"
 	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
+	uc_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainreorgevent"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainstate"
 	uc_blockchainsyncstatus "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainsyncstatus"
 	uc_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdata"
+	uc_blockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdatadto"
 	uc_blocktx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blocktx"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdatadto"
+	uc_mempooltxdto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/mempooltxdto"
 	uc_nftok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/nftok"
 	uc_pstx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/pstx"
 	uc_storagetransaction "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/storagetransaction"
 	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
+	uc_validatorset "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/validatorset"
 	uc_wallet "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/wallet"
 	uc_walletutil "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/walletutil"
 )
@@ -105,6 +108,8 @@ func doRunDaemonCmd() {
 	tokDB := disk.NewDiskStorage(flagDataDirectory, "token", logger)
 	nftokDB := disk.NewDiskStorage(flagDataDirectory, "non_fungible_token", logger)
 	pstxDB := disk.NewDiskStorage(flagDataDirectory, "pending_signed_transaction", logger)
+	blockchainReorgEventDB := disk.NewDiskStorage(flagDataDirectory, "blockchain_reorg_event", logger)
+	validatorSetDB := disk.NewDiskStorage(flagDataDirectory, "validator_set", logger)
 
 	// ------------ Repo ------------
 
@@ -129,17 +134,21 @@ func doRunDaemonCmd() {
 	blockchainStateDTORepo := auth_repo.NewBlockchainStateDTORepo(
 		blockchainStateDTORepoConfig,
 		logger)
-	genesisBlockDataDTORepoConfig := auth_repo.NewGenesisBlockDataDTOConfigurationProvider(flagAuthorityAddress)
-	genesisBlockDataDTORepo := auth_repo.NewGenesisBlockDataDTORepo(
+	genesisBlockDataDTORepoConfig := repo.NewGenesisBlockDataDTOConfigurationProvider(flagAuthorityAddress)
+	genesisBlockDataDTORepo := repo.NewGenesisBlockDataDTORepo(
 		genesisBlockDataDTORepoConfig,
 		logger)
 	blockDataRepo := repo.NewBlockDataRepo(
 		logger,
 		blockDataDB)
-	blockDataDTORepoConfig := auth_repo.NewBlockDataDTOConfigurationProvider(flagAuthorityAddress)
-	blockDataDTORepo := auth_repo.NewBlockDataDTORepo(
+	blockDataDTORepoConfig := repo.NewBlockDataDTOConfigurationProvider(flagAuthorityAddress)
+	blockDataDTORepo := repo.NewBlockDataDTORepo(
 		blockDataDTORepoConfig,
 		logger)
+	blockDataRangeDTORepoConfig := repo.NewBlockDataRangeDTOConfigurationProvider(flagAuthorityAddress)
+	blockDataRangeDTORepo := repo.NewBlockDataRangeDTORepository(
+		blockDataRangeDTORepoConfig,
+		logger)
 	tokRepo := repo.NewTokenRepo(
 		logger,
 		tokDB)
@@ -150,9 +159,13 @@ func doRunDaemonCmd() {
 	nftokenRepo := repo.NewNonFungibleTokenRepo(logger, nftokDB)
 	nftAssetRepoConfig := repo.NewNFTAssetRepoConfigurationProvider(flagNFTStorageAddress, "")
 	nftAssetRepo := repo.NewNFTAssetRepo(nftAssetRepoConfig, logger)
-	mempoolTxDTORepoConfig := auth_repo.NewMempoolTransactionDTOConfigurationProvider(flagAuthorityAddress)
-	mempoolTxDTORepo := auth_repo.NewMempoolTransactionDTORepo(mempoolTxDTORepoConfig, logger)
+	mempoolTxDTORepoConfig := repo.NewMempoolTransactionDTOConfigurationProvider(flagAuthorityAddress)
+	mempoolTxDTORepo := repo.NewMempoolTransactionDTORepo(mempoolTxDTORepoConfig, logger)
 	pstxRepo := repo.NewPendingSignedTransactionRepo(logger, pstxDB)
+	blockchainReorgEventRepo := repo.NewBlockchainReorgEventRepo(logger, blockchainReorgEventDB)
+	validatorSetRepo := repo.NewValidatorSetRepo(logger, validatorSetDB)
+	txReceiptDTORepoConfig := repo.NewTransactionReceiptDTOConfigurationProvider(flagAuthorityAddress)
+	txReceiptDTORepo := repo.NewTransactionReceiptDTORepository(txReceiptDTORepoConfig, logger)
 	blockchainSyncStatusRepo := repo.NewBlockchainSyncStatusRepo(logger, memDB)
 
 	// ------------ Use-Case ------------
@@ -166,7 +179,9 @@ func doRunDaemonCmd() {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 	storageTransactionCommitUseCase := uc_storagetransaction.NewStorageTransactionCommitUseCase(
 		logger,
 		walletRepo,
@@ -175,7 +190,9 @@ func doRunDaemonCmd() {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 	storageTransactionDiscardUseCase := uc_storagetransaction.NewStorageTransactionDiscardUseCase(
 		logger,
 		walletRepo,
@@ -184,7 +201,9 @@ func doRunDaemonCmd() {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 
 	// Wallet Utility
 	openHDWalletFromMnemonicUseCase := uc_walletutil.NewOpenHDWalletFromMnemonicUseCase(
@@ -232,12 +251,12 @@ func doRunDaemonCmd() {
 		accountRepo)
 	upsertAccountUseCase := uc_account.NewUpsertAccountUseCase(
 		logger,
+		flagChainID,
 		accountRepo)
 	accountsFilterByAddressesUseCase := uc_account.NewAccountsFilterByAddressesUseCase(
 		logger,
 		accountRepo,
 	)
-	_ = getAccountsHashStateUseCase
 
 	// Blockchain State
 	upsertBlockchainStateUseCase := uc_blockchainstate.NewUpsertBlockchainStateUseCase(
@@ -280,11 +299,17 @@ func doRunDaemonCmd() {
 	listBlockTransactionsByAddressUseCase := uc_blocktx.NewListBlockTransactionsByAddressUseCase(
 		logger,
 		blockDataRepo)
+	getTransactionReceiptFromBlockchainAuthorityUseCase := uc_blocktx.NewGetTransactionReceiptFromBlockchainAuthorityUseCase(
+		logger,
+		txReceiptDTORepo)
 
 	// Block Data DTO
 	getBlockDataDTOFromBlockchainAuthorityUseCase := uc_blockdatadto.NewGetBlockDataDTOFromBlockchainAuthorityUseCase(
 		logger,
 		blockDataDTORepo)
+	listBlockDataDTOInRangeFromBlockchainAuthorityUseCase := uc_blockdata.NewListBlockDataDTOInRangeFromBlockchainAuthorityUseCase(
+		logger,
+		blockDataRangeDTORepo)
 
 	// Token
 	getTokUseCase := uc_tok.NewGetTokenUseCase(
@@ -335,6 +360,28 @@ func doRunDaemonCmd() {
 		logger,
 		pstxRepo)
 
+	// Validator Set
+	getValidatorSetUseCase := uc_validatorset.NewGetValidatorSetUseCase(
+		logger,
+		validatorSetRepo)
+	upsertValidatorSetUseCase := uc_validatorset.NewUpsertValidatorSetUseCase(
+		logger,
+		validatorSetRepo)
+
+	// Blockchain Reorg Event
+	createBlockchainReorgEventUseCase := uc_blockchainreorgevent.NewCreateBlockchainReorgEventUseCase(
+		logger,
+		blockchainReorgEventRepo)
+	listBlockchainReorgEventsByChainIDUseCase := uc_blockchainreorgevent.NewListBlockchainReorgEventsByChainIDUseCase(
+		logger,
+		blockchainReorgEventRepo)
+	deleteBlockDataUseCase := uc_blockdata.NewDeleteBlockDataUseCase(
+		logger,
+		blockDataRepo)
+	deleteTokenUseCase := uc_tok.NewDeleteTokenUseCase(
+		logger,
+		tokRepo)
+
 	// Blockchain Sync Status
 	setBlockchainSyncStatusUseCase := uc_blockchainsyncstatus.NewSetBlockchainSyncStatusUseCase(
 		logger,
@@ -396,6 +443,24 @@ func doRunDaemonCmd() {
 		getTokUseCase,
 		submitMempoolTransactionDTOToBlockchainAuthorityUseCase,
 	)
+	tokenSwapOfferService := service_tok.NewTokenSwapOfferService(
+		logger,
+		getGenesisBlockDataUseCase,
+		getAccountUseCase,
+		getWalletUseCase,
+		mnemonicFromEncryptedHDWalletUseCase,
+		privateKeyFromHDWalletUseCase,
+		getTokUseCase,
+	)
+	tokenSwapAcceptService := service_tok.NewTokenSwapAcceptService(
+		logger,
+		getAccountUseCase,
+		getWalletUseCase,
+		mnemonicFromEncryptedHDWalletUseCase,
+		privateKeyFromHDWalletUseCase,
+		getTokUseCase,
+		submitMempoolTransactionDTOToBlockchainAuthorityUseCase,
+	)
 	tokenBurnService := service_tok.NewTokenBurnService(
 		logger,
 		storageTransactionOpenUseCase,
@@ -428,6 +493,13 @@ func doRunDaemonCmd() {
 		upsertAccountUseCase,
 		upsertTokenIfPreviousTokenNonceGTEUseCase,
 		deletePendingSignedTransactionUseCase,
+		getAccountsHashStateUseCase,
+		deleteBlockDataUseCase,
+		deleteTokenUseCase,
+		createBlockchainReorgEventUseCase,
+		listBlockDataDTOInRangeFromBlockchainAuthorityUseCase,
+		getValidatorSetUseCase,
+		upsertValidatorSetUseCase,
 	)
 	blockchainSyncWithBlockchainAuthorityViaServerSentEventsService := service_blockchain.NewBlockchainSyncWithBlockchainAuthorityViaServerSentEventsService(
 		logger,
@@ -485,6 +557,20 @@ func doRunDaemonCmd() {
 		upsertAccountUseCase,
 		createWalletUseCase,
 	)
+	signMessageService := service_wallet.NewSignMessageService(
+		logger,
+		getWalletUseCase,
+		mnemonicFromEncryptedHDWalletUseCase,
+		privateKeyFromHDWalletUseCase,
+	)
+	getTransactionReceiptService := service_blocktx.NewGetTransactionReceiptService(
+		logger,
+		getTransactionReceiptFromBlockchainAuthorityUseCase,
+	)
+	listBlockchainReorgEventsService := service_blockchainreorgevent.NewListBlockchainReorgEventsService(
+		logger,
+		listBlockchainReorgEventsByChainIDUseCase,
+	)
 
 	// ------------ Interfaces ------------
 
@@ -506,6 +592,11 @@ func doRunDaemonCmd() {
 		tokenListByOwnerService,
 		exportWalletService,
 		importWalletService,
+		getTransactionReceiptService,
+		listBlockchainReorgEventsService,
+		signMessageService,
+		tokenSwapOfferService,
+		tokenSwapAcceptService,
 	)
 
 	//
@@ -29,6 +29,7 @@ var (
 	flagChainID           uint16
 	flagAuthorityAddress  string
 	flagNFTStorageAddress string
+	flagFastSync          bool
 )
 
 func InitializeCmd() *cobra.Command {
@@ -57,12 +58,14 @@ func InitializeCmd() *cobra.Command {
 				log.Fatalf("You have already configured ComicCoin: NFTStorageAddress was set with: %v\n", preferences.NFTStorageAddress)
 			}
 			preferences.SetNFTStorageAddress(flagNFTStorageAddress)
+			preferences.SetFastSync(flagFastSync)
 
 			logger.Debug("Configued ComicCoin",
 				slog.Any("DataDirectory", preferences.DataDirectory),
 				slog.Any("ChainID", preferences.ChainID),
 				slog.Any("AuthorityAddress", preferences.AuthorityAddress),
 				slog.Any("NFTStorageAddress", preferences.NFTStorageAddress),
+				slog.Any("FastSync", preferences.FastSync),
 				slog.Any("FilePathPreferences", preferences.GetFilePathOfPreferencesFile()))
 		},
 	}
@@ -70,6 +73,7 @@ func InitializeCmd() *cobra.Command {
 	cmd.Flags().Uint16Var(&flagChainID, "chain-id", ChainIDMainNet, "The blockchain to sync with")
 	cmd.Flags().StringVar(&flagAuthorityAddress, "authority-address", "https://comiccoinauthority.com", "The BlockChain authority address to connect to")
 	cmd.Flags().StringVar(&flagNFTStorageAddress, "nftstorage-address", "https://comiccoinnftstorage.com", "The NFT storage service adress to connect to")
+	cmd.Flags().BoolVar(&flagFastSync, "fast-sync", false, "Bootstrap the local blockchain from the latest state snapshot of the Authority instead of syncing every block")
 
 	return cmd
 }
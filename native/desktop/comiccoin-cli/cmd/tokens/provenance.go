@@ -0,0 +1,146 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"math/big"
+	"os"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/logger"
+	"github.com/spf13/cobra"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/repo"
+)
+
+// Command line argument flags
+var (
+	flagProvenanceFile   string
+	flagProvenanceOutput string
+)
+
+func TokenProvenanceCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "provenance",
+		Short: "Get and verify the chain of custody of the token",
+		Long: `Downloads the signed provenance of the token from the Authority, or reads a
+previously saved provenance with --file, and verifies every transaction
+offline against the block headers of our local blockchain.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunTokenProvenanceCommand()
+		},
+	}
+
+	cmd.Flags().StringVar(&flagDataDirectory, "data-directory", preferences.DataDirectory, "The data directory to save to")
+	cmd.Flags().Uint16Var(&flagChainID, "chain-id", preferences.ChainID, "The blockchain to sync with")
+	cmd.Flags().StringVar(&flagAuthorityAddress, "authority-address", preferences.AuthorityAddress, "The BlockChain authority address to connect to")
+
+	cmd.Flags().StringVar(&flagTokenID, "token-id", "", "The unique token identification to download the provenance of")
+	cmd.Flags().StringVar(&flagProvenanceFile, "file", "", "The previously saved provenance to verify instead of downloading it")
+	cmd.Flags().StringVar(&flagProvenanceOutput, "output", "", "The file to save the downloaded provenance to")
+
+	return cmd
+}
+
+func doRunTokenProvenanceCommand() {
+	logger := logger.NewProvider()
+	ctx := context.Background()
+
+	//
+	// STEP 1:
+	// Load the provenance document.
+	//
+
+	var provenance *domain.TokenProvenance
+	if flagProvenanceFile != "" {
+		data, err := os.ReadFile(flagProvenanceFile)
+		if err != nil {
+			log.Fatalf("Failed reading provenance file: %v", err)
+		}
+		provenance = &domain.TokenProvenance{}
+		if err := json.Unmarshal(data, provenance); err != nil {
+			log.Fatalf("Failed decoding provenance file: %v", err)
+		}
+	} else {
+		if flagTokenID == "" {
+			log.Fatal("Either `token-id` or `file` is required")
+		}
+		tokenID, ok := new(big.Int).SetString(flagTokenID, 10)
+		if !ok {
+			log.Fatal("Failed convert `token_id` to big.Int")
+		}
+
+		tokenProvenanceDTORepoConfig := repo.NewTokenProvenanceDTOConfigurationProvider(flagAuthorityAddress)
+		tokenProvenanceDTORepo := repo.NewTokenProvenanceDTORepository(tokenProvenanceDTORepoConfig, logger)
+
+		var err error
+		provenance, err = tokenProvenanceDTORepo.GetFromBlockchainAuthorityByTokenID(ctx, tokenID)
+		if err != nil {
+			log.Fatalf("Failed getting token provenance: %v", err)
+		}
+		if provenance == nil {
+			log.Fatalf("Token does not exist: %v", tokenID)
+		}
+	}
+	if provenance.ChainID != flagChainID {
+		log.Fatalf("Provenance is for chain ID %v but expected %v", provenance.ChainID, flagChainID)
+	}
+
+	//
+	// STEP 2:
+	// Verify the provenance against the blocks of our local blockchain.
+	//
+
+	comicCoincRPCClientRepoConfigurationProvider := repo.NewComicCoincRPCClientRepoConfigurationProvider("localhost", "2233")
+	rpcClient := repo.NewComicCoincRPCClientRepo(comicCoincRPCClientRepoConfigurationProvider, logger)
+
+	localBlocks := make(map[string]*domain.BlockData)
+	for _, proof := range provenance.Transactions {
+		if proof == nil {
+			continue
+		}
+		if _, ok := localBlocks[proof.BlockHash]; ok {
+			continue
+		}
+		blockData, err := rpcClient.GetBlockDataByHash(ctx, proof.BlockHash)
+		if err != nil {
+			log.Fatalf("Failed getting block data: %v", err)
+		}
+		if blockData != nil {
+			localBlocks[proof.BlockHash] = blockData
+		}
+	}
+	if err := domain.VerifyTokenProvenance(provenance, localBlocks); err != nil {
+		log.Fatalf("Failed verifying token provenance: %v", err)
+	}
+
+	//
+	// STEP 3:
+	// Save the provenance and print the chain of custody.
+	//
+
+	if flagProvenanceOutput != "" {
+		data, err := json.MarshalIndent(provenance, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed encoding token provenance: %v", err)
+		}
+		if err := os.WriteFile(flagProvenanceOutput, data, 0644); err != nil {
+			log.Fatalf("Failed saving token provenance: %v", err)
+		}
+	}
+
+	logger.Info("Token provenance verified",
+		slog.Any("token_id", provenance.TokenIDString),
+		slog.Any("metadata_uri", provenance.MetadataURI),
+		slog.Any("mint_block_number", provenance.MintBlockNumberString),
+		slog.Any("burned", provenance.Burned),
+		slog.Any("burn_block_number", provenance.BurnBlockNumberString))
+	for _, owner := range provenance.Owners {
+		logger.Info("Token owner",
+			slog.Any("owner", owner.Owner),
+			slog.Any("acquired_block_number", owner.AcquiredBlockNumberString),
+			slog.Any("disposed_block_number", owner.DisposedBlockNumberString))
+	}
+}
@@ -0,0 +1,179 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/logger"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/repo"
+)
+
+// Command line argument flags
+var (
+	flagSwapAccountAddress  string
+	flagSwapWalletPassword  string
+	flagSwapBuyerAddress    string
+	flagSwapPrice           uint64
+	flagSwapOfferFile       string
+	flagSwapOfferOutputFile string
+)
+
+func SwapTokensCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "swap",
+		Short: "Sell a token for coins in a single transaction signed by both the seller and the buyer",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(SwapOfferTokensCmd())
+	cmd.AddCommand(SwapAcceptTokensCmd())
+
+	return cmd
+}
+
+func SwapOfferTokensCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "offer",
+		Short: "Sign an offer to sell your token to the buyer for the price",
+		Long: `Signs the swap as the seller and exports the offer, nothing is submitted
+to the Authority. Hand the offer to the buyer who accepts it with
+"tokens swap accept". The offer stops being valid as soon as you send any
+other transaction from your account.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunSwapOfferTokensCommand()
+		},
+	}
+
+	cmd.Flags().Uint16Var(&flagChainID, "chain-id", preferences.ChainID, "The blockchain to sync with")
+
+	cmd.Flags().StringVar(&flagSwapAccountAddress, "seller-account-address", "", "The address of the account which owns the token")
+	cmd.MarkFlagRequired("seller-account-address")
+
+	cmd.Flags().StringVar(&flagSwapWalletPassword, "wallet-password", "", "The password to decrypt the account wallet with")
+	cmd.MarkFlagRequired("wallet-password")
+
+	cmd.Flags().StringVar(&flagTokenID, "token-id", "", "The unique token identification of the token to sell")
+	cmd.MarkFlagRequired("token-id")
+
+	cmd.Flags().StringVar(&flagSwapBuyerAddress, "buyer-address", "", "The address of the account whom will buy the token")
+	cmd.MarkFlagRequired("buyer-address")
+
+	cmd.Flags().Uint64Var(&flagSwapPrice, "price", 0, "The amount of coins the buyer pays for the token")
+	cmd.MarkFlagRequired("price")
+
+	cmd.Flags().StringVar(&flagSwapOfferOutputFile, "output", "", "The file to save the offer to, printed if not set")
+
+	return cmd
+}
+
+func doRunSwapOfferTokensCommand() {
+	logger := logger.NewProvider()
+	comicCoincRPCClientRepoConfigurationProvider := repo.NewComicCoincRPCClientRepoConfigurationProvider("localhost", "2233")
+	rpcClient := repo.NewComicCoincRPCClientRepo(comicCoincRPCClientRepoConfigurationProvider, logger)
+
+	ctx := context.Background()
+	sellerAddr := common.HexToAddress(strings.ToLower(flagSwapAccountAddress))
+	buyerAddr := common.HexToAddress(strings.ToLower(flagSwapBuyerAddress))
+	tokenID, ok := new(big.Int).SetString(flagTokenID, 10)
+	if !ok {
+		log.Fatal("Failed convert `token_id` to big.Int")
+	}
+	pass, err := sstring.NewSecureString(flagSwapWalletPassword)
+	if err != nil {
+		log.Fatalf("Failed secure password: %v", err)
+	}
+
+	offer, err := rpcClient.TokenSwapOffer(ctx, flagChainID, &sellerAddr, pass, &buyerAddr, tokenID, flagSwapPrice)
+	if err != nil {
+		log.Fatalf("Failed execute token swap offer service: %v", err)
+	}
+
+	offerBytes, err := json.MarshalIndent(offer, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed encoding swap offer: %v", err)
+	}
+	if flagSwapOfferOutputFile == "" {
+		fmt.Println(string(offerBytes))
+		return
+	}
+	if err := os.WriteFile(flagSwapOfferOutputFile, offerBytes, 0600); err != nil {
+		log.Fatalf("Failed saving swap offer: %v", err)
+	}
+
+	logger.Info("Swap offer saved",
+		slog.Any("token_id", tokenID),
+		slog.Any("buyer-address", flagSwapBuyerAddress),
+		slog.Any("price", flagSwapPrice),
+		slog.Any("output", flagSwapOfferOutputFile))
+}
+
+func SwapAcceptTokensCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "accept",
+		Short: "Co-sign the swap offer of the seller and submit it to buy the token",
+		Long: `Verifies the offer against our local blockchain, co-signs it as the buyer and
+submits the swap to the Authority. The coins are only paid if the token is
+transferred to you in the same transaction.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunSwapAcceptTokensCommand()
+		},
+	}
+
+	cmd.Flags().Uint16Var(&flagChainID, "chain-id", preferences.ChainID, "The blockchain to sync with")
+
+	cmd.Flags().StringVar(&flagSwapAccountAddress, "buyer-account-address", "", "The address of the account which buys the token")
+	cmd.MarkFlagRequired("buyer-account-address")
+
+	cmd.Flags().StringVar(&flagSwapWalletPassword, "wallet-password", "", "The password to decrypt the account wallet with")
+	cmd.MarkFlagRequired("wallet-password")
+
+	cmd.Flags().StringVar(&flagSwapOfferFile, "file", "", "The swap offer given by the seller")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func doRunSwapAcceptTokensCommand() {
+	logger := logger.NewProvider()
+	comicCoincRPCClientRepoConfigurationProvider := repo.NewComicCoincRPCClientRepoConfigurationProvider("localhost", "2233")
+	rpcClient := repo.NewComicCoincRPCClientRepo(comicCoincRPCClientRepoConfigurationProvider, logger)
+
+	ctx := context.Background()
+	buyerAddr := common.HexToAddress(strings.ToLower(flagSwapAccountAddress))
+	pass, err := sstring.NewSecureString(flagSwapWalletPassword)
+	if err != nil {
+		log.Fatalf("Failed secure password: %v", err)
+	}
+
+	data, err := os.ReadFile(flagSwapOfferFile)
+	if err != nil {
+		log.Fatalf("Failed reading swap offer file: %v", err)
+	}
+	offer := &domain.SignedTransaction{}
+	if err := json.Unmarshal(data, offer); err != nil {
+		log.Fatalf("Failed decoding swap offer file: %v", err)
+	}
+
+	if err := rpcClient.TokenSwapAccept(ctx, flagChainID, &buyerAddr, pass, offer); err != nil {
+		log.Fatalf("Failed execute token swap accept service: %v", err)
+	}
+
+	logger.Info("Swap submitted to the blockchain authority",
+		slog.Any("token_id", offer.GetTokenID()),
+		slog.Any("seller", offer.From),
+		slog.Any("buyer", offer.To),
+		slog.Any("value", offer.Value))
+}
@@ -39,6 +39,8 @@ func TokensCmd() *cobra.Command {
 	cmd.AddCommand(TransferTokensCmd())
 	cmd.AddCommand(BurnTokensCmd())
 	cmd.AddCommand(ListTokensCmd())
+	cmd.AddCommand(TokenProvenanceCmd())
+	cmd.AddCommand(SwapTokensCmd())
 
 	return cmd
 }
@@ -0,0 +1,208 @@
+// Package signature provides helper functions for handling the blockchain
+// signature needs. It produces the exact same signatures as the Authority,
+// including the versioned canonical encoding, see `canonical.go`.
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ZeroHash represents a hash code of zeros.
+const ZeroHash string = "0x0000000000000000000000000000000000000000000000000000000000000000"
+
+// comicCoinID is an arbitrary number for signing messages.
+const comicCoinID = 29
+
+// Hash returns a unique string for the value.
+func Hash(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ZeroHash
+	}
+	hash := sha256.Sum256(data)
+	return hexutil.Encode(hash[:])
+}
+
+// Sign uses the specified private key to sign the data.
+func Sign(value any, privateKey *ecdsa.PrivateKey) (v, r, s *big.Int, err error) {
+	if privateKey == nil {
+		return nil, nil, nil, errors.New("private key is nil")
+	}
+
+	data, err := stamp(value)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("stamp error: %w", err)
+	}
+
+	sig, err := crypto.Sign(data, privateKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("signing error: %w", err)
+	}
+
+	publicKeyECDSA, ok := privateKey.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return nil, nil, nil, errors.New("error casting public key to ECDSA")
+	}
+	publicKeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
+
+	rs := sig[:crypto.RecoveryIDOffset]
+	if !crypto.VerifySignature(publicKeyBytes, data, rs) {
+		return nil, nil, nil, errors.New("invalid signature produced")
+	}
+
+	v, r, s = toSignatureValues(sig)
+	return v, r, s, nil
+}
+
+// VerifySignature verifies the signature conforms to our standards.
+func VerifySignature(v, r, s *big.Int) error {
+	uintV := v.Uint64() - comicCoinID
+	if uintV != 0 && uintV != 1 {
+		return errors.New("invalid recovery id")
+	}
+	if !crypto.ValidateSignatureValues(byte(uintV), r, s, false) {
+		return errors.New("invalid signature values")
+	}
+	return nil
+}
+
+// FromAddress extracts the address for the account that signed the data.
+func FromAddress(value any, v, r, s *big.Int) (string, error) {
+	publicKey, err := GetPublicKeyFromSignature(value, v, r, s)
+	if err != nil {
+		return "", err
+	}
+	return crypto.PubkeyToAddress(*publicKey).String(), nil
+}
+
+// GetPublicKeyFromSignature extracts the public key for the account that signed the data.
+func GetPublicKeyFromSignature(value any, v, r, s *big.Int) (*ecdsa.PublicKey, error) {
+	data, err := stamp(value)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.SigToPub(data, ToSignatureBytes(v, r, s))
+}
+
+// SignatureString returns the signature as a string.
+func SignatureString(v, r, s *big.Int) string {
+	return hexutil.Encode(ToSignatureBytesWithComicCoinID(v, r, s))
+}
+
+// ToVRSFromHexSignature converts a hex representation of the signature into its R, S and V parts.
+func ToVRSFromHexSignature(sigStr string) (v, r, s *big.Int, err error) {
+	sig, err := hex.DecodeString(sigStr[2:])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	r = big.NewInt(0).SetBytes(sig[:32])
+	s = big.NewInt(0).SetBytes(sig[32:64])
+	v = big.NewInt(0).SetBytes([]byte{sig[64]})
+	return v, r, s, nil
+}
+
+// ToSignatureBytes converts the r, s, v values into a slice of bytes with the removal of the comicCoinID.
+func ToSignatureBytes(v, r, s *big.Int) []byte {
+	sig := make([]byte, crypto.SignatureLength)
+
+	rBytes := make([]byte, 32)
+	r.FillBytes(rBytes)
+	copy(sig, rBytes)
+
+	sBytes := make([]byte, 32)
+	s.FillBytes(sBytes)
+	copy(sig[32:], sBytes)
+
+	sig[64] = byte(v.Uint64() - comicCoinID)
+	return sig
+}
+
+// ToSignatureBytesWithComicCoinID converts the r, s, v values into a slice of bytes keeping the ComicCoin id.
+func ToSignatureBytesWithComicCoinID(v, r, s *big.Int) []byte {
+	sig := ToSignatureBytes(v, r, s)
+	sig[64] = byte(v.Uint64())
+	return sig
+}
+
+// toSignatureValues converts the signature into the r, s, v values.
+func toSignatureValues(sig []byte) (v, r, s *big.Int) {
+	r = big.NewInt(0).SetBytes(sig[:32])
+	s = big.NewInt(0).SetBytes(sig[32:64])
+	v = big.NewInt(0).SetBytes([]byte{sig[64] + comicCoinID})
+	return v, r, s
+}
+
+// HashWithComicCoinStamp returns the hash of the value with the ComicCoin stamp.
+func HashWithComicCoinStamp(value any) ([]byte, error) {
+	return stamp(value)
+}
+
+// stamp returns a hash of 32 bytes that represents this data. Values which
+// use the canonical signing version are hashed in their canonical encoding,
+// all other values are hashed in JSON without their empty fields.
+func stamp(value any) ([]byte, error) {
+	canonical, version, err := CanonicalBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	if version != VersionLegacy {
+		stamp := []byte(fmt.Sprintf("\x19ComicCoin Signed Message v%d:\n%d", version, len(canonical)))
+		return crypto.Keccak256(stamp, canonical), nil
+	}
+
+	v, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(v, &normalized); err != nil {
+		return nil, err
+	}
+	cleanMap(normalized)
+	v, err = json.Marshal(normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	stamp := []byte(fmt.Sprintf("\x19ComicCoin Signed Message:\n%d", len(v)))
+	return crypto.Keccak256(stamp, v), nil
+}
+
+// cleanMap removes the empty strings, nil values, empty arrays and empty
+// nested maps from the map so they are not part of the legacy signature.
+func cleanMap(m map[string]interface{}) {
+	for k, v := range m {
+		switch v := v.(type) {
+		case string:
+			if v == "" {
+				delete(m, k)
+			}
+		case nil:
+			delete(m, k)
+		case []interface{}:
+			if len(v) == 0 {
+				delete(m, k)
+			} else {
+				for _, elem := range v {
+					if mm, ok := elem.(map[string]interface{}); ok {
+						cleanMap(mm)
+					}
+				}
+			}
+		case map[string]interface{}:
+			cleanMap(v)
+			if len(v) == 0 {
+				delete(m, k)
+			}
+		}
+	}
+}
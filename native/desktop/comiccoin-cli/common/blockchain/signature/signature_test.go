@@ -0,0 +1,59 @@
+package signature
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The same key, value and results as the signature tests of the Authority,
+// values which are not versioned are signed and hashed like before.
+const (
+	pkHexKey     = "fae85851bdf5c9f49923722ce38f3c1defcfd3619ef5453230a58ad805499959"
+	from         = "0xdd6B972ffcc631a62CAE1BB9d80b7ff429c8ebA4"
+	expectedHash = "0x0f6887ac85101d6d6425a617edf35bd721b5f619fb92c36c3d2224e3bdb0ee5a"
+)
+
+type TestData struct {
+	Name string
+}
+
+func TestSign(t *testing.T) {
+	pk, err := crypto.HexToECDSA(pkHexKey)
+	if err != nil {
+		t.Fatalf("failed decoding private key: %v", err)
+	}
+	value := TestData{Name: "Bill"}
+
+	v, r, s, err := Sign(value, pk)
+	if err != nil {
+		t.Fatalf("failed signing: %v", err)
+	}
+	if err := VerifySignature(v, r, s); err != nil {
+		t.Fatalf("expected signature to verify: %v", err)
+	}
+	addr, err := FromAddress(value, v, r, s)
+	if err != nil || addr != from {
+		t.Fatalf("expected signer %v, got %v, %v", from, addr, err)
+	}
+
+	// The signature must survive the round trip through its string form.
+	v2, r2, s2, err := ToVRSFromHexSignature(SignatureString(v, r, s))
+	if err != nil {
+		t.Fatalf("failed decoding signature: %v", err)
+	}
+	if addr, err := FromAddress(value, v2, r2, s2); err != nil || addr != from {
+		t.Fatalf("expected signer %v, got %v, %v", from, addr, err)
+	}
+
+	// A signature of another value must not verify to the signer.
+	if addr, _ := FromAddress(TestData{Name: "Jill"}, v, r, s); addr == from {
+		t.Fatal("expected the signature of another value to recover another signer")
+	}
+}
+
+func TestHash(t *testing.T) {
+	if hash := Hash(TestData{Name: "Bill"}); hash != expectedHash {
+		t.Fatalf("expected hash %v, got %v", expectedHash, hash)
+	}
+}
@@ -41,6 +41,11 @@ type Preferences struct {
 	// AuthorityAddress holds the address of the ComicCoin blockchain authority
 	// address that our client will communicate with.
 	AuthorityAddress string `json:"authority_address"`
+
+	// FastSync variable controls whether an empty local blockchain gets
+	// bootstrapped from the latest state snapshot of the Authority instead
+	// of replaying every block since the genesis block.
+	FastSync bool `json:"fast_sync"`
 }
 
 var (
@@ -138,6 +143,15 @@ func (pref *Preferences) SetNFTStorageAddress(remoteAddress string) error {
 	return ioutil.WriteFile(FilePathPreferences, data, 0666)
 }
 
+func (pref *Preferences) SetFastSync(fastSync bool) error {
+	pref.FastSync = fastSync
+	data, err := json.MarshalIndent(pref, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(FilePathPreferences, data, 0666)
+}
+
 func (pref *Preferences) GetFilePathOfPreferencesFile() string {
 	return FilePathPreferences
 }
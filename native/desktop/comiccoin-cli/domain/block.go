@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/blockchain/merkle"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// Block represents a group of transactions batched together.
+// It contains a block header and a Merkle tree of transactions.
+type Block struct {
+	// Header is the block header, which contains metadata about the block.
+	Header *BlockHeader
+
+	// The signature of this block's "Header" field which was applied by the
+	// proof-of-authority validator.
+	HeaderSignatureBytes []byte `bson:"header_signature_bytes" json:"header_signature_bytes"`
+
+	// MerkleTree is the Merkle tree of transactions, which allows for efficient verification of transaction inclusion.
+	MerkleTree *merkle.Tree[BlockTransaction]
+
+	// The proof-of-authority validator whom executed the validation of
+	// this block data in our blockchain.
+	Validator *Validator `bson:"validator" json:"validator"`
+}
+
+// Hash returns the unique hash for the Block, this is the hash of the block
+// header and not the whole block, exactly like the Authority does it.
+func (b Block) Hash() string {
+	// If this is the genesis block, return a special zero hash.
+	if b.Header.IsNumberZero() {
+		return signature.ZeroHash
+	}
+	return signature.Hash(b.Header)
+}
+
+// ToBlock converts a storage block into a database block.
+func ToBlock(blockData *BlockData) (*Block, error) {
+	// Create a new Merkle tree from the block's transactions.
+	tree, err := merkle.NewTree(blockData.Trans)
+	if err != nil {
+		return &Block{}, err
+	}
+
+	// Create a new block from the block data and Merkle tree.
+	block := &Block{
+		Header:               blockData.Header,
+		HeaderSignatureBytes: blockData.HeaderSignatureBytes,
+		MerkleTree:           tree,
+		Validator:            blockData.Validator,
+	}
+
+	return block, nil
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// BlockchainReorgEvent represents a reorganization of our local blockchain
+// which happened because the Authority's blockchain no longer contained our
+// latest blocks. Our local blocks, accounts and tokens were rolled back to the
+// common ancestor and the Authority's branch was applied afterwards.
+type BlockchainReorgEvent struct {
+	// The unique identifier for the blockchain which was reorganized.
+	ChainID uint16 `json:"chain_id"`
+
+	// The latest block we had in common with the Authority.
+	CommonAncestorHash        string `json:"common_ancestor_hash"`
+	CommonAncestorNumberBytes []byte `json:"common_ancestor_number_bytes"`
+
+	// The latest block we had locally before the reorganization.
+	PreviousLatestHash        string `json:"previous_latest_hash"`
+	PreviousLatestNumberBytes []byte `json:"previous_latest_number_bytes"`
+
+	// The latest block of the Authority when the reorganization happened.
+	GlobalLatestHash string `json:"global_latest_hash"`
+
+	// The hashes of our local blocks which were rolled back, newest first.
+	RolledBackBlockHashes []string `json:"rolled_back_block_hashes"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetCommonAncestorNumber returns the block number of the common ancestor.
+func (e *BlockchainReorgEvent) GetCommonAncestorNumber() *big.Int {
+	return new(big.Int).SetBytes(e.CommonAncestorNumberBytes)
+}
+
+// GetPreviousLatestNumber returns the block number we had locally before the
+// reorganization.
+func (e *BlockchainReorgEvent) GetPreviousLatestNumber() *big.Int {
+	return new(big.Int).SetBytes(e.PreviousLatestNumberBytes)
+}
+
+type BlockchainReorgEventRepository interface {
+	Upsert(ctx context.Context, e *BlockchainReorgEvent) error
+	ListByChainID(ctx context.Context, chainID uint16) ([]*BlockchainReorgEvent, error)
+
+	OpenTransaction() error
+
+	CommitTransaction() error
+
+	DiscardTransaction()
+}
+
+// Serialize serializes the reorg event into a byte slice.
+// This method uses the cbor library to marshal the event into a byte slice.
+func (e *BlockchainReorgEvent) Serialize() ([]byte, error) {
+	dataBytes, err := cbor.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize blockchain reorg event: %v", err)
+	}
+	return dataBytes, nil
+}
+
+// NewBlockchainReorgEventFromDeserialize deserializes a reorg event from a byte slice.
+// This method uses the cbor library to unmarshal the byte slice into a reorg event.
+func NewBlockchainReorgEventFromDeserialize(data []byte) (*BlockchainReorgEvent, error) {
+	e := &BlockchainReorgEvent{}
+
+	// Defensive code: If the input data is empty, return a nil deserialization result.
+	if data == nil {
+		return nil, nil
+	}
+
+	if err := cbor.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to deserialize blockchain reorg event: %v", err)
+	}
+	return e, nil
+}
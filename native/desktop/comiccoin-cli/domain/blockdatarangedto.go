@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"context"
+	"math/big"
+)
+
+// BlockDataRangeDTORepository downloads consecutive blocks from the Authority
+// in a single request. The Authority limits the number of blocks returned per
+// request so callers must continue from the block after the last one returned.
+type BlockDataRangeDTORepository interface {
+	ListFromBlockchainAuthorityByHeaderNumberRange(ctx context.Context, from, to *big.Int) ([]*BlockDataDTO, error)
+}
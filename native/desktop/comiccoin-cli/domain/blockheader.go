@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// BlockHeader represents common information required for each block.
+type BlockHeader struct {
+	ChainID        uint16         `bson:"chain_id" json:"chain_id"`               // Keep track of which chain this block belongs to.
+	NumberBytes    []byte         `bson:"number_bytes" json:"number_bytes"`       // Ethereum: Block number in the chain.
+	NumberString   string         `bson:"-" json:"number_string"`                 // Read-only response in string format - will not be saved in database, only returned via API.
+	PrevBlockHash  string         `bson:"prev_block_hash" json:"prev_block_hash"` // Bitcoin: Hash of the previous block in the chain.
+	TimeStamp      uint64         `bson:"timestamp" json:"timestamp"`             // Bitcoin: Time the block was mined.
+	Difficulty     uint16         `bson:"difficulty" json:"difficulty"`           // Ethereum: Number of 0's needed to solve the hash solution.
+	Beneficiary    common.Address `bson:"beneficiary" json:"beneficiary"`         // Ethereum: The account who is receiving fees .
+	TransactionFee uint64         `bson:"transaction_fee" json:"transaction_fee"` // ComicCoin: Fee that must be paid for every transaction. This value is provided by the authority.
+
+	// The StateRoot represents a hash of the in-memory account balance
+	// database. This field allows the blockchain to provide a guarantee that
+	// the accounting of the transactions and fees for each account on each
+	// node is exactly the same.
+	StateRoot string `bson:"state_root" json:"state_root"` // Ethereum: Represents a hash of the accounts and their balances.
+
+	TransRoot   string `bson:"trans_root" json:"trans_root"`   // Both: Represents the merkle tree root hash for the transactions in this block.
+	NonceBytes  []byte `bson:"nonce_bytes" json:"nonce_bytes"` // Both: Value identified to solve the hash solution.
+	NonceString string `bson:"-" json:"nonce_string"`          // Read-only response in string format - will not be saved in database, only returned via API.
+
+	LatestTokenIDBytes  []byte `bson:"latest_token_id_bytes" json:"latest_token_id_bytes"` // ComicCoin: The latest token that the blockchain points to.
+	LatestTokenIDString string `bson:"-" json:"latest_token_id_string"`                    // Read-only response in string format - will not be saved in database, only returned via API.
+	TokensRoot          string `bson:"tokens_root" json:"tokens_root"`                     // ComicCoin: Represents the hash of all the tokens and their owners.
+
+	Version uint8 `bson:"version,omitempty" json:"version,omitempty"` // ComicCoin: The signing version, see `signature.VersionCanonical`; zero is the legacy JSON signing.
+}
+
+func (bh *BlockHeader) GetNumber() *big.Int {
+	return new(big.Int).SetBytes(bh.NumberBytes)
+}
+
+func (bh *BlockHeader) IsNumberZero() bool {
+	return len(bh.GetNumber().Bits()) == 0
+}
+
+func (bh *BlockHeader) SetNumber(n *big.Int) {
+	bh.NumberBytes = n.Bytes()
+}
+
+func (bh *BlockHeader) GetNonce() *big.Int {
+	return new(big.Int).SetBytes(bh.NonceBytes)
+}
+
+func (bh *BlockHeader) IsNonceZero() bool {
+	return len(bh.GetNonce().Bits()) == 0
+}
+
+func (bh *BlockHeader) SetNonce(n *big.Int) {
+	bh.NonceBytes = n.Bytes()
+}
+
+func (bh *BlockHeader) GetLatestTokenID() *big.Int {
+	return new(big.Int).SetBytes(bh.LatestTokenIDBytes)
+}
+
+func (bh *BlockHeader) IsLatestTokenIDZero() bool {
+	return len(bh.GetLatestTokenID().Bits()) == 0
+}
+
+func (bh *BlockHeader) SeLatestTokenID(n *big.Int) {
+	bh.LatestTokenIDBytes = n.Bytes()
+}
+
+// HasStrictAccountNonces returns true if the account nonces of the block only
+// count the transactions sent from each account, this matches
+// `BlockHeader.HasStrictAccountNonces` of the Authority. Blocks with a legacy
+// header also incremented the nonce of an existing receiver of coins and of
+// the proof of authority account for every transaction.
+func (bh *BlockHeader) HasStrictAccountNonces() bool {
+	return bh.Version != signature.VersionLegacy
+}
+
+// HasStateTrieRoots returns true if the `StateRoot` and `TokensRoot` of the
+// block are the roots of the accounts trie and the tokens trie, see
+// `HashAccountsStateTrie`. Blocks with a legacy header were sealed before the
+// Authority switched to the tries and their `StateRoot` is the legacy hash of
+// the accounts, see `HashAccountsStateLegacy`.
+func (bh *BlockHeader) HasStateTrieRoots() bool {
+	return bh.Version != signature.VersionLegacy
+}
+
+// SigningVersion returns the signing version of the block header.
+func (bh *BlockHeader) SigningVersion() uint8 {
+	return bh.Version
+}
+
+// CanonicalFields returns the fields of the block header in the order they
+// are signed for its signing version, this must match the Authority. The
+// read-only `_string` fields are never signed.
+//
+//	[version, chain_id, number, prev_block_hash, timestamp, difficulty,
+//	 beneficiary, transaction_fee, state_root, trans_root, nonce,
+//	 latest_token_id, tokens_root]
+func (bh *BlockHeader) CanonicalFields() ([]any, error) {
+	if bh.Version != signature.VersionCanonical {
+		return nil, fmt.Errorf("%w: %d", signature.ErrUnsupportedVersion, bh.Version)
+	}
+	return []any{
+		bh.ChainID,
+		bh.GetNumber().Bytes(),
+		bh.PrevBlockHash,
+		bh.TimeStamp,
+		bh.Difficulty,
+		bh.Beneficiary.Bytes(),
+		bh.TransactionFee,
+		bh.StateRoot,
+		bh.TransRoot,
+		bh.GetNonce().Bytes(),
+		bh.GetLatestTokenID().Bytes(),
+		bh.TokensRoot,
+	}, nil
+}
+
+// Serialize serializes a block header into a byte array.
+// It returns the serialized byte array and an error if one occurs.
+func (b *BlockHeader) Serialize() ([]byte, error) {
+	// Marshal the block data into a byte array using CBOR.
+	dataBytes, err := cbor.Marshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize block header: %v", err)
+	}
+	return dataBytes, nil
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// BlockTransaction represents the transaction as it's recorded inside a block. This
+// includes a timestamp and gas fees.
+type BlockTransaction struct {
+	SignedTransaction
+	TimeStamp uint64 `bson:"timestamp" json:"timestamp"` // Ethereum: The time the transaction was received.
+	Fee       uint64 `bson:"fee" json:"fee"`             // ComicCoin: Fee paid for this transaction to the ComicCoin authority.
+
+	// The royalty paid to the creator of the token out of the coin payment
+	// of a token transfer, the recipient is credited the rest. Empty for
+	// every other transaction so their hash does not change.
+	RoyaltyRecipient *common.Address `bson:"royalty_recipient,omitempty" json:"royalty_recipient,omitempty"`
+	Royalty          uint64          `bson:"royalty,omitempty" json:"royalty,omitempty"`
+}
+
+// DEVELOPERS NOTE:
+// The `Fee` of the block transaction shadows the `Fee` which the sender
+// signed in the embedded `Transaction` since both use the `fee` key, so the
+// signed fee would be dropped when the block is downloaded or stored and
+// the signature could not be verified anymore. Therefore the signed fee is
+// encoded under its own `signed_fee` key, which is left out when empty so
+// the hash of the transactions without their own fee does not change.
+
+// blockTransaction has the fields of the block transaction without its
+// encoding methods.
+type blockTransaction BlockTransaction
+
+// blockTransactionEncoding is the JSON and CBOR form of a block transaction.
+type blockTransactionEncoding struct {
+	blockTransaction
+	SignedFee uint64 `json:"signed_fee,omitempty"`
+}
+
+// MarshalJSON encodes the block transaction including its signed fee.
+func (tx BlockTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(blockTransactionEncoding{blockTransaction(tx), tx.Transaction.Fee})
+}
+
+// UnmarshalJSON decodes the block transaction including its signed fee.
+func (tx *BlockTransaction) UnmarshalJSON(data []byte) error {
+	var enc blockTransactionEncoding
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return err
+	}
+	*tx = BlockTransaction(enc.blockTransaction)
+	tx.Transaction.Fee = enc.SignedFee
+	return nil
+}
+
+// MarshalCBOR encodes the block transaction including its signed fee.
+func (tx BlockTransaction) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(blockTransactionEncoding{blockTransaction(tx), tx.Transaction.Fee})
+}
+
+// UnmarshalCBOR decodes the block transaction including its signed fee.
+func (tx *BlockTransaction) UnmarshalCBOR(data []byte) error {
+	var enc blockTransactionEncoding
+	if err := cbor.Unmarshal(data, &enc); err != nil {
+		return err
+	}
+	*tx = BlockTransaction(enc.blockTransaction)
+	tx.Transaction.Fee = enc.SignedFee
+	return nil
+}
+
+func (dto *BlockTransaction) Serialize() ([]byte, error) {
+	dataBytes, err := cbor.Marshal(dto)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize block transaction: %v", err)
+	}
+	return dataBytes, nil
+}
+
+func NewBlockTransactionFromDeserialize(data []byte) (*BlockTransaction, error) {
+	// Variable we will use to return.
+	dto := &BlockTransaction{}
+
+	// Defensive code: If programmer entered empty bytes then we will
+	// return nil deserialization result.
+	if data == nil {
+		return nil, nil
+	}
+
+	if err := cbor.Unmarshal(data, &dto); err != nil {
+		return nil, fmt.Errorf("failed to deserialize block transaction: %v", err)
+	}
+	return dto, nil
+}
+
+// Hash implements the merkle Hashable interface for providing a hash
+// of a block transaction.
+func (tx BlockTransaction) Hash() ([]byte, error) {
+	str := signature.Hash(tx)
+
+	// Need to remove the 0x prefix from the hash.
+	return hex.DecodeString(str[2:])
+}
+
+// Equals implements the merkle Hashable interface for providing an equality
+// check between two block transactions. If the nonce and signatures are the
+// same, the two blocks are the same.
+func (tx BlockTransaction) Equals(otherTx BlockTransaction) bool {
+	// Note: MongoDB doesn't support `*big.Int` so we are forced to do this.
+	txV, txR, txS := tx.SignedTransaction.GetBigIntFields()
+	otherTxV, otherTxR, otherTxS := otherTx.SignedTransaction.GetBigIntFields()
+
+	txSig := signature.ToSignatureBytes(txV, txR, txS)
+	otherTxSig := signature.ToSignatureBytes(otherTxV, otherTxR, otherTxS)
+
+	return tx.GetNonce().Cmp(otherTx.GetNonce()) == 0 && bytes.Equal(txSig, otherTxSig)
+}
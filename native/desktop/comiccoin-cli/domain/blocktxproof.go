@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockTransactionProof represents the merkle inclusion proof of a single
+// transaction returned by the Authority's
+// `/authority/api/v1/block-transactions/{nonce}/proof` endpoint.
+type BlockTransactionProof struct {
+	BlockHash            string            `json:"block_hash"`
+	Header               *BlockHeader      `json:"header"`
+	HeaderSignatureBytes []byte            `json:"header_signature_bytes"`
+	Validator            *Validator        `json:"validator"`
+	Transaction          *BlockTransaction `json:"transaction"`
+	TransactionIndex     uint64            `json:"transaction_index"`
+	MerklePath           [][]byte          `json:"merkle_path"`
+	MerklePathOrder      []int64           `json:"merkle_path_order"` // Either `0` (sibling first) or `1` (sibling second).
+}
+
+// VerifyBlockTransactionProof checks the proven transaction hashes up the
+// merkle path to the block's transaction root and that the block header was
+// signed by the expected proof of authority validator. This lets the wallet
+// prove a payment was included without syncing the entire blockchain.
+func VerifyBlockTransactionProof(proof *BlockTransactionProof, trustedValidator *Validator) error {
+	if proof == nil {
+		return errors.New("proof is missing")
+	}
+	if proof.Header == nil {
+		return errors.New("proof is missing block header")
+	}
+	if proof.Transaction == nil {
+		return errors.New("proof is missing transaction")
+	}
+	if proof.Validator == nil || trustedValidator == nil {
+		return errors.New("proof is missing validator")
+	}
+	if !bytes.Equal(proof.Validator.PublicKeyBytes, trustedValidator.PublicKeyBytes) {
+		return errors.New("proof was signed by an untrusted validator")
+	}
+	if len(proof.MerklePath) != len(proof.MerklePathOrder) {
+		return errors.New("proof merkle path and order are different lengths")
+	}
+
+	// Step 1: Hash the transaction up the merkle path. The read-only
+	// `_string` fields are never part of the merkle tree so remove them.
+	hash, err := withoutBlockTransactionJSONStrings(*proof.Transaction).Hash()
+	if err != nil {
+		return fmt.Errorf("failed hashing transaction: %v", err)
+	}
+	for i, sibling := range proof.MerklePath {
+		var data []byte
+		switch proof.MerklePathOrder[i] {
+		case 0:
+			data = append(append([]byte{}, sibling...), hash...)
+		case 1:
+			data = append(append([]byte{}, hash...), sibling...)
+		default:
+			return fmt.Errorf("invalid merkle path order: %v", proof.MerklePathOrder[i])
+		}
+		sum := sha256.Sum256(data)
+		hash = sum[:]
+	}
+
+	// Step 2: Compare against the merkle root of the block.
+	transRoot, err := hexutil.Decode(proof.Header.TransRoot)
+	if err != nil {
+		return fmt.Errorf("failed decoding transaction root: %v", err)
+	}
+	if !bytes.Equal(hash, transRoot) {
+		return errors.New("transaction is not included in block")
+	}
+
+	// Step 3: Verify the validator signed the block header.
+	if !trustedValidator.Verify(proof.HeaderSignatureBytes, withoutBlockHeaderJSONStrings(proof.Header)) {
+		return errors.New("block header signature is invalid")
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+var (
+	// ErrBlockTampered is returned when a block downloaded from the Authority
+	// does not match its own hash, signature, merkle root or state root.
+	ErrBlockTampered = errors.New("block data has been tampered with")
+
+	// ErrBlockForked is returned when a block downloaded from the Authority
+	// does not extend the latest block we have locally.
+	ErrBlockForked = errors.New("block does not extend local blockchain, chain has forked")
+
+	// ErrBlockTimeStampInFuture is returned when the timestamp of a block
+	// downloaded from the Authority is more than `ValidatorMaxClockDrift`
+	// past our local time.
+	ErrBlockTimeStampInFuture = errors.New("block timestamp is too far in the future")
+)
+
+// ValidateGenesisBlockData verifies the genesis block was signed by the
+// validator it includes. The genesis validator is the first member of the
+// validator set, see `ValidatorSet`.
+func ValidateGenesisBlockData(genesis *GenesisBlockData) error {
+	if genesis == nil || genesis.Header == nil || genesis.Validator == nil {
+		return fmt.Errorf("%w: genesis block is incomplete", ErrBlockTampered)
+	}
+	if !genesis.Validator.Verify(genesis.HeaderSignatureBytes, withoutBlockHeaderJSONStrings(genesis.Header)) {
+		return fmt.Errorf("%w: genesis block header signature is invalid", ErrBlockTampered)
+	}
+	return nil
+}
+
+// ValidateBlockData verifies the block downloaded from the Authority before
+// it is applied to our local blockchain. This performs the same checks as
+// `Block.ValidateBlock` except for the state root which can only be checked
+// after the block transactions were applied, see `ValidateBlockDataStateRoot`.
+// The `validators` are the validator set after the previous block.
+func ValidateBlockData(blockData, previousBlockData *BlockData, validators []*Validator) error {
+	if previousBlockData == nil || previousBlockData.Header == nil {
+		return errors.New("previous block is missing")
+	}
+	if blockData == nil || blockData.Header == nil {
+		return fmt.Errorf("%w: block is incomplete", ErrBlockTampered)
+	}
+
+	// The timestamp decides how many validators missed their turn, so it
+	// must not be in the future, see `ValidatorMaxClockDrift`.
+	if err := ValidateBlockTimeStamp(blockData.Header, time.Now()); err != nil {
+		return err
+	}
+	scheduledValidator := ScheduledValidatorAt(validators, blockData.Header.GetNumber(), previousBlockData.Header.TimeStamp, blockData.Header.TimeStamp)
+	if err := validateBlockDataContents(blockData, scheduledValidator); err != nil {
+		return err
+	}
+	number := blockData.Header.GetNumber()
+
+	//
+	// VALIDATION 4:
+	// Check: block extends our previous block.
+	//
+
+	if blockData.Header.Difficulty < previousBlockData.Header.Difficulty {
+		return fmt.Errorf("%w: block %v difficulty is less than previous block difficulty, parent %d, block %d", ErrBlockTampered, number, previousBlockData.Header.Difficulty, blockData.Header.Difficulty)
+	}
+
+	nextNumber := new(big.Int).Add(previousBlockData.Header.GetNumber(), big.NewInt(1))
+	if number.Cmp(nextNumber) != 0 {
+		return fmt.Errorf("%w: block is not the next number, got %v, exp %v", ErrBlockForked, number, nextNumber)
+	}
+	if blockData.Header.PrevBlockHash != previousBlockData.Hash {
+		return fmt.Errorf("%w: block %v parent hash does not match our known parent, got %v, exp %v", ErrBlockForked, number, blockData.Header.PrevBlockHash, previousBlockData.Hash)
+	}
+	if blockData.Header.TimeStamp < previousBlockData.Header.TimeStamp {
+		return fmt.Errorf("%w: block %v timestamp is before parent block", ErrBlockTampered, number)
+	}
+	return nil
+}
+
+// ValidateBlockTimeStamp verifies the timestamp of the block header is not
+// more than `ValidatorMaxClockDrift` past the time `now`, returns
+// `ErrBlockTimeStampInFuture` otherwise.
+func ValidateBlockTimeStamp(header *BlockHeader, now time.Time) error {
+	maxTimeStamp := uint64(now.Add(ValidatorMaxClockDrift).UnixMilli())
+	if header.TimeStamp > maxTimeStamp {
+		return fmt.Errorf("%w: block %v timestamp %v is after %v", ErrBlockTimeStampInFuture, header.GetNumber(), header.TimeStamp, maxTimeStamp)
+	}
+	return nil
+}
+
+// validateBlockDataContents verifies the block was signed by the scheduled
+// validator and that its hash and merkle root match its contents.
+func validateBlockDataContents(blockData *BlockData, scheduledValidator *Validator) error {
+	if blockData == nil || blockData.Header == nil || blockData.Validator == nil {
+		return fmt.Errorf("%w: block is incomplete", ErrBlockTampered)
+	}
+	if scheduledValidator == nil {
+		return errors.New("validator set is empty")
+	}
+	number := blockData.Header.GetNumber()
+
+	//
+	// VALIDATION 1:
+	// Check: block was signed by the validator scheduled to seal it.
+	//
+
+	if !bytes.Equal(blockData.Validator.PublicKeyBytes, scheduledValidator.PublicKeyBytes) {
+		return fmt.Errorf("%w: block %v was not validated by the scheduled validator", ErrBlockTampered, number)
+	}
+	header := withoutBlockHeaderJSONStrings(blockData.Header)
+	if !scheduledValidator.Verify(blockData.HeaderSignatureBytes, header) {
+		return fmt.Errorf("%w: block %v header signature is invalid", ErrBlockTampered, number)
+	}
+
+	//
+	// VALIDATION 2:
+	// Check: block hash matches the header and solves the difficulty.
+	//
+
+	trans := make([]BlockTransaction, 0, len(blockData.Trans))
+	for _, blockTx := range blockData.Trans {
+		trans = append(trans, withoutBlockTransactionJSONStrings(blockTx))
+	}
+	block, err := ToBlock(&BlockData{
+		Hash:                 blockData.Hash,
+		Header:               header,
+		HeaderSignatureBytes: blockData.HeaderSignatureBytes,
+		Trans:                trans,
+		Validator:            blockData.Validator,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: block %v transactions are invalid: %v", ErrBlockTampered, number, err)
+	}
+	if hash := block.Hash(); hash != blockData.Hash {
+		return fmt.Errorf("%w: block %v hash does not match header, got %v, exp %v", ErrBlockTampered, number, blockData.Hash, hash)
+	}
+	if !isBlockHashSolved(blockData.Header.Difficulty, blockData.Hash) {
+		return fmt.Errorf("%w: block %v hash %v does not solve difficulty %d", ErrBlockTampered, number, blockData.Hash, blockData.Header.Difficulty)
+	}
+
+	//
+	// VALIDATION 3:
+	// Check: merkle root matches the transactions.
+	//
+
+	if blockData.Header.TransRoot != block.MerkleTree.RootHex() {
+		return fmt.Errorf("%w: block %v merkle root does not match transactions, got %v, exp %v", ErrBlockTampered, number, block.MerkleTree.RootHex(), blockData.Header.TransRoot)
+	}
+	return nil
+}
+
+// ValidateBlockDataStateRoot verifies the hash of our local accounts, after
+// the block transactions were applied, matches the block state root.
+func ValidateBlockDataStateRoot(blockData *BlockData, stateRoot string) error {
+	if blockData.Header.StateRoot != stateRoot {
+		return fmt.Errorf("%w: block %v state root does not match local accounts, got %v, exp %v", ErrBlockTampered, blockData.Header.GetNumber(), stateRoot, blockData.Header.StateRoot)
+	}
+	return nil
+}
+
+// isBlockHashSolved checks the hash starts with a difficulty number of 0's.
+func isBlockHashSolved(difficulty uint16, hash string) bool {
+	const match = "0x00000000000000000"
+	if len(hash) != 66 || int(difficulty)+2 > len(match) {
+		return false
+	}
+	return hash[:difficulty+2] == match[:difficulty+2]
+}
+
+// withoutBlockHeaderJSONStrings returns a copy of the block header without
+// the read-only `_string` fields, this is the form which was signed.
+func withoutBlockHeaderJSONStrings(bh *BlockHeader) *BlockHeader {
+	header := *bh
+	header.NumberString = ""
+	header.NonceString = ""
+	header.LatestTokenIDString = ""
+	return &header
+}
+
+// withoutBlockTransactionJSONStrings returns a copy of the block transaction
+// without the read-only `_string` fields, this is the form which was hashed
+// into the merkle tree.
+func withoutBlockTransactionJSONStrings(tx BlockTransaction) BlockTransaction {
+	tx.NonceString = ""
+	tx.DataString = ""
+	tx.TokenIDString = ""
+	tx.TokenNonceString = ""
+	return tx
+}
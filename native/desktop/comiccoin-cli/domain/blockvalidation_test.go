@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+func TestValidateBlockDataCanonicalHeader(t *testing.T) {
+	validatorKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	validator := &Validator{
+		ID:             "test",
+		PublicKeyBytes: crypto.FromECDSAPub(&validatorKey.PublicKey),
+	}
+
+	accountKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	from := crypto.PubkeyToAddress(accountKey.PublicKey)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tx := Transaction{
+		ChainID:    1,
+		NonceBytes: big.NewInt(1).Bytes(),
+		From:       &from,
+		To:         &to,
+		Value:      10,
+		Type:       TransactionTypeCoin,
+		Version:    TransactionVersion,
+	}
+	stx, err := tx.Sign(accountKey)
+	if err != nil {
+		t.Fatalf("failed signing transaction: %v", err)
+	}
+	trans := []BlockTransaction{{SignedTransaction: stx, TimeStamp: 1700000000001}}
+
+	previousBlockData := &BlockData{
+		Hash: "0x00000abc",
+		Header: &BlockHeader{
+			ChainID:     1,
+			NumberBytes: big.NewInt(0).Bytes(),
+			TimeStamp:   1700000000000,
+		},
+	}
+
+	// Seal the block exactly like the Authority does it: the canonical
+	// header is hashed and signed by the proof of authority validator.
+	block, err := ToBlock(&BlockData{Trans: trans})
+	if err != nil {
+		t.Fatalf("failed creating block: %v", err)
+	}
+	block.Header = &BlockHeader{
+		ChainID:            1,
+		NumberBytes:        big.NewInt(1).Bytes(),
+		PrevBlockHash:      previousBlockData.Hash,
+		TimeStamp:          1700000000001,
+		Beneficiary:        from,
+		TransactionFee:     1,
+		StateRoot:          "0x01",
+		TransRoot:          block.MerkleTree.RootHex(),
+		NonceBytes:         big.NewInt(0).Bytes(),
+		LatestTokenIDBytes: big.NewInt(0).Bytes(),
+		TokensRoot:         "0x03",
+		Version:            signature.VersionCanonical,
+	}
+	headerSig, err := validator.Sign(validatorKey, block.Header)
+	if err != nil {
+		t.Fatalf("failed signing header: %v", err)
+	}
+	blockData := &BlockData{
+		Hash:                 block.Hash(),
+		Header:               block.Header,
+		HeaderSignatureBytes: headerSig,
+		Trans:                trans,
+		Validator:            validator,
+	}
+
+	// The Authority API returns the read-only strings, they were not signed.
+	downloaded := *blockData
+	header := *blockData.Header
+	header.NumberString = "1"
+	header.NonceString = "0"
+	header.LatestTokenIDString = "0"
+	downloaded.Header = &header
+
+	validators := []*Validator{validator}
+	if err := ValidateBlockData(&downloaded, previousBlockData, validators); err != nil {
+		t.Fatalf("expected canonical block to validate: %v", err)
+	}
+
+	tamperedHeader := header
+	tamperedHeader.TransactionFee = 0
+	tampered := downloaded
+	tampered.Header = &tamperedHeader
+	if err := ValidateBlockData(&tampered, previousBlockData, validators); !errors.Is(err, ErrBlockTampered) {
+		t.Fatalf("expected tampered header to be rejected, got %v", err)
+	}
+
+	// A legacy header with the same fields is signed differently.
+	legacyHeader := header
+	legacyHeader.Version = signature.VersionLegacy
+	legacy := downloaded
+	legacy.Header = &legacyHeader
+	if err := ValidateBlockData(&legacy, previousBlockData, validators); !errors.Is(err, ErrBlockTampered) {
+		t.Fatalf("expected header signed with another version to be rejected, got %v", err)
+	}
+}
+
+// sealTestBlockData seals the block which follows the previous block with
+// the validator, the same way the Authority does it.
+func sealTestBlockData(t *testing.T, key *ecdsa.PrivateKey, validator *Validator, previousBlockData *BlockData, timeStamp uint64) *BlockData {
+	t.Helper()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	tx := Transaction{
+		ChainID:    1,
+		NonceBytes: big.NewInt(1).Bytes(),
+		From:       &from,
+		To:         &from,
+		Value:      1,
+		Type:       TransactionTypeCoin,
+		Version:    TransactionVersion,
+	}
+	stx, err := tx.Sign(key)
+	if err != nil {
+		t.Fatalf("failed signing transaction: %v", err)
+	}
+	trans := []BlockTransaction{{SignedTransaction: stx, TimeStamp: timeStamp}}
+	block, err := ToBlock(&BlockData{Trans: trans})
+	if err != nil {
+		t.Fatalf("failed creating block: %v", err)
+	}
+	block.Header = &BlockHeader{
+		ChainID:            1,
+		NumberBytes:        new(big.Int).Add(previousBlockData.Header.GetNumber(), big.NewInt(1)).Bytes(),
+		PrevBlockHash:      previousBlockData.Hash,
+		TimeStamp:          timeStamp,
+		Beneficiary:        from,
+		TransactionFee:     1,
+		TransRoot:          block.MerkleTree.RootHex(),
+		LatestTokenIDBytes: big.NewInt(0).Bytes(),
+		Version:            signature.VersionCanonical,
+	}
+	headerSig, err := validator.Sign(key, block.Header)
+	if err != nil {
+		t.Fatalf("failed signing header: %v", err)
+	}
+	return &BlockData{
+		Hash:                 block.Hash(),
+		Header:               block.Header,
+		HeaderSignatureBytes: headerSig,
+		Trans:                trans,
+		Validator:            validator,
+	}
+}
+
+func TestValidateBlockDataFutureTimeStamp(t *testing.T) {
+	keys := make(map[*Validator]*ecdsa.PrivateKey)
+	validators := make([]*Validator, 0, 2)
+	for _, id := range []string{"a", "b"} {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("failed generating key: %v", err)
+		}
+		validator := &Validator{ID: id, PublicKeyBytes: crypto.FromECDSAPub(&key.PublicKey)}
+		keys[validator] = key
+		validators = append(validators, validator)
+	}
+
+	// The validator whose turn only comes after the validator scheduled for
+	// block 1 missed its turn.
+	outOfTurn := ScheduledValidator(validators, big.NewInt(2))
+	timeout := uint64(ValidatorFallbackTimeout.Milliseconds())
+	now := uint64(time.Now().UnixMilli())
+
+	// Inflating the timestamp must not hand the turn to the validator.
+	previousBlockData := &BlockData{
+		Hash:   "0x00000abc",
+		Header: &BlockHeader{ChainID: 1, NumberBytes: big.NewInt(0).Bytes(), TimeStamp: now},
+	}
+	blockData := sealTestBlockData(t, keys[outOfTurn], outOfTurn, previousBlockData, now+timeout)
+	if err := ValidateBlockData(blockData, previousBlockData, validators); !errors.Is(err, ErrBlockTimeStampInFuture) {
+		t.Fatalf("expected %v, got %v", ErrBlockTimeStampInFuture, err)
+	}
+
+	// Once the scheduled validator really missed its turn it is accepted.
+	previousBlockData.Header.TimeStamp = now - timeout
+	blockData = sealTestBlockData(t, keys[outOfTurn], outOfTurn, previousBlockData, now)
+	if err := ValidateBlockData(blockData, previousBlockData, validators); err != nil {
+		t.Fatalf("expected fallback validator to be accepted, got %v", err)
+	}
+}
@@ -66,9 +66,9 @@ type ComicCoincRPCClientRepository interface {
 	ListBlockTransactionsByAddress(
 		ctx context.Context,
 		address *common.Address,
-	) ([]*auth_domain.BlockTransaction, error)
+	) ([]*BlockTransaction, error)
 
-	GetBlockDataByHash(ctx context.Context, hash string) (*auth_domain.BlockData, error)
+	GetBlockDataByHash(ctx context.Context, hash string) (*BlockData, error)
 
 	ListTokensByOwnerAddress(
 		ctx context.Context,
@@ -78,4 +78,28 @@ type ComicCoincRPCClientRepository interface {
 	ExportWallet(ctx context.Context, accountAddress *common.Address, filepath string) error
 
 	ImportWallet(ctx context.Context, walletFilepath string) error
+
+	SignMessage(ctx context.Context, accountAddress *common.Address, accountWalletPassword *sstring.SecureString, message string) (string, error)
+
+	TokenSwapOffer(
+		ctx context.Context,
+		chainID uint16,
+		sellerAccountAddress *common.Address,
+		accountWalletPassword *sstring.SecureString,
+		buyer *common.Address,
+		tokenID *big.Int,
+		price uint64,
+	) (*SignedTransaction, error)
+
+	TokenSwapAccept(
+		ctx context.Context,
+		chainID uint16,
+		buyerAccountAddress *common.Address,
+		accountWalletPassword *sstring.SecureString,
+		offer *SignedTransaction,
+	) error
+
+	GetTransactionReceipt(ctx context.Context, hash string) (*TransactionReceipt, error)
+
+	ListBlockchainReorgEvents(ctx context.Context, chainID uint16) ([]*BlockchainReorgEvent, error)
 }
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// The fee market of the Authority lets every transaction carry its own `Fee`
+// which is paid to the authority on top of the value. Transactions without
+// a fee keep the legacy rule where the block `TransactionFee` is taken out
+// of the value. This must match the Authority so our local balances are the
+// same as the balances of the blockchain.
+
+// ErrTransactionFeeTooLow is returned when a transaction pays less than the
+// minimum fee of the fee market.
+var ErrTransactionFeeTooLow = errors.New("transaction fee is less than the minimum fee")
+
+// ErrFeeMarketNotActive is returned when a transaction carries its own fee
+// before the fee market was activated.
+var ErrFeeMarketNotActive = errors.New("transaction fee is not accepted until the fee market is active")
+
+// SplitFees returns the amount taken from the sender, the amount given to the
+// recipient and the fee collected by the authority for the transaction. The
+// `legacyFee` is the `TransactionFee` of the block, it is only used for
+// transactions without their own fee. For a swap the buyer pays the seller,
+// see `Payer` and `Payee`.
+func (tx *Transaction) SplitFees(legacyFee uint64) (debit, credit, fee uint64) {
+	switch tx.Type {
+	case TransactionTypeCoin, TransactionTypeSwap:
+		if tx.Fee > 0 {
+			return tx.Value + tx.Fee, tx.Value, tx.Fee
+		}
+		return tx.Value, tx.Value - legacyFee, legacyFee
+	case TransactionTypeToken:
+		if tx.Fee > 0 {
+			return tx.Value + tx.Fee, tx.Value, tx.Fee
+		}
+		// Note: The value of a legacy token transaction was always the fee.
+		return tx.Value, 0, tx.Value
+	default:
+		return 0, 0, 0
+	}
+}
+
+// ValidateFee verifies the fee of the transaction follows the rules of the
+// block it is being sealed in.
+func (tx *Transaction) ValidateFee(feeMarketActive bool, minimumFee uint64) error {
+	if !tx.PaysFee() {
+		if tx.Fee != 0 {
+			return fmt.Errorf("transaction type %v does not pay a fee", tx.Type)
+		}
+		return nil
+	}
+	if tx.Fee == 0 {
+		// Legacy rule: the fee is taken out of the value.
+		if (tx.Type == TransactionTypeCoin || tx.Type == TransactionTypeSwap) && tx.Value < minimumFee {
+			return fmt.Errorf("%w: value %v does not cover the fee %v", ErrTransactionFeeTooLow, tx.Value, minimumFee)
+		}
+		return nil
+	}
+	if !feeMarketActive {
+		return ErrFeeMarketNotActive
+	}
+	if tx.Fee < minimumFee {
+		return fmt.Errorf("%w: got %v but minimum is %v", ErrTransactionFeeTooLow, tx.Fee, minimumFee)
+	}
+	if tx.Value > math.MaxUint64-tx.Fee {
+		return errors.New("transaction value and fee overflow")
+	}
+	return nil
+}
+
+// PaysFee returns true if the transaction type pays a fee to the authority.
+func (tx *Transaction) PaysFee() bool {
+	return tx.Type == TransactionTypeCoin || tx.Type == TransactionTypeToken || tx.Type == TransactionTypeSwap
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// MempoolTransaction represents a transaction that is submitted to the
+// mempool of the Authority. It contains the transaction data, as well as
+// the ECDSA signature and recovery identifier.
+type MempoolTransaction struct {
+	ID primitive.ObjectID `bson:"_id" json:"id"`
+
+	// The signed transaction data, including sender, recipient, amount, and other metadata like V, R, S, etc.
+	SignedTransaction
+}
+
+// Validate checks if the transaction is valid.
+// It verifies the signature, makes sure the account addresses are correct,
+// and checks if the 'from' and 'to' accounts are not the same.
+func (mtx MempoolTransaction) Validate(chainID uint16, isPoA bool) error {
+	return mtx.SignedTransaction.Validate(chainID, isPoA)
+}
+
+// Serialize serializes the mempool transaction into a byte slice.
+// This method uses the cbor library to marshal the transaction into a byte slice.
+func (mtx *MempoolTransaction) Serialize() ([]byte, error) {
+	dataBytes, err := cbor.Marshal(mtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize mempool transaction: %v", err)
+	}
+	return dataBytes, nil
+}
+
+// NewMempoolTransactionFromDeserialize deserializes a mempool transaction from a byte slice.
+// This method uses the cbor library to unmarshal the byte slice into a transaction.
+func NewMempoolTransactionFromDeserialize(data []byte) (*MempoolTransaction, error) {
+	// Create a new transaction variable to return.
+	mtx := &MempoolTransaction{}
+
+	// Defensive code: If the input data is empty, return a nil deserialization result.
+	if data == nil {
+		return nil, nil
+	}
+
+	if err := cbor.Unmarshal(data, &mtx); err != nil {
+		return nil, fmt.Errorf("failed to deserialize mempool transaction: %v", err)
+	}
+	return mtx, nil
+}
+
+// FromAddress extracts the account address from the signed transaction by
+// recovering the public key from the signature.
+func (mtx MempoolTransaction) FromAddress() (string, error) {
+	// Note: MongoDB doesn't support `*big.Int` so we are forced to do this.
+	v, r, s := mtx.SignedTransaction.GetBigIntFields()
+
+	return signature.FromAddress(mtx.SignedTransaction.Transaction, v, r, s)
+}
+
+func (mtx MempoolTransaction) FromPublicKey() (*ecdsa.PublicKey, error) {
+	// Note: MongoDB doesn't support `*big.Int` so we are forced to do this.
+	v, r, s := mtx.SignedTransaction.GetBigIntFields()
+
+	return signature.GetPublicKeyFromSignature(mtx.SignedTransaction.Transaction, v, r, s)
+}
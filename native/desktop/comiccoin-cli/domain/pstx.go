@@ -5,8 +5,6 @@ import (
 	"math/big"
 
 	"github.com/fxamacker/cbor/v2"
-
-	auth_domain "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
 )
 
 // PendingSignedTransaction struct is responsible for storing all the
@@ -16,16 +14,16 @@ import (
 //
 // Use this struct to prevent the user, of this current local wallet, to make
 // any transactions unless there are no more
-type PendingSignedTransaction auth_domain.SignedTransaction
+type PendingSignedTransaction SignedTransaction
 
 // SignedTransactionToPendingSignedTransaction method converts a
 // `SignedTransaction` data type into a `PendingSignedTransaction` data type.
-func SignedTransactionToPendingSignedTransaction(pstx *auth_domain.SignedTransaction) *PendingSignedTransaction {
+func SignedTransactionToPendingSignedTransaction(pstx *SignedTransaction) *PendingSignedTransaction {
 	return (*PendingSignedTransaction)(pstx)
 }
 
-func PendingSignedTransactionToSignedTransaction(pstx *PendingSignedTransaction) *auth_domain.SignedTransaction {
-	return (*auth_domain.SignedTransaction)(pstx)
+func PendingSignedTransactionToSignedTransaction(pstx *PendingSignedTransaction) *SignedTransaction {
+	return (*SignedTransaction)(pstx)
 }
 
 const (
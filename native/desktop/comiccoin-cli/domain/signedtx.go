@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// SignedTransaction is a signed version of the transaction. This is how
+// clients like a wallet provide transactions for inclusion into the blockchain.
+type SignedTransaction struct {
+	Transaction
+
+	// Known Issue:
+	// MongoDB does not natively support storing `*big.Int` types directly
+	// therefore the `V`, `R`, and `S` fields are stored as `[]byte`.
+
+	VBytes []byte `bson:"v_bytes,omitempty" json:"v_bytes"`  // Ethereum: Recovery identifier, either 29 or 30 with comicCoinID.
+	RBytes []byte `bson:"r_bytes,omitempty"  json:"r_bytes"` // Ethereum: First coordinate of the ECDSA signature.
+	SBytes []byte `bson:"s_bytes,omitempty"  json:"s_bytes"` // Ethereum: Second coordinate of the ECDSA signature.
+
+	// The signature of the `To` account which accepts a `swap` transaction,
+	// empty for every other transaction so their hash does not change.
+	CounterpartyVBytes []byte `bson:"counterparty_v_bytes,omitempty" json:"counterparty_v_bytes,omitempty"`
+	CounterpartyRBytes []byte `bson:"counterparty_r_bytes,omitempty" json:"counterparty_r_bytes,omitempty"`
+	CounterpartySBytes []byte `bson:"counterparty_s_bytes,omitempty" json:"counterparty_s_bytes,omitempty"`
+}
+
+// SetBigIntFields allows setting *big.Int values to []byte fields for MongoDB storage.
+func (tx *SignedTransaction) SetBigIntFields(v, r, s *big.Int) {
+	tx.VBytes = v.Bytes()
+	tx.RBytes = r.Bytes()
+	tx.SBytes = s.Bytes()
+}
+
+// GetBigIntFields retrieves *big.Int values from []byte fields after loading from MongoDB.
+func (tx *SignedTransaction) GetBigIntFields() (*big.Int, *big.Int, *big.Int) {
+	return new(big.Int).SetBytes(tx.VBytes), new(big.Int).SetBytes(tx.RBytes), new(big.Int).SetBytes(tx.SBytes)
+}
+
+// Validate checks if the transaction is valid. It verifies the signature,
+// makes sure the account addresses are correct, and checks if the 'from'
+// and 'to' accounts are not the same (unless you are the proof of authority!)
+func (stx SignedTransaction) Validate(chainID uint16, isPoA bool) error {
+	// Check if the transaction's chain ID matches the expected one.
+	if stx.ChainID != chainID {
+		return fmt.Errorf("invalid chain id, got[%d] exp[%d]", stx.ChainID, chainID)
+	}
+
+	address, err := stx.FromAddress()
+	if err != nil {
+		return err
+	}
+	if address != string(stx.From.Hex()) {
+		return errors.New("signature address doesn't match from address")
+	}
+
+	// A swap must also be signed by the account paying for the token.
+	if stx.Type == TransactionTypeSwap {
+		if err := stx.ValidateSwap(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (stx *SignedTransaction) Serialize() ([]byte, error) {
+	dataBytes, err := cbor.Marshal(stx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize signed transaction: %v", err)
+	}
+	return dataBytes, nil
+}
+
+func NewSignedTransactionFromDeserialize(data []byte) (*SignedTransaction, error) {
+	// Variable we will use to return.
+	stx := &SignedTransaction{}
+
+	// Defensive code: If programmer entered empty bytes then we will
+	// return nil deserialization result.
+	if data == nil {
+		return nil, nil
+	}
+
+	if err := cbor.Unmarshal(data, &stx); err != nil {
+		return nil, fmt.Errorf("failed to deserialize signed transaction: %v", err)
+	}
+	return stx, nil
+}
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// signingVectorsPath is the test vectors of the Authority, the CLI must sign,
+// verify and hash exactly like the Authority so both run against the same file.
+const signingVectorsPath = "../../../../cloud/comiccoin/internal/authority/domain/testdata/signing_vectors.json"
+
+// signingVectors are the cross-language test vectors of the canonical
+// signing version shared with the Authority.
+type signingVectors struct {
+	PrivateKey   string `json:"private_key"`
+	Address      string `json:"address"`
+	Transactions []struct {
+		Name        string `json:"name"`
+		Transaction struct {
+			Version          uint8         `json:"version"`
+			ChainID          uint16        `json:"chain_id"`
+			Nonce            string        `json:"nonce"`
+			From             string        `json:"from"`
+			To               string        `json:"to"`
+			Value            uint64        `json:"value"`
+			Data             hexutil.Bytes `json:"data"`
+			Type             string        `json:"type"`
+			TokenID          string        `json:"token_id"`
+			TokenMetadataURI string        `json:"token_metadata_uri"`
+			TokenNonce       string        `json:"token_nonce"`
+			Fee              uint64        `json:"fee"`
+		} `json:"transaction"`
+		Canonical   string `json:"canonical"`
+		SigningHash string `json:"signing_hash"`
+		Signature   string `json:"signature"`
+	} `json:"transactions"`
+	BlockHeaders []struct {
+		Name   string `json:"name"`
+		Header struct {
+			Version        uint8  `json:"version"`
+			ChainID        uint16 `json:"chain_id"`
+			Number         string `json:"number"`
+			PrevBlockHash  string `json:"prev_block_hash"`
+			TimeStamp      uint64 `json:"timestamp"`
+			Difficulty     uint16 `json:"difficulty"`
+			Beneficiary    string `json:"beneficiary"`
+			TransactionFee uint64 `json:"transaction_fee"`
+			StateRoot      string `json:"state_root"`
+			TransRoot      string `json:"trans_root"`
+			Nonce          string `json:"nonce"`
+			LatestTokenID  string `json:"latest_token_id"`
+			TokensRoot     string `json:"tokens_root"`
+		} `json:"header"`
+		Canonical string `json:"canonical"`
+		Digest    string `json:"digest"`
+	} `json:"block_headers"`
+}
+
+func readSigningVectors(t *testing.T) signingVectors {
+	data, err := os.ReadFile(signingVectorsPath)
+	if err != nil {
+		t.Fatalf("failed reading vectors: %v", err)
+	}
+	var vectors signingVectors
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatalf("failed decoding vectors: %v", err)
+	}
+	return vectors
+}
+
+func mustBigIntBytes(t *testing.T, s string) []byte {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid number: %v", s)
+	}
+	return n.Bytes()
+}
+
+func TestCanonicalSigningVectors(t *testing.T) {
+	vectors := readSigningVectors(t)
+	privateKey, err := crypto.HexToECDSA(vectors.PrivateKey)
+	if err != nil {
+		t.Fatalf("failed decoding private key: %v", err)
+	}
+
+	for _, vector := range vectors.Transactions {
+		t.Run(vector.Name, func(t *testing.T) {
+			v := vector.Transaction
+			from := common.HexToAddress(v.From)
+			to := common.HexToAddress(v.To)
+			tx := Transaction{
+				ChainID:          v.ChainID,
+				NonceBytes:       mustBigIntBytes(t, v.Nonce),
+				From:             &from,
+				To:               &to,
+				Value:            v.Value,
+				Data:             v.Data,
+				Type:             v.Type,
+				TokenIDBytes:     mustBigIntBytes(t, v.TokenID),
+				TokenMetadataURI: v.TokenMetadataURI,
+				TokenNonceBytes:  mustBigIntBytes(t, v.TokenNonce),
+				Version:          v.Version,
+				Fee:              v.Fee,
+			}
+
+			canonical, version, err := signature.CanonicalBytes(tx)
+			if err != nil || version == signature.VersionLegacy {
+				t.Fatalf("failed canonical encoding: %v", err)
+			}
+			if got := hexutil.Encode(canonical); got != vector.Canonical {
+				t.Fatalf("expected canonical %v, got %v", vector.Canonical, got)
+			}
+			signingHash, err := tx.HashWithComicCoinStamp()
+			if err != nil {
+				t.Fatalf("failed hashing transaction: %v", err)
+			}
+			if got := hexutil.Encode(signingHash); got != vector.SigningHash {
+				t.Fatalf("expected signing hash %v, got %v", vector.SigningHash, got)
+			}
+
+			stx, err := tx.Sign(privateKey)
+			if err != nil {
+				t.Fatalf("failed signing transaction: %v", err)
+			}
+			if got := signature.SignatureString(stx.GetBigIntFields()); got != vector.Signature {
+				t.Fatalf("expected signature %v, got %v", vector.Signature, got)
+			}
+
+			// The signature made by the Authority must verify to the signer.
+			sigV, sigR, sigS, err := signature.ToVRSFromHexSignature(vector.Signature)
+			if err != nil {
+				t.Fatalf("failed decoding signature: %v", err)
+			}
+			if err := signature.VerifySignature(sigV, sigR, sigS); err != nil {
+				t.Fatalf("expected signature to verify: %v", err)
+			}
+			signer, err := signature.FromAddress(tx, sigV, sigR, sigS)
+			if err != nil || signer != vectors.Address {
+				t.Fatalf("expected signer %v, got %v, %v", vectors.Address, signer, err)
+			}
+
+			// The signed transaction must survive being stored inside a
+			// block, see `BlockTransaction.MarshalCBOR`.
+			blockTx := &BlockTransaction{SignedTransaction: stx, Fee: v.Fee}
+			data, err := blockTx.Serialize()
+			if err != nil {
+				t.Fatalf("failed serializing block transaction: %v", err)
+			}
+			stored, err := NewBlockTransactionFromDeserialize(data)
+			if err != nil {
+				t.Fatalf("failed deserializing block transaction: %v", err)
+			}
+			if err := stored.Validate(v.ChainID, false); err != nil {
+				t.Fatalf("expected stored transaction to validate: %v", err)
+			}
+		})
+	}
+
+	for _, vector := range vectors.BlockHeaders {
+		t.Run(vector.Name, func(t *testing.T) {
+			v := vector.Header
+			header := &BlockHeader{
+				ChainID:            v.ChainID,
+				NumberBytes:        mustBigIntBytes(t, v.Number),
+				PrevBlockHash:      v.PrevBlockHash,
+				TimeStamp:          v.TimeStamp,
+				Difficulty:         v.Difficulty,
+				Beneficiary:        common.HexToAddress(v.Beneficiary),
+				TransactionFee:     v.TransactionFee,
+				StateRoot:          v.StateRoot,
+				TransRoot:          v.TransRoot,
+				NonceBytes:         mustBigIntBytes(t, v.Nonce),
+				LatestTokenIDBytes: mustBigIntBytes(t, v.LatestTokenID),
+				TokensRoot:         v.TokensRoot,
+				Version:            v.Version,
+			}
+
+			canonical, version, err := signature.CanonicalBytes(header)
+			if err != nil || version == signature.VersionLegacy {
+				t.Fatalf("failed canonical encoding: %v", err)
+			}
+			if got := hexutil.Encode(canonical); got != vector.Canonical {
+				t.Fatalf("expected canonical %v, got %v", vector.Canonical, got)
+			}
+			digest := sha256.Sum256(canonical)
+			if got := hexutil.Encode(digest[:]); got != vector.Digest {
+				t.Fatalf("expected digest %v, got %v", vector.Digest, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	auth_domain "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// ErrStateSnapshotInvalid is returned when a state snapshot downloaded from
+// the Authority does not match the block it claims to be a snapshot of.
+var ErrStateSnapshotInvalid = errors.New("state snapshot is invalid")
+
+// StateSnapshot is a copy of every account and token of the blockchain right
+// after the block `BlockHash` was applied, exported and signed by the
+// Authority. We use it to bootstrap our local blockchain without replaying
+// every block since the genesis block.
+type StateSnapshot struct {
+	ChainID           uint16                 `json:"chain_id"`
+	BlockNumberBytes  []byte                 `json:"block_number_bytes"`
+	BlockNumberString string                 `json:"block_number_string"`
+	BlockHash         string                 `json:"block_hash"`
+	AccountHashState  string                 `json:"account_hash_state"`
+	TokenHashState    string                 `json:"token_hash_state"`
+	ContentHash       string                 `json:"content_hash"`
+	Accounts          []*auth_domain.Account `json:"accounts"`
+	Tokens            []*auth_domain.Token   `json:"tokens"`
+	Validators        []*Validator           `json:"validators"`
+	ValidatorSetHash  string                 `json:"validator_set_hash"`
+	SignatureBytes    []byte                 `json:"signature_bytes"`
+	Validator         *Validator             `json:"validator"`
+	CreatedAt         time.Time              `json:"created_at"`
+}
+
+// stateSnapshotCommitment is the part of the state snapshot which was signed
+// by the Authority. The field order must match the Authority as the
+// signature is over the JSON encoding.
+type stateSnapshotCommitment struct {
+	ChainID          uint16 `json:"chain_id"`
+	BlockNumberBytes []byte `json:"block_number_bytes"`
+	BlockHash        string `json:"block_hash"`
+	AccountHashState string `json:"account_hash_state"`
+	TokenHashState   string `json:"token_hash_state"`
+	ContentHash      string `json:"content_hash"`
+	ValidatorSetHash string `json:"validator_set_hash"`
+}
+
+// StateSnapshotDTORepository downloads the most recent state snapshot
+// exported by the Authority.
+type StateSnapshotDTORepository interface {
+	GetLatestFromBlockchainAuthority(ctx context.Context) (*StateSnapshot, error)
+}
+
+func (s *StateSnapshot) GetBlockNumber() *big.Int {
+	return new(big.Int).SetBytes(s.BlockNumberBytes)
+}
+
+// ValidateStateSnapshot verifies the state snapshot downloaded from the
+// Authority before we bootstrap our local blockchain from it. The block the
+// snapshot was taken at, and the snapshot itself, must be signed by the
+// genesis validator and its state root and tokens root must match the
+// accounts and tokens of the snapshot.
+//
+// DEVELOPERS NOTE:
+// We skip the blocks before the snapshot so we never see the validator
+// transactions which changed the validator set, therefore the validator set
+// in the snapshot is only trusted because the genesis validator, which we
+// trust on first use, signed its hash. A snapshot signed by any other
+// validator is rejected, even if that validator is in the snapshot, as
+// anyone can add their own key to the snapshot and sign it.
+func ValidateStateSnapshot(snapshot *StateSnapshot, blockData *BlockData, genesisValidator *Validator) error {
+	if snapshot == nil {
+		return fmt.Errorf("%w: snapshot is missing", ErrStateSnapshotInvalid)
+	}
+	if genesisValidator == nil {
+		return errors.New("genesis validator is missing")
+	}
+	if blockData == nil || blockData.Validator == nil {
+		return fmt.Errorf("%w: block is incomplete", ErrBlockTampered)
+	}
+
+	//
+	// VALIDATION 1:
+	// Check: block the snapshot was taken at is authentic.
+	//
+
+	if !IsStateSnapshotSignedByGenesisValidator(snapshot, blockData, genesisValidator) {
+		return fmt.Errorf("%w: block %v was not validated by the genesis validator", ErrStateSnapshotInvalid, blockData.Hash)
+	}
+	signer := genesisValidator
+	if err := validateBlockDataContents(blockData, signer); err != nil {
+		return err
+	}
+	if snapshot.BlockHash != blockData.Hash || snapshot.ChainID != blockData.Header.ChainID || snapshot.GetBlockNumber().Cmp(blockData.Header.GetNumber()) != 0 {
+		return fmt.Errorf("%w: snapshot was not taken at block %v", ErrStateSnapshotInvalid, blockData.Hash)
+	}
+	if !blockData.Header.HasStateTrieRoots() {
+		return fmt.Errorf("%w: block %v does not commit to the state tries", ErrStateSnapshotInvalid, blockData.Hash)
+	}
+
+	//
+	// VALIDATION 2:
+	// Check: snapshot was signed by the validator which sealed the block.
+	//
+
+	commitment := &stateSnapshotCommitment{
+		ChainID:          snapshot.ChainID,
+		BlockNumberBytes: snapshot.BlockNumberBytes,
+		BlockHash:        snapshot.BlockHash,
+		AccountHashState: snapshot.AccountHashState,
+		TokenHashState:   snapshot.TokenHashState,
+		ContentHash:      snapshot.ContentHash,
+		ValidatorSetHash: snapshot.ValidatorSetHash,
+	}
+	if !signer.Verify(snapshot.SignatureBytes, commitment) {
+		return fmt.Errorf("%w: signature is invalid", ErrStateSnapshotInvalid)
+	}
+
+	//
+	// VALIDATION 3:
+	// Check: accounts and tokens match the state root and tokens root of the
+	// block header, see `HashAccountsStateTrie` and `HashTokensStateTrie`.
+	//
+
+	for _, account := range snapshot.Accounts {
+		if account.ChainID != snapshot.ChainID {
+			return fmt.Errorf("%w: account %v is for chain %v, exp %v", ErrStateSnapshotInvalid, account.Address, account.ChainID, snapshot.ChainID)
+		}
+	}
+	for _, tok := range snapshot.Tokens {
+		if tok.ChainID != snapshot.ChainID {
+			return fmt.Errorf("%w: token %v is for chain %v, exp %v", ErrStateSnapshotInvalid, tok.GetID(), tok.ChainID, snapshot.ChainID)
+		}
+	}
+	accountHashState, err := HashAccountsStateTrie(snapshot.Accounts, snapshot.ChainID)
+	if err != nil {
+		return err
+	}
+	if accountHashState != snapshot.AccountHashState || accountHashState != blockData.Header.StateRoot {
+		return fmt.Errorf("%w: accounts do not match block state root, got %v, exp %v", ErrStateSnapshotInvalid, accountHashState, blockData.Header.StateRoot)
+	}
+	tokenHashState, err := HashTokensStateTrie(snapshot.Tokens, snapshot.ChainID)
+	if err != nil {
+		return err
+	}
+	if tokenHashState != snapshot.TokenHashState || tokenHashState != blockData.Header.TokensRoot {
+		return fmt.Errorf("%w: tokens do not match block tokens root, got %v, exp %v", ErrStateSnapshotInvalid, tokenHashState, blockData.Header.TokensRoot)
+	}
+
+	//
+	// VALIDATION 4:
+	// Check: accounts and tokens were not tampered with, this matches
+	// `HashStateSnapshotContents` of the Authority.
+	//
+
+	contentHash, err := hashStateSnapshotContents(snapshot.Accounts, snapshot.Tokens)
+	if err != nil {
+		return err
+	}
+	if contentHash != snapshot.ContentHash {
+		return fmt.Errorf("%w: content hash does not match, got %v, exp %v", ErrStateSnapshotInvalid, contentHash, snapshot.ContentHash)
+	}
+
+	//
+	// VALIDATION 5:
+	// Check: validator set was not tampered with.
+	//
+
+	validatorSetHash, err := hashValidatorSet(snapshot.Validators)
+	if err != nil {
+		return err
+	}
+	if len(snapshot.Validators) == 0 || validatorSetHash != snapshot.ValidatorSetHash {
+		return fmt.Errorf("%w: validator set hash does not match, got %v, exp %v", ErrStateSnapshotInvalid, validatorSetHash, snapshot.ValidatorSetHash)
+	}
+	return nil
+}
+
+// IsStateSnapshotSignedByGenesisValidator returns true if the snapshot and the
+// block it was taken at claim to be signed by the genesis validator, the
+// signatures themselves are checked by `ValidateStateSnapshot`.
+func IsStateSnapshotSignedByGenesisValidator(snapshot *StateSnapshot, blockData *BlockData, genesisValidator *Validator) bool {
+	if snapshot == nil || blockData == nil || blockData.Validator == nil || genesisValidator == nil {
+		return false
+	}
+	if snapshot.Validator != nil && !bytes.Equal(snapshot.Validator.PublicKeyBytes, genesisValidator.PublicKeyBytes) {
+		return false
+	}
+	return bytes.Equal(blockData.Validator.PublicKeyBytes, genesisValidator.PublicKeyBytes)
+}
+
+// hashStateSnapshotContents returns the hash of the accounts, sorted by
+// address, followed by the tokens, sorted by token ID.
+func hashStateSnapshotContents(accounts []*auth_domain.Account, tokens []*auth_domain.Token) (string, error) {
+	sortedAccounts := make([]*auth_domain.Account, len(accounts))
+	copy(sortedAccounts, accounts)
+	sort.Slice(sortedAccounts, func(i, j int) bool {
+		return strings.ToLower(sortedAccounts[i].Address.String()) < strings.ToLower(sortedAccounts[j].Address.String())
+	})
+	sortedTokens := make([]*auth_domain.Token, len(tokens))
+	copy(sortedTokens, tokens)
+	sort.Slice(sortedTokens, func(i, j int) bool {
+		return sortedTokens[i].GetID().Cmp(sortedTokens[j].GetID()) < 0
+	})
+
+	contentBytes := make([]byte, 0)
+	for _, account := range sortedAccounts {
+		accountBytes, err := account.Serialize()
+		if err != nil {
+			return "", err
+		}
+		contentBytes = append(contentBytes, accountBytes...)
+	}
+	for _, tok := range sortedTokens {
+		tokBytes, err := tok.Serialize()
+		if err != nil {
+			return "", err
+		}
+		contentBytes = append(contentBytes, tokBytes...)
+	}
+	return signature.Hash(contentBytes), nil
+}
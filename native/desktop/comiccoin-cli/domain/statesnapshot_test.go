@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// stateSnapshotVectorPath is the state snapshot exported by the Authority
+// which the Authority verifies in its own tests, we read the same file so
+// both sides must agree on it.
+const stateSnapshotVectorPath = "../../../../cloud/comiccoin/internal/authority/domain/testdata/state_snapshot_vector.json"
+
+type stateSnapshotVector struct {
+	GenesisValidator *Validator     `json:"genesis_validator"`
+	BlockData        *BlockData     `json:"block_data"`
+	Snapshot         *StateSnapshot `json:"snapshot"`
+}
+
+func readStateSnapshotVector(t *testing.T) *stateSnapshotVector {
+	t.Helper()
+	data, err := os.ReadFile(stateSnapshotVectorPath)
+	if err != nil {
+		t.Fatalf("failed reading state snapshot vector: %v", err)
+	}
+	vector := &stateSnapshotVector{}
+	if err := json.Unmarshal(data, vector); err != nil {
+		t.Fatalf("failed decoding state snapshot vector: %v", err)
+	}
+	return vector
+}
+
+func TestValidateStateSnapshotVector(t *testing.T) {
+	vector := readStateSnapshotVector(t)
+	if err := ValidateStateSnapshot(vector.Snapshot, vector.BlockData, vector.GenesisValidator); err != nil {
+		t.Fatalf("expected state snapshot exported by the Authority to be valid, got %v", err)
+	}
+}
+
+func TestValidateStateSnapshotRejectsValidatorNotTrusted(t *testing.T) {
+	vector := readStateSnapshotVector(t)
+	snapshot, blockData := vector.Snapshot, vector.BlockData
+
+	// An attacker adds their own key to the validator set of the snapshot
+	// and seals the block and the snapshot with it.
+	attackerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	attacker := &Validator{ID: "attacker", PublicKeyBytes: crypto.FromECDSAPub(&attackerKey.PublicKey)}
+	blockData.Validator = attacker
+	blockData.HeaderSignatureBytes, err = attacker.Sign(attackerKey, withoutBlockHeaderJSONStrings(blockData.Header))
+	if err != nil {
+		t.Fatalf("failed signing header: %v", err)
+	}
+	snapshot.Validators = append(snapshot.Validators, attacker)
+	snapshot.ValidatorSetHash, err = hashValidatorSet(snapshot.Validators)
+	if err != nil {
+		t.Fatalf("failed hashing validator set: %v", err)
+	}
+	snapshot.Validator = attacker
+	snapshot.SignatureBytes, err = attacker.Sign(attackerKey, &stateSnapshotCommitment{
+		ChainID:          snapshot.ChainID,
+		BlockNumberBytes: snapshot.BlockNumberBytes,
+		BlockHash:        snapshot.BlockHash,
+		AccountHashState: snapshot.AccountHashState,
+		TokenHashState:   snapshot.TokenHashState,
+		ContentHash:      snapshot.ContentHash,
+		ValidatorSetHash: snapshot.ValidatorSetHash,
+	})
+	if err != nil {
+		t.Fatalf("failed signing snapshot: %v", err)
+	}
+
+	if IsStateSnapshotSignedByGenesisValidator(snapshot, blockData, vector.GenesisValidator) {
+		t.Fatalf("expected snapshot to not be signed by the genesis validator")
+	}
+	if err := ValidateStateSnapshot(snapshot, blockData, vector.GenesisValidator); !errors.Is(err, ErrStateSnapshotInvalid) {
+		t.Fatalf("expected %v, got %v", ErrStateSnapshotInvalid, err)
+	}
+}
+
+func TestValidateStateSnapshotTampered(t *testing.T) {
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	tests := []struct {
+		name   string
+		tamper func(t *testing.T, snapshot *StateSnapshot, blockData *BlockData)
+	}{
+		{"tampered account", func(t *testing.T, snapshot *StateSnapshot, blockData *BlockData) {
+			snapshot.Accounts[0].Balance++
+		}},
+		{"tampered token", func(t *testing.T, snapshot *StateSnapshot, blockData *BlockData) {
+			snapshot.Tokens[0].Owner = snapshot.Accounts[len(snapshot.Accounts)-1].Address
+		}},
+		{"wrong signer", func(t *testing.T, snapshot *StateSnapshot, blockData *BlockData) {
+			// The snapshot still claims the genesis validator but was
+			// signed with another key.
+			other := &Validator{ID: "other", PublicKeyBytes: crypto.FromECDSAPub(&otherKey.PublicKey)}
+			sig, err := other.Sign(otherKey, &stateSnapshotCommitment{
+				ChainID:          snapshot.ChainID,
+				BlockNumberBytes: snapshot.BlockNumberBytes,
+				BlockHash:        snapshot.BlockHash,
+				AccountHashState: snapshot.AccountHashState,
+				TokenHashState:   snapshot.TokenHashState,
+				ContentHash:      snapshot.ContentHash,
+				ValidatorSetHash: snapshot.ValidatorSetHash,
+			})
+			if err != nil {
+				t.Fatalf("failed signing snapshot: %v", err)
+			}
+			snapshot.SignatureBytes = sig
+		}},
+		{"wrong block hash", func(t *testing.T, snapshot *StateSnapshot, blockData *BlockData) {
+			snapshot.BlockHash = "0x0000000000000000000000000000000000000000000000000000000000000001"
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vector := readStateSnapshotVector(t)
+			tt.tamper(t, vector.Snapshot, vector.BlockData)
+			if err := ValidateStateSnapshot(vector.Snapshot, vector.BlockData, vector.GenesisValidator); !errors.Is(err, ErrStateSnapshotInvalid) {
+				t.Fatalf("expected %v, got %v", ErrStateSnapshotInvalid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	auth_domain "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// The Authority stores the accounts and the tokens each in a sparse merkle
+// tree with 256-bit keys, the roots of these trees are the `StateRoot` and
+// the `TokensRoot` of the block header. We compute the roots of our local
+// accounts and tokens from scratch the exact same way so we can verify the
+// blocks we download.
+const stateTrieDepth = 256
+
+var (
+	stateTrieLeafPrefix = []byte{0x00}
+	stateTrieNodePrefix = []byte{0x01}
+
+	// stateTrieEmptyHashes[h] is the hash of an empty subtree of height `h`,
+	// where height zero is a leaf and height `stateTrieDepth` is the root.
+	stateTrieEmptyHashes [stateTrieDepth + 1][]byte
+)
+
+func init() {
+	stateTrieEmptyHashes[0] = make([]byte, sha256.Size)
+	for h := 1; h <= stateTrieDepth; h++ {
+		stateTrieEmptyHashes[h] = hashStateTrieNode(stateTrieEmptyHashes[h-1], stateTrieEmptyHashes[h-1])
+	}
+}
+
+type stateTrieLeaf struct {
+	key      []byte
+	leafHash []byte
+}
+
+// HashAccountsStateTrie returns the root of the accounts trie of the chain,
+// this must match the `StateRoot` of the block header once the block was
+// applied to our local accounts.
+func HashAccountsStateTrie(accounts []*auth_domain.Account, chainID uint16) (string, error) {
+	leaves := make([]stateTrieLeaf, 0, len(accounts))
+	for _, account := range accounts {
+		if account.ChainID != chainID {
+			continue
+		}
+
+		// DEVELOPERS NOTE:
+		// Normalize the nonce so an account loaded from the database
+		// serializes the same as the Authority's copy of the account.
+		acc := *account
+		acc.NonceBytes = acc.GetNonce().Bytes()
+		accountBytes, err := acc.Serialize()
+		if err != nil {
+			return "", err
+		}
+		key := stateTrieKey(acc.Address.Bytes())
+		leaves = append(leaves, stateTrieLeaf{key, hashStateTrieLeaf(key, accountBytes)})
+	}
+	return hexutil.Encode(stateTrieRoot(leaves)), nil
+}
+
+// HashTokensStateTrie returns the root of the tokens trie of the chain, this
+// must match the `TokensRoot` of the block header.
+func HashTokensStateTrie(tokens []*auth_domain.Token, chainID uint16) (string, error) {
+	leaves := make([]stateTrieLeaf, 0, len(tokens))
+	for _, token := range tokens {
+		if token.ChainID != chainID {
+			continue
+		}
+		tok := *token
+		tok.IDBytes = tok.GetID().Bytes()
+		tok.NonceBytes = tok.GetNonce().Bytes()
+		tokBytes, err := tok.Serialize()
+		if err != nil {
+			return "", err
+		}
+		key := stateTrieKey(common.LeftPadBytes(tok.IDBytes, 32))
+		leaves = append(leaves, stateTrieLeaf{key, hashStateTrieLeaf(key, tokBytes)})
+	}
+	return hexutil.Encode(stateTrieRoot(leaves)), nil
+}
+
+// HashAccountsStateLegacy returns the state root the Authority used before
+// the accounts trie was introduced: the hash of the serialized accounts with
+// a balance, sorted by address. Blocks sealed before the upgrade carry this
+// state root so we still need it to verify them.
+func HashAccountsStateLegacy(accounts []*auth_domain.Account, chainID uint16) (string, error) {
+	accountsWithBalance := make([]*auth_domain.Account, 0)
+	for _, account := range accounts {
+		if account.Balance > 0 && account.ChainID == chainID {
+			accountsWithBalance = append(accountsWithBalance, account)
+		}
+	}
+	sort.Slice(accountsWithBalance, func(i, j int) bool {
+		return strings.ToLower(accountsWithBalance[i].Address.String()) < strings.ToLower(accountsWithBalance[j].Address.String())
+	})
+
+	accountsBytes := make([]byte, 0)
+	for _, account := range accountsWithBalance {
+		accountBytes, err := account.Serialize()
+		if err != nil {
+			return "", err
+		}
+		accountsBytes = append(accountsBytes, accountBytes...)
+	}
+	return signature.Hash(accountsBytes), nil
+}
+
+func stateTrieKey(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+func hashStateTrieLeaf(key, value []byte) []byte {
+	h := sha256.New()
+	h.Write(stateTrieLeafPrefix)
+	h.Write(key)
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+func hashStateTrieNode(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(stateTrieNodePrefix)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// stateTrieBit returns the bit of the key at the depth, the most significant
+// bit decides the first step from the root.
+func stateTrieBit(key []byte, depth int) byte {
+	return (key[depth/8] >> (7 - uint(depth%8))) & 1
+}
+
+func stateTrieRoot(leaves []stateTrieLeaf) []byte {
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].key, leaves[j].key) < 0
+	})
+	return buildStateTrie(leaves, 0)
+}
+
+// buildStateTrie returns the hash of the subtree at the depth which contains
+// the sorted leaves.
+func buildStateTrie(leaves []stateTrieLeaf, depth int) []byte {
+	if len(leaves) == 0 {
+		return stateTrieEmptyHashes[stateTrieDepth-depth]
+	}
+	if depth == stateTrieDepth {
+		return leaves[len(leaves)-1].leafHash
+	}
+	split := sort.Search(len(leaves), func(i int) bool {
+		return stateTrieBit(leaves[i].key, depth) == 1
+	})
+	return hashStateTrieNode(buildStateTrie(leaves[:split], depth+1), buildStateTrie(leaves[split:], depth+1))
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSwapOfferCoSign(t *testing.T) {
+	sellerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	buyerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	seller := crypto.PubkeyToAddress(sellerKey.PublicKey)
+	buyer := crypto.PubkeyToAddress(buyerKey.PublicKey)
+
+	tx := Transaction{
+		ChainID:          1,
+		NonceBytes:       big.NewInt(1).Bytes(),
+		From:             &seller,
+		To:               &buyer,
+		Value:            50,
+		Type:             TransactionTypeSwap,
+		TokenIDBytes:     big.NewInt(7).Bytes(),
+		TokenMetadataURI: "ipfs://metadata",
+		TokenNonceBytes:  big.NewInt(2).Bytes(),
+		Version:          TransactionVersion,
+		Fee:              1,
+	}
+	signed, err := tx.Sign(sellerKey)
+	if err != nil {
+		t.Fatalf("failed signing offer: %v", err)
+	}
+
+	// The offer is handed to the buyer as JSON, see `TokenSwapOfferService`.
+	offerJSON, err := json.Marshal(signed)
+	if err != nil {
+		t.Fatalf("failed encoding offer: %v", err)
+	}
+	offer := SignedTransaction{}
+	if err := json.Unmarshal(offerJSON, &offer); err != nil {
+		t.Fatalf("failed decoding offer: %v", err)
+	}
+	if err := offer.ValidateOffer(1); err != nil {
+		t.Fatalf("expected offer to be valid, got %v", err)
+	}
+	if err := offer.Validate(1, false); !errors.Is(err, ErrSwapNotCoSigned) {
+		t.Fatalf("expected %v, got %v", ErrSwapNotCoSigned, err)
+	}
+	if debit, _, _ := offer.SplitFees(0); debit != 51 {
+		t.Fatalf("expected buyer to pay 51, got %v", debit)
+	}
+	if *offer.Payer() != buyer || *offer.Payee() != seller || !offer.MovesToken() {
+		t.Fatalf("expected buyer to pay seller for the token")
+	}
+
+	swap, err := offer.CoSign(buyerKey)
+	if err != nil {
+		t.Fatalf("failed co-signing swap: %v", err)
+	}
+
+	// The swap must stay valid once it is stored inside a block.
+	blockTx := &BlockTransaction{SignedTransaction: swap, Fee: 1}
+	data, err := blockTx.Serialize()
+	if err != nil {
+		t.Fatalf("failed serializing block transaction: %v", err)
+	}
+	stored, err := NewBlockTransactionFromDeserialize(data)
+	if err != nil {
+		t.Fatalf("failed deserializing block transaction: %v", err)
+	}
+	if err := stored.Validate(1, false); err != nil {
+		t.Fatalf("expected stored swap to be valid, got %v", err)
+	}
+
+	stored.Value = 1
+	if err := stored.Validate(1, false); err == nil {
+		t.Fatalf("expected changed swap to be invalid")
+	}
+}
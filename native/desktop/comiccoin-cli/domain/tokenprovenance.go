@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// ErrTokenProvenanceInvalid is returned when a token provenance document does
+// not match the blocks of our local blockchain.
+var ErrTokenProvenanceInvalid = errors.New("token provenance is invalid")
+
+// tokenBurnAddress is the owner of every burned token.
+var tokenBurnAddress = common.HexToAddress("0x0000000000000000000000000000000000000000")
+
+// TokenProvenanceOwner is a single owner in the chain of custody of a token.
+type TokenProvenanceOwner struct {
+	Owner                     *common.Address `json:"owner"`
+	AcquiredBlockHash         string          `json:"acquired_block_hash"`
+	AcquiredBlockNumberString string          `json:"acquired_block_number_string"`
+	AcquiredBlockTimeStamp    uint64          `json:"acquired_block_timestamp"`
+	DisposedBlockHash         string          `json:"disposed_block_hash,omitempty"`
+	DisposedBlockNumberString string          `json:"disposed_block_number_string,omitempty"`
+	DisposedBlockTimeStamp    uint64          `json:"disposed_block_timestamp,omitempty"`
+}
+
+// TokenProvenance is the chain of custody of a token returned by the
+// Authority's `/authority/api/v1/tokens/{id}/provenance` endpoint. It
+// includes the merkle inclusion proof of every token transaction so it can be
+// verified offline against the block headers of our local blockchain.
+type TokenProvenance struct {
+	ChainID               uint16                   `json:"chain_id"`
+	TokenIDBytes          []byte                   `json:"token_id_bytes"`
+	TokenIDString         string                   `json:"token_id_string"`
+	MetadataURI           string                   `json:"metadata_uri"`
+	MintBlockHash         string                   `json:"mint_block_hash"`
+	MintBlockNumberString string                   `json:"mint_block_number_string"`
+	Owners                []*TokenProvenanceOwner  `json:"owners"`
+	Burned                bool                     `json:"burned"`
+	BurnBlockHash         string                   `json:"burn_block_hash,omitempty"`
+	BurnBlockNumberString string                   `json:"burn_block_number_string,omitempty"`
+	Transactions          []*BlockTransactionProof `json:"transactions"` // Ordered by token nonce starting with the mint.
+	ContentHash           string                   `json:"content_hash"`
+	SignatureBytes        []byte                   `json:"signature_bytes"`
+	Validator             *Validator               `json:"validator"`
+	CreatedAt             time.Time                `json:"created_at"`
+}
+
+// tokenProvenanceCommitment is the part of the token provenance which was
+// signed by the Authority. The field order must match the Authority as the
+// signature is over the JSON encoding.
+type tokenProvenanceCommitment struct {
+	ChainID      uint16 `json:"chain_id"`
+	TokenIDBytes []byte `json:"token_id_bytes"`
+	ContentHash  string `json:"content_hash"`
+}
+
+// TokenProvenanceDTORepository downloads the provenance of a token from the
+// Authority.
+type TokenProvenanceDTORepository interface {
+	GetFromBlockchainAuthorityByTokenID(ctx context.Context, tokenID *big.Int) (*TokenProvenance, error)
+}
+
+func (p *TokenProvenance) GetTokenID() *big.Int {
+	return new(big.Int).SetBytes(p.TokenIDBytes)
+}
+
+// VerifyTokenProvenance verifies the token provenance offline against the
+// blocks of our local blockchain, keyed by block hash. Every transaction must
+// be included in one of our blocks, the chain of custody must match the
+// transactions and the document must be signed by a validator which sealed
+// one of those blocks.
+func VerifyTokenProvenance(p *TokenProvenance, localBlocks map[string]*BlockData) error {
+	if p == nil {
+		return fmt.Errorf("%w: provenance is missing", ErrTokenProvenanceInvalid)
+	}
+	if len(p.Transactions) == 0 {
+		return fmt.Errorf("%w: token has no transactions", ErrTokenProvenanceInvalid)
+	}
+
+	//
+	// VALIDATION 1:
+	// Check: every transaction is included in a block of our blockchain.
+	//
+
+	var signer *Validator
+	for i, proof := range p.Transactions {
+		if proof == nil || proof.Header == nil {
+			return fmt.Errorf("%w: transaction %v is missing", ErrTokenProvenanceInvalid, i)
+		}
+		blockData, ok := localBlocks[proof.BlockHash]
+		if !ok || blockData == nil || blockData.Header == nil || blockData.Validator == nil {
+			return fmt.Errorf("%w: block %v does not exist in our blockchain", ErrTokenProvenanceInvalid, proof.BlockHash)
+		}
+		if blockData.Header.ChainID != proof.Header.ChainID ||
+			blockData.Header.GetNumber().Cmp(proof.Header.GetNumber()) != 0 ||
+			blockData.Header.TransRoot != proof.Header.TransRoot {
+			return fmt.Errorf("%w: block header %v does not match our blockchain", ErrTokenProvenanceInvalid, proof.BlockHash)
+		}
+		if err := VerifyBlockTransactionProof(proof, blockData.Validator); err != nil {
+			return fmt.Errorf("%w: transaction %v: %v", ErrTokenProvenanceInvalid, i, err)
+		}
+		if p.Validator != nil && bytes.Equal(p.Validator.PublicKeyBytes, blockData.Validator.PublicKeyBytes) {
+			signer = blockData.Validator
+		}
+	}
+
+	//
+	// VALIDATION 2:
+	// Check: document was signed by a validator of our blockchain.
+	//
+
+	if signer == nil {
+		return fmt.Errorf("%w: provenance was not signed by a known validator", ErrTokenProvenanceInvalid)
+	}
+	contentHash, err := hashTokenProvenanceContents(p.Transactions)
+	if err != nil {
+		return err
+	}
+	if contentHash != p.ContentHash {
+		return fmt.Errorf("%w: content hash does not match, got %v, exp %v", ErrTokenProvenanceInvalid, contentHash, p.ContentHash)
+	}
+	commitment := &tokenProvenanceCommitment{
+		ChainID:      p.ChainID,
+		TokenIDBytes: p.TokenIDBytes,
+		ContentHash:  p.ContentHash,
+	}
+	if !signer.Verify(p.SignatureBytes, commitment) {
+		return fmt.Errorf("%w: signature is invalid", ErrTokenProvenanceInvalid)
+	}
+
+	//
+	// VALIDATION 3:
+	// Check: chain of custody matches the transactions.
+	//
+
+	return verifyTokenProvenanceChainOfCustody(p)
+}
+
+// verifyTokenProvenanceChainOfCustody walks the transactions in token nonce
+// order, applying the same rules as the Authority, and compares the mint,
+// owners and burn against the document.
+func verifyTokenProvenanceChainOfCustody(p *TokenProvenance) error {
+	if !sort.SliceIsSorted(p.Transactions, func(i, j int) bool {
+		return p.Transactions[i].Transaction.GetTokenNonce().Cmp(p.Transactions[j].Transaction.GetTokenNonce()) < 0
+	}) {
+		return fmt.Errorf("%w: transactions are not in token nonce order", ErrTokenProvenanceInvalid)
+	}
+
+	owners := make([]*TokenProvenanceOwner, 0, len(p.Transactions))
+	var mintBlockHash, burnBlockHash, metadataURI string
+	var previousNonce *big.Int
+	burned := false
+	for i, proof := range p.Transactions {
+		tx := proof.Transaction
+		if !tx.MovesToken() || tx.ChainID != p.ChainID || tx.GetTokenID().Cmp(p.GetTokenID()) != 0 {
+			return fmt.Errorf("%w: transaction %v is not for token %v", ErrTokenProvenanceInvalid, i, p.GetTokenID())
+		}
+		if burned {
+			return fmt.Errorf("%w: transaction %v happened after the burn", ErrTokenProvenanceInvalid, i)
+		}
+
+		tokenNonce := tx.GetTokenNonce()
+		if i == 0 {
+			if tokenNonce.Sign() != 0 {
+				return fmt.Errorf("%w: first transaction is not the mint", ErrTokenProvenanceInvalid)
+			}
+			mintBlockHash = proof.BlockHash
+		} else {
+			if tokenNonce.Cmp(previousNonce) <= 0 {
+				return fmt.Errorf("%w: token nonce %v does not increase", ErrTokenProvenanceInvalid, tokenNonce)
+			}
+			current := owners[len(owners)-1]
+			if tx.From == nil || current.Owner == nil || *tx.From != *current.Owner {
+				return fmt.Errorf("%w: token nonce %v was not sent by the owner", ErrTokenProvenanceInvalid, tokenNonce)
+			}
+			current.DisposedBlockHash = proof.BlockHash
+		}
+		previousNonce = tokenNonce
+		metadataURI = tx.TokenMetadataURI
+
+		if i > 0 && (tx.To == nil || *tx.To == tokenBurnAddress) {
+			burned = true
+			burnBlockHash = proof.BlockHash
+			continue
+		}
+		owners = append(owners, &TokenProvenanceOwner{Owner: tx.To, AcquiredBlockHash: proof.BlockHash})
+	}
+
+	if p.MintBlockHash != mintBlockHash || p.Burned != burned || p.BurnBlockHash != burnBlockHash || p.MetadataURI != metadataURI {
+		return fmt.Errorf("%w: mint or burn does not match the transactions", ErrTokenProvenanceInvalid)
+	}
+	if len(p.Owners) != len(owners) {
+		return fmt.Errorf("%w: expected %v owners but got %v", ErrTokenProvenanceInvalid, len(owners), len(p.Owners))
+	}
+	for i, owner := range owners {
+		got := p.Owners[i]
+		if got == nil || got.Owner == nil || owner.Owner == nil || *got.Owner != *owner.Owner ||
+			got.AcquiredBlockHash != owner.AcquiredBlockHash || got.DisposedBlockHash != owner.DisposedBlockHash {
+			return fmt.Errorf("%w: owner %v does not match the transactions", ErrTokenProvenanceInvalid, i)
+		}
+	}
+	return nil
+}
+
+// hashTokenProvenanceContents returns the hash of the block hash, index and
+// hash of every transaction in the order given.
+func hashTokenProvenanceContents(proofs []*BlockTransactionProof) (string, error) {
+	contentBytes := make([]byte, 0)
+	for _, proof := range proofs {
+		txHash, err := withoutBlockTransactionJSONStrings(*proof.Transaction).Hash()
+		if err != nil {
+			return "", err
+		}
+		contentBytes = append(contentBytes, []byte(proof.BlockHash)...)
+		contentBytes = append(contentBytes, new(big.Int).SetUint64(proof.TransactionIndex).Bytes()...)
+		contentBytes = append(contentBytes, txHash...)
+	}
+	return signature.Hash(contentBytes), nil
+}
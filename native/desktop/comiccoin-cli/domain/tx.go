@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+// DEVELOPERS NOTE:
+// The transaction, block and validator types of this package mirror the
+// types of the Authority. We keep our own copy, instead of using the
+// Authority domain package we depend on, so the fields and the signing
+// versions which were added to the Authority afterwords are downloaded,
+// stored, signed and verified exactly the way the Authority does it.
+
+const (
+	TransactionTypeCoin  = "coin"
+	TransactionTypeToken = "token"
+
+	// TransactionTypeSwap exchanges a token of the `From` account for a coin
+	// payment of the `To` account, both accounts sign the transaction and
+	// the exchange is applied entirely or not at all. See `swap.go`.
+	TransactionTypeSwap = "swap"
+)
+
+// Transaction structure represents a transfer of coins between accounts
+// which have not been added to the blockchain yet and are waiting for the miner
+// to receive and verify. Once  transactions have been veriried
+// they will be deleted from our system as they will live in the blockchain
+// afterwords.
+type Transaction struct {
+	ChainID          uint16          `bson:"chain_id" json:"chain_id"`                     // Ethereum: The chain id that is listed in the genesis file.
+	NonceBytes       []byte          `bson:"nonce_bytes" json:"nonce_bytes"`               // Ethereum: Unique id for the transaction supplied by the user.
+	NonceString      string          `bson:"-" json:"nonce_string"`                        // Read-only response in string format - will not be saved in database, only returned via API.
+	From             *common.Address `bson:"from" json:"from"`                             // Ethereum: Account sending the transaction. Will be checked against signature.
+	To               *common.Address `bson:"to" json:"to"`                                 // Ethereum: Account receiving the benefit of the transaction.
+	Value            uint64          `bson:"value" json:"value"`                           // Ethereum: Monetary value received from this transaction.
+	Data             []byte          `bson:"data" json:"data"`                             // Ethereum: Extra data related to the transaction.
+	DataString       string          `bson:"-" json:"data_string"`                         // Read-only response in string format - will not be saved in database, only returned via API.
+	Type             string          `bson:"type" json:"type"`                             // ComicCoin: The type of transaction this is, either `coin` or `token`.
+	TokenIDBytes     []byte          `bson:"token_id_bytes" json:"token_id_bytes"`         // ComicCoin: Unique identifier for the Token (if this transaciton is an Token).
+	TokenIDString    string          `bson:"-" json:"token_id_string"`                     // Read-only response in string format - will not be saved in database, only returned via API.
+	TokenMetadataURI string          `bson:"token_metadata_uri" json:"token_metadata_uri"` // ComicCoin: URI pointing to Token metadata file (if this transaciton is an Token).
+	TokenNonceBytes  []byte          `bson:"token_nonce_bytes" json:"token_nonce_bytes"`   // ComicCoin: For every transaction action (mint, transfer, burn, etc), increment token nonce by value of 1.
+	TokenNonceString string          `bson:"-" json:"token_nonce_string"`                  // Read-only response in string format - will not be saved in database, only returned via API.
+	Version          uint8           `bson:"version,omitempty" json:"version,omitempty"`   // ComicCoin: The signing version, see `signature.VersionCanonical`; zero is the legacy JSON signing.
+	Fee              uint64          `bson:"fee,omitempty" json:"fee,omitempty"`           // ComicCoin: Fee paid to the authority on top of the value, zero means the legacy fee is taken out of the value. See `SplitFees`.
+}
+
+const (
+	// TransactionVersionFee is the canonical signing version which also
+	// signs the `Fee` of the transaction.
+	TransactionVersionFee uint8 = 2
+
+	// TransactionVersion is the signing version of the transactions created
+	// by this build.
+	TransactionVersion = TransactionVersionFee
+)
+
+func (tx *Transaction) GetNonce() *big.Int {
+	return new(big.Int).SetBytes(tx.NonceBytes)
+}
+
+func (tx *Transaction) IsNonceZero() bool {
+	return len(tx.GetNonce().Bits()) == 0
+}
+
+func (tx *Transaction) SetNonce(n *big.Int) {
+	tx.NonceBytes = n.Bytes()
+}
+
+func (tx *Transaction) GetTokenID() *big.Int {
+	return new(big.Int).SetBytes(tx.TokenIDBytes)
+}
+
+func (tx *Transaction) IsTokenIDZero() bool {
+	return len(tx.GetTokenID().Bits()) == 0
+}
+
+func (tx *Transaction) SetTTokenID(n *big.Int) {
+	tx.TokenIDBytes = n.Bytes()
+}
+
+func (tx *Transaction) GetTokenNonce() *big.Int {
+	return new(big.Int).SetBytes(tx.TokenNonceBytes)
+}
+
+func (tx *Transaction) IsTokenNonceZero() bool {
+	return len(tx.GetTokenNonce().Bits()) == 0
+}
+
+func (tx *Transaction) SetTokenNonce(n *big.Int) {
+	tx.TokenNonceBytes = n.Bytes()
+}
+
+// SigningVersion returns the signing version of the transaction.
+func (tx Transaction) SigningVersion() uint8 {
+	return tx.Version
+}
+
+// CanonicalFields returns the fields of the transaction in the order they
+// are signed for its signing version, this must match the Authority. The
+// read-only `_string` fields are never signed.
+//
+//	1: [version, chain_id, nonce, from, to, value, data, type, token_id,
+//	    token_metadata_uri, token_nonce]
+//	2: [version, chain_id, nonce, from, to, value, data, type, token_id,
+//	    token_metadata_uri, token_nonce, fee]
+func (tx Transaction) CanonicalFields() ([]any, error) {
+	fields := []any{
+		tx.ChainID,
+		tx.GetNonce().Bytes(),
+		canonicalAddress(tx.From),
+		canonicalAddress(tx.To),
+		tx.Value,
+		tx.Data,
+		tx.Type,
+		tx.GetTokenID().Bytes(),
+		tx.TokenMetadataURI,
+		tx.GetTokenNonce().Bytes(),
+	}
+	switch tx.Version {
+	case signature.VersionCanonical:
+		// The fee is not signed in this version so it must not be set.
+		if tx.Fee != 0 {
+			return nil, fmt.Errorf("transaction fee requires signing version %d", TransactionVersionFee)
+		}
+		return fields, nil
+	case TransactionVersionFee:
+		return append(fields, tx.Fee), nil
+	default:
+		return nil, fmt.Errorf("%w: %d", signature.ErrUnsupportedVersion, tx.Version)
+	}
+}
+
+// canonicalAddress returns the bytes of the address or nil if missing.
+func canonicalAddress(addr *common.Address) []byte {
+	if addr == nil {
+		return nil
+	}
+	return addr.Bytes()
+}
+
+// Sign function signs the  transaction using the user's private key
+// and returns a signed version of that transaction.
+func (tx Transaction) Sign(privateKey *ecdsa.PrivateKey) (SignedTransaction, error) {
+	v, r, s, err := signature.Sign(tx, privateKey)
+	if err != nil {
+		return SignedTransaction{}, err
+	}
+	signedTx := SignedTransaction{
+		Transaction: tx,
+	}
+
+	// Note: MongoDB doesn't support `*big.Int` so we are forced to do this.
+	signedTx.SetBigIntFields(v, r, s)
+
+	return signedTx, nil
+}
+
+// HashWithComicCoinStamp creates a unique hash of the transaction and
+// prepares it for signing by adding a special "stamp".
+func (tx Transaction) HashWithComicCoinStamp() ([]byte, error) {
+	return signature.HashWithComicCoinStamp(tx)
+}
+
+// FromAddress extracts the account address from the signed transaction by
+// recovering the public key from the signature.
+func (stx SignedTransaction) FromAddress() (string, error) {
+	// Note: MongoDB doesn't support `*big.Int` so we are forced to do this.
+	v, r, s := stx.GetBigIntFields()
+
+	return signature.FromAddress(stx.Transaction, v, r, s)
+}
+
+// VerifySignature checks if the signature is valid by ensuring the V value
+// is correct and that the signature follows the proper rules.
+func VerifySignature(v, r, s *big.Int) error {
+	return signature.VerifySignature(v, r, s)
+}
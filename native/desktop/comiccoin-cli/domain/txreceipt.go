@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	TransactionReceiptStatusPending  = "pending"
+	TransactionReceiptStatusIncluded = "included"
+	TransactionReceiptStatusRejected = "rejected"
+)
+
+// TransactionReceipt represents the outcome of a signed transaction which was
+// submitted to the Authority, copied from the Authority's domain.
+type TransactionReceipt struct {
+	Hash                 string          `json:"hash"`
+	MempoolTransactionID string          `json:"mempool_transaction_id"`
+	ChainID              uint16          `json:"chain_id"`
+	From                 *common.Address `json:"from"`
+	NonceBytes           []byte          `json:"nonce_bytes"`
+	NonceString          string          `json:"nonce_string"`
+	Status               string          `json:"status"` // Either `pending`, `included` or `rejected`.
+	RejectionReason      string          `json:"rejection_reason,omitempty"`
+	BlockNumberBytes     []byte          `json:"block_number_bytes,omitempty"`
+	BlockNumberString    string          `json:"block_number_string,omitempty"`
+	BlockHash            string          `json:"block_hash,omitempty"`
+	TransactionIndex     uint64          `json:"transaction_index"` // The position of the transaction inside `BlockData.Trans` (if included).
+	CreatedAt            time.Time       `json:"created_at"`
+	ModifiedAt           time.Time       `json:"modified_at"`
+}
+
+type TransactionReceiptDTORepository interface {
+	GetFromBlockchainAuthorityByHash(ctx context.Context, hash string) (*TransactionReceipt, error)
+}
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+)
+
+const (
+	// TransactionTypeValidator is the transaction type the Authority uses to
+	// add or remove a validator from the validator set.
+	TransactionTypeValidator = "validator"
+
+	ValidatorSetChangeActionAdd    = "add"
+	ValidatorSetChangeActionRemove = "remove"
+
+	// ValidatorFallbackTimeout is how long after the previous block the
+	// scheduled validator has to seal the next block before the turn passes
+	// to the next validator, this must match the Authority.
+	ValidatorFallbackTimeout = 30 * time.Second
+
+	// ValidatorMaxClockDrift is how far past our local time the timestamp of
+	// a block header may be, this must match the Authority. It is shorter
+	// than `ValidatorFallbackTimeout` so a validator cannot take the turn of
+	// the scheduled validator by sealing the block with a timestamp in the
+	// future.
+	ValidatorMaxClockDrift = 5 * time.Second
+)
+
+// ErrValidatorSetChangeInvalid is returned when a `validator` transaction
+// cannot be applied to our local validator set.
+var ErrValidatorSetChangeInvalid = errors.New("validator set change is invalid")
+
+// ValidatorSet holds the validators which are authorised to seal the next
+// block of the blockchain. The set starts with the genesis validator and
+// changes when a block includes a `validator` transaction, the change takes
+// effect from the following block onwards.
+type ValidatorSet struct {
+	ChainID    uint16       `json:"chain_id"`
+	Validators []*Validator `json:"validators"`
+}
+
+// ValidatorSetChange is the payload stored in the `Data` field of a
+// transaction of type `validator`.
+type ValidatorSetChange struct {
+	Action    string     `json:"action"`
+	Validator *Validator `json:"validator"`
+}
+
+type ValidatorSetRepository interface {
+	// GetByChainID returns the validator set or nil if none was saved yet.
+	GetByChainID(ctx context.Context, chainID uint16) (*ValidatorSet, error)
+	Upsert(ctx context.Context, validatorSet *ValidatorSet) error
+
+	OpenTransaction() error
+	CommitTransaction() error
+	DiscardTransaction()
+}
+
+// Serialize serializes the validator set into a byte slice.
+// This method uses the cbor library to marshal the validator set into a byte slice.
+func (b *ValidatorSet) Serialize() ([]byte, error) {
+	dataBytes, err := cbor.Marshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize validator set: %v", err)
+	}
+	return dataBytes, nil
+}
+
+// NewValidatorSetFromDeserialize deserializes a validator set from a byte slice.
+// This method uses the cbor library to unmarshal the byte slice into a validator set.
+func NewValidatorSetFromDeserialize(data []byte) (*ValidatorSet, error) {
+	validatorSet := &ValidatorSet{}
+
+	// Defensive code: If the input data is empty, return a nil deserialization result.
+	if data == nil {
+		return nil, nil
+	}
+
+	if err := cbor.Unmarshal(data, &validatorSet); err != nil {
+		return nil, fmt.Errorf("failed to deserialize validator set: %v", err)
+	}
+	return validatorSet, nil
+}
+
+// ApplyBlockDataToValidatorSet returns the validator set after the `validator`
+// transactions of the block were applied, this is the set which must have
+// sealed the block after it. The callers slice is not modified.
+func ApplyBlockDataToValidatorSet(validators []*Validator, blockData *BlockData) ([]*Validator, error) {
+	result := validators
+	for _, blockTx := range blockData.Trans {
+		if blockTx.Type != TransactionTypeValidator {
+			continue
+		}
+		change, err := newValidatorSetChangeFromData(blockTx.Data)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = applyValidatorSetChange(result, change, false); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// RevertBlockDataFromValidatorSet returns the validator set before the
+// `validator` transactions of the block were applied. The callers slice is
+// not modified.
+func RevertBlockDataFromValidatorSet(validators []*Validator, blockData *BlockData) ([]*Validator, error) {
+	result := validators
+	for i := len(blockData.Trans) - 1; i >= 0; i-- {
+		blockTx := blockData.Trans[i]
+		if blockTx.Type != TransactionTypeValidator {
+			continue
+		}
+		change, err := newValidatorSetChangeFromData(blockTx.Data)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = applyValidatorSetChange(result, change, true); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func newValidatorSetChangeFromData(data []byte) (*ValidatorSetChange, error) {
+	change := &ValidatorSetChange{}
+	if err := json.Unmarshal(data, change); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidatorSetChangeInvalid, err)
+	}
+	if change.Validator == nil || len(change.Validator.PublicKeyBytes) == 0 {
+		return nil, fmt.Errorf("%w: missing validator", ErrValidatorSetChangeInvalid)
+	}
+	return change, nil
+}
+
+// applyValidatorSetChange adds or removes the validator, or does the
+// opposite if `revert` is true.
+func applyValidatorSetChange(validators []*Validator, change *ValidatorSetChange, revert bool) ([]*Validator, error) {
+	add := change.Action == ValidatorSetChangeActionAdd
+	if change.Action != ValidatorSetChangeActionAdd && change.Action != ValidatorSetChangeActionRemove {
+		return nil, fmt.Errorf("%w: unsupported action: %v", ErrValidatorSetChangeInvalid, change.Action)
+	}
+	if revert {
+		add = !add
+	}
+
+	index := -1
+	for i, v := range validators {
+		if bytes.Equal(v.PublicKeyBytes, change.Validator.PublicKeyBytes) {
+			index = i
+			break
+		}
+	}
+
+	result := make([]*Validator, 0, len(validators)+1)
+	if add {
+		if index >= 0 {
+			return nil, fmt.Errorf("%w: validator already exists", ErrValidatorSetChangeInvalid)
+		}
+		result = append(result, validators...)
+		return append(result, change.Validator), nil
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("%w: validator does not exist", ErrValidatorSetChangeInvalid)
+	}
+	if len(validators) == 1 {
+		return nil, fmt.Errorf("%w: cannot remove the last validator", ErrValidatorSetChangeInvalid)
+	}
+	result = append(result, validators[:index]...)
+	return append(result, validators[index+1:]...), nil
+}
+
+// sortedValidators returns a copy of the validators sorted by public key.
+func sortedValidators(validators []*Validator) []*Validator {
+	sorted := make([]*Validator, len(validators))
+	copy(sorted, validators)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].PublicKeyBytes, sorted[j].PublicKeyBytes) < 0
+	})
+	return sorted
+}
+
+// ScheduledValidator returns the validator which must seal the block with
+// the particular block number, this must match the schedule of the Authority.
+func ScheduledValidator(validators []*Validator, blockNumber *big.Int) *Validator {
+	if len(validators) == 0 {
+		return nil
+	}
+	sorted := sortedValidators(validators)
+	index := new(big.Int).Mod(blockNumber, big.NewInt(int64(len(sorted))))
+	return sorted[index.Int64()]
+}
+
+// ScheduledValidatorAt returns the validator which may seal the block with
+// the particular block number and header timestamp, given the timestamp of
+// the previous block, this must match the schedule of the Authority. Every
+// `ValidatorFallbackTimeout` since the previous block skips one validator.
+func ScheduledValidatorAt(validators []*Validator, blockNumber *big.Int, previousTimeStamp, timeStamp uint64) *Validator {
+	skipped := new(big.Int)
+	if timeStamp > previousTimeStamp {
+		skipped.SetUint64((timeStamp - previousTimeStamp) / uint64(ValidatorFallbackTimeout.Milliseconds()))
+	}
+	return ScheduledValidator(validators, skipped.Add(skipped, blockNumber))
+}
+
+// hashValidatorSet returns the hash of the validators sorted by public key,
+// this must match the hash of the Authority.
+func hashValidatorSet(validators []*Validator) (string, error) {
+	dataBytes, err := json.Marshal(sortedValidators(validators))
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize validator set: %v", err)
+	}
+	return signature.Hash(dataBytes), nil
+}
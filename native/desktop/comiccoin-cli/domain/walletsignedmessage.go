@@ -0,0 +1,8 @@
+package domain
+
+// WalletSignedMessage is the value which the wallet signs with the ComicCoin
+// stamp to prove it controls its address, for example to connect the wallet
+// to an IAM account. The JSON must match what the verifier expects.
+type WalletSignedMessage struct {
+	Message string `json:"message"`
+}
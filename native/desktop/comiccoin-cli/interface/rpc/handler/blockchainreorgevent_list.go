@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type BlockchainReorgEventListByChainIDArgs struct {
+	ChainID uint16
+}
+
+type BlockchainReorgEventListByChainIDReply struct {
+	BlockchainReorgEvents []*domain.BlockchainReorgEvent
+}
+
+func (impl *ComicCoinRPCServer) BlockchainReorgEventListByChainID(args *BlockchainReorgEventListByChainIDArgs, reply *BlockchainReorgEventListByChainIDReply) error {
+
+	events, err := impl.listBlockchainReorgEventsService.Execute(context.Background(), args.ChainID)
+	if err != nil {
+		return err
+	}
+
+	// Fill reply pointer to send the data back
+	*reply = BlockchainReorgEventListByChainIDReply{
+		BlockchainReorgEvents: events,
+	}
+	return nil
+}
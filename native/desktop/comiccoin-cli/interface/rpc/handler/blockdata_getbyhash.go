@@ -3,7 +3,7 @@ package handler
 import (
 	"context"
 
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 )
 
 type BlockDataGetByHashArgs struct {
@@ -3,8 +3,9 @@ package handler
 import (
 	"context"
 
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 )
 
 type ListBlockTransactionsByAddressArgs struct {
@@ -4,6 +4,7 @@ import (
 	"log/slog"
 
 	service_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/account"
+	service_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchainreorgevent"
 	service_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockdata"
 	service_blocktx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blocktx"
 	service_coin "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/coin"
@@ -28,6 +29,11 @@ type ComicCoinRPCServer struct {
 	tokenListByOwnerService               service_tok.TokenListByOwnerService
 	exportWalletService                   service_wallet.ExportWalletService
 	importWalletService                   service_wallet.ImportWalletService
+	getTransactionReceiptService          service_blocktx.GetTransactionReceiptService
+	listBlockchainReorgEventsService      service_blockchainreorgevent.ListBlockchainReorgEventsService
+	signMessageService                    service_wallet.SignMessageService
+	tokenSwapOfferService                 service_tok.TokenSwapOfferService
+	tokenSwapAcceptService                service_tok.TokenSwapAcceptService
 }
 
 func NewComicCoinRPCServer(
@@ -46,6 +52,11 @@ func NewComicCoinRPCServer(
 	s12 service_tok.TokenListByOwnerService,
 	s13 service_wallet.ExportWalletService,
 	s14 service_wallet.ImportWalletService,
+	s15 service_blocktx.GetTransactionReceiptService,
+	s16 service_blockchainreorgevent.ListBlockchainReorgEventsService,
+	s17 service_wallet.SignMessageService,
+	s18 service_tok.TokenSwapOfferService,
+	s19 service_tok.TokenSwapAcceptService,
 ) *ComicCoinRPCServer {
 
 	// Create a new RPC server instance.
@@ -65,6 +76,11 @@ func NewComicCoinRPCServer(
 		tokenListByOwnerService:               s12,
 		exportWalletService:                   s13,
 		importWalletService:                   s14,
+		getTransactionReceiptService:          s15,
+		listBlockchainReorgEventsService:      s16,
+		signMessageService:                    s17,
+		tokenSwapOfferService:                 s18,
+		tokenSwapAcceptService:                s19,
 	}
 
 	return port
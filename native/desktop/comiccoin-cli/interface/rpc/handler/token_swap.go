@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type TokenSwapOfferArgs struct {
+	ChainID               uint16
+	SellerAccountAddress  *common.Address
+	AccountWalletPassword string
+	Buyer                 *common.Address
+	TokenID               *big.Int
+	Price                 uint64
+}
+
+type TokenSwapOfferReply struct {
+	Offer *domain.SignedTransaction
+}
+
+func (impl *ComicCoinRPCServer) TokenSwapOffer(args *TokenSwapOfferArgs, reply *TokenSwapOfferReply) error {
+	pass, secureErr := sstring.NewSecureString(args.AccountWalletPassword)
+	if secureErr != nil {
+		return secureErr
+	}
+	offer, err := impl.tokenSwapOfferService.Execute(
+		context.Background(),
+		args.ChainID,
+		args.SellerAccountAddress,
+		pass,
+		args.Buyer,
+		args.TokenID,
+		args.Price,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Fill reply pointer to send the data back
+	*reply = TokenSwapOfferReply{
+		Offer: offer,
+	}
+	return nil
+}
+
+type TokenSwapAcceptArgs struct {
+	ChainID               uint16
+	BuyerAccountAddress   *common.Address
+	AccountWalletPassword string
+	Offer                 *domain.SignedTransaction
+}
+
+type TokenSwapAcceptReply struct {
+}
+
+func (impl *ComicCoinRPCServer) TokenSwapAccept(args *TokenSwapAcceptArgs, reply *TokenSwapAcceptReply) error {
+	pass, secureErr := sstring.NewSecureString(args.AccountWalletPassword)
+	if secureErr != nil {
+		return secureErr
+	}
+	err := impl.tokenSwapAcceptService.Execute(
+		context.Background(),
+		args.ChainID,
+		args.BuyerAccountAddress,
+		pass,
+		args.Offer,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Fill reply pointer to send the data back
+	*reply = TokenSwapAcceptReply{}
+	return nil
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type TransactionReceiptGetByHashArgs struct {
+	Hash string
+}
+
+type TransactionReceiptGetByHashReply struct {
+	TransactionReceipt *domain.TransactionReceipt
+}
+
+func (impl *ComicCoinRPCServer) TransactionReceiptGetByHash(args *TransactionReceiptGetByHashArgs, reply *TransactionReceiptGetByHashReply) error {
+
+	receipt, err := impl.getTransactionReceiptService.Execute(context.Background(), args.Hash)
+	if err != nil {
+		return err
+	}
+
+	// Fill reply pointer to send the data back
+	*reply = TransactionReceiptGetByHashReply{
+		TransactionReceipt: receipt,
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
+)
+
+type SignMessageArgs struct {
+	AccountAddress        *common.Address
+	AccountWalletPassword string
+	Message               string
+}
+
+type SignMessageReply struct {
+	Signature string
+}
+
+func (impl *ComicCoinRPCServer) SignMessage(args *SignMessageArgs, reply *SignMessageReply) error {
+	pass, secureErr := sstring.NewSecureString(args.AccountWalletPassword)
+	if secureErr != nil {
+		return secureErr
+	}
+	sig, err := impl.signMessageService.Execute(context.Background(), args.AccountAddress, pass, args.Message)
+	if err != nil {
+		return err
+	}
+
+	// Fill reply pointer to send the data back
+	*reply = SignMessageReply{
+		Signature: sig,
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	rpchandler "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/interface/rpc/handler"
 	service_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/account"
+	service_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchainreorgevent"
 	service_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockdata"
 	service_blocktx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blocktx"
 	service_coin "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/coin"
@@ -88,9 +89,14 @@ func NewRPCServer(
 	s12 service_tok.TokenListByOwnerService,
 	s13 service_wallet.ExportWalletService,
 	s14 service_wallet.ImportWalletService,
+	s15 service_blocktx.GetTransactionReceiptService,
+	s16 service_blockchainreorgevent.ListBlockchainReorgEventsService,
+	s17 service_wallet.SignMessageService,
+	s18 service_tok.TokenSwapOfferService,
+	s19 service_tok.TokenSwapAcceptService,
 ) RPCServer {
 	// Create a new RPC server
-	myServer := rpchandler.NewComicCoinRPCServer(logger, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11, s12, s13, s14)
+	myServer := rpchandler.NewComicCoinRPCServer(logger, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11, s12, s13, s14, s15, s16, s17, s18, s19)
 
 	// Create a new RPC server instance.
 	port := &RPCServerImpl{
@@ -3,14 +3,13 @@ package repo
 import (
 	"context"
 	"log/slog"
-	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/blockchain/signature"
 	disk "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 )
 
 type AccountRepo struct {
@@ -116,44 +115,7 @@ func (r *AccountRepo) HashStateByChainID(ctx context.Context, chainID uint16) (s
 	if err != nil {
 		return "", err
 	}
-
-	// Variable used to only store the accounts which have a balance greater
-	// then the value of zero.
-	accountsWithBalance := make([]*domain.Account, 0)
-
-	// Iterate through all the accounts and only save the accounts with balance.
-	for _, account := range accounts {
-		if account.Balance > 0 && account.ChainID == chainID {
-			accountsWithBalance = append(accountsWithBalance, account)
-		}
-	}
-
-	// Sort the accounts by address
-	sort.Sort(byAccount(accountsWithBalance))
-
-	// Serialize the accounts to JSON
-	accountsBytes := make([]byte, 0)
-	for _, account := range accountsWithBalance {
-		// DEVELOPERS NOTE:
-		// In Go, the order of struct fields is determined by the order in which
-		// they are defined in the struct. However, this order is not guaranteed
-		// to be the same across different nodes or even different runs of the
-		// same program.
-		//
-		// To fix this issue, you can use a deterministic serialization
-		// algorithm, such as JSON or CBOR, to serialize the Account struct
-		// before hashing it. This will ensure that the fields are always
-		// serialized in the same order, regardless of the node or run.
-		accountBytes, err := account.Serialize()
-		if err != nil {
-			return "", err
-		}
-		accountsBytes = append(accountsBytes, accountBytes...)
-	}
-
-	// Hash the deterministic serialized accounts
-	res := signature.Hash(accountsBytes)
-	return res, nil
+	return ccdomain.HashAccountsStateTrie(accounts, chainID)
 }
 
 func (r *AccountRepo) OpenTransaction() error {
@@ -167,24 +129,3 @@ func (r *AccountRepo) CommitTransaction() error {
 func (r *AccountRepo) DiscardTransaction() {
 	r.dbClient.DiscardTransaction()
 }
-
-// =============================================================================
-
-// byAccount provides sorting support by the account id value.
-type byAccount []*domain.Account
-
-// Len returns the number of transactions in the list.
-func (ba byAccount) Len() int {
-	return len(ba)
-}
-
-// Less helps to sort the list by account id in ascending order to keep the
-// accounts in the right order of processing.
-func (ba byAccount) Less(i, j int) bool {
-	return strings.ToLower(ba[i].Address.String()) < strings.ToLower(ba[j].Address.String())
-}
-
-// Swap moves accounts in the order of the account id value.
-func (ba byAccount) Swap(i, j int) {
-	ba[i], ba[j] = ba[j], ba[i]
-}
@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	disk "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage"
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type BlockchainReorgEventRepo struct {
+	logger   *slog.Logger
+	dbClient disk.Storage
+}
+
+func NewBlockchainReorgEventRepo(logger *slog.Logger, db disk.Storage) domain.BlockchainReorgEventRepository {
+	return &BlockchainReorgEventRepo{logger, db}
+}
+
+func (r *BlockchainReorgEventRepo) Upsert(ctx context.Context, e *domain.BlockchainReorgEvent) error {
+	bBytes, err := e.Serialize()
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%v_%v", e.ChainID, e.CreatedAt.UnixNano())
+	if err := r.dbClient.Set(key, bBytes); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *BlockchainReorgEventRepo) ListByChainID(ctx context.Context, chainID uint16) ([]*domain.BlockchainReorgEvent, error) {
+	res := make([]*domain.BlockchainReorgEvent, 0)
+	err := r.dbClient.Iterate(func(key, value []byte) error {
+		e, err := domain.NewBlockchainReorgEventFromDeserialize(value)
+		if err != nil {
+			r.logger.Error("failed to deserialize",
+				slog.String("key", string(key)),
+				slog.String("value", string(value)),
+				slog.Any("error", err))
+			return err
+		}
+
+		if e != nil && e.ChainID == chainID {
+			res = append(res, e)
+		}
+
+		// Return nil to indicate success
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *BlockchainReorgEventRepo) OpenTransaction() error {
+	return r.dbClient.OpenTransaction()
+}
+
+func (r *BlockchainReorgEventRepo) CommitTransaction() error {
+	return r.dbClient.CommitTransaction()
+}
+
+func (r *BlockchainReorgEventRepo) DiscardTransaction() {
+	r.dbClient.DiscardTransaction()
+}
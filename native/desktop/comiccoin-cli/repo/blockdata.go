@@ -12,7 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	disk "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage"
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 )
 
 type BlockDataRepo struct {
@@ -117,7 +118,7 @@ func (r *BlockDataRepo) ListBlockTransactionsByAddress(ctx context.Context, addr
 		}
 
 		for _, tx := range blockdata.Trans {
-			if strings.ToLower(tx.To.String()) == strings.ToLower(address.String()) || strings.ToLower(tx.From.String()) == strings.ToLower(address.String()) {
+			if strings.ToLower(tx.To.String()) == strings.ToLower(address.String()) || strings.ToLower(tx.From.String()) == strings.ToLower(address.String()) || (tx.RoyaltyRecipient != nil && strings.ToLower(tx.RoyaltyRecipient.String()) == strings.ToLower(address.String())) {
 				res = append(res, &tx)
 				counter++
 			}
@@ -146,7 +147,7 @@ func (r *BlockDataRepo) ListWithLimitForBlockTransactionsByAddress(ctx context.C
 		}
 
 		for _, tx := range blockdata.Trans {
-			if strings.ToLower(tx.To.String()) == strings.ToLower(address.String()) || strings.ToLower(tx.From.String()) == strings.ToLower(address.String()) {
+			if strings.ToLower(tx.To.String()) == strings.ToLower(address.String()) || strings.ToLower(tx.From.String()) == strings.ToLower(address.String()) || (tx.RoyaltyRecipient != nil && strings.ToLower(tx.RoyaltyRecipient.String()) == strings.ToLower(address.String())) {
 				res = append(res, &tx)
 				counter++
 			}
@@ -0,0 +1,94 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+const (
+	listBlockDataInRangeURL string = "/authority/api/v1/blockdata?from=%s&to=%s"
+)
+
+type BlockDataRangeDTOConfigurationProvider interface {
+	GetAuthorityAddress() string
+}
+
+type blockDataRangeDTOConfigurationProviderImpl struct {
+	authorityAddress string
+}
+
+func NewBlockDataRangeDTOConfigurationProvider(authorityAddress string) BlockDataRangeDTOConfigurationProvider {
+	return &blockDataRangeDTOConfigurationProviderImpl{
+		authorityAddress: authorityAddress,
+	}
+}
+
+func (impl *blockDataRangeDTOConfigurationProviderImpl) GetAuthorityAddress() string {
+	return impl.authorityAddress
+}
+
+type BlockDataRangeDTORepo struct {
+	config BlockDataRangeDTOConfigurationProvider
+	logger *slog.Logger
+}
+
+func NewBlockDataRangeDTORepository(
+	config BlockDataRangeDTOConfigurationProvider,
+	logger *slog.Logger,
+) domain.BlockDataRangeDTORepository {
+	return &BlockDataRangeDTORepo{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (repo *BlockDataRangeDTORepo) ListFromBlockchainAuthorityByHeaderNumberRange(ctx context.Context, from, to *big.Int) ([]*domain.BlockDataDTO, error) {
+	modifiedURL := fmt.Sprintf(listBlockDataInRangeURL, from.String(), to.String())
+	httpEndpoint := fmt.Sprintf("%s%s", repo.config.GetAuthorityAddress(), modifiedURL)
+
+	repo.logger.Debug("Fetching block data range from the Authority...",
+		slog.Any("http_endpoint", httpEndpoint))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", httpEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/x-ndjson")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to block data range endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, string(body))
+	}
+
+	// The Authority streams one block per line so decode them one at a time
+	// instead of waiting for the entire response.
+	res := make([]*domain.BlockDataDTO, 0)
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		blockDataDTO := &domain.BlockDataDTO{}
+		if err := decoder.Decode(blockDataDTO); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			repo.logger.Error("Failed decoding block data",
+				slog.Any("error", err))
+			return nil, err
+		}
+		res = append(res, blockDataDTO)
+	}
+	return res, nil
+}
@@ -347,7 +347,7 @@ func (r *ComicCoincRPCClientRepo) TokenBurn(
 func (r *ComicCoincRPCClientRepo) ListBlockTransactionsByAddress(
 	ctx context.Context,
 	accountAddress *common.Address,
-) ([]*auth_domain.BlockTransaction, error) {
+) ([]*domain.BlockTransaction, error) {
 	// Define our request / response here by copy and pasting from the server codebase.
 
 	type ListBlockTransactionsByAddressArgs struct {
@@ -355,7 +355,7 @@ func (r *ComicCoincRPCClientRepo) ListBlockTransactionsByAddress(
 	}
 
 	type ListBlockTransactionsByAddressReply struct {
-		BlockTransactions []*auth_domain.BlockTransaction
+		BlockTransactions []*domain.BlockTransaction
 	}
 
 	// Construct our request / response.
@@ -376,14 +376,14 @@ func (r *ComicCoincRPCClientRepo) ListBlockTransactionsByAddress(
 func (r *ComicCoincRPCClientRepo) GetBlockDataByHash(
 	ctx context.Context,
 	hash string,
-) (*auth_domain.BlockData, error) {
+) (*domain.BlockData, error) {
 	// Define our request / response here by copy and pasting from the server codebase.
 	type BlockDataGetByHashArgs struct {
 		Hash string
 	}
 
 	type BlockDataGetByHashReply struct {
-		BlockData *auth_domain.BlockData
+		BlockData *domain.BlockData
 	}
 
 	// Construct our request / response.
@@ -454,6 +454,114 @@ func (r *ComicCoincRPCClientRepo) ExportWallet(ctx context.Context, accountAddre
 	return nil
 }
 
+func (r *ComicCoincRPCClientRepo) SignMessage(ctx context.Context, accountAddress *common.Address, accountWalletPassword *sstring.SecureString, message string) (string, error) {
+	// Define our request / response here by copy and pasting from the server codebase.
+	type SignMessageArgs struct {
+		AccountAddress        *common.Address
+		AccountWalletPassword string
+		Message               string
+	}
+
+	type SignMessageReply struct {
+		Signature string
+	}
+
+	// Construct our request / response.
+	args := SignMessageArgs{
+		AccountAddress:        accountAddress,
+		AccountWalletPassword: accountWalletPassword.String(),
+		Message:               message,
+	}
+	var reply SignMessageReply
+
+	// Execute the remote procedure call.
+	callError := r.rpcClient.Call("ComicCoinRPCServer.SignMessage", args, &reply)
+	if callError != nil {
+		return "", callError
+	}
+
+	return reply.Signature, nil
+}
+
+func (r *ComicCoincRPCClientRepo) TokenSwapOffer(
+	ctx context.Context,
+	chainID uint16,
+	sellerAccountAddress *common.Address,
+	accountWalletPassword *sstring.SecureString,
+	buyer *common.Address,
+	tokenID *big.Int,
+	price uint64,
+) (*domain.SignedTransaction, error) {
+	// Define our request / response here by copy and pasting from the server codebase.
+	type TokenSwapOfferArgs struct {
+		ChainID               uint16
+		SellerAccountAddress  *common.Address
+		AccountWalletPassword string
+		Buyer                 *common.Address
+		TokenID               *big.Int
+		Price                 uint64
+	}
+
+	type TokenSwapOfferReply struct {
+		Offer *domain.SignedTransaction
+	}
+
+	// Construct our request / response.
+	args := TokenSwapOfferArgs{
+		ChainID:               chainID,
+		SellerAccountAddress:  sellerAccountAddress,
+		AccountWalletPassword: accountWalletPassword.String(),
+		Buyer:                 buyer,
+		TokenID:               tokenID,
+		Price:                 price,
+	}
+	var reply TokenSwapOfferReply
+
+	// Execute the remote procedure call.
+	callError := r.rpcClient.Call("ComicCoinRPCServer.TokenSwapOffer", args, &reply)
+	if callError != nil {
+		return nil, callError
+	}
+
+	return reply.Offer, nil
+}
+
+func (r *ComicCoincRPCClientRepo) TokenSwapAccept(
+	ctx context.Context,
+	chainID uint16,
+	buyerAccountAddress *common.Address,
+	accountWalletPassword *sstring.SecureString,
+	offer *domain.SignedTransaction,
+) error {
+	// Define our request / response here by copy and pasting from the server codebase.
+	type TokenSwapAcceptArgs struct {
+		ChainID               uint16
+		BuyerAccountAddress   *common.Address
+		AccountWalletPassword string
+		Offer                 *domain.SignedTransaction
+	}
+
+	type TokenSwapAcceptReply struct {
+	}
+
+	// Construct our request / response.
+	args := TokenSwapAcceptArgs{
+		ChainID:               chainID,
+		BuyerAccountAddress:   buyerAccountAddress,
+		AccountWalletPassword: accountWalletPassword.String(),
+		Offer:                 offer,
+	}
+	var reply TokenSwapAcceptReply
+
+	// Execute the remote procedure call.
+	callError := r.rpcClient.Call("ComicCoinRPCServer.TokenSwapAccept", args, &reply)
+	if callError != nil {
+		return callError
+	}
+
+	return nil
+}
+
 func (r *ComicCoincRPCClientRepo) ImportWallet(ctx context.Context, filepath string) error {
 	// Define our request / response here by copy and pasting from the server codebase.
 	type ImportWalletArgs struct {
@@ -476,3 +584,53 @@ func (r *ComicCoincRPCClientRepo) ImportWallet(ctx context.Context, filepath str
 
 	return nil
 }
+
+func (r *ComicCoincRPCClientRepo) GetTransactionReceipt(ctx context.Context, hash string) (*domain.TransactionReceipt, error) {
+	// Define our request / response here by copy and pasting from the server codebase.
+	type TransactionReceiptGetByHashArgs struct {
+		Hash string
+	}
+
+	type TransactionReceiptGetByHashReply struct {
+		TransactionReceipt *domain.TransactionReceipt
+	}
+
+	// Construct our request / response.
+	args := TransactionReceiptGetByHashArgs{
+		Hash: hash,
+	}
+	var reply TransactionReceiptGetByHashReply
+
+	// Execute the remote procedure call.
+	callError := r.rpcClient.Call("ComicCoinRPCServer.TransactionReceiptGetByHash", args, &reply)
+	if callError != nil {
+		return nil, callError
+	}
+
+	return reply.TransactionReceipt, nil
+}
+
+func (r *ComicCoincRPCClientRepo) ListBlockchainReorgEvents(ctx context.Context, chainID uint16) ([]*domain.BlockchainReorgEvent, error) {
+	// Define our request / response here by copy and pasting from the server codebase.
+	type BlockchainReorgEventListByChainIDArgs struct {
+		ChainID uint16
+	}
+
+	type BlockchainReorgEventListByChainIDReply struct {
+		BlockchainReorgEvents []*domain.BlockchainReorgEvent
+	}
+
+	// Construct our request / response.
+	args := BlockchainReorgEventListByChainIDArgs{
+		ChainID: chainID,
+	}
+	var reply BlockchainReorgEventListByChainIDReply
+
+	// Execute the remote procedure call.
+	callError := r.rpcClient.Call("ComicCoinRPCServer.BlockchainReorgEventListByChainID", args, &reply)
+	if callError != nil {
+		return nil, callError
+	}
+
+	return reply.BlockchainReorgEvents, nil
+}
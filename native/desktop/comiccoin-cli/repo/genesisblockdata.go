@@ -6,7 +6,8 @@ import (
 	"log/slog"
 
 	disk "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage"
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 )
 
 type GenesisBlockDataRepo struct {
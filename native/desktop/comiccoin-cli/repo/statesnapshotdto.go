@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+const (
+	getLatestStateSnapshotURL string = "/authority/api/v1/state-snapshots/latest"
+)
+
+type StateSnapshotDTOConfigurationProvider interface {
+	GetAuthorityAddress() string
+}
+
+type stateSnapshotDTOConfigurationProviderImpl struct {
+	authorityAddress string
+}
+
+func NewStateSnapshotDTOConfigurationProvider(authorityAddress string) StateSnapshotDTOConfigurationProvider {
+	return &stateSnapshotDTOConfigurationProviderImpl{
+		authorityAddress: authorityAddress,
+	}
+}
+
+func (impl *stateSnapshotDTOConfigurationProviderImpl) GetAuthorityAddress() string {
+	return impl.authorityAddress
+}
+
+type StateSnapshotDTORepo struct {
+	config StateSnapshotDTOConfigurationProvider
+	logger *slog.Logger
+}
+
+func NewStateSnapshotDTORepository(
+	config StateSnapshotDTOConfigurationProvider,
+	logger *slog.Logger,
+) domain.StateSnapshotDTORepository {
+	return &StateSnapshotDTORepo{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (repo *StateSnapshotDTORepo) GetLatestFromBlockchainAuthority(ctx context.Context) (*domain.StateSnapshot, error) {
+	httpEndpoint := fmt.Sprintf("%s%s", repo.config.GetAuthorityAddress(), getLatestStateSnapshotURL)
+
+	repo.logger.Debug("Fetching latest state snapshot from the Authority...",
+		slog.Any("http_endpoint", httpEndpoint))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", httpEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to state snapshot endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The Authority has not exported a snapshot yet.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, string(body))
+	}
+
+	snapshot := &domain.StateSnapshot{}
+	if err := json.NewDecoder(resp.Body).Decode(snapshot); err != nil {
+		repo.logger.Error("Failed decoding state snapshot",
+			slog.Any("error", err))
+		return nil, err
+	}
+	return snapshot, nil
+}
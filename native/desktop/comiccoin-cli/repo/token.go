@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"log/slog"
 	"math/big"
-	"sort"
 	"strings"
 
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/blockchain/signature"
 	disk "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
 	"github.com/ethereum/go-ethereum/common"
+
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 )
 
 type TokenRepo struct {
@@ -120,7 +120,7 @@ func (r *TokenRepo) CountByOwner(ctx context.Context, owner *common.Address) (in
 }
 
 func (r *TokenRepo) DeleteByID(ctx context.Context, id *big.Int) error {
-	err := r.dbClient.Delete(fmt.Sprintf("%v", id))
+	err := r.dbClient.Delete(fmt.Sprintf("%v", id.Bytes()))
 	if err != nil {
 		return err
 	}
@@ -132,36 +132,7 @@ func (r *TokenRepo) HashStateByChainID(ctx context.Context, chainID uint16) (str
 	if err != nil {
 		return "", err
 	}
-
-	// Sort and hash our tokens.
-	sort.Sort(byToken(tokens))
-
-	// Serialize the accounts to JSON
-	tokensBytes := make([]byte, 0)
-	for _, tok := range tokens {
-		// DEVELOPERS NOTE:
-		// In Go, the order of struct fields is determined by the order in which
-		// they are defined in the struct. However, this order is not guaranteed
-		// to be the same across different nodes or even different runs of the
-		// same program.
-		//
-		// To fix this issue, you can use a deterministic serialization
-		// algorithm, such as JSON or CBOR, to serialize the Account struct
-		// before hashing it. This will ensure that the fields are always
-		// serialized in the same order, regardless of the node or run.
-		tokBytes, err := tok.Serialize()
-		if err != nil {
-			return "", err
-		}
-
-		// For defensive purposes, only append by chain ID.
-		if tok.ChainID == chainID {
-			tokensBytes = append(tokensBytes, tokBytes...)
-		}
-	}
-
-	// Hash the deterministic serialized tokens.
-	return signature.Hash(tokensBytes), nil
+	return ccdomain.HashTokensStateTrie(tokens, chainID)
 }
 
 func (r *TokenRepo) OpenTransaction() error {
@@ -175,24 +146,3 @@ func (r *TokenRepo) CommitTransaction() error {
 func (r *TokenRepo) DiscardTransaction() {
 	r.dbClient.DiscardTransaction()
 }
-
-// =============================================================================
-
-// byToken provides sorting support by the token id value.
-type byToken []*domain.Token
-
-// Len returns the number of transactions in the list.
-func (ba byToken) Len() int {
-	return len(ba)
-}
-
-// Less helps to sort the list by token id in ascending order to keep the
-// tokens in the right order of processing.
-func (ba byToken) Less(i, j int) bool {
-	return ba[i].GetID().Cmp(ba[j].GetID()) < 0
-}
-
-// Swap moves tokens in the order of the token id value.
-func (ba byToken) Swap(i, j int) {
-	ba[i], ba[j] = ba[j], ba[i]
-}
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+const (
+	getTokenProvenanceURL string = "/authority/api/v1/tokens/${TOKEN_ID}/provenance"
+)
+
+type TokenProvenanceDTOConfigurationProvider interface {
+	GetAuthorityAddress() string
+}
+
+type tokenProvenanceDTOConfigurationProviderImpl struct {
+	authorityAddress string
+}
+
+func NewTokenProvenanceDTOConfigurationProvider(authorityAddress string) TokenProvenanceDTOConfigurationProvider {
+	return &tokenProvenanceDTOConfigurationProviderImpl{
+		authorityAddress: authorityAddress,
+	}
+}
+
+func (impl *tokenProvenanceDTOConfigurationProviderImpl) GetAuthorityAddress() string {
+	return impl.authorityAddress
+}
+
+type TokenProvenanceDTORepo struct {
+	config TokenProvenanceDTOConfigurationProvider
+	logger *slog.Logger
+}
+
+func NewTokenProvenanceDTORepository(
+	config TokenProvenanceDTOConfigurationProvider,
+	logger *slog.Logger,
+) domain.TokenProvenanceDTORepository {
+	return &TokenProvenanceDTORepo{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (repo *TokenProvenanceDTORepo) GetFromBlockchainAuthorityByTokenID(ctx context.Context, tokenID *big.Int) (*domain.TokenProvenance, error) {
+	modifiedURL := strings.ReplaceAll(getTokenProvenanceURL, "${TOKEN_ID}", tokenID.String())
+	httpEndpoint := fmt.Sprintf("%s%s", repo.config.GetAuthorityAddress(), modifiedURL)
+
+	repo.logger.Debug("Fetching token provenance from the Authority...",
+		slog.Any("http_endpoint", httpEndpoint))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", httpEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to provenance endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, string(body))
+	}
+
+	provenance := &domain.TokenProvenance{}
+	if err := json.NewDecoder(resp.Body).Decode(provenance); err != nil {
+		repo.logger.Error("Failed decoding token provenance",
+			slog.Any("error", err))
+		return nil, err
+	}
+	return provenance, nil
+}
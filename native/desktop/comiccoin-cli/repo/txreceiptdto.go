@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+const (
+	getTransactionReceiptURL string = "/authority/api/v1/transactions/${HASH}/receipt"
+)
+
+type TransactionReceiptDTOConfigurationProvider interface {
+	GetAuthorityAddress() string
+}
+
+type transactionReceiptDTOConfigurationProviderImpl struct {
+	authorityAddress string
+}
+
+func NewTransactionReceiptDTOConfigurationProvider(authorityAddress string) TransactionReceiptDTOConfigurationProvider {
+	return &transactionReceiptDTOConfigurationProviderImpl{
+		authorityAddress: authorityAddress,
+	}
+}
+
+func (impl *transactionReceiptDTOConfigurationProviderImpl) GetAuthorityAddress() string {
+	return impl.authorityAddress
+}
+
+type TransactionReceiptDTORepo struct {
+	config TransactionReceiptDTOConfigurationProvider
+	logger *slog.Logger
+}
+
+func NewTransactionReceiptDTORepository(
+	config TransactionReceiptDTOConfigurationProvider,
+	logger *slog.Logger,
+) domain.TransactionReceiptDTORepository {
+	return &TransactionReceiptDTORepo{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (repo *TransactionReceiptDTORepo) GetFromBlockchainAuthorityByHash(ctx context.Context, hash string) (*domain.TransactionReceipt, error) {
+	modifiedURL := strings.ReplaceAll(getTransactionReceiptURL, "${HASH}", hash)
+	httpEndpoint := fmt.Sprintf("%s%s", repo.config.GetAuthorityAddress(), modifiedURL)
+
+	repo.logger.Debug("Fetching transaction receipt from the Authority...",
+		slog.Any("http_endpoint", httpEndpoint))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", httpEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to receipt endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The Authority returns not found until it has received the transaction.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, string(body))
+	}
+
+	receipt := &domain.TransactionReceipt{}
+	if err := json.NewDecoder(resp.Body).Decode(receipt); err != nil {
+		repo.logger.Error("Failed decoding transaction receipt",
+			slog.Any("error", err))
+		return nil, err
+	}
+	return receipt, nil
+}
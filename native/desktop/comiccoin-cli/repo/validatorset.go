@@ -0,0 +1,58 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	disk "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage"
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type ValidatorSetRepo struct {
+	logger   *slog.Logger
+	dbClient disk.Storage
+}
+
+func NewValidatorSetRepo(logger *slog.Logger, db disk.Storage) domain.ValidatorSetRepository {
+	return &ValidatorSetRepo{logger, db}
+}
+
+func (r *ValidatorSetRepo) Upsert(ctx context.Context, validatorSet *domain.ValidatorSet) error {
+	bBytes, err := validatorSet.Serialize()
+	if err != nil {
+		return err
+	}
+	if err := r.dbClient.Set(fmt.Sprintf("%v", validatorSet.ChainID), bBytes); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *ValidatorSetRepo) GetByChainID(ctx context.Context, chainID uint16) (*domain.ValidatorSet, error) {
+	bBytes, err := r.dbClient.Get(fmt.Sprintf("%v", chainID))
+	if err != nil {
+		return nil, err
+	}
+	b, err := domain.NewValidatorSetFromDeserialize(bBytes)
+	if err != nil {
+		r.logger.Error("failed to deserialize",
+			slog.Any("chainID", chainID),
+			slog.String("bin", string(bBytes)),
+			slog.Any("error", err))
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *ValidatorSetRepo) OpenTransaction() error {
+	return r.dbClient.OpenTransaction()
+}
+
+func (r *ValidatorSetRepo) CommitTransaction() error {
+	return r.dbClient.CommitTransaction()
+}
+
+func (r *ValidatorSetRepo) DiscardTransaction() {
+	r.dbClient.DiscardTransaction()
+}
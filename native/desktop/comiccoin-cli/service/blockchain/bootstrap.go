@@ -0,0 +1,332 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
+	uc_blockchainstate "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainstate"
+	uc_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdata"
+	uc_blockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdatadto"
+	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdatadto"
+	uc_statesnapshot "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/statesnapshot"
+	uc_storagetransaction "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/storagetransaction"
+	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
+	uc_validatorset "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/validatorset"
+)
+
+// BlockchainBootstrapFromStateSnapshotService fast-syncs an empty local
+// blockchain by downloading the latest state snapshot exported by the
+// Authority instead of replaying every block since the genesis block.
+// Afterwards `BlockchainSyncWithBlockchainAuthorityService` only needs to
+// sync the blocks which came after the snapshot.
+//
+// The service opens its own storage transaction so it must not be called
+// while the caller has a storage transaction open.
+type BlockchainBootstrapFromStateSnapshotService interface {
+	// Execute bootstraps our local blockchain from the latest state
+	// snapshot. Returns false if nothing was done because our local
+	// blockchain already exists or the Authority has no snapshot yet.
+	Execute(ctx context.Context, chainID uint16) (bool, error)
+}
+
+type blockchainBootstrapFromStateSnapshotServiceImpl struct {
+	logger                                                  *slog.Logger
+	getGenesisBlockDataUseCase                              uc_genesisblockdata.GetGenesisBlockDataUseCase
+	upsertGenesisBlockDataUseCase                           uc_genesisblockdata.UpsertGenesisBlockDataUseCase
+	getGenesisBlockDataDTOFromBlockchainAuthorityUseCase    uc_genesisblockdatadto.GetGenesisBlockDataDTOFromBlockchainAuthorityUseCase
+	getBlockchainStateUseCase                               uc_blockchainstate.GetBlockchainStateUseCase
+	upsertBlockchainStateUseCase                            uc_blockchainstate.UpsertBlockchainStateUseCase
+	upsertBlockDataUseCase                                  uc_blockdata.UpsertBlockDataUseCase
+	getBlockDataDTOFromBlockchainAuthorityUseCase           uc_blockdatadto.GetBlockDataDTOFromBlockchainAuthorityUseCase
+	upsertAccountUseCase                                    uc_account.UpsertAccountUseCase
+	getAccountsHashStateUseCase                             uc_account.GetAccountsHashStateUseCase
+	upsertTokenIfPreviousTokenNonceGTEUseCase               uc_tok.UpsertTokenIfPreviousTokenNonceGTEUseCase
+	getLatestStateSnapshotDTOFromBlockchainAuthorityUseCase uc_statesnapshot.GetLatestStateSnapshotDTOFromBlockchainAuthorityUseCase
+	upsertValidatorSetUseCase                               uc_validatorset.UpsertValidatorSetUseCase
+	storageTransactionOpenUseCase                           uc_storagetransaction.StorageTransactionOpenUseCase
+	storageTransactionCommitUseCase                         uc_storagetransaction.StorageTransactionCommitUseCase
+	storageTransactionDiscardUseCase                        uc_storagetransaction.StorageTransactionDiscardUseCase
+}
+
+func NewBlockchainBootstrapFromStateSnapshotService(
+	logger *slog.Logger,
+	uc1 uc_genesisblockdata.GetGenesisBlockDataUseCase,
+	uc2 uc_genesisblockdata.UpsertGenesisBlockDataUseCase,
+	uc3 uc_genesisblockdatadto.GetGenesisBlockDataDTOFromBlockchainAuthorityUseCase,
+	uc4 uc_blockchainstate.GetBlockchainStateUseCase,
+	uc5 uc_blockchainstate.UpsertBlockchainStateUseCase,
+	uc6 uc_blockdata.UpsertBlockDataUseCase,
+	uc7 uc_blockdatadto.GetBlockDataDTOFromBlockchainAuthorityUseCase,
+	uc8 uc_account.UpsertAccountUseCase,
+	uc9 uc_account.GetAccountsHashStateUseCase,
+	uc10 uc_tok.UpsertTokenIfPreviousTokenNonceGTEUseCase,
+	uc11 uc_statesnapshot.GetLatestStateSnapshotDTOFromBlockchainAuthorityUseCase,
+	uc12 uc_validatorset.UpsertValidatorSetUseCase,
+	uc13 uc_storagetransaction.StorageTransactionOpenUseCase,
+	uc14 uc_storagetransaction.StorageTransactionCommitUseCase,
+	uc15 uc_storagetransaction.StorageTransactionDiscardUseCase,
+) BlockchainBootstrapFromStateSnapshotService {
+	return &blockchainBootstrapFromStateSnapshotServiceImpl{logger, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8, uc9, uc10, uc11, uc12, uc13, uc14, uc15}
+}
+
+func (s *blockchainBootstrapFromStateSnapshotServiceImpl) Execute(ctx context.Context, chainID uint16) (bool, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chainID"] = "missing value"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Validation failed",
+			slog.Any("error", e))
+		return false, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// We only bootstrap an empty local blockchain, once we have a local
+	// blockchain we keep it up-to-date by syncing the blocks.
+	//
+
+	localBlockchainState, err := s.getBlockchainStateUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed getting local blockchain state",
+			slog.Any("chain_id", chainID),
+			slog.Any("error", err))
+		return false, err
+	}
+	if localBlockchainState != nil {
+		s.logger.Debug("Local blockchain already exists, skipping bootstrap from state snapshot",
+			slog.Any("chain_id", chainID))
+		return false, nil
+	}
+
+	//
+	// STEP 3:
+	// Download the latest state snapshot and the block it was taken at.
+	//
+
+	snapshot, err := s.getLatestStateSnapshotDTOFromBlockchainAuthorityUseCase.Execute(ctx)
+	if err != nil {
+		s.logger.Error("Failed getting latest state snapshot",
+			slog.Any("chain_id", chainID),
+			slog.Any("error", err))
+		return false, err
+	}
+	if snapshot == nil {
+		s.logger.Warn("Authority has no state snapshot, falling back to full sync",
+			slog.Any("chain_id", chainID))
+		return false, nil
+	}
+	if snapshot.ChainID != chainID {
+		return false, fmt.Errorf("%w: snapshot is for chain %v, exp %v", ccdomain.ErrStateSnapshotInvalid, snapshot.ChainID, chainID)
+	}
+
+	blockDataDTO, err := s.getBlockDataDTOFromBlockchainAuthorityUseCase.ExecuteByHash(ctx, snapshot.BlockHash)
+	if err != nil {
+		s.logger.Error("Failed getting state snapshot block data",
+			slog.Any("hash", snapshot.BlockHash),
+			slog.Any("error", err))
+		return false, err
+	}
+	if blockDataDTO == nil {
+		return false, fmt.Errorf("Block data does not exist for hash: %v", snapshot.BlockHash)
+	}
+	blockData := ccdomain.BlockDataDTOToBlockData(blockDataDTO)
+
+	//
+	// STEP 4:
+	// Verify the snapshot against the block header using the genesis
+	// validator, which we trust on first use. The state roots are recomputed
+	// from the snapshot in memory so nothing is saved unless they match.
+	//
+
+	genesis, isGenesisDownloaded, err := s.getOrDownloadGenesis(ctx, chainID)
+	if err != nil {
+		return false, err
+	}
+	if !ccdomain.IsStateSnapshotSignedByGenesisValidator(snapshot, blockData, genesis.Validator) {
+		// DEVELOPERS NOTE:
+		// Only the genesis validator can vouch for the validator set of the
+		// snapshot, see `ValidateStateSnapshot`. The snapshot of a block
+		// sealed by any other validator is not an attack by itself so we
+		// replay the blocks instead.
+		s.logger.Warn("State snapshot was not signed by the genesis validator, falling back to full sync",
+			slog.Any("hash", snapshot.BlockHash),
+			slog.Any("chain_id", chainID))
+		return false, nil
+	}
+	if err := ccdomain.ValidateStateSnapshot(snapshot, blockData, genesis.Validator); err != nil {
+		s.logger.Error("Failed validating state snapshot",
+			slog.Any("hash", snapshot.BlockHash),
+			slog.Any("error", err))
+		return false, err
+	}
+
+	//
+	// STEP 5:
+	// Save the snapshot to our local blockchain in one storage transaction,
+	// everything we write is discarded if anything fails so we never keep
+	// half of a snapshot without a local blockchain state.
+	//
+
+	if err := s.storageTransactionOpenUseCase.Execute(); err != nil {
+		s.storageTransactionDiscardUseCase.Execute()
+		s.logger.Error("Failed opening storage transaction",
+			slog.Any("error", err))
+		return false, err
+	}
+	if err := s.saveStateSnapshot(ctx, snapshot, blockData, genesis, isGenesisDownloaded); err != nil {
+		s.storageTransactionDiscardUseCase.Execute()
+		return false, err
+	}
+	if err := s.storageTransactionCommitUseCase.Execute(); err != nil {
+		s.storageTransactionDiscardUseCase.Execute()
+		s.logger.Error("Failed committing storage transaction",
+			slog.Any("error", err))
+		return false, err
+	}
+
+	s.logger.Info("Local blockchain bootstrapped from state snapshot",
+		slog.Any("chain_id", chainID),
+		slog.Any("hash", blockData.Hash),
+		slog.Any("header_number", blockData.Header.GetNumber().String()),
+		slog.Int("accounts", len(snapshot.Accounts)),
+		slog.Int("tokens", len(snapshot.Tokens)),
+		slog.Int("validators", len(snapshot.Validators)))
+
+	return true, nil
+}
+
+// saveStateSnapshot saves the genesis block, accounts, tokens, validator set
+// and block of the state snapshot and then points our local blockchain state
+// at the snapshot block so the sync continues from there.
+func (s *blockchainBootstrapFromStateSnapshotServiceImpl) saveStateSnapshot(ctx context.Context, snapshot *ccdomain.StateSnapshot, blockData *ccdomain.BlockData, genesis *ccdomain.GenesisBlockData, isGenesisDownloaded bool) error {
+	if isGenesisDownloaded {
+		if err := s.upsertGenesis(ctx, genesis); err != nil {
+			return err
+		}
+	}
+	for _, account := range snapshot.Accounts {
+		if err := s.upsertAccountUseCase.Execute(ctx, account.Address, account.Balance, account.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting account from state snapshot",
+				slog.Any("address", account.Address),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	for _, tok := range snapshot.Tokens {
+		if err := s.upsertTokenIfPreviousTokenNonceGTEUseCase.Execute(ctx, tok.GetID(), tok.Owner, tok.MetadataURI, tok.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting token from state snapshot",
+				slog.Any("token_id", tok.GetID()),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	validatorSet := &ccdomain.ValidatorSet{
+		ChainID:    snapshot.ChainID,
+		Validators: snapshot.Validators,
+	}
+	if err := s.upsertValidatorSetUseCase.Execute(ctx, validatorSet); err != nil {
+		s.logger.Error("Failed upserting validator set from state snapshot",
+			slog.Any("error", err))
+		return err
+	}
+	if err := s.upsertBlockDataUseCase.Execute(ctx, blockData.Hash, blockData.Header, blockData.HeaderSignatureBytes, blockData.Trans, blockData.Validator); err != nil {
+		s.logger.Error("Failed upserting state snapshot block data",
+			slog.Any("hash", blockData.Hash),
+			slog.Any("error", err))
+		return err
+	}
+
+	// Defensive code: The snapshot was already checked against the block in
+	// `ValidateStateSnapshot`, this catches accounts left over in our local
+	// database which are not part of the snapshot.
+	if err := validateLocalAccountsStateRoot(ctx, s.getAccountsHashStateUseCase, blockData, nil); err != nil {
+		s.logger.Error("Failed validating local accounts against state snapshot block",
+			slog.Any("hash", blockData.Hash),
+			slog.Any("error", err))
+		return err
+	}
+
+	localBlockchainState := &domain.BlockchainState{
+		ChainID:                blockData.Header.ChainID,
+		LatestBlockNumberBytes: blockData.Header.NumberBytes,
+		LatestHash:             blockData.Hash,
+		LatestTokenIDBytes:     blockData.Header.LatestTokenIDBytes,
+		TransactionFee:         blockData.Header.TransactionFee,
+		AccountHashState:       blockData.Header.StateRoot,
+		TokenHashState:         blockData.Header.TokensRoot,
+	}
+	if err := s.upsertBlockchainStateUseCase.Execute(ctx, localBlockchainState); err != nil {
+		s.logger.Error("Failed upserting local blockchain state from state snapshot",
+			slog.Any("chain_id", snapshot.ChainID),
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// getOrDownloadGenesis returns our local genesis block or downloads it from
+// the Authority, returning true if it was downloaded. The downloaded genesis
+// block is not saved until the state snapshot was verified so a full sync can
+// still apply the genesis block if we fall back to it.
+func (s *blockchainBootstrapFromStateSnapshotServiceImpl) getOrDownloadGenesis(ctx context.Context, chainID uint16) (*ccdomain.GenesisBlockData, bool, error) {
+	genesis, err := s.getGenesisBlockDataUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed getting genesis block locally",
+			slog.Any("chain_id", chainID),
+			slog.Any("error", err))
+		return nil, false, err
+	}
+	if genesis != nil {
+		return genesis, false, nil
+	}
+
+	genesisDTO, err := s.getGenesisBlockDataDTOFromBlockchainAuthorityUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed getting genesis block remotely",
+			slog.Any("chain_id", chainID),
+			slog.Any("error", err))
+		return nil, false, err
+	}
+	if genesisDTO == nil {
+		return nil, false, fmt.Errorf("Genesis block data does not exist for `chain_id`: %v", chainID)
+	}
+	genesis = ccdomain.GenesisBlockDataDTOToGenesisBlockData(genesisDTO)
+	if err := ccdomain.ValidateGenesisBlockData(genesis); err != nil {
+		s.logger.Error("Failed validating genesis block",
+			slog.Any("chain_id", chainID),
+			slog.Any("error", err))
+		return nil, false, err
+	}
+	return genesis, true, nil
+}
+
+// upsertGenesis saves the genesis block. Unlike a full sync we do not apply
+// the genesis block transactions since the state snapshot already includes
+// their effects.
+func (s *blockchainBootstrapFromStateSnapshotServiceImpl) upsertGenesis(ctx context.Context, genesis *ccdomain.GenesisBlockData) error {
+	if err := s.upsertGenesisBlockDataUseCase.Execute(ctx, genesis.Hash, genesis.Header, genesis.HeaderSignatureBytes, genesis.Trans, genesis.Validator); err != nil {
+		s.logger.Error("Failed upserting genesis (pure)",
+			slog.Any("chain_id", genesis.Header.ChainID),
+			slog.Any("error", err))
+		return err
+	}
+	if err := s.upsertBlockDataUseCase.Execute(ctx, genesis.Hash, genesis.Header, genesis.HeaderSignatureBytes, genesis.Trans, genesis.Validator); err != nil {
+		s.logger.Error("Failed upserting genesis (block data)",
+			slog.Any("chain_id", genesis.Header.ChainID),
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+	"github.com/ethereum/go-ethereum/common"
+
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+// stateSnapshotVectorPath is the state snapshot exported by the Authority,
+// see `readStateSnapshotVector` of the domain tests.
+const stateSnapshotVectorPath = "../../../../../cloud/comiccoin/internal/authority/domain/testdata/state_snapshot_vector.json"
+
+type stateSnapshotVector struct {
+	GenesisValidator *ccdomain.Validator     `json:"genesis_validator"`
+	BlockData        *ccdomain.BlockData     `json:"block_data"`
+	Snapshot         *ccdomain.StateSnapshot `json:"snapshot"`
+}
+
+func readStateSnapshotVector(t *testing.T) *stateSnapshotVector {
+	t.Helper()
+	data, err := os.ReadFile(stateSnapshotVectorPath)
+	if err != nil {
+		t.Fatalf("failed reading state snapshot vector: %v", err)
+	}
+	vector := &stateSnapshotVector{}
+	if err := json.Unmarshal(data, vector); err != nil {
+		t.Fatalf("failed decoding state snapshot vector: %v", err)
+	}
+	return vector
+}
+
+// fakeBootstrapStore implements every use case of the bootstrap service and
+// keeps the writes of the open storage transaction apart from the committed
+// writes, like the LevelDB storage does.
+type fakeBootstrapStore struct {
+	vector    *stateSnapshotVector
+	localRoot string
+	failOn    string
+
+	pending   []string
+	committed []string
+	isOpen    bool
+	isOpened  bool
+}
+
+func (f *fakeBootstrapStore) write(name string) error {
+	if name == f.failOn {
+		return errors.New("failed writing " + name)
+	}
+	if !f.isOpen {
+		f.committed = append(f.committed, name)
+		return nil
+	}
+	f.pending = append(f.pending, name)
+	return nil
+}
+
+type fakeGetGenesisBlockDataUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeGetGenesisBlockDataUseCase) Execute(ctx context.Context, chainID uint16) (*ccdomain.GenesisBlockData, error) {
+	return &ccdomain.GenesisBlockData{Validator: uc.vector.GenesisValidator}, nil
+}
+
+type fakeUpsertGenesisBlockDataUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeUpsertGenesisBlockDataUseCase) Execute(ctx context.Context, hash string, header *ccdomain.BlockHeader, headerSignature []byte, trans []ccdomain.BlockTransaction, validator *ccdomain.Validator) error {
+	return uc.write("genesis")
+}
+
+type fakeGetGenesisBlockDataDTOUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeGetGenesisBlockDataDTOUseCase) Execute(ctx context.Context, chainID uint16) (*ccdomain.GenesisBlockDataDTO, error) {
+	return nil, errors.New("genesis block must not be downloaded")
+}
+
+type fakeGetBlockchainStateUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeGetBlockchainStateUseCase) Execute(ctx context.Context, chainID uint16) (*domain.BlockchainState, error) {
+	return nil, nil
+}
+
+type fakeBootstrapUpsertBlockchainStateUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeBootstrapUpsertBlockchainStateUseCase) Execute(ctx context.Context, bcs *domain.BlockchainState) error {
+	return uc.write("blockchain_state")
+}
+
+type fakeBootstrapUpsertBlockDataUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeBootstrapUpsertBlockDataUseCase) Execute(ctx context.Context, hash string, header *ccdomain.BlockHeader, headerSignature []byte, trans []ccdomain.BlockTransaction, validator *ccdomain.Validator) error {
+	return uc.write("block_data")
+}
+
+type fakeGetBlockDataDTOUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeGetBlockDataDTOUseCase) ExecuteByHash(ctx context.Context, hash string) (*ccdomain.BlockDataDTO, error) {
+	if hash != uc.vector.BlockData.Hash {
+		return nil, nil
+	}
+	return ccdomain.BlockDataToBlockDataDTO(uc.vector.BlockData), nil
+}
+
+func (uc fakeGetBlockDataDTOUseCase) ExecuteByHeaderNumber(ctx context.Context, headerNumber *big.Int) (*ccdomain.BlockDataDTO, error) {
+	return nil, nil
+}
+
+type fakeBootstrapUpsertAccountUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeBootstrapUpsertAccountUseCase) Execute(ctx context.Context, address *common.Address, balance uint64, nonce *big.Int) error {
+	return uc.write("account")
+}
+
+type fakeGetAccountsHashStateUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeGetAccountsHashStateUseCase) Execute(ctx context.Context, chainID uint16) (string, error) {
+	return uc.localRoot, nil
+}
+
+func (uc fakeGetAccountsHashStateUseCase) ExecuteWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	return uc.localRoot, nil
+}
+
+func (uc fakeGetAccountsHashStateUseCase) ExecuteLegacyWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	return "", errors.New("legacy state root must not be used")
+}
+
+type fakeBootstrapUpsertTokenUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeBootstrapUpsertTokenUseCase) Execute(ctx context.Context, id *big.Int, owner *common.Address, metadataURI string, nonce *big.Int) error {
+	return uc.write("token")
+}
+
+type fakeGetLatestStateSnapshotUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeGetLatestStateSnapshotUseCase) Execute(ctx context.Context) (*ccdomain.StateSnapshot, error) {
+	return uc.vector.Snapshot, nil
+}
+
+type fakeBootstrapUpsertValidatorSetUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeBootstrapUpsertValidatorSetUseCase) Execute(ctx context.Context, validatorSet *ccdomain.ValidatorSet) error {
+	return uc.write("validator_set")
+}
+
+type fakeStorageTransactionOpenUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeStorageTransactionOpenUseCase) Execute() error {
+	uc.isOpen, uc.isOpened = true, true
+	return nil
+}
+
+type fakeStorageTransactionCommitUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeStorageTransactionCommitUseCase) Execute() error {
+	uc.committed = append(uc.committed, uc.pending...)
+	uc.pending, uc.isOpen = nil, false
+	return nil
+}
+
+type fakeStorageTransactionDiscardUseCase struct{ *fakeBootstrapStore }
+
+func (uc fakeStorageTransactionDiscardUseCase) Execute() {
+	uc.pending, uc.isOpen = nil, false
+}
+
+func newTestBootstrapService(f *fakeBootstrapStore) BlockchainBootstrapFromStateSnapshotService {
+	return NewBlockchainBootstrapFromStateSnapshotService(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		fakeGetGenesisBlockDataUseCase{f},
+		fakeUpsertGenesisBlockDataUseCase{f},
+		fakeGetGenesisBlockDataDTOUseCase{f},
+		fakeGetBlockchainStateUseCase{f},
+		fakeBootstrapUpsertBlockchainStateUseCase{f},
+		fakeBootstrapUpsertBlockDataUseCase{f},
+		fakeGetBlockDataDTOUseCase{f},
+		fakeBootstrapUpsertAccountUseCase{f},
+		fakeGetAccountsHashStateUseCase{f},
+		fakeBootstrapUpsertTokenUseCase{f},
+		fakeGetLatestStateSnapshotUseCase{f},
+		fakeBootstrapUpsertValidatorSetUseCase{f},
+		fakeStorageTransactionOpenUseCase{f},
+		fakeStorageTransactionCommitUseCase{f},
+		fakeStorageTransactionDiscardUseCase{f},
+	)
+}
+
+func TestBootstrapFromStateSnapshot(t *testing.T) {
+	vector := readStateSnapshotVector(t)
+	f := &fakeBootstrapStore{vector: vector, localRoot: vector.BlockData.Header.StateRoot}
+
+	ok, err := newTestBootstrapService(f).Execute(context.Background(), vector.Snapshot.ChainID)
+	if err != nil || !ok {
+		t.Fatalf("expected bootstrap to succeed, got %v, %v", ok, err)
+	}
+	if f.isOpen {
+		t.Fatal("expected the storage transaction to be closed")
+	}
+	last := f.committed[len(f.committed)-1]
+	if last != "blockchain_state" {
+		t.Fatalf("expected the blockchain state to be saved last, got %v", last)
+	}
+	if len(f.committed) != len(vector.Snapshot.Accounts)+len(vector.Snapshot.Tokens)+3 {
+		t.Fatalf("unexpected writes: %v", f.committed)
+	}
+}
+
+func TestBootstrapFromStateSnapshotWritesNothingOnFailure(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(f *fakeBootstrapStore)
+		isSafe bool // Whether the failure was caught before opening the storage transaction.
+	}{
+		{"tampered account", func(f *fakeBootstrapStore) {
+			f.vector.Snapshot.Accounts[0].Balance++
+		}, true},
+		{"tampered token", func(f *fakeBootstrapStore) {
+			f.vector.Snapshot.Tokens[0].MetadataURI = "https://example.com/tampered"
+		}, true},
+		{"tampered block header", func(f *fakeBootstrapStore) {
+			f.vector.BlockData.Header.StateRoot = "0x0000000000000000000000000000000000000000000000000000000000000001"
+		}, true},
+		{"local accounts do not match", func(f *fakeBootstrapStore) {
+			f.localRoot = "0x0000000000000000000000000000000000000000000000000000000000000001"
+		}, false},
+		{"failed upserting validator set", func(f *fakeBootstrapStore) {
+			f.failOn = "validator_set"
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vector := readStateSnapshotVector(t)
+			f := &fakeBootstrapStore{vector: vector, localRoot: vector.BlockData.Header.StateRoot}
+			tt.setup(f)
+
+			if _, err := newTestBootstrapService(f).Execute(context.Background(), vector.Snapshot.ChainID); err == nil {
+				t.Fatal("expected bootstrap to fail")
+			}
+			if len(f.committed) != 0 {
+				t.Fatalf("expected nothing to be saved, got %v", f.committed)
+			}
+			if f.isOpen {
+				t.Fatal("expected the storage transaction to be discarded")
+			}
+			if tt.isSafe && f.isOpened {
+				t.Fatal("expected the snapshot to be rejected before opening the storage transaction")
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+// reorganizeIfForkedFromGlobalBlockchainNetwork checks our latest local block
+// is still part of the global blockchain network. If it is not then the
+// Authority rewrote history so we find the common ancestor, roll back our
+// local blocks, accounts and tokens to that point and record a reorg event.
+// Afterwards the local blockchain state points to the common ancestor so the
+// sync will re-apply the new branch.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) reorganizeIfForkedFromGlobalBlockchainNetwork(ctx context.Context, localBlockchainState, globalBlockchainState *domain.BlockchainState) error {
+	//
+	// STEP 1:
+	// Walk back from our latest block until we find a block which is also
+	// in the global blockchain network, this is the common ancestor.
+	//
+
+	latestBlockData, err := s.getBlockDataUseCase.ExecuteByHash(ctx, localBlockchainState.LatestHash)
+	if err != nil {
+		s.logger.Error("Failed getting latest block data from local blockchain",
+			slog.Any("error", err))
+		return err
+	}
+	if latestBlockData == nil {
+		return fmt.Errorf("Latest block data does not exist for hash: %v", localBlockchainState.LatestHash)
+	}
+
+	globalLatestNumber := globalBlockchainState.GetLatestBlockNumber()
+	rolledBack := make([]*ccdomain.BlockData, 0)
+	ancestor := latestBlockData
+	for {
+		number := ancestor.Header.GetNumber()
+		if number.Cmp(globalLatestNumber) <= 0 {
+			globalBlockDataDTO, err := s.getBlockDataDTOFromBlockchainAuthorityUseCase.ExecuteByHeaderNumber(ctx, number)
+			if err != nil {
+				s.logger.Error("Failed getting block data from global blockchain network",
+					slog.Any("header_number", number.String()),
+					slog.Any("error", err))
+				return err
+			}
+			if globalBlockDataDTO != nil && globalBlockDataDTO.Hash == ancestor.Hash {
+				break
+			}
+		}
+		if number.Sign() == 0 {
+			return fmt.Errorf("%w: genesis block does not match the global blockchain network", ccdomain.ErrBlockForked)
+		}
+
+		rolledBack = append(rolledBack, ancestor)
+		parent, err := s.getBlockDataUseCase.ExecuteByHash(ctx, ancestor.Header.PrevBlockHash)
+		if err != nil {
+			s.logger.Error("Failed getting parent block data from local blockchain",
+				slog.Any("error", err))
+			return err
+		}
+		if parent == nil {
+			return fmt.Errorf("Parent block data does not exist for hash: %v", ancestor.Header.PrevBlockHash)
+		}
+		ancestor = parent
+	}
+	if len(rolledBack) == 0 {
+		return nil
+	}
+
+	s.logger.Warn("Local blockchain forked from global blockchain network, rolling back to common ancestor",
+		slog.Any("chain_id", localBlockchainState.ChainID),
+		slog.String("common_ancestor_hash", ancestor.Hash),
+		slog.String("common_ancestor_number", ancestor.Header.GetNumber().String()),
+		slog.Int("rolled_back_blocks", len(rolledBack)))
+
+	//
+	// STEP 2:
+	// Roll back the accounts and validator set and delete the blocks, newest
+	// first.
+	//
+
+	validatorSet, err := s.getValidatorSetUseCase.Execute(ctx, localBlockchainState.ChainID)
+	if err != nil {
+		s.logger.Error("Failed getting local validator set",
+			slog.Any("error", err))
+		return err
+	}
+
+	rolledBackHashes := make([]string, 0, len(rolledBack))
+	rolledBackTokenIDs := make(map[string]*big.Int)
+	for _, blockData := range rolledBack {
+		// Defensive code: If we never saved a validator set then the
+		// validator set was never changed, so there is nothing to revert.
+		if validatorSet != nil {
+			validators, err := ccdomain.RevertBlockDataFromValidatorSet(validatorSet.Validators, blockData)
+			if err != nil {
+				s.logger.Error("Failed reverting validator set changes",
+					slog.Any("hash", blockData.Hash),
+					slog.Any("error", err))
+				return err
+			}
+			validatorSet.Validators = validators
+		}
+		for i := len(blockData.Trans) - 1; i >= 0; i-- {
+			blockTx := blockData.Trans[i]
+			if err := s.revertAccountForTransaction(ctx, blockData, &blockTx); err != nil {
+				s.logger.Error("Failed reverting transaction",
+					slog.Any("hash", blockData.Hash),
+					slog.Any("error", err))
+				return err
+			}
+			if blockTx.MovesToken() {
+				rolledBackTokenIDs[blockTx.GetTokenID().String()] = blockTx.GetTokenID()
+			}
+		}
+		if err := s.deleteBlockDataUseCase.Execute(ctx, blockData.Hash); err != nil {
+			s.logger.Error("Failed deleting block data",
+				slog.Any("hash", blockData.Hash),
+				slog.Any("error", err))
+			return err
+		}
+		rolledBackHashes = append(rolledBackHashes, blockData.Hash)
+	}
+
+	if validatorSet != nil {
+		if err := s.upsertValidatorSetUseCase.Execute(ctx, validatorSet); err != nil {
+			s.logger.Error("Failed upserting local validator set",
+				slog.Any("error", err))
+			return err
+		}
+	}
+
+	//
+	// STEP 3:
+	// Roll back the tokens by restoring them to their most recent
+	// transaction at or before the common ancestor.
+	//
+
+	if err := s.restoreTokensAtBlock(ctx, ancestor, rolledBackTokenIDs); err != nil {
+		s.logger.Error("Failed restoring tokens",
+			slog.Any("error", err))
+		return err
+	}
+
+	//
+	// STEP 4:
+	// Verify our accounts match the common ancestor and then point our local
+	// blockchain state to it.
+	//
+
+	if !ancestor.Header.IsNumberZero() {
+		if err := validateLocalAccountsStateRoot(ctx, s.getAccountsHashStateUseCase, ancestor, nil); err != nil {
+			s.logger.Error("Failed rolling back local accounts to common ancestor",
+				slog.Any("error", err))
+			return err
+		}
+	}
+
+	previousLatestHash := localBlockchainState.LatestHash
+	previousLatestNumberBytes := localBlockchainState.LatestBlockNumberBytes
+	localBlockchainState.LatestBlockNumberBytes = ancestor.Header.NumberBytes
+	localBlockchainState.LatestHash = ancestor.Hash
+	localBlockchainState.LatestTokenIDBytes = ancestor.Header.LatestTokenIDBytes
+	localBlockchainState.TransactionFee = ancestor.Header.TransactionFee
+	localBlockchainState.AccountHashState = ancestor.Header.StateRoot
+	localBlockchainState.TokenHashState = ancestor.Header.TokensRoot
+	if err := s.upsertBlockchainStateUseCase.Execute(ctx, localBlockchainState); err != nil {
+		s.logger.Error("Failed upserting local blockchain state",
+			slog.Any("error", err))
+		return err
+	}
+
+	//
+	// STEP 5:
+	// Record the reorganization so it can be surfaced to the user.
+	//
+
+	event := &ccdomain.BlockchainReorgEvent{
+		ChainID:                   localBlockchainState.ChainID,
+		CommonAncestorHash:        ancestor.Hash,
+		CommonAncestorNumberBytes: ancestor.Header.NumberBytes,
+		PreviousLatestHash:        previousLatestHash,
+		PreviousLatestNumberBytes: previousLatestNumberBytes,
+		GlobalLatestHash:          globalBlockchainState.LatestHash,
+		RolledBackBlockHashes:     rolledBackHashes,
+		CreatedAt:                 time.Now().UTC(),
+	}
+	if err := s.createBlockchainReorgEventUseCase.Execute(ctx, event); err != nil {
+		s.logger.Error("Failed creating blockchain reorg event",
+			slog.Any("error", err))
+		return err
+	}
+
+	s.logger.Warn("Local blockchain rolled back to common ancestor",
+		slog.Any("chain_id", event.ChainID),
+		slog.String("common_ancestor_hash", event.CommonAncestorHash),
+		slog.String("previous_latest_hash", event.PreviousLatestHash),
+		slog.String("global_latest_hash", event.GlobalLatestHash),
+		slog.Any("rolled_back_block_hashes", event.RolledBackBlockHashes))
+	return nil
+}
+
+// revertAccountForTransaction undoes the changes `processAccountForTransaction`
+// applied to the accounts for the transaction.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) revertAccountForTransaction(ctx context.Context, blockData *ccdomain.BlockData, blockTx *ccdomain.BlockTransaction) error {
+	// Variables hold the coins taken from the sender, the value the receiver
+	// was given and the fee the Authority collected for this transaction.
+	switch blockTx.Type {
+	case ccdomain.TransactionTypeCoin, ccdomain.TransactionTypeToken, ccdomain.TransactionTypeSwap:
+	case ccdomain.TransactionTypeValidator:
+		// Only the nonce of the sender was changed.
+	default:
+		return nil
+	}
+	debit, credit, fee := blockTx.SplitFees(blockData.Header.TransactionFee)
+	received, royalty := blockTx.SplitCredit(credit)
+
+	//
+	// STEP 1:
+	// Refund the sender and restore their nonce, for a swap the buyer is
+	// refunded instead.
+	//
+
+	if blockTx.From != nil {
+		acc, _ := s.getAccountUseCase.Execute(ctx, blockTx.From)
+		if acc == nil {
+			return fmt.Errorf("The `From` account does not exist in our database for hash: %v", blockTx.From.String())
+		}
+		if blockTx.Type != ccdomain.TransactionTypeSwap {
+			acc.Balance += debit
+		}
+		accNonce := acc.GetNonce()
+		if accNonce.Sign() > 0 {
+			accNonce.Sub(accNonce, big.NewInt(1))
+		}
+		if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, accNonce); err != nil {
+			return err
+		}
+	}
+	if blockTx.Type == ccdomain.TransactionTypeSwap && blockTx.To != nil {
+		acc, _ := s.getAccountUseCase.Execute(ctx, blockTx.To)
+		if acc == nil {
+			return fmt.Errorf("The `To` account does not exist in our database for hash: %v", blockTx.To.String())
+		}
+		acc.Balance += debit
+		if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			return err
+		}
+	}
+
+	//
+	// STEP 2:
+	// Take back the value deposited into the receiver, for a swap this is
+	// the seller.
+	//
+
+	isLegacyCoin := !blockData.Header.HasStrictAccountNonces() && blockTx.Type == ccdomain.TransactionTypeCoin
+	if payee := blockTx.Payee(); payee != nil && (received > 0 || isLegacyCoin) {
+		acc, _ := s.getAccountUseCase.Execute(ctx, payee)
+		if acc == nil {
+			return fmt.Errorf("The receiving account does not exist in our database for hash: %v", payee.String())
+		}
+		if acc.Balance < received {
+			return fmt.Errorf("%w: receiving account %v balance is less than the value to roll back", ccdomain.ErrBlockTampered, payee.String())
+		}
+		acc.Balance -= received
+
+		// Legacy blocks also incremented the nonce of an existing receiver
+		// of coins, a receiver created by the transaction still has a zero
+		// nonce.
+		accNonce := acc.GetNonce()
+		if isLegacyCoin && accNonce.Sign() > 0 {
+			accNonce.Sub(accNonce, big.NewInt(1))
+		}
+		if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, accNonce); err != nil {
+			return err
+		}
+	}
+
+	//
+	// STEP 3:
+	// Take back the royalty paid to the creator of the token.
+	//
+
+	if royalty > 0 {
+		acc, _ := s.getAccountUseCase.Execute(ctx, blockTx.RoyaltyRecipient)
+		if acc == nil {
+			return fmt.Errorf("The royalty recipient account does not exist in our database for hash: %v", blockTx.RoyaltyRecipient.String())
+		}
+		if acc.Balance < royalty {
+			return fmt.Errorf("%w: royalty recipient %v balance is less than the royalty to roll back", ccdomain.ErrBlockTampered, blockTx.RoyaltyRecipient.String())
+		}
+		acc.Balance -= royalty
+		if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			return err
+		}
+	}
+
+	//
+	// STEP 4:
+	// Take back the transaction fee collected by the Authority.
+	//
+
+	proofOfAuthorityAccount, err := s.getAccountUseCase.Execute(ctx, &blockData.Header.Beneficiary)
+	if err != nil {
+		return err
+	}
+	if proofOfAuthorityAccount == nil {
+		return fmt.Errorf("Proof of authority account does not exist")
+	}
+	if proofOfAuthorityAccount.Balance < fee {
+		return fmt.Errorf("%w: proof of authority account balance is less than the fee to roll back", ccdomain.ErrBlockTampered)
+	}
+	proofOfAuthorityAccount.Balance -= fee
+
+	// Legacy blocks also incremented the nonce of the proof of authority
+	// account, see `BlockHeader.HasStrictAccountNonces`.
+	pofNonce := proofOfAuthorityAccount.GetNonce()
+	if !blockData.Header.HasStrictAccountNonces() && blockTx.Type != ccdomain.TransactionTypeValidator && pofNonce.Sign() > 0 {
+		pofNonce.Sub(pofNonce, big.NewInt(1))
+	}
+	return s.upsertAccountUseCase.Execute(ctx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, pofNonce)
+}
+
+// restoreTokensAtBlock deletes the tokens and then restores each token from
+// its most recent transaction in our local blockchain ending at `blockData`.
+// Tokens which were minted after `blockData` will remain deleted.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) restoreTokensAtBlock(ctx context.Context, blockData *ccdomain.BlockData, tokenIDs map[string]*big.Int) error {
+	for _, tokenID := range tokenIDs {
+		if err := s.deleteTokenUseCase.Execute(ctx, tokenID); err != nil {
+			return err
+		}
+	}
+
+	for len(tokenIDs) > 0 {
+		for i := len(blockData.Trans) - 1; i >= 0; i-- {
+			blockTx := blockData.Trans[i]
+			if !blockTx.MovesToken() {
+				continue
+			}
+			key := blockTx.GetTokenID().String()
+			if _, ok := tokenIDs[key]; !ok {
+				continue
+			}
+			err := s.upsertTokenIfPreviousTokenNonceGTEUseCase.Execute(
+				ctx,
+				blockTx.GetTokenID(),
+				blockTx.To,
+				blockTx.TokenMetadataURI,
+				blockTx.GetTokenNonce())
+			if err != nil {
+				return err
+			}
+			delete(tokenIDs, key)
+		}
+		if blockData.Header.IsNumberZero() {
+			break
+		}
+
+		parent, err := s.getBlockDataUseCase.ExecuteByHash(ctx, blockData.Header.PrevBlockHash)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			return fmt.Errorf("Parent block data does not exist for hash: %v", blockData.Header.PrevBlockHash)
+		}
+		blockData = parent
+	}
+	return nil
+}
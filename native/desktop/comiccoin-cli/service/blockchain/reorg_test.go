@@ -0,0 +1,313 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+var (
+	reorgSender      = common.HexToAddress("0x2000000000000000000000000000000000000001")
+	reorgReceiver    = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	reorgBeneficiary = common.HexToAddress("0x2000000000000000000000000000000000000003")
+)
+
+type fakeGetBlockDataUseCase struct{ *fakeLocalChain }
+
+func (uc fakeGetBlockDataUseCase) ExecuteByHash(ctx context.Context, hash string) (*ccdomain.BlockData, error) {
+	return uc.blockDatas[hash], nil
+}
+
+type fakeDeleteBlockDataUseCase struct{ *fakeLocalChain }
+
+func (uc fakeDeleteBlockDataUseCase) Execute(ctx context.Context, hash string) error {
+	delete(uc.blockDatas, hash)
+	return nil
+}
+
+type fakeDeleteTokenUseCase struct{ *fakeLocalChain }
+
+func (uc fakeDeleteTokenUseCase) Execute(ctx context.Context, tokenID *big.Int) error {
+	delete(uc.tokens, tokenID.String())
+	return nil
+}
+
+type fakeGetValidatorSetUseCase struct{ *fakeLocalChain }
+
+func (uc fakeGetValidatorSetUseCase) Execute(ctx context.Context, chainID uint16) (*ccdomain.ValidatorSet, error) {
+	return nil, nil
+}
+
+type fakeCreateBlockchainReorgEventUseCase struct{ *fakeLocalChain }
+
+func (uc fakeCreateBlockchainReorgEventUseCase) Execute(ctx context.Context, e *ccdomain.BlockchainReorgEvent) error {
+	uc.reorgEvents = append(uc.reorgEvents, e)
+	return nil
+}
+
+// fakeGlobalBlockchain is the blockchain of the Authority, by header number.
+type fakeGlobalBlockchain map[uint64]*ccdomain.BlockData
+
+func (g fakeGlobalBlockchain) ExecuteByHash(ctx context.Context, hash string) (*ccdomain.BlockDataDTO, error) {
+	for _, blockData := range g {
+		if blockData.Hash == hash {
+			return ccdomain.BlockDataToBlockDataDTO(blockData), nil
+		}
+	}
+	return nil, nil
+}
+
+func (g fakeGlobalBlockchain) ExecuteByHeaderNumber(ctx context.Context, headerNumber *big.Int) (*ccdomain.BlockDataDTO, error) {
+	blockData, ok := g[headerNumber.Uint64()]
+	if !ok {
+		return nil, nil
+	}
+	return ccdomain.BlockDataToBlockDataDTO(blockData), nil
+}
+
+// newTestReorgService returns the sync service with the use cases to apply
+// and roll back blocks of the local chain.
+func newTestReorgService(chain *fakeLocalChain, global fakeGlobalBlockchain) *blockchainSyncWithBlockchainAuthorityServiceImpl {
+	s := newTestSyncService(chain)
+	s.getBlockDataUseCase = fakeGetBlockDataUseCase{chain}
+	s.deleteBlockDataUseCase = fakeDeleteBlockDataUseCase{chain}
+	s.deleteTokenUseCase = fakeDeleteTokenUseCase{chain}
+	s.getValidatorSetUseCase = fakeGetValidatorSetUseCase{chain}
+	s.createBlockchainReorgEventUseCase = fakeCreateBlockchainReorgEventUseCase{chain}
+	s.getBlockDataDTOFromBlockchainAuthorityUseCase = global
+	return s
+}
+
+// newTestReorgChain returns the local chain with only the genesis block.
+func newTestReorgChain(t *testing.T) (*fakeLocalChain, *domain.BlockchainState) {
+	t.Helper()
+	chain := newFakeLocalChain(1)
+	chain.setAccount(reorgSender, 1000, 0)
+	chain.setAccount(reorgBeneficiary, 0, 0)
+	genesis := &ccdomain.BlockData{
+		Hash: "0xgenesis",
+		Header: &ccdomain.BlockHeader{
+			ChainID:     1,
+			NumberBytes: big.NewInt(0).Bytes(),
+			Beneficiary: reorgBeneficiary,
+			Version:     signature.VersionCanonical,
+		},
+	}
+	chain.blockDatas[genesis.Hash] = genesis
+	return chain, &domain.BlockchainState{ChainID: 1, LatestBlockNumberBytes: genesis.Header.NumberBytes, LatestHash: genesis.Hash}
+}
+
+// appendTestBlock seals the transactions on top of the latest local block
+// with the state root of their changes and applies the block to the chain.
+func appendTestBlock(t *testing.T, s *blockchainSyncWithBlockchainAuthorityServiceImpl, chain *fakeLocalChain, localBlockchainState *domain.BlockchainState, hash string, trans ...ccdomain.BlockTransaction) *ccdomain.BlockData {
+	t.Helper()
+	ctx := context.Background()
+	blockData := &ccdomain.BlockData{
+		Hash: hash,
+		Header: &ccdomain.BlockHeader{
+			ChainID:        1,
+			NumberBytes:    new(big.Int).Add(localBlockchainState.GetLatestBlockNumber(), big.NewInt(1)).Bytes(),
+			PrevBlockHash:  localBlockchainState.LatestHash,
+			TransactionFee: 1,
+			Beneficiary:    reorgBeneficiary,
+			Version:        signature.VersionCanonical,
+		},
+		Trans: trans,
+	}
+
+	changes := s.newBlockDataStateChanges(1)
+	for _, blockTx := range blockData.Trans {
+		if err := s.processAccountForTransaction(ctx, changes, blockData, &blockTx); err != nil {
+			t.Fatalf("failed processing transaction of %v: %v", hash, err)
+		}
+	}
+	stateRoot, err := ccdomain.HashAccountsStateTrie(chain.accountsList(changes.Accounts()), 1)
+	if err != nil {
+		t.Fatalf("failed hashing accounts: %v", err)
+	}
+	blockData.Header.StateRoot = stateRoot
+
+	if err := s.applyBlockData(ctx, blockData, nil, localBlockchainState); err != nil {
+		t.Fatalf("failed applying %v: %v", hash, err)
+	}
+	return blockData
+}
+
+func testCoinTx(from, to common.Address, value, nonce uint64) ccdomain.BlockTransaction {
+	return ccdomain.BlockTransaction{SignedTransaction: ccdomain.SignedTransaction{Transaction: ccdomain.Transaction{
+		ChainID: 1, Type: ccdomain.TransactionTypeCoin, From: &from, To: &to, Value: value, NonceBytes: new(big.Int).SetUint64(nonce).Bytes(),
+	}}}
+}
+
+func testTokenTx(from, to common.Address, nonce, tokenID, tokenNonce uint64) ccdomain.BlockTransaction {
+	return ccdomain.BlockTransaction{SignedTransaction: ccdomain.SignedTransaction{Transaction: ccdomain.Transaction{
+		ChainID: 1, Type: ccdomain.TransactionTypeToken, From: &from, To: &to, NonceBytes: new(big.Int).SetUint64(nonce).Bytes(),
+		TokenIDBytes: new(big.Int).SetUint64(tokenID).Bytes(), TokenMetadataURI: fmt.Sprintf("https://example.com/%d", tokenID), TokenNonceBytes: new(big.Int).SetUint64(tokenNonce).Bytes(),
+	}}}
+}
+
+// snapshotAccounts returns a copy of the balances and nonces of the accounts.
+func snapshotAccounts(chain *fakeLocalChain) map[common.Address][2]uint64 {
+	accounts := make(map[common.Address][2]uint64)
+	for address, acc := range chain.accounts {
+		accounts[address] = [2]uint64{acc.Balance, acc.GetNonce().Uint64()}
+	}
+	return accounts
+}
+
+func requireAccounts(t *testing.T, chain *fakeLocalChain, expected map[common.Address][2]uint64) {
+	t.Helper()
+	got := snapshotAccounts(chain)
+	if len(got) != len(expected) {
+		t.Fatalf("expected accounts %v, got %v", expected, got)
+	}
+	for address, want := range expected {
+		if got[address] != want {
+			t.Fatalf("expected account %v to have balance and nonce %v, got %v", address.Hex(), want, got[address])
+		}
+	}
+}
+
+func TestReorganizeForkAtDepthOne(t *testing.T) {
+	ctx := context.Background()
+	chain, localBlockchainState := newTestReorgChain(t)
+	global := fakeGlobalBlockchain{0: chain.blockDatas["0xgenesis"]}
+	s := newTestReorgService(chain, global)
+
+	block1 := appendTestBlock(t, s, chain, localBlockchainState, "0xblock1", testCoinTx(reorgSender, reorgReceiver, 10, 1))
+	accountsAtBlock1 := snapshotAccounts(chain)
+	appendTestBlock(t, s, chain, localBlockchainState, "0xblock2",
+		testCoinTx(reorgSender, reorgReceiver, 20, 2),
+		testTokenTx(reorgSender, reorgReceiver, 3, 1, 0))
+
+	// The Authority replaced our latest block with another block.
+	global[1] = block1
+	global[2] = &ccdomain.BlockData{Hash: "0xglobal2", Header: &ccdomain.BlockHeader{ChainID: 1, NumberBytes: big.NewInt(2).Bytes(), PrevBlockHash: block1.Hash}}
+	globalBlockchainState := &domain.BlockchainState{ChainID: 1, LatestBlockNumberBytes: big.NewInt(2).Bytes(), LatestHash: "0xglobal2"}
+
+	if err := s.reorganizeIfForkedFromGlobalBlockchainNetwork(ctx, localBlockchainState, globalBlockchainState); err != nil {
+		t.Fatalf("failed reorganizing: %v", err)
+	}
+
+	requireAccounts(t, chain, accountsAtBlock1)
+	if _, ok := chain.tokens["1"]; ok {
+		t.Fatal("expected the token minted in the rolled back block to be deleted")
+	}
+	if _, ok := chain.blockDatas["0xblock2"]; ok {
+		t.Fatal("expected the rolled back block to be deleted")
+	}
+	if chain.blockchainState.LatestHash != block1.Hash || localBlockchainState.LatestHash != block1.Hash {
+		t.Fatalf("expected local blockchain state to point to the common ancestor, got %v", chain.blockchainState.LatestHash)
+	}
+	if len(chain.reorgEvents) != 1 {
+		t.Fatalf("expected one reorg event, got %d", len(chain.reorgEvents))
+	}
+	event := chain.reorgEvents[0]
+	if event.CommonAncestorHash != block1.Hash || event.PreviousLatestHash != "0xblock2" || len(event.RolledBackBlockHashes) != 1 {
+		t.Fatalf("unexpected reorg event: %+v", event)
+	}
+
+	// The new branch applies on top of the common ancestor.
+	appendTestBlock(t, s, chain, localBlockchainState, "0xglobal2", testCoinTx(reorgSender, reorgReceiver, 5, 2))
+	if got := chain.accounts[reorgReceiver].Balance; got != accountsAtBlock1[reorgReceiver][0]+4 {
+		t.Fatalf("expected the new branch to be applied, receiver has %v", got)
+	}
+}
+
+func TestReorganizeForkDeeperThanSyncWindow(t *testing.T) {
+	ctx := context.Background()
+	chain, localBlockchainState := newTestReorgChain(t)
+	global := fakeGlobalBlockchain{0: chain.blockDatas["0xgenesis"]}
+	s := newTestReorgService(chain, global)
+	accountsAtGenesis := snapshotAccounts(chain)
+
+	// Our local blockchain is more than a sync window ahead of the common
+	// ancestor and ahead of the latest block of the Authority.
+	depth := blockDataSyncWindowSize + 5
+	for i := 1; i <= depth; i++ {
+		appendTestBlock(t, s, chain, localBlockchainState, fmt.Sprintf("0xlocal%d", i), testCoinTx(reorgSender, reorgReceiver, 2, uint64(i)))
+	}
+	for i := 1; i <= 3; i++ {
+		global[uint64(i)] = &ccdomain.BlockData{Hash: fmt.Sprintf("0xglobal%d", i), Header: &ccdomain.BlockHeader{ChainID: 1, NumberBytes: big.NewInt(int64(i)).Bytes()}}
+	}
+	globalBlockchainState := &domain.BlockchainState{ChainID: 1, LatestBlockNumberBytes: big.NewInt(3).Bytes(), LatestHash: "0xglobal3"}
+
+	if err := s.reorganizeIfForkedFromGlobalBlockchainNetwork(ctx, localBlockchainState, globalBlockchainState); err != nil {
+		t.Fatalf("failed reorganizing: %v", err)
+	}
+
+	requireAccounts(t, chain, map[common.Address][2]uint64{
+		reorgSender:      accountsAtGenesis[reorgSender],
+		reorgBeneficiary: accountsAtGenesis[reorgBeneficiary],
+		// The receiver was created by the rolled back blocks, it stays
+		// behind without any coins.
+		reorgReceiver: {0, 0},
+	})
+	if len(chain.blockDatas) != 1 || localBlockchainState.LatestHash != "0xgenesis" {
+		t.Fatalf("expected only the genesis block to remain, got %d blocks and latest %v", len(chain.blockDatas), localBlockchainState.LatestHash)
+	}
+	if got := len(chain.reorgEvents[0].RolledBackBlockHashes); got != depth {
+		t.Fatalf("expected %d rolled back blocks, got %d", depth, got)
+	}
+}
+
+func TestReorganizeRestoresTokenOwner(t *testing.T) {
+	ctx := context.Background()
+	chain, localBlockchainState := newTestReorgChain(t)
+	global := fakeGlobalBlockchain{0: chain.blockDatas["0xgenesis"]}
+	s := newTestReorgService(chain, global)
+
+	block1 := appendTestBlock(t, s, chain, localBlockchainState, "0xblock1",
+		testCoinTx(reorgSender, reorgReceiver, 10, 1),
+		testTokenTx(reorgSender, reorgReceiver, 2, 1, 0))
+	block2 := appendTestBlock(t, s, chain, localBlockchainState, "0xblock2")
+	accountsAtBlock2 := snapshotAccounts(chain)
+	appendTestBlock(t, s, chain, localBlockchainState, "0xblock3", testTokenTx(reorgReceiver, reorgSender, 1, 1, 1))
+	if owner := chain.tokens["1"].Owner; *owner != reorgSender {
+		t.Fatalf("expected the token to be transferred to the sender, got %v", owner.Hex())
+	}
+
+	global[1] = block1
+	global[2] = block2
+	global[3] = &ccdomain.BlockData{Hash: "0xglobal3", Header: &ccdomain.BlockHeader{ChainID: 1, NumberBytes: big.NewInt(3).Bytes(), PrevBlockHash: block2.Hash}}
+	globalBlockchainState := &domain.BlockchainState{ChainID: 1, LatestBlockNumberBytes: big.NewInt(3).Bytes(), LatestHash: "0xglobal3"}
+
+	if err := s.reorganizeIfForkedFromGlobalBlockchainNetwork(ctx, localBlockchainState, globalBlockchainState); err != nil {
+		t.Fatalf("failed reorganizing: %v", err)
+	}
+
+	requireAccounts(t, chain, accountsAtBlock2)
+	tok := chain.tokens["1"]
+	if tok == nil || *tok.Owner != reorgReceiver || tok.GetNonce().Sign() != 0 || tok.MetadataURI != "https://example.com/1" {
+		t.Fatalf("expected the token to be restored to its owner at the common ancestor, got %+v", tok)
+	}
+	if localBlockchainState.LatestHash != block2.Hash {
+		t.Fatalf("expected local blockchain state to point to the common ancestor, got %v", localBlockchainState.LatestHash)
+	}
+}
+
+func TestReorganizeGenesisMismatch(t *testing.T) {
+	chain, localBlockchainState := newTestReorgChain(t)
+	global := fakeGlobalBlockchain{0: {Hash: "0xanothergenesis", Header: &ccdomain.BlockHeader{ChainID: 1}}}
+	s := newTestReorgService(chain, global)
+	appendTestBlock(t, s, chain, localBlockchainState, "0xblock1", testCoinTx(reorgSender, reorgReceiver, 10, 1))
+	accountsAtBlock1 := snapshotAccounts(chain)
+
+	globalBlockchainState := &domain.BlockchainState{ChainID: 1, LatestBlockNumberBytes: big.NewInt(0).Bytes(), LatestHash: "0xanothergenesis"}
+	err := s.reorganizeIfForkedFromGlobalBlockchainNetwork(context.Background(), localBlockchainState, globalBlockchainState)
+	if !errors.Is(err, ccdomain.ErrBlockForked) {
+		t.Fatalf("expected %v, got %v", ccdomain.ErrBlockForked, err)
+	}
+	requireAccounts(t, chain, accountsAtBlock1)
+	if len(chain.reorgEvents) != 0 {
+		t.Fatal("expected no reorg event")
+	}
+}
@@ -5,23 +5,23 @@ import (
 	"fmt"
 	"log/slog"
 	"math/big"
-	"math/rand"
-	"time"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
-	authority_domain "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
 	uc_blockchainstatedto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockchainstatedto"
-	uc_blockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockdatadto"
-	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/genesisblockdatadto"
 
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
+	uc_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainreorgevent"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainstate"
 	uc_blockchainsyncstatus "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainsyncstatus"
 	uc_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdata"
+	uc_blockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdatadto"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdatadto"
 	uc_pstx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/pstx"
 	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
+	uc_validatorset "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/validatorset"
 )
 
 type BlockchainSyncWithBlockchainAuthorityService interface {
@@ -29,22 +29,29 @@ type BlockchainSyncWithBlockchainAuthorityService interface {
 }
 
 type blockchainSyncWithBlockchainAuthorityServiceImpl struct {
-	logger                                               *slog.Logger
-	getBlockchainSyncStatusUseCase                       uc_blockchainsyncstatus.GetBlockchainSyncStatusUseCase
-	setBlockchainSyncStatusUseCase                       uc_blockchainsyncstatus.SetBlockchainSyncStatusUseCase
-	getGenesisBlockDataUseCase                           uc_genesisblockdata.GetGenesisBlockDataUseCase
-	upsertGenesisBlockDataUseCase                        uc_genesisblockdata.UpsertGenesisBlockDataUseCase
-	getGenesisBlockDataDTOFromBlockchainAuthorityUseCase uc_genesisblockdatadto.GetGenesisBlockDataDTOFromBlockchainAuthorityUseCase
-	getBlockchainStateUseCase                            uc_blockchainstate.GetBlockchainStateUseCase
-	upsertBlockchainStateUseCase                         uc_blockchainstate.UpsertBlockchainStateUseCase
-	getBlockchainStateDTOFromBlockchainAuthorityUseCase  uc_blockchainstatedto.GetBlockchainStateDTOFromBlockchainAuthorityUseCase
-	getBlockDataUseCase                                  uc_blockdata.GetBlockDataUseCase
-	upsertBlockDataUseCase                               uc_blockdata.UpsertBlockDataUseCase
-	getBlockDataDTOFromBlockchainAuthorityUseCase        uc_blockdatadto.GetBlockDataDTOFromBlockchainAuthorityUseCase
-	getAccountUseCase                                    uc_account.GetAccountUseCase
-	upsertAccountUseCase                                 uc_account.UpsertAccountUseCase
-	upsertTokenIfPreviousTokenNonceGTEUseCase            uc_tok.UpsertTokenIfPreviousTokenNonceGTEUseCase
-	deletePendingSignedTransactionUseCase                uc_pstx.DeletePendingSignedTransactionUseCase
+	logger                                                *slog.Logger
+	getBlockchainSyncStatusUseCase                        uc_blockchainsyncstatus.GetBlockchainSyncStatusUseCase
+	setBlockchainSyncStatusUseCase                        uc_blockchainsyncstatus.SetBlockchainSyncStatusUseCase
+	getGenesisBlockDataUseCase                            uc_genesisblockdata.GetGenesisBlockDataUseCase
+	upsertGenesisBlockDataUseCase                         uc_genesisblockdata.UpsertGenesisBlockDataUseCase
+	getGenesisBlockDataDTOFromBlockchainAuthorityUseCase  uc_genesisblockdatadto.GetGenesisBlockDataDTOFromBlockchainAuthorityUseCase
+	getBlockchainStateUseCase                             uc_blockchainstate.GetBlockchainStateUseCase
+	upsertBlockchainStateUseCase                          uc_blockchainstate.UpsertBlockchainStateUseCase
+	getBlockchainStateDTOFromBlockchainAuthorityUseCase   uc_blockchainstatedto.GetBlockchainStateDTOFromBlockchainAuthorityUseCase
+	getBlockDataUseCase                                   uc_blockdata.GetBlockDataUseCase
+	upsertBlockDataUseCase                                uc_blockdata.UpsertBlockDataUseCase
+	getBlockDataDTOFromBlockchainAuthorityUseCase         uc_blockdatadto.GetBlockDataDTOFromBlockchainAuthorityUseCase
+	getAccountUseCase                                     uc_account.GetAccountUseCase
+	upsertAccountUseCase                                  uc_account.UpsertAccountUseCase
+	upsertTokenIfPreviousTokenNonceGTEUseCase             uc_tok.UpsertTokenIfPreviousTokenNonceGTEUseCase
+	deletePendingSignedTransactionUseCase                 uc_pstx.DeletePendingSignedTransactionUseCase
+	getAccountsHashStateUseCase                           uc_account.GetAccountsHashStateUseCase
+	deleteBlockDataUseCase                                uc_blockdata.DeleteBlockDataUseCase
+	deleteTokenUseCase                                    uc_tok.DeleteTokenUseCase
+	createBlockchainReorgEventUseCase                     uc_blockchainreorgevent.CreateBlockchainReorgEventUseCase
+	listBlockDataDTOInRangeFromBlockchainAuthorityUseCase uc_blockdata.ListBlockDataDTOInRangeFromBlockchainAuthorityUseCase
+	getValidatorSetUseCase                                uc_validatorset.GetValidatorSetUseCase
+	upsertValidatorSetUseCase                             uc_validatorset.UpsertValidatorSetUseCase
 }
 
 func NewBlockchainSyncWithBlockchainAuthorityService(
@@ -64,8 +71,15 @@ func NewBlockchainSyncWithBlockchainAuthorityService(
 	uc13 uc_account.UpsertAccountUseCase,
 	uc14 uc_tok.UpsertTokenIfPreviousTokenNonceGTEUseCase,
 	uc15 uc_pstx.DeletePendingSignedTransactionUseCase,
+	uc16 uc_account.GetAccountsHashStateUseCase,
+	uc17 uc_blockdata.DeleteBlockDataUseCase,
+	uc18 uc_tok.DeleteTokenUseCase,
+	uc19 uc_blockchainreorgevent.CreateBlockchainReorgEventUseCase,
+	uc20 uc_blockdata.ListBlockDataDTOInRangeFromBlockchainAuthorityUseCase,
+	uc21 uc_validatorset.GetValidatorSetUseCase,
+	uc22 uc_validatorset.UpsertValidatorSetUseCase,
 ) BlockchainSyncWithBlockchainAuthorityService {
-	return &blockchainSyncWithBlockchainAuthorityServiceImpl{logger, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8, uc9, uc10, uc11, uc12, uc13, uc14, uc15}
+	return &blockchainSyncWithBlockchainAuthorityServiceImpl{logger, uc1, uc2, uc3, uc4, uc5, uc6, uc7, uc8, uc9, uc10, uc11, uc12, uc13, uc14, uc15, uc16, uc17, uc18, uc19, uc20, uc21, uc22}
 }
 
 func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) Execute(ctx context.Context, chainID uint16) error {
@@ -124,7 +138,7 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) Execute(ctx context.C
 		return err
 	}
 	// Convert from network format data to our local format.
-	globalBlockchainState := authority_domain.BlockchainStateDTOToBlockchainState(globalBlockchainStateDTO)
+	globalBlockchainState := domain.BlockchainStateDTOToBlockchainState(globalBlockchainStateDTO)
 
 	// Fetch our local blockchain state.
 	localBlockchainState, err := s.getBlockchainStateUseCase.Execute(ctx, chainID)
@@ -162,6 +176,15 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) Execute(ctx context.C
 		}
 		s.logger.Debug("Local blockchain state is out of sync with global blockchain network",
 			slog.Any("chain_id", chainID))
+
+		// If the Authority rewrote history then roll back our local blockchain
+		// to the common ancestor before we apply the new branch.
+		if err := s.reorganizeIfForkedFromGlobalBlockchainNetwork(ctx, localBlockchainState, globalBlockchainState); err != nil {
+			s.logger.Error("Failed reorganizing local blockchain",
+				slog.Any("chain_id", chainID),
+				slog.Any("error", err))
+			return err
+		}
 	}
 
 	//
@@ -178,7 +201,7 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) Execute(ctx context.C
 	}
 	defer s.setBlockchainSyncStatusUseCase.Execute(ctx, false)
 
-	if err := s.syncWithGlobalBlockchainNetwork(ctx, localBlockchainState, globalBlockchainState); err != nil {
+	if err := s.syncWithGlobalBlockchainNetwork(ctx, genesis, localBlockchainState, globalBlockchainState); err != nil {
 		if localBlockchainState.LatestHash == globalBlockchainState.LatestHash {
 			s.logger.Debug("Failed to sync with the global blockchain network",
 				slog.Any("chain_id", chainID))
@@ -205,7 +228,7 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) Execute(ctx context.C
 	return nil
 }
 
-func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) getGenesisLocallyOrDownloadGenesisFromGlobalBlockchainNetwork(ctx context.Context, chainID uint16) (*domain.GenesisBlockData, error) {
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) getGenesisLocallyOrDownloadGenesisFromGlobalBlockchainNetwork(ctx context.Context, chainID uint16) (*ccdomain.GenesisBlockData, error) {
 	genesis, err := s.getGenesisBlockDataUseCase.Execute(ctx, chainID)
 	if err != nil {
 		s.logger.Error("Failed getting genesis block locally",
@@ -239,7 +262,17 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) getGenesisLocallyOrDo
 		}
 
 		// Convert from network format data to our local format.
-		genesis = authority_domain.GenesisBlockDataDTOToGenesisBlockData(genesisDTO)
+		genesis = ccdomain.GenesisBlockDataDTOToGenesisBlockData(genesisDTO)
+
+		// DEVELOPERS NOTE:
+		// The genesis validator is trusted on first use, every block which
+		// follows must be signed by this validator.
+		if err := ccdomain.ValidateGenesisBlockData(genesis); err != nil {
+			s.logger.Error("Failed validating genesis block",
+				slog.Any("chain_id", chainID),
+				slog.Any("error", err))
+			return nil, err
+		}
 
 		// Save the genesis block data to local database.
 		if err := s.upsertGenesisBlockDataUseCase.Execute(ctx, genesis.Hash, genesis.Header, genesis.HeaderSignatureBytes, genesis.Trans, genesis.Validator); err != nil {
@@ -303,7 +336,7 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) getGenesisLocallyOrDo
 	return genesis, nil
 }
 
-func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) syncWithGlobalBlockchainNetwork(ctx context.Context, localBlockchainState, globalBlockchainState *domain.BlockchainState) error {
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) syncWithGlobalBlockchainNetwork(ctx context.Context, genesis *ccdomain.GenesisBlockData, localBlockchainState, globalBlockchainState *domain.BlockchainState) error {
 	//
 	// Algorithm:
 	// (1) Download the most recent block from the Global Blockchain. Please
@@ -311,9 +344,10 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) syncWithGlobalBlockch
 	//     the chain.
 	// (2) Get our recent block from our local Blockchain. Please note our
 	//     block will contain the earliest `number` we have of the chain
-	// (3) Iterate from the earliest `number` to the most recent `number` by
-	//     downloading all the blocks for the missing blocks and save them to
-	//     our local blockchain.
+	// (3) Iterate from the block after the earliest `number` to the most
+	//     recent `number` by downloading the missing blocks in windows,
+	//     verifying each block against its parent and the validator scheduled
+	//     to seal it, and save them in order to our local blockchain.
 	// (4) When our local Blockchain and Global Blockchain have the same
 	//     `number` then that means we have successfully synchronized; therefore,
 	//     stop the synching.
@@ -332,7 +366,7 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) syncWithGlobalBlockch
 	}
 
 	// Convert from network transfer data-structure to our application data-structure.
-	latestBlockData := authority_domain.BlockDataDTOToBlockData(latestBlockDataDTO)
+	latestBlockData := ccdomain.BlockDataDTOToBlockData(latestBlockDataDTO)
 
 	//
 	// STEP 2
@@ -353,139 +387,284 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) syncWithGlobalBlockch
 	// STEP 3
 	//
 
+	// DEVELOPERS NOTE:
+	// The earliest block was already applied to our local blockchain so we
+	// start at the block after it and use it as the parent to verify against.
+	previousBlockData := earliestBlockData
 	earliestNumber := earliestBlockData.Header.GetNumber()
-	number := earliestNumber
+	number := new(big.Int).Add(earliestNumber, big.NewInt(1))
 	latestNumber := latestBlockData.Header.GetNumber()
 
+	validators, err := s.getLocalValidatorSet(ctx, genesis)
+	if err != nil {
+		s.logger.Error("Failed getting local validator set",
+			slog.Any("error", err))
+		return err
+	}
+
 	s.logger.Debug("Processed block data",
 		slog.String("earliest_number", earliestNumber.String()),
 		slog.String("current_number", number.String()),
 		slog.String("latest_number", latestNumber.String()))
 
-	for number.Cmp(latestNumber) <= 0 {
-		s.logger.Debug("Fetching block data from global blockchain network...",
-			slog.Any("header_number", number.String()))
+	// DEVELOPERS NOTE:
+	// Blocks are downloaded and verified in windows by a background goroutine
+	// while we apply the previous window. The channel is buffered so the
+	// downloader can only get a few windows ahead of us; if we apply slower
+	// than we download then the downloader waits (backpressure) instead of
+	// holding the entire blockchain in memory.
+	downloadCtx, cancelDownload := context.WithCancel(ctx)
+	defer cancelDownload()
+	windows := make(chan *blockDataSyncWindow, blockDataSyncWindowBufferSize)
+	go s.downloadAndVerifyBlockDataWindows(downloadCtx, validators, previousBlockData, number, latestNumber, windows)
+
+	for window := range windows {
+		if window.err != nil {
+			s.logger.Error("Failed downloading block data from global blockchain network",
+				slog.Any("error", window.err))
+			return window.err
+		}
 
-		// Fetch from the global blockchain network.
-		blockDataDTO, err := s.getBlockDataDTOFromBlockchainAuthorityUseCase.ExecuteByHeaderNumber(ctx, number)
-		if err != nil {
-			s.logger.Debug("Failed to get block data from global blockchain network",
-				slog.Any("header_number", number.String()))
-			return err
+		// Apply the verified blocks in order.
+		for _, blockData := range window.blockDatas {
+			nextValidators, err := ccdomain.ApplyBlockDataToValidatorSet(validators, blockData)
+			if err != nil {
+				s.logger.Error("Failed applying validator set changes",
+					slog.Any("header_number", blockData.Header.GetNumber().String()),
+					slog.Any("error", err))
+				return err
+			}
+			if err := s.applyBlockData(ctx, blockData, nextValidators, localBlockchainState); err != nil {
+				return err
+			}
+			previousBlockData = blockData
+			validators = nextValidators
 		}
 
-		blockData := authority_domain.BlockDataDTOToBlockData(blockDataDTO)
+		s.logger.Debug("Processed block data",
+			slog.String("earliest_number", earliestNumber.String()),
+			slog.String("processed_number", previousBlockData.Header.GetNumber().String()),
+			slog.String("latest_number", latestNumber.String()))
+	}
+
+	// If we processed up to the latest block number but do not share the
+	// latest hash then the global blockchain has forked from ours.
+	if previousBlockData.Hash != globalBlockchainState.LatestHash {
+		err := fmt.Errorf("%w: local latest hash %v, global latest hash %v", ccdomain.ErrBlockForked, previousBlockData.Hash, globalBlockchainState.LatestHash)
+		s.logger.Error("Failed syncing with global blockchain network",
+			slog.Any("error", err))
+		return err
+	}
 
-		s.logger.Debug("Downloaded block data from global blockchain network and saving to local database...",
-			slog.Any("header_number", number.String()))
+	s.logger.Debug("Finished syncing with global blockchain network")
+	return nil
+}
+
+// getLocalValidatorSet returns the validator set after our latest local block
+// was applied. If no validator set was saved yet then the validator set was
+// never changed, so the genesis validator is the only validator.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) getLocalValidatorSet(ctx context.Context, genesis *ccdomain.GenesisBlockData) ([]*ccdomain.Validator, error) {
+	validatorSet, err := s.getValidatorSetUseCase.Execute(ctx, genesis.Header.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if validatorSet == nil || len(validatorSet.Validators) == 0 {
+		return []*ccdomain.Validator{genesis.Validator}, nil
+	}
+	return validatorSet.Validators, nil
+}
 
-		// Save it to the local database.
-		if err := s.upsertBlockDataUseCase.Execute(ctx, blockData.Hash, blockData.Header, blockData.HeaderSignatureBytes, blockData.Trans, blockData.Validator); err != nil {
-			s.logger.Debug("Failed to upsert block data ",
-				slog.Any("header_number", number.String()))
+// applyBlockData applies the transactions of the verified block to our local
+// accounts and tokens, saves the block and advances our local blockchain state.
+// The `validators` is the validator set after the block was applied.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) applyBlockData(ctx context.Context, blockData *ccdomain.BlockData, validators []*ccdomain.Validator, localBlockchainState *domain.BlockchainState) error {
+	// DEVELOPERS NOTE:
+	// The changes of the transactions are kept in memory until the state
+	// root of the block was checked, see `blockDataStateChanges`.
+	changes := s.newBlockDataStateChanges(blockData.Header.ChainID)
+
+	// Process account coins and tokens from the transactions.
+	for _, blockTx := range blockData.Trans {
+		//
+		// Process 🪪 accounts.
+		//
+
+		s.logger.Debug("Processing block tx...",
+			slog.Any("type", blockTx.Type),
+			slog.Any("nonce", blockTx.GetNonce()),
+			slog.Any("timestamp", blockTx.TimeStamp))
+		if err := s.processAccountForTransaction(ctx, changes, blockData, &blockTx); err != nil {
+			s.logger.Error("Failed processing transaction",
+				slog.Any("error", err))
 			return err
 		}
 
-		// Process account coins and tokens from the transactions.
-		for _, blockTx := range blockData.Trans {
-			//
-			// Process 🪪 accounts.
-			//
+		//
+		// Process 🎟️ tokens.
+		//
+
+		if blockTx.MovesToken() {
+			// Save our token to the local database ONLY if this transaction
+			// is the most recent one. We track "most recent" transaction by
+			// the nonce value in the token.
+			changes.UpsertTokenIfPreviousTokenNonceGTE(
+				blockTx.GetTokenID(),
+				blockTx.To,
+				blockTx.TokenMetadataURI,
+				blockTx.GetTokenNonce())
+		}
 
-			s.logger.Debug("Processing block tx...",
-				slog.Any("type", blockTx.Type),
-				slog.Any("nonce", blockTx.GetNonce()),
-				slog.Any("timestamp", blockTx.TimeStamp))
-			if err := s.processAccountForTransaction(ctx, blockData, &blockTx); err != nil {
-				s.logger.Error("Failed processing transaction",
-					slog.Any("error", err))
-				return err
-			}
+		s.logger.Debug("Finished processing block tx",
+			slog.Any("type", blockTx.Type),
+			slog.Any("nonce", blockTx.GetNonce()),
+			slog.Any("timestamp", blockTx.TimeStamp))
+	}
 
-			//
-			// Process 🎟️ tokens.
-			//
-
-			if blockTx.Type == domain.TransactionTypeToken {
-				// Save our token to the local database ONLY if this transaction
-				// is the most recent one. We track "most recent" transaction by
-				// the nonce value in the token.
-				err := s.upsertTokenIfPreviousTokenNonceGTEUseCase.Execute(
-					ctx,
-					blockTx.GetTokenID(),
-					blockTx.To,
-					blockTx.TokenMetadataURI,
-					blockTx.GetTokenNonce())
-				if err != nil {
-					s.logger.Error("Failed upserting (if previous token nonce GTE then current)",
-						slog.Any("type", blockTx.Type),
-						slog.Any("token_id", blockTx.GetTokenID()),
-						slog.Any("owner", blockTx.To),
-						slog.Any("metadataURI", blockTx.TokenMetadataURI),
-						slog.Any("nonce", blockTx.GetTokenNonce()),
-						slog.Any("error", err))
-					return err
-				}
-			}
+	//
+	// Verify the state of our local accounts matches the block.
+	//
 
-			s.logger.Debug("Finished processing block tx",
-				slog.Any("type", blockTx.Type),
-				slog.Any("nonce", blockTx.GetNonce()),
-				slog.Any("timestamp", blockTx.TimeStamp))
+	// DEVELOPERS NOTE:
+	// If the check fails we stop without saving the changes of the block or
+	// advancing our local blockchain state, so the next sync starts again
+	// from the last block which matched.
+	if err := validateLocalAccountsStateRoot(ctx, s.getAccountsHashStateUseCase, blockData, changes.Accounts()); err != nil {
+		s.logger.Error("Failed validating block data state root",
+			slog.Any("header_number", blockData.Header.GetNumber().String()),
+			slog.Any("hash", blockData.Hash),
+			slog.Any("error", err))
+		return err
+	}
+	if err := changes.Commit(ctx); err != nil {
+		s.logger.Error("Failed saving accounts and tokens of block",
+			slog.Any("header_number", blockData.Header.GetNumber().String()),
+			slog.Any("error", err))
+		return err
+	}
 
-			//
-			// Delete any local pending signed transactions (if there are any).
-			//
+	//
+	// Delete any local pending signed transactions (if there are any).
+	//
 
-			// Note: Do not handle errors.
-			delErr := s.deletePendingSignedTransactionUseCase.Execute(ctx, blockTx.GetNonce())
-			if delErr != nil {
-				s.logger.Debug("Delete pending signed transaction",
-					slog.Any("err", delErr))
-			}
+	for _, blockTx := range blockData.Trans {
+		// Note: Do not handle errors.
+		delErr := s.deletePendingSignedTransactionUseCase.Execute(ctx, blockTx.GetNonce())
+		if delErr != nil {
+			s.logger.Debug("Delete pending signed transaction",
+				slog.Any("err", delErr))
 		}
+	}
 
-		// IMPORTANT: Increment our header number to the next value.
-		number = blockData.Header.GetNumber()
-		number = number.Add(number, big.NewInt(1))
+	//
+	// Save the validator set if this block changed it.
+	//
 
-		s.logger.Debug("Processed block data",
-			slog.String("earliest_number", earliestNumber.String()),
-			slog.String("next_number", number.String()),
-			slog.String("latest_number", latestNumber.String()))
+	for _, blockTx := range blockData.Trans {
+		if blockTx.Type != ccdomain.TransactionTypeValidator {
+			continue
+		}
+		validatorSet := &ccdomain.ValidatorSet{
+			ChainID:    blockData.Header.ChainID,
+			Validators: validators,
+		}
+		if err := s.upsertValidatorSetUseCase.Execute(ctx, validatorSet); err != nil {
+			s.logger.Error("Failed upserting local validator set",
+				slog.Any("error", err))
+			return err
+		}
+		s.logger.Info("Validator set changed",
+			slog.Any("header_number", blockData.Header.GetNumber().String()),
+			slog.Int("validators", len(validators)))
+		break
+	}
 
-		// Artificial delay as to not overload the network resources. We will
-		// randomly pick an artificial delay between 1 second to 2 seconds.
-		randInt := rand.Intn(2) + 1
-		s.logger.Debug("Applying artificial delay",
-			slog.Any("seconds", randInt))
-		time.Sleep(time.Duration(randInt) * time.Second)
+	// Save it to the local database.
+	if err := s.upsertBlockDataUseCase.Execute(ctx, blockData.Hash, blockData.Header, blockData.HeaderSignatureBytes, blockData.Trans, blockData.Validator); err != nil {
+		s.logger.Debug("Failed to upsert block data ",
+			slog.Any("header_number", blockData.Header.GetNumber().String()))
+		return err
+	}
+
+	// Advance our local blockchain state so we never re-apply this block.
+	localBlockchainState.LatestBlockNumberBytes = blockData.Header.NumberBytes
+	localBlockchainState.LatestHash = blockData.Hash
+	localBlockchainState.LatestTokenIDBytes = blockData.Header.LatestTokenIDBytes
+	localBlockchainState.TransactionFee = blockData.Header.TransactionFee
+	localBlockchainState.AccountHashState = blockData.Header.StateRoot
+	localBlockchainState.TokenHashState = blockData.Header.TokensRoot
+	if err := s.upsertBlockchainStateUseCase.Execute(ctx, localBlockchainState); err != nil {
+		s.logger.Error("Failed upserting local blockchain state",
+			slog.Any("error", err))
+		return err
 	}
-	s.logger.Debug("Finished syncing with global blockchain network")
 	return nil
 }
 
-func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForTransaction(ctx context.Context, blockData *domain.BlockData, blockTx *domain.BlockTransaction) error {
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForTransaction(ctx context.Context, changes *blockDataStateChanges, blockData *ccdomain.BlockData, blockTx *ccdomain.BlockTransaction) error {
+	//
+	// CASE 1 OF 4: 🎟️ Token Transaction
+	//
+
+	if blockTx.Type == ccdomain.TransactionTypeToken {
+		return s.processAccountForTokenTransaction(ctx, changes, blockData, blockTx)
+	}
+
 	//
-	// CASE 1 OF 2: 🎟️ Token Transaction
+	// CASE 2 OF 4: 🪙 Coin Transaction
 	//
 
-	if blockTx.Type == domain.TransactionTypeToken {
-		return s.processAccountForTokenTransaction(ctx, blockData, blockTx)
+	if blockTx.Type == ccdomain.TransactionTypeCoin {
+		return s.processAccountForCoinTransaction(ctx, changes, blockData, blockTx)
 	}
 
 	//
-	// CASE 2 OF 2: 🪙 Coin Transaction
+	// CASE 3 OF 4: 🛡️ Validator Transaction
 	//
 
-	if blockTx.Type == domain.TransactionTypeCoin {
-		return s.processAccountForCoinTransaction(ctx, blockData, blockTx)
+	if blockTx.Type == ccdomain.TransactionTypeValidator {
+		return s.processAccountForValidatorTransaction(ctx, changes, blockTx)
+	}
+
+	//
+	// CASE 4 OF 4: 🤝 Swap Transaction
+	//
+
+	if blockTx.Type == ccdomain.TransactionTypeSwap {
+		return s.processAccountForSwapTransaction(ctx, changes, blockData, blockTx)
+	}
+
+	return nil
+}
+
+// processAccountForValidatorTransaction increments the nonce of the sender,
+// no coins are transfered and no fee is collected for validator set changes.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForValidatorTransaction(ctx context.Context, changes *blockDataStateChanges, blockTx *ccdomain.BlockTransaction) error {
+	acc, _ := changes.GetAccount(ctx, blockTx.From)
+	if acc == nil {
+		s.logger.Error("The `From` account does not exist in our database.",
+			slog.Any("hash", blockTx.From))
+		return fmt.Errorf("The `From` account does not exist in our database for hash: %v", blockTx.From.String())
 	}
 
+	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
+	noince := acc.GetNonce()
+	noince.Add(noince, big.NewInt(1))
+	acc.NonceBytes = noince.Bytes()
+
+	if err := changes.UpsertAccount(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return err
+	}
 	return nil
 }
 
-func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForCoinTransaction(ctx context.Context, blockData *domain.BlockData, blockTx *domain.BlockTransaction) error {
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForCoinTransaction(ctx context.Context, changes *blockDataStateChanges, blockData *ccdomain.BlockData, blockTx *ccdomain.BlockTransaction) error {
+	// Variables hold the coins taken from the sender, the coins given to the
+	// receiver and the fee collected by the Authority. Transactions without
+	// their own fee pay the block transaction fee out of their value.
+	debit, credit, fee := blockTx.SplitFees(blockData.Header.TransactionFee)
+
 	//
 	// STEP 1
 	//
@@ -493,21 +672,21 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForCoin
 	if blockTx.From != nil {
 		// DEVELOPERS NOTE:
 		// We already *should* have a `From` account in our database, so we can
-		acc, _ := s.getAccountUseCase.Execute(ctx, blockTx.From)
+		acc, _ := changes.GetAccount(ctx, blockTx.From)
 		if acc == nil {
 			s.logger.Error("The `From` account does not exist in our database.",
 				slog.Any("hash", blockTx.From))
 			return fmt.Errorf("The `From` account does not exist in our database for hash: %v", blockTx.From.String())
 		}
 
-		acc.Balance -= blockTx.Value
+		acc.Balance -= debit
 
 		// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
 		noince := acc.GetNonce()
 		noince.Add(noince, big.NewInt(1))
 		acc.NonceBytes = noince.Bytes()
 
-		if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+		if err := changes.UpsertAccount(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
 			s.logger.Error("Failed upserting account.",
 				slog.Any("error", err))
 			return err
@@ -519,12 +698,7 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForCoin
 	//
 
 	if blockTx.To != nil {
-
-		// Variable holds the value of coins to transfer to the account after
-		// the transaction fee was collected by the Authority.
-		var valueMinusFees uint64 = blockTx.Value - blockData.Header.TransactionFee
-
-		acc, _ := s.getAccountUseCase.Execute(ctx, blockTx.To)
+		acc, _ := changes.GetAccount(ctx, blockTx.To)
 		if acc == nil {
 			acc = &domain.Account{
 				Address: blockTx.To,
@@ -532,18 +706,23 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForCoin
 				// Always start by zero, increment by 1 after mining successful.
 				NonceBytes: big.NewInt(0).Bytes(),
 
-				Balance: valueMinusFees,
+				Balance: credit,
 			}
 		} else {
-			acc.Balance += valueMinusFees
-
-			// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
-			noince := acc.GetNonce()
-			noince.Add(noince, big.NewInt(1))
-			acc.NonceBytes = noince.Bytes()
+			// DEVELOPERS NOTE:
+			// Receiving coins does not change the account nonce as the nonce
+			// only tracks the transactions sent from this account, except
+			// for legacy blocks which were sealed when it did.
+			acc.Balance += credit
+
+			if !blockData.Header.HasStrictAccountNonces() {
+				accNonce := acc.GetNonce()
+				accNonce.Add(accNonce, big.NewInt(1))
+				acc.NonceBytes = accNonce.Bytes()
+			}
 		}
 
-		if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+		if err := changes.UpsertAccount(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
 			s.logger.Error("Failed upserting account.",
 				slog.Any("error", err))
 			return err
@@ -555,7 +734,7 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForCoin
 	//
 
 	// Deposit the transaction fee back to the coinbase to be recirculated.
-	proofOfAuthorityAccount, err := s.getAccountUseCase.Execute(ctx, &blockData.Header.Beneficiary)
+	proofOfAuthorityAccount, err := changes.GetAccount(ctx, &blockData.Header.Beneficiary)
 	if err != nil {
 		s.logger.Error("Failed getting proof of authority account.",
 			slog.Any("error", err))
@@ -567,28 +746,37 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForCoin
 	}
 
 	// Collect transaction fee from this coin transaction.
-	proofOfAuthorityAccount.Balance += blockData.Header.TransactionFee
-
-	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
-	pofNonce := proofOfAuthorityAccount.GetNonce()
-	pofNonce.Add(pofNonce, big.NewInt(1))
-	proofOfAuthorityAccount.NonceBytes = pofNonce.Bytes()
+	proofOfAuthorityAccount.Balance += fee
+
+	// Legacy blocks also incremented the nonce of the proof of authority
+	// account, see `BlockHeader.HasStrictAccountNonces`.
+	if !blockData.Header.HasStrictAccountNonces() {
+		pofNonce := proofOfAuthorityAccount.GetNonce()
+		pofNonce.Add(pofNonce, big.NewInt(1))
+		proofOfAuthorityAccount.NonceBytes = pofNonce.Bytes()
+	}
 
-	if err := s.upsertAccountUseCase.Execute(ctx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
+	if err := changes.UpsertAccount(ctx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
 		s.logger.Error("Failed upserting account.",
 			slog.Any("error", err))
 		return err
 	}
 	s.logger.Debug("Authority collected transaction fee from coin transfer",
 		slog.Any("authority_address", proofOfAuthorityAccount.Address),
-		slog.Any("collected_fee", blockData.Header.TransactionFee),
+		slog.Any("collected_fee", fee),
 		slog.Any("new_balance", proofOfAuthorityAccount.Balance),
 	)
 
 	return nil
 }
 
-func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForTokenTransaction(ctx context.Context, blockData *domain.BlockData, blockTx *domain.BlockTransaction) error {
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForTokenTransaction(ctx context.Context, changes *blockDataStateChanges, blockData *ccdomain.BlockData, blockTx *ccdomain.BlockTransaction) error {
+	// Variables hold the coins taken from the sender, the coin payment made
+	// to the receiver and the creator of the token and the fee collected by
+	// the Authority. Legacy token transactions never transfer coins.
+	debit, credit, fee := blockTx.SplitFees(blockData.Header.TransactionFee)
+	proceeds, royalty := blockTx.SplitCredit(credit)
+
 	//
 	// STEP 1:
 	// Check to see if we have an account for this particular token and
@@ -596,21 +784,21 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForToke
 	//
 
 	if blockTx.From != nil {
-		acc, _ := s.getAccountUseCase.Execute(ctx, blockTx.From)
+		acc, _ := changes.GetAccount(ctx, blockTx.From)
 		if acc == nil {
 			s.logger.Error("The `From` account does not exist in our database.",
 				slog.Any("hash", blockTx.From))
 			return fmt.Errorf("The `From` account does not exist in our database for hash: %v", blockTx.From.String())
 		}
 
-		acc.Balance -= blockTx.Value // Note: The value is equal to the transaction fee.
+		acc.Balance -= debit
 
 		// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
 		accNonce := acc.GetNonce()
 		accNonce.Add(accNonce, big.NewInt(1))
 		acc.NonceBytes = accNonce.Bytes()
 
-		if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+		if err := changes.UpsertAccount(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
 			s.logger.Error("Failed upserting account.",
 				slog.Any("error", err))
 			return err
@@ -624,27 +812,54 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForToke
 	//
 
 	if blockTx.To != nil {
-		acc, _ := s.getAccountUseCase.Execute(ctx, blockTx.To)
+		acc, _ := changes.GetAccount(ctx, blockTx.To)
 		if acc == nil {
 			acc = &domain.Account{
 				Address:    blockTx.To,
 				NonceBytes: big.NewInt(0).Bytes(), // Always start by zero, increment by 1 after mining successful.
 				Balance:    0,
 			}
-			if err := s.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
-				s.logger.Error("Failed upserting account.",
-					slog.Any("error", err))
-				return err
-			}
+		}
+
+		// Deposit the coin payment made with the token, less the royalty.
+		acc.Balance += proceeds
+
+		if err := changes.UpsertAccount(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting account.",
+				slog.Any("error", err))
+			return err
 		}
 	}
 
 	//
 	// STEP 3:
+	// Pay the royalty of the coin payment to the creator of the token.
+	//
+
+	if royalty > 0 {
+		acc, _ := changes.GetAccount(ctx, blockTx.RoyaltyRecipient)
+		if acc == nil {
+			acc = &domain.Account{
+				Address:    blockTx.RoyaltyRecipient,
+				NonceBytes: big.NewInt(0).Bytes(), // Always start by zero, increment by 1 after mining successful.
+				Balance:    0,
+			}
+		}
+		acc.Balance += royalty
+
+		if err := changes.UpsertAccount(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting account.",
+				slog.Any("error", err))
+			return err
+		}
+	}
+
+	//
+	// STEP 4:
 	// Deposit the transaction fee back to the coinbase to be recirculated.
 	//
 
-	proofOfAuthorityAccount, err := s.getAccountUseCase.Execute(ctx, &blockData.Header.Beneficiary)
+	proofOfAuthorityAccount, err := changes.GetAccount(ctx, &blockData.Header.Beneficiary)
 	if err != nil {
 		s.logger.Error("Failed getting proof of authority account.",
 			slog.Any("error", err))
@@ -656,23 +871,144 @@ func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForToke
 	}
 
 	// Collect transaction fee from this token transaction.
-	proofOfAuthorityAccount.Balance += blockTx.Value // Note: The value is equal to the transaction fee.
-
-	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
-	pofNonce := proofOfAuthorityAccount.GetNonce()
-	pofNonce.Add(pofNonce, big.NewInt(1))
-	proofOfAuthorityAccount.NonceBytes = pofNonce.Bytes()
+	proofOfAuthorityAccount.Balance += fee
+
+	// Legacy blocks also incremented the nonce of the proof of authority
+	// account, see `BlockHeader.HasStrictAccountNonces`.
+	if !blockData.Header.HasStrictAccountNonces() {
+		pofNonce := proofOfAuthorityAccount.GetNonce()
+		pofNonce.Add(pofNonce, big.NewInt(1))
+		proofOfAuthorityAccount.NonceBytes = pofNonce.Bytes()
+	}
 
-	if err := s.upsertAccountUseCase.Execute(ctx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
+	if err := changes.UpsertAccount(ctx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
 		s.logger.Error("Failed upserting account.",
 			slog.Any("error", err))
 		return err
 	}
 	s.logger.Debug("Authority collected transaction fee from token transfer or burn",
 		slog.Any("authority_address", proofOfAuthorityAccount.Address),
-		slog.Any("collected_fee", blockData.Header.TransactionFee),
+		slog.Any("collected_fee", fee),
 		slog.Any("new_balance", proofOfAuthorityAccount.Balance),
 	)
 
 	return nil
 }
+
+// processAccountForSwapTransaction pays the seller with the coins of the
+// buyer, the token itself changes owner like any other token transaction.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) processAccountForSwapTransaction(ctx context.Context, changes *blockDataStateChanges, blockData *ccdomain.BlockData, blockTx *ccdomain.BlockTransaction) error {
+	// Variables hold the coins taken from the buyer, the price given to the
+	// seller and the creator of the token and the fee collected by the
+	// Authority.
+	debit, credit, fee := blockTx.SplitFees(blockData.Header.TransactionFee)
+	proceeds, royalty := blockTx.SplitCredit(credit)
+
+	//
+	// STEP 1:
+	// Take the price and the fee from the buyer.
+	//
+
+	buyer, _ := changes.GetAccount(ctx, blockTx.To)
+	if buyer == nil {
+		s.logger.Error("The `To` account does not exist in our database.",
+			slog.Any("hash", blockTx.To))
+		return fmt.Errorf("The `To` account does not exist in our database for hash: %v", blockTx.To.String())
+	}
+	buyer.Balance -= debit
+
+	if err := changes.UpsertAccount(ctx, buyer.Address, buyer.Balance, buyer.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return err
+	}
+
+	//
+	// STEP 2:
+	// Pay the seller the price, less the royalty.
+	//
+
+	seller, _ := changes.GetAccount(ctx, blockTx.From)
+	if seller == nil {
+		s.logger.Error("The `From` account does not exist in our database.",
+			slog.Any("hash", blockTx.From))
+		return fmt.Errorf("The `From` account does not exist in our database for hash: %v", blockTx.From.String())
+	}
+	seller.Balance += proceeds
+
+	// Note: We do this to prevent reply attacks. (See notes in either `domain/accounts.go` or `service/genesis_init.go`)
+	sellerNonce := seller.GetNonce()
+	sellerNonce.Add(sellerNonce, big.NewInt(1))
+	seller.NonceBytes = sellerNonce.Bytes()
+
+	if err := changes.UpsertAccount(ctx, seller.Address, seller.Balance, seller.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return err
+	}
+
+	//
+	// STEP 3:
+	// Pay the royalty of the price to the creator of the token.
+	//
+
+	if royalty > 0 {
+		acc, _ := changes.GetAccount(ctx, blockTx.RoyaltyRecipient)
+		if acc == nil {
+			acc = &domain.Account{
+				Address:    blockTx.RoyaltyRecipient,
+				NonceBytes: big.NewInt(0).Bytes(), // Always start by zero, increment by 1 after mining successful.
+				Balance:    0,
+			}
+		}
+		acc.Balance += royalty
+
+		if err := changes.UpsertAccount(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			s.logger.Error("Failed upserting account.",
+				slog.Any("error", err))
+			return err
+		}
+	}
+
+	//
+	// STEP 4:
+	// Deposit the transaction fee back to the coinbase to be recirculated.
+	//
+
+	proofOfAuthorityAccount, err := changes.GetAccount(ctx, &blockData.Header.Beneficiary)
+	if err != nil {
+		s.logger.Error("Failed getting proof of authority account.",
+			slog.Any("error", err))
+		return err
+	}
+	if proofOfAuthorityAccount == nil {
+		s.logger.Error("Proof of authority account does not exist")
+		return fmt.Errorf("Proof of authority account does not exist")
+	}
+	proofOfAuthorityAccount.Balance += fee
+
+	if err := changes.UpsertAccount(ctx, proofOfAuthorityAccount.Address, proofOfAuthorityAccount.Balance, proofOfAuthorityAccount.GetNonce()); err != nil {
+		s.logger.Error("Failed upserting account.",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// validateLocalAccountsStateRoot verifies the hash of our local accounts, with
+// the `changed` accounts not saved yet, matches the block state root. The
+// header version decides which hash the block committed to, see
+// `BlockHeader.HasStateTrieRoots`, the other hash is never accepted.
+func validateLocalAccountsStateRoot(ctx context.Context, uc uc_account.GetAccountsHashStateUseCase, blockData *ccdomain.BlockData, changed []*domain.Account) error {
+	var stateRoot string
+	var err error
+	if blockData.Header.HasStateTrieRoots() {
+		stateRoot, err = uc.ExecuteWithChanges(ctx, blockData.Header.ChainID, changed)
+	} else {
+		stateRoot, err = uc.ExecuteLegacyWithChanges(ctx, blockData.Header.ChainID, changed)
+	}
+	if err != nil {
+		return err
+	}
+	return ccdomain.ValidateBlockDataStateRoot(blockData, stateRoot)
+}
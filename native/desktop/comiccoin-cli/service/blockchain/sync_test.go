@@ -0,0 +1,269 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"testing"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+// fakeAccountsHashStateUseCase returns fixed state roots for both schemes.
+type fakeAccountsHashStateUseCase struct {
+	trieRoot   string
+	legacyRoot string
+}
+
+func (uc *fakeAccountsHashStateUseCase) Execute(ctx context.Context, chainID uint16) (string, error) {
+	return uc.trieRoot, nil
+}
+
+func (uc *fakeAccountsHashStateUseCase) ExecuteWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	return uc.trieRoot, nil
+}
+
+func (uc *fakeAccountsHashStateUseCase) ExecuteLegacyWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	return uc.legacyRoot, nil
+}
+
+func TestValidateLocalAccountsStateRootScheme(t *testing.T) {
+	uc := &fakeAccountsHashStateUseCase{trieRoot: "0xtrie", legacyRoot: "0xlegacy"}
+	tests := []struct {
+		name      string
+		version   uint8
+		stateRoot string
+		expected  error
+	}{
+		{"canonical header with trie root", signature.VersionCanonical, "0xtrie", nil},
+		{"canonical header with legacy root", signature.VersionCanonical, "0xlegacy", ccdomain.ErrBlockTampered},
+		{"legacy header with legacy root", signature.VersionLegacy, "0xlegacy", nil},
+		{"legacy header with trie root", signature.VersionLegacy, "0xtrie", ccdomain.ErrBlockTampered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blockData := &ccdomain.BlockData{
+				Header: &ccdomain.BlockHeader{ChainID: 1, StateRoot: tt.stateRoot, Version: tt.version},
+			}
+			err := validateLocalAccountsStateRoot(context.Background(), uc, blockData, nil)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+// fakeLocalChain is our local database in memory, the adapters below
+// implement the use cases of the sync service on top of it.
+type fakeLocalChain struct {
+	chainID         uint16
+	accounts        map[common.Address]*domain.Account
+	tokens          map[string]*domain.Token
+	blockDatas      map[string]*ccdomain.BlockData
+	blockchainState *domain.BlockchainState
+	reorgEvents     []*ccdomain.BlockchainReorgEvent
+}
+
+func newFakeLocalChain(chainID uint16) *fakeLocalChain {
+	return &fakeLocalChain{
+		chainID:    chainID,
+		accounts:   make(map[common.Address]*domain.Account),
+		tokens:     make(map[string]*domain.Token),
+		blockDatas: make(map[string]*ccdomain.BlockData),
+	}
+}
+
+func (c *fakeLocalChain) setAccount(address common.Address, balance, nonce uint64) {
+	c.accounts[address] = &domain.Account{
+		ChainID:    c.chainID,
+		Address:    &address,
+		Balance:    balance,
+		NonceBytes: new(big.Int).SetUint64(nonce).Bytes(),
+	}
+}
+
+// accountsList returns the accounts, replaced by the `changed` accounts.
+func (c *fakeLocalChain) accountsList(changed []*domain.Account) []*domain.Account {
+	byAddress := make(map[common.Address]*domain.Account)
+	for address, acc := range c.accounts {
+		byAddress[address] = acc
+	}
+	for _, acc := range changed {
+		byAddress[*acc.Address] = acc
+	}
+	accounts := make([]*domain.Account, 0, len(byAddress))
+	for _, acc := range byAddress {
+		accounts = append(accounts, acc)
+	}
+	return accounts
+}
+
+type fakeGetAccountUseCase struct{ *fakeLocalChain }
+
+func (uc fakeGetAccountUseCase) Execute(ctx context.Context, address *common.Address) (*domain.Account, error) {
+	acc, ok := uc.accounts[*address]
+	if !ok {
+		return nil, nil
+	}
+	copied := *acc
+	return &copied, nil
+}
+
+type fakeUpsertAccountUseCase struct{ *fakeLocalChain }
+
+func (uc fakeUpsertAccountUseCase) Execute(ctx context.Context, address *common.Address, balance uint64, nonce *big.Int) error {
+	uc.setAccount(*address, balance, nonce.Uint64())
+	return nil
+}
+
+type fakeLocalAccountsHashStateUseCase struct{ *fakeLocalChain }
+
+func (uc fakeLocalAccountsHashStateUseCase) Execute(ctx context.Context, chainID uint16) (string, error) {
+	return ccdomain.HashAccountsStateTrie(uc.accountsList(nil), chainID)
+}
+
+func (uc fakeLocalAccountsHashStateUseCase) ExecuteWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	return ccdomain.HashAccountsStateTrie(uc.accountsList(changed), chainID)
+}
+
+func (uc fakeLocalAccountsHashStateUseCase) ExecuteLegacyWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	return ccdomain.HashAccountsStateLegacy(uc.accountsList(changed), chainID)
+}
+
+type fakeUpsertTokenUseCase struct{ *fakeLocalChain }
+
+func (uc fakeUpsertTokenUseCase) Execute(ctx context.Context, id *big.Int, owner *common.Address, metadataURI string, nonce *big.Int) error {
+	if previous, ok := uc.tokens[id.String()]; ok && previous.GetNonce().Cmp(nonce) > 0 {
+		return nil
+	}
+	uc.tokens[id.String()] = &domain.Token{
+		ChainID:     uc.chainID,
+		IDBytes:     id.Bytes(),
+		Owner:       owner,
+		MetadataURI: metadataURI,
+		NonceBytes:  nonce.Bytes(),
+	}
+	return nil
+}
+
+type fakeDeletePendingSignedTransactionUseCase struct{ *fakeLocalChain }
+
+func (uc fakeDeletePendingSignedTransactionUseCase) Execute(ctx context.Context, nonce *big.Int) error {
+	return nil
+}
+
+type fakeUpsertBlockDataUseCase struct{ *fakeLocalChain }
+
+func (uc fakeUpsertBlockDataUseCase) Execute(ctx context.Context, hash string, header *ccdomain.BlockHeader, headerSignature []byte, trans []ccdomain.BlockTransaction, validator *ccdomain.Validator) error {
+	uc.blockDatas[hash] = &ccdomain.BlockData{Hash: hash, Header: header, HeaderSignatureBytes: headerSignature, Trans: trans, Validator: validator}
+	return nil
+}
+
+type fakeUpsertBlockchainStateUseCase struct{ *fakeLocalChain }
+
+func (uc fakeUpsertBlockchainStateUseCase) Execute(ctx context.Context, bcs *domain.BlockchainState) error {
+	copied := *bcs
+	uc.blockchainState = &copied
+	return nil
+}
+
+type fakeUpsertValidatorSetUseCase struct{ *fakeLocalChain }
+
+func (uc fakeUpsertValidatorSetUseCase) Execute(ctx context.Context, validatorSet *ccdomain.ValidatorSet) error {
+	return nil
+}
+
+// newTestSyncService returns the sync service with the use cases to apply
+// blocks to the local chain.
+func newTestSyncService(chain *fakeLocalChain) *blockchainSyncWithBlockchainAuthorityServiceImpl {
+	return &blockchainSyncWithBlockchainAuthorityServiceImpl{
+		logger:               slog.New(slog.NewTextHandler(io.Discard, nil)),
+		getAccountUseCase:    fakeGetAccountUseCase{chain},
+		upsertAccountUseCase: fakeUpsertAccountUseCase{chain},
+		upsertTokenIfPreviousTokenNonceGTEUseCase: fakeUpsertTokenUseCase{chain},
+		deletePendingSignedTransactionUseCase:     fakeDeletePendingSignedTransactionUseCase{chain},
+		getAccountsHashStateUseCase:               fakeLocalAccountsHashStateUseCase{chain},
+		upsertBlockDataUseCase:                    fakeUpsertBlockDataUseCase{chain},
+		upsertBlockchainStateUseCase:              fakeUpsertBlockchainStateUseCase{chain},
+		upsertValidatorSetUseCase:                 fakeUpsertValidatorSetUseCase{chain},
+	}
+}
+
+func TestApplyBlockDataStateRootMismatchKeepsLocalState(t *testing.T) {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	receiver := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	beneficiary := common.HexToAddress("0x1000000000000000000000000000000000000003")
+
+	chain := newFakeLocalChain(1)
+	chain.setAccount(sender, 100, 0)
+	chain.setAccount(beneficiary, 0, 0)
+	s := newTestSyncService(chain)
+
+	blockData := &ccdomain.BlockData{
+		Hash: "0xblock1",
+		Header: &ccdomain.BlockHeader{
+			ChainID:        1,
+			NumberBytes:    big.NewInt(1).Bytes(),
+			TransactionFee: 1,
+			Beneficiary:    beneficiary,
+			Version:        signature.VersionCanonical,
+			StateRoot:      "0xnotthestateroot",
+		},
+		Trans: []ccdomain.BlockTransaction{
+			{SignedTransaction: ccdomain.SignedTransaction{Transaction: ccdomain.Transaction{
+				ChainID: 1, Type: ccdomain.TransactionTypeCoin, From: &sender, To: &receiver, Value: 10, NonceBytes: big.NewInt(1).Bytes(),
+			}}},
+			{SignedTransaction: ccdomain.SignedTransaction{Transaction: ccdomain.Transaction{
+				ChainID: 1, Type: ccdomain.TransactionTypeToken, From: &sender, To: &receiver, NonceBytes: big.NewInt(2).Bytes(),
+				TokenIDBytes: big.NewInt(7).Bytes(), TokenMetadataURI: "https://example.com/7", TokenNonceBytes: big.NewInt(1).Bytes(),
+			}}},
+		},
+	}
+	localBlockchainState := &domain.BlockchainState{ChainID: 1, LatestHash: "0xblock0"}
+
+	// A block whose state root does not match must leave everything as it was.
+	if err := s.applyBlockData(context.Background(), blockData, nil, localBlockchainState); !errors.Is(err, ccdomain.ErrBlockTampered) {
+		t.Fatalf("expected %v, got %v", ccdomain.ErrBlockTampered, err)
+	}
+	if chain.accounts[sender].Balance != 100 || chain.accounts[sender].GetNonce().Uint64() != 0 {
+		t.Fatalf("expected sender to be unchanged, got %+v", chain.accounts[sender])
+	}
+	if _, ok := chain.accounts[receiver]; ok {
+		t.Fatal("expected receiver to not be created")
+	}
+	if len(chain.tokens) != 0 || len(chain.blockDatas) != 0 || chain.blockchainState != nil {
+		t.Fatal("expected no token, block or blockchain state to be saved")
+	}
+	if localBlockchainState.LatestHash != "0xblock0" {
+		t.Fatalf("expected local blockchain state to not advance, got %v", localBlockchainState.LatestHash)
+	}
+
+	// The same block with the state root of its changes is saved.
+	expected := newFakeLocalChain(1)
+	expected.setAccount(sender, 90, 2)
+	expected.setAccount(receiver, 9, 0)
+	expected.setAccount(beneficiary, 1, 0)
+	stateRoot, err := ccdomain.HashAccountsStateTrie(expected.accountsList(nil), 1)
+	if err != nil {
+		t.Fatalf("failed hashing accounts: %v", err)
+	}
+	blockData.Header.StateRoot = stateRoot
+	if err := s.applyBlockData(context.Background(), blockData, nil, localBlockchainState); err != nil {
+		t.Fatalf("expected block to be applied, got %v", err)
+	}
+	if chain.accounts[receiver].Balance != 9 || chain.accounts[beneficiary].Balance != 1 {
+		t.Fatalf("unexpected balances, receiver %v, beneficiary %v", chain.accounts[receiver].Balance, chain.accounts[beneficiary].Balance)
+	}
+	if tok := chain.tokens["7"]; tok == nil || *tok.Owner != receiver {
+		t.Fatal("expected token to be owned by the receiver")
+	}
+	if localBlockchainState.LatestHash != blockData.Hash || chain.blockDatas[blockData.Hash] == nil {
+		t.Fatal("expected block to be saved and local blockchain state to advance")
+	}
+}
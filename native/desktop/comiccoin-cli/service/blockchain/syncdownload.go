@@ -0,0 +1,148 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"runtime"
+	"sync"
+
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+const (
+	// blockDataSyncWindowSize is the number of blocks requested from the
+	// Authority at once, this matches the maximum the Authority returns.
+	blockDataSyncWindowSize = 100
+
+	// blockDataSyncWindowBufferSize is the number of verified windows which
+	// can wait to be applied before the downloader pauses.
+	blockDataSyncWindowBufferSize = 2
+)
+
+// blockDataSyncWindow is a batch of consecutive verified blocks, or the error
+// which stopped the download.
+type blockDataSyncWindow struct {
+	blockDatas []*ccdomain.BlockData
+	err        error
+}
+
+// downloadAndVerifyBlockDataWindows downloads the blocks after
+// `previousBlockData` up to `latestNumber` from the Authority in windows,
+// verifies every window and sends it to `windows`. The channel is closed
+// when the download finished, failed or the context was cancelled.
+// `validators` is the validator set after `previousBlockData` was applied.
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) downloadAndVerifyBlockDataWindows(
+	ctx context.Context,
+	validators []*ccdomain.Validator,
+	previousBlockData *ccdomain.BlockData,
+	from *big.Int,
+	latestNumber *big.Int,
+	windows chan<- *blockDataSyncWindow,
+) {
+	defer close(windows)
+
+	send := func(window *blockDataSyncWindow) bool {
+		select {
+		case windows <- window:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	from = new(big.Int).Set(from)
+	for from.Cmp(latestNumber) <= 0 {
+		to := new(big.Int).Add(from, big.NewInt(blockDataSyncWindowSize-1))
+		if to.Cmp(latestNumber) > 0 {
+			to = new(big.Int).Set(latestNumber)
+		}
+
+		s.logger.Debug("Fetching block data window from global blockchain network...",
+			slog.String("from", from.String()),
+			slog.String("to", to.String()))
+
+		blockDataDTOs, err := s.listBlockDataDTOInRangeFromBlockchainAuthorityUseCase.Execute(ctx, from, to)
+		if err != nil {
+			send(&blockDataSyncWindow{err: err})
+			return
+		}
+		if len(blockDataDTOs) == 0 {
+			err := fmt.Errorf("Block data does not exist for header number: %v", from.String())
+			send(&blockDataSyncWindow{err: err})
+			return
+		}
+
+		// Convert from network transfer data-structure to our application data-structure.
+		blockDatas := make([]*ccdomain.BlockData, 0, len(blockDataDTOs))
+		for _, blockDataDTO := range blockDataDTOs {
+			blockDatas = append(blockDatas, ccdomain.BlockDataDTOToBlockData(blockDataDTO))
+		}
+
+		// Verify every block against its parent and the validator
+		// scheduled to seal it before any of their transactions are applied.
+		validators, err = verifyBlockDataWindow(blockDatas, previousBlockData, validators)
+		if err != nil {
+			send(&blockDataSyncWindow{err: err})
+			return
+		}
+
+		if !send(&blockDataSyncWindow{blockDatas: blockDatas}) {
+			return
+		}
+
+		// The Authority may return fewer blocks than we requested, so
+		// continue from the block after the last one we received.
+		previousBlockData = blockDatas[len(blockDatas)-1]
+		from = new(big.Int).Add(previousBlockData.Header.GetNumber(), big.NewInt(1))
+	}
+}
+
+// verifyBlockDataWindow verifies the consecutive blocks in parallel. Every
+// block is checked against the block before it in the window, the first
+// block is checked against `previousBlockData`. If more than one block fails
+// then the error of the earliest block is returned, otherwise the validator
+// set after the last block of the window is returned.
+func verifyBlockDataWindow(blockDatas []*ccdomain.BlockData, previousBlockData *ccdomain.BlockData, validators []*ccdomain.Validator) ([]*ccdomain.Validator, error) {
+	// DEVELOPERS NOTE:
+	// A `validator` transaction in a block changes the validator set from
+	// the following block onwards, so we compute the set every block must
+	// be verified against in order before verifying the blocks in parallel.
+	validatorSets := make([][]*ccdomain.Validator, len(blockDatas))
+	for i, blockData := range blockDatas {
+		validatorSets[i] = validators
+		next, err := ccdomain.ApplyBlockDataToValidatorSet(validators, blockData)
+		if err != nil {
+			return nil, fmt.Errorf("failed applying validator set changes of block %v: %w", blockData.Header.GetNumber().String(), err)
+		}
+		validators = next
+	}
+
+	errs := make([]error, len(blockDatas))
+	sem := make(chan struct{}, runtime.NumCPU())
+
+	var wg sync.WaitGroup
+	for i := range blockDatas {
+		parent := previousBlockData
+		if i > 0 {
+			parent = blockDatas[i-1]
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, blockData, parent *ccdomain.BlockData) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = ccdomain.ValidateBlockData(blockData, parent, validatorSets[i])
+		}(i, blockDatas[i], parent)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return validators, nil
+}
@@ -0,0 +1,282 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+// fakeBlockDataRangeUseCase serves the blocks of the Authority by header
+// number and records every requested range. Like the Authority it returns
+// at most `maxSize` blocks per request.
+type fakeBlockDataRangeUseCase struct {
+	blockDatas []*ccdomain.BlockData
+	maxSize    int64
+
+	mu       sync.Mutex
+	requests [][2]int64
+}
+
+func (uc *fakeBlockDataRangeUseCase) Execute(ctx context.Context, from, to *big.Int) ([]*ccdomain.BlockDataDTO, error) {
+	uc.mu.Lock()
+	uc.requests = append(uc.requests, [2]int64{from.Int64(), to.Int64()})
+	uc.mu.Unlock()
+
+	last := to.Int64()
+	if max := from.Int64() + uc.maxSize - 1; last > max {
+		last = max
+	}
+	var blockDataDTOs []*ccdomain.BlockDataDTO
+	for n := from.Int64(); n <= last && n < int64(len(uc.blockDatas)); n++ {
+		blockDataDTOs = append(blockDataDTOs, ccdomain.BlockDataToBlockDataDTO(uc.blockDatas[n]))
+	}
+	return blockDataDTOs, nil
+}
+
+func (uc *fakeBlockDataRangeUseCase) Requests() [][2]int64 {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return append([][2]int64(nil), uc.requests...)
+}
+
+// newTestSealedBlockchain returns the genesis block followed by `count`
+// blocks sealed by the validator, the same way the Authority does it.
+func newTestSealedBlockchain(t *testing.T, count int) ([]*ccdomain.BlockData, []*ccdomain.Validator) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed generating key: %v", err)
+	}
+	validator := &ccdomain.Validator{ID: "test", PublicKeyBytes: crypto.FromECDSAPub(&key.PublicKey)}
+
+	timeStamp := uint64(time.Now().Add(-time.Hour).UnixMilli())
+	blockDatas := []*ccdomain.BlockData{{
+		Hash:   "0x00000abc",
+		Header: &ccdomain.BlockHeader{ChainID: 1, NumberBytes: big.NewInt(0).Bytes(), TimeStamp: timeStamp},
+	}}
+	for i := 1; i <= count; i++ {
+		blockDatas = append(blockDatas, sealTestBlockData(t, key, validator, blockDatas[i-1], timeStamp+uint64(i)))
+	}
+	return blockDatas, []*ccdomain.Validator{validator}
+}
+
+func sealTestBlockData(t *testing.T, key *ecdsa.PrivateKey, validator *ccdomain.Validator, previousBlockData *ccdomain.BlockData, timeStamp uint64) *ccdomain.BlockData {
+	t.Helper()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	tx := ccdomain.Transaction{
+		ChainID:    1,
+		NonceBytes: previousBlockData.Header.NumberBytes,
+		From:       &from,
+		To:         &from,
+		Value:      1,
+		Type:       ccdomain.TransactionTypeCoin,
+		Version:    ccdomain.TransactionVersion,
+	}
+	stx, err := tx.Sign(key)
+	if err != nil {
+		t.Fatalf("failed signing transaction: %v", err)
+	}
+	trans := []ccdomain.BlockTransaction{{SignedTransaction: stx, TimeStamp: timeStamp}}
+	block, err := ccdomain.ToBlock(&ccdomain.BlockData{Trans: trans})
+	if err != nil {
+		t.Fatalf("failed creating block: %v", err)
+	}
+	block.Header = &ccdomain.BlockHeader{
+		ChainID:            1,
+		NumberBytes:        new(big.Int).Add(previousBlockData.Header.GetNumber(), big.NewInt(1)).Bytes(),
+		PrevBlockHash:      previousBlockData.Hash,
+		TimeStamp:          timeStamp,
+		Beneficiary:        from,
+		TransactionFee:     1,
+		TransRoot:          block.MerkleTree.RootHex(),
+		LatestTokenIDBytes: big.NewInt(0).Bytes(),
+		Version:            signature.VersionCanonical,
+	}
+	headerSig, err := validator.Sign(key, block.Header)
+	if err != nil {
+		t.Fatalf("failed signing header: %v", err)
+	}
+	return &ccdomain.BlockData{
+		Hash:                 block.Hash(),
+		Header:               block.Header,
+		HeaderSignatureBytes: headerSig,
+		Trans:                trans,
+		Validator:            validator,
+	}
+}
+
+// downloadTestWindows runs the download to the end and returns the windows.
+func downloadTestWindows(ctx context.Context, s *blockchainSyncWithBlockchainAuthorityServiceImpl, validators []*ccdomain.Validator, previousBlockData *ccdomain.BlockData, from, latestNumber int64) []*blockDataSyncWindow {
+	windows := make(chan *blockDataSyncWindow, blockDataSyncWindowBufferSize)
+	go s.downloadAndVerifyBlockDataWindows(ctx, validators, previousBlockData, big.NewInt(from), big.NewInt(latestNumber), windows)
+
+	var received []*blockDataSyncWindow
+	for window := range windows {
+		received = append(received, window)
+	}
+	return received
+}
+
+// requireConsecutiveWindows checks the windows hold every block from
+// `from` to `latestNumber` once and in order, and returns their sizes.
+func requireConsecutiveWindows(t *testing.T, windows []*blockDataSyncWindow, blockDatas []*ccdomain.BlockData, from, latestNumber int64) []int {
+	t.Helper()
+	var sizes []int
+	next := from
+	for _, window := range windows {
+		if window.err != nil {
+			t.Fatalf("failed downloading window: %v", window.err)
+		}
+		for _, blockData := range window.blockDatas {
+			if blockData.Hash != blockDatas[next].Hash {
+				t.Fatalf("expected block %d, got block %v", next, blockData.Header.GetNumber())
+			}
+			next++
+		}
+		sizes = append(sizes, len(window.blockDatas))
+	}
+	if next != latestNumber+1 {
+		t.Fatalf("expected blocks up to %d, got up to %d", latestNumber, next-1)
+	}
+	return sizes
+}
+
+func TestDownloadAndVerifyBlockDataWindows(t *testing.T) {
+	blockDatas, validators := newTestSealedBlockchain(t, 2*blockDataSyncWindowSize+50)
+	uc := &fakeBlockDataRangeUseCase{blockDatas: blockDatas, maxSize: blockDataSyncWindowSize}
+	s := newTestSyncService(newFakeLocalChain(1))
+	s.listBlockDataDTOInRangeFromBlockchainAuthorityUseCase = uc
+
+	latestNumber := int64(len(blockDatas) - 1)
+	windows := downloadTestWindows(context.Background(), s, validators, blockDatas[0], 1, latestNumber)
+
+	sizes := requireConsecutiveWindows(t, windows, blockDatas, 1, latestNumber)
+	if len(sizes) != 3 || sizes[0] != blockDataSyncWindowSize || sizes[1] != blockDataSyncWindowSize || sizes[2] != 50 {
+		t.Fatalf("unexpected window sizes: %v", sizes)
+	}
+	expected := [][2]int64{{1, 100}, {101, 200}, {201, 250}}
+	if requests := uc.Requests(); len(requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	} else {
+		for i := range expected {
+			if requests[i] != expected[i] {
+				t.Fatalf("expected requests %v, got %v", expected, requests)
+			}
+		}
+	}
+}
+
+func TestDownloadAndVerifyBlockDataWindowsClampedByAuthority(t *testing.T) {
+	// The Authority returns fewer blocks than requested, e.g. because its
+	// `BlockDataRangeMaxSize` is smaller than our window.
+	blockDatas, validators := newTestSealedBlockchain(t, 95)
+	uc := &fakeBlockDataRangeUseCase{blockDatas: blockDatas, maxSize: 30}
+	s := newTestSyncService(newFakeLocalChain(1))
+	s.listBlockDataDTOInRangeFromBlockchainAuthorityUseCase = uc
+
+	windows := downloadTestWindows(context.Background(), s, validators, blockDatas[0], 1, 95)
+
+	sizes := requireConsecutiveWindows(t, windows, blockDatas, 1, 95)
+	if len(sizes) != 4 || sizes[0] != 30 || sizes[3] != 5 {
+		t.Fatalf("unexpected window sizes: %v", sizes)
+	}
+	for i, request := range uc.Requests() {
+		if from := int64(1 + 30*i); request[0] != from {
+			t.Fatalf("expected request %d to continue from %d, got %v", i, from, request)
+		}
+	}
+}
+
+func TestDownloadAndVerifyBlockDataWindowsNothingToDownload(t *testing.T) {
+	blockDatas, validators := newTestSealedBlockchain(t, 5)
+	uc := &fakeBlockDataRangeUseCase{blockDatas: blockDatas, maxSize: blockDataSyncWindowSize}
+	s := newTestSyncService(newFakeLocalChain(1))
+	s.listBlockDataDTOInRangeFromBlockchainAuthorityUseCase = uc
+
+	// We already have the latest block of the Authority.
+	windows := downloadTestWindows(context.Background(), s, validators, blockDatas[5], 6, 5)
+
+	if len(windows) != 0 || len(uc.Requests()) != 0 {
+		t.Fatalf("expected nothing to be downloaded, got %d windows and requests %v", len(windows), uc.Requests())
+	}
+}
+
+func TestDownloadAndVerifyBlockDataWindowsStopsAtTamperedBlock(t *testing.T) {
+	blockDatas, validators := newTestSealedBlockchain(t, blockDataSyncWindowSize+10)
+	tampered := *blockDatas[blockDataSyncWindowSize+3]
+	tamperedHeader := *tampered.Header
+	tamperedHeader.TransactionFee = 0
+	tampered.Header = &tamperedHeader
+	blockDatas[blockDataSyncWindowSize+3] = &tampered
+
+	uc := &fakeBlockDataRangeUseCase{blockDatas: blockDatas, maxSize: blockDataSyncWindowSize}
+	s := newTestSyncService(newFakeLocalChain(1))
+	s.listBlockDataDTOInRangeFromBlockchainAuthorityUseCase = uc
+
+	windows := downloadTestWindows(context.Background(), s, validators, blockDatas[0], 1, int64(len(blockDatas)-1))
+
+	if len(windows) != 2 || windows[0].err != nil || len(windows[0].blockDatas) != blockDataSyncWindowSize {
+		t.Fatalf("expected the first window to be verified, got %d windows", len(windows))
+	}
+	if !errors.Is(windows[1].err, ccdomain.ErrBlockTampered) || windows[1].blockDatas != nil {
+		t.Fatalf("expected the window with the tampered block to fail with %v, got %v", ccdomain.ErrBlockTampered, windows[1].err)
+	}
+}
+
+func TestDownloadAndVerifyBlockDataWindowsBackpressure(t *testing.T) {
+	blockDatas, validators := newTestSealedBlockchain(t, 10)
+	uc := &fakeBlockDataRangeUseCase{blockDatas: blockDatas, maxSize: 1}
+	s := newTestSyncService(newFakeLocalChain(1))
+	s.listBlockDataDTOInRangeFromBlockchainAuthorityUseCase = uc
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	windows := make(chan *blockDataSyncWindow, blockDataSyncWindowBufferSize)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.downloadAndVerifyBlockDataWindows(ctx, validators, blockDatas[0], big.NewInt(1), big.NewInt(10), windows)
+	}()
+
+	// Nobody applies the windows, so the downloader must pause once the
+	// buffer is full and it holds one more window it cannot send.
+	expected := blockDataSyncWindowBufferSize + 1
+	deadline := time.Now().Add(5 * time.Second)
+	for len(uc.Requests()) < expected && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got := len(uc.Requests()); got != expected {
+		t.Fatalf("expected the downloader to pause after %d requests, got %d", expected, got)
+	}
+
+	// Applying one window lets the downloader fetch one more.
+	<-windows
+	deadline = time.Now().Add(5 * time.Second)
+	for len(uc.Requests()) < expected+1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got := len(uc.Requests()); got != expected+1 {
+		t.Fatalf("expected the downloader to pause after %d requests, got %d", expected+1, got)
+	}
+
+	// Cancelling stops the paused downloader and closes the channel.
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the downloader to stop when cancelled")
+	}
+	for range windows {
+	}
+}
@@ -0,0 +1,110 @@
+package blockchain
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+	"github.com/ethereum/go-ethereum/common"
+
+	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
+	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
+)
+
+// blockDataStateChanges holds the accounts and tokens changed by the
+// transactions of one block in memory. Nothing is saved to our local
+// database until `Commit` is called, which we only do once the state root
+// of the block matches, so a block which does not match leaves our local
+// accounts and tokens untouched.
+type blockDataStateChanges struct {
+	chainID                                   uint16
+	getAccountUseCase                         uc_account.GetAccountUseCase
+	upsertAccountUseCase                      uc_account.UpsertAccountUseCase
+	upsertTokenIfPreviousTokenNonceGTEUseCase uc_tok.UpsertTokenIfPreviousTokenNonceGTEUseCase
+
+	// The accounts are saved in the order they were first changed.
+	accounts     map[common.Address]*domain.Account
+	accountOrder []common.Address
+
+	tokens []*blockDataTokenChange
+}
+
+type blockDataTokenChange struct {
+	id          *big.Int
+	owner       *common.Address
+	metadataURI string
+	nonce       *big.Int
+}
+
+func (s *blockchainSyncWithBlockchainAuthorityServiceImpl) newBlockDataStateChanges(chainID uint16) *blockDataStateChanges {
+	return &blockDataStateChanges{
+		chainID:              chainID,
+		getAccountUseCase:    s.getAccountUseCase,
+		upsertAccountUseCase: s.upsertAccountUseCase,
+		upsertTokenIfPreviousTokenNonceGTEUseCase: s.upsertTokenIfPreviousTokenNonceGTEUseCase,
+		accounts: make(map[common.Address]*domain.Account),
+	}
+}
+
+// GetAccount returns a copy of the account as changed by the block so far,
+// or nil if the account does not exist.
+func (c *blockDataStateChanges) GetAccount(ctx context.Context, address *common.Address) (*domain.Account, error) {
+	if address == nil {
+		return nil, nil
+	}
+	acc, ok := c.accounts[*address]
+	if !ok {
+		return c.getAccountUseCase.Execute(ctx, address)
+	}
+	copied := *acc
+	return &copied, nil
+}
+
+// UpsertAccount records the change of the account without saving it.
+func (c *blockDataStateChanges) UpsertAccount(ctx context.Context, address *common.Address, balance uint64, nonce *big.Int) error {
+	if address == nil {
+		return httperror.NewForBadRequestWithSingleField("address", "missing value")
+	}
+	if _, ok := c.accounts[*address]; !ok {
+		c.accountOrder = append(c.accountOrder, *address)
+	}
+	addr := *address
+	c.accounts[addr] = &domain.Account{
+		ChainID:    c.chainID,
+		Address:    &addr,
+		NonceBytes: nonce.Bytes(),
+		Balance:    balance,
+	}
+	return nil
+}
+
+// UpsertTokenIfPreviousTokenNonceGTE records the change of the token without
+// saving it, see `UpsertTokenIfPreviousTokenNonceGTEUseCase`.
+func (c *blockDataStateChanges) UpsertTokenIfPreviousTokenNonceGTE(id *big.Int, owner *common.Address, metadataURI string, nonce *big.Int) {
+	c.tokens = append(c.tokens, &blockDataTokenChange{id, owner, metadataURI, nonce})
+}
+
+// Accounts returns the changed accounts.
+func (c *blockDataStateChanges) Accounts() []*domain.Account {
+	accounts := make([]*domain.Account, 0, len(c.accountOrder))
+	for _, address := range c.accountOrder {
+		accounts = append(accounts, c.accounts[address])
+	}
+	return accounts
+}
+
+// Commit saves the changed accounts and tokens to our local database.
+func (c *blockDataStateChanges) Commit(ctx context.Context) error {
+	for _, acc := range c.Accounts() {
+		if err := c.upsertAccountUseCase.Execute(ctx, acc.Address, acc.Balance, acc.GetNonce()); err != nil {
+			return err
+		}
+	}
+	for _, tok := range c.tokens {
+		if err := c.upsertTokenIfPreviousTokenNonceGTEUseCase.Execute(ctx, tok.id, tok.owner, tok.metadataURI, tok.nonce); err != nil {
+			return err
+		}
+	}
+	return nil
+}
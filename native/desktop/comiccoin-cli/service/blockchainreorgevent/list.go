@@ -0,0 +1,41 @@
+package blockchainreorgevent
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+	uc_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainreorgevent"
+)
+
+type ListBlockchainReorgEventsService interface {
+	Execute(ctx context.Context, chainID uint16) ([]*domain.BlockchainReorgEvent, error)
+}
+
+type listBlockchainReorgEventsServiceImpl struct {
+	logger                                    *slog.Logger
+	listBlockchainReorgEventsByChainIDUseCase uc_blockchainreorgevent.ListBlockchainReorgEventsByChainIDUseCase
+}
+
+func NewListBlockchainReorgEventsService(
+	logger *slog.Logger,
+	uc1 uc_blockchainreorgevent.ListBlockchainReorgEventsByChainIDUseCase,
+) ListBlockchainReorgEventsService {
+	return &listBlockchainReorgEventsServiceImpl{logger, uc1}
+}
+
+func (s *listBlockchainReorgEventsServiceImpl) Execute(ctx context.Context, chainID uint16) ([]*domain.BlockchainReorgEvent, error) {
+	if chainID == 0 {
+		return nil, httperror.NewForBadRequestWithSingleField("chain_id", "missing value")
+	}
+
+	events, err := s.listBlockchainReorgEventsByChainIDUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("failed listing blockchain reorg events",
+			slog.Any("error", err))
+		return nil, err
+	}
+	return events, nil
+}
@@ -6,8 +6,8 @@ import (
 	"strings"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
-	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
 
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 	uc_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdata"
 )
 
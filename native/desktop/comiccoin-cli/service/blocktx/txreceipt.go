@@ -0,0 +1,59 @@
+package blocktx
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+	uc_blocktx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blocktx"
+)
+
+type GetTransactionReceiptService interface {
+	Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error)
+}
+
+type getTransactionReceiptServiceImpl struct {
+	logger                                              *slog.Logger
+	getTransactionReceiptFromBlockchainAuthorityUseCase uc_blocktx.GetTransactionReceiptFromBlockchainAuthorityUseCase
+}
+
+func NewGetTransactionReceiptService(
+	logger *slog.Logger,
+	uc1 uc_blocktx.GetTransactionReceiptFromBlockchainAuthorityUseCase,
+) GetTransactionReceiptService {
+	return &getTransactionReceiptServiceImpl{logger, uc1}
+}
+
+func (s *getTransactionReceiptServiceImpl) Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if hash == "" {
+		e["hash"] = "missing value"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from the Authority.
+	//
+
+	receipt, err := s.getTransactionReceiptFromBlockchainAuthorityUseCase.Execute(ctx, hash)
+	if err != nil {
+		s.logger.Error("failed getting transaction receipt",
+			slog.Any("hash", hash),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, httperror.NewForNotFoundWithSingleField("hash", "transaction receipt does not exist")
+	}
+	return receipt, nil
+}
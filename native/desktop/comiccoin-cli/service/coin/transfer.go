@@ -6,18 +6,16 @@ import (
 	"log"
 	"log/slog"
 	"math/big"
-	"time"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
 	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
-	auth_domain "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
-	uc_mempooltxdto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/mempooltxdto"
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_mempooltxdto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/mempooltxdto"
 	uc_pstx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/pstx"
 	uc_storagetransaction "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/storagetransaction"
 	uc_wallet "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/wallet"
@@ -212,14 +210,21 @@ func (s *coinTransferServiceImpl) Execute(
 	// Create our pending transaction and sign it with the accounts private key.
 	//
 
-	tx := &auth_domain.Transaction{
+	// DEVELOPERS NOTE:
+	// The authority only accepts a transaction if its nonce is exactly one
+	// more then the nonce of the sending account.
+	txNonce := account.GetNonce()
+	txNonce.Add(txNonce, big.NewInt(1))
+
+	tx := &domain.Transaction{
 		ChainID:    chainID,
-		NonceBytes: big.NewInt(time.Now().Unix()).Bytes(),
+		NonceBytes: txNonce.Bytes(),
 		From:       fromAccountAddress,
 		To:         to,
 		Value:      (value + txFee),
 		Data:       data,
-		Type:       auth_domain.TransactionTypeCoin,
+		Type:       domain.TransactionTypeCoin,
+		Version:    domain.TransactionVersion,
 	}
 
 	stx, signingErr := tx.Sign(privateKey)
@@ -270,7 +275,7 @@ func (s *coinTransferServiceImpl) Execute(
 	// STEP 6: Submit to ComicCoin Authority to execute
 	//
 
-	mempoolTx := &auth_domain.MempoolTransaction{
+	mempoolTx := &domain.MempoolTransaction{
 		ID:                primitive.NewObjectID(),
 		SignedTransaction: stx,
 	}
@@ -6,14 +6,14 @@ import (
 	"log/slog"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
-	authority_domain "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
-	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/genesisblockdatadto"
 
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdatadto"
 )
 
 type GenesisBlockDataGetOrSyncService interface {
-	Execute(ctx context.Context, chainID uint16) (*authority_domain.GenesisBlockData, error)
+	Execute(ctx context.Context, chainID uint16) (*domain.GenesisBlockData, error)
 }
 
 type genesisBlockDataGetOrSyncServiceImpl struct {
@@ -33,7 +33,7 @@ func NewGenesisBlockDataGetOrSyncService(
 }
 
 // Execute method gets genesis block data from authority if we don't have it locally, else gets genesis from local source.
-func (s *genesisBlockDataGetOrSyncServiceImpl) Execute(ctx context.Context, chainID uint16) (*authority_domain.GenesisBlockData, error) {
+func (s *genesisBlockDataGetOrSyncServiceImpl) Execute(ctx context.Context, chainID uint16) (*domain.GenesisBlockData, error) {
 	//
 	// STEP 1: Validation.
 	//
@@ -86,7 +86,7 @@ func (s *genesisBlockDataGetOrSyncServiceImpl) Execute(ctx context.Context, chai
 	}
 
 	// Convert from network format data to our local format.
-	genesis = authority_domain.GenesisBlockDataDTOToGenesisBlockData(genesisDTO)
+	genesis = domain.GenesisBlockDataDTOToGenesisBlockData(genesisDTO)
 
 	// Save the genesis block data to local database.
 	if err := s.upsertGenesisBlockDataUseCase.Execute(ctx, genesis.Hash, genesis.Header, genesis.HeaderSignatureBytes, genesis.Trans, genesis.Validator); err != nil {
@@ -7,18 +7,16 @@ import (
 	"log/slog"
 	"math/big"
 	"strings"
-	"time"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
 	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
-	auth_domain "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
-	uc_mempooltxdto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/mempooltxdto"
 	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_mempooltxdto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/mempooltxdto"
 	uc_pstx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/pstx"
 	uc_storagetransaction "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/storagetransaction"
 	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
@@ -231,17 +229,24 @@ func (s *tokenBurnServiceImpl) Execute(
 	// Burn an NFT by setting its owner to the burn address
 	burnAddress := common.HexToAddress("0x0000000000000000000000000000000000000000")
 
-	tx := &auth_domain.Transaction{
+	// DEVELOPERS NOTE:
+	// The authority only accepts a transaction if its nonce is exactly one
+	// more then the nonce of the sending account.
+	txNonce := account.GetNonce()
+	txNonce.Add(txNonce, big.NewInt(1))
+
+	tx := &domain.Transaction{
 		ChainID:          chainID,
-		NonceBytes:       big.NewInt(time.Now().Unix()).Bytes(),
+		NonceBytes:       txNonce.Bytes(),
 		From:             fromAccountAddress,
 		To:               &burnAddress,
 		Value:            txFee, // Users pay transaction fee for transfering NFTs.
 		Data:             []byte{},
-		Type:             auth_domain.TransactionTypeToken,
+		Type:             domain.TransactionTypeToken,
 		TokenIDBytes:     tok.IDBytes,
 		TokenMetadataURI: tok.MetadataURI,
 		TokenNonceBytes:  tok.NonceBytes,
+		Version:          domain.TransactionVersion,
 	}
 
 	stx, signingErr := tx.Sign(privateKey)
@@ -276,7 +281,7 @@ func (s *tokenBurnServiceImpl) Execute(
 		slog.Any("tx_sig_s_bytes", stx.SBytes),
 		slog.Any("tx_nonce", stx.GetNonce()))
 
-	mempoolTx := &auth_domain.MempoolTransaction{
+	mempoolTx := &domain.MempoolTransaction{
 		ID:                primitive.NewObjectID(),
 		SignedTransaction: stx,
 	}
@@ -0,0 +1,379 @@
+package tok
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
+	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_mempooltxdto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/mempooltxdto"
+	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
+	uc_wallet "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/wallet"
+	uc_walletutil "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/walletutil"
+)
+
+// TokenSwapOfferService creates the offer of the seller to swap their token
+// for coins of the buyer. The offer is only signed by the seller and is not
+// submitted, it must be handed to the buyer who accepts it with the
+// `TokenSwapAcceptService`.
+type TokenSwapOfferService interface {
+	Execute(
+		ctx context.Context,
+		chainID uint16,
+		sellerAccountAddress *common.Address,
+		accountWalletPassword *sstring.SecureString,
+		buyer *common.Address,
+		tokenID *big.Int,
+		price uint64,
+	) (*domain.SignedTransaction, error)
+}
+
+type tokenSwapOfferServiceImpl struct {
+	logger                               *slog.Logger
+	getGenesisBlockDataUseCase           uc_genesisblockdata.GetGenesisBlockDataUseCase
+	getAccountUseCase                    uc_account.GetAccountUseCase
+	getWalletUseCase                     uc_wallet.GetWalletUseCase
+	mnemonicFromEncryptedHDWalletUseCase uc_walletutil.MnemonicFromEncryptedHDWalletUseCase
+	privateKeyFromHDWalletUseCase        uc_walletutil.PrivateKeyFromHDWalletUseCase
+	getTokenUseCase                      uc_tok.GetTokenUseCase
+}
+
+func NewTokenSwapOfferService(
+	logger *slog.Logger,
+	uc1 uc_genesisblockdata.GetGenesisBlockDataUseCase,
+	uc2 uc_account.GetAccountUseCase,
+	uc3 uc_wallet.GetWalletUseCase,
+	uc4 uc_walletutil.MnemonicFromEncryptedHDWalletUseCase,
+	uc5 uc_walletutil.PrivateKeyFromHDWalletUseCase,
+	uc6 uc_tok.GetTokenUseCase,
+) TokenSwapOfferService {
+	return &tokenSwapOfferServiceImpl{logger, uc1, uc2, uc3, uc4, uc5, uc6}
+}
+
+func (s *tokenSwapOfferServiceImpl) Execute(
+	ctx context.Context,
+	chainID uint16,
+	sellerAccountAddress *common.Address,
+	accountWalletPassword *sstring.SecureString,
+	buyer *common.Address,
+	tokenID *big.Int,
+	price uint64,
+) (*domain.SignedTransaction, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if sellerAccountAddress == nil {
+		e["seller_account_address"] = "missing value"
+	}
+	if accountWalletPassword == nil {
+		e["account_wallet_password"] = "missing value"
+	}
+	if buyer == nil {
+		e["buyer"] = "missing value"
+	}
+	if tokenID == nil {
+		e["token_id"] = "missing value"
+	}
+	if price == 0 {
+		e["price"] = "missing value"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating create swap offer parameters",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get related records.
+	//
+
+	genesis, err := s.getGenesisBlockDataUseCase.Execute(ctx, chainID)
+	if err != nil {
+		s.logger.Error("failed getting genesis from database",
+			slog.Any("chain_id", chainID),
+			slog.Any("error", err))
+		return nil, err
+	}
+	if genesis == nil {
+		return nil, fmt.Errorf("failed getting genesis block from database: %s", "genesis d.n.e.")
+	}
+
+	account, err := s.getAccountUseCase.Execute(ctx, sellerAccountAddress)
+	if err != nil {
+		s.logger.Error("failed getting account",
+			slog.Any("seller_account_address", sellerAccountAddress),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("failed getting account: %s", err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("failed getting account: %s", "d.n.e.")
+	}
+
+	tok, err := s.getTokenUseCase.Execute(ctx, tokenID)
+	if err != nil {
+		s.logger.Error("failed getting token from database",
+			slog.Any("token_id", tokenID),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("failed getting token from database: %s", err)
+	}
+	if tok == nil {
+		return nil, fmt.Errorf("failed getting token from database: %s", "token d.n.e.")
+	}
+	if strings.ToLower(account.Address.Hex()) != strings.ToLower(tok.Owner.Hex()) {
+		return nil, fmt.Errorf("you do not own the token: %v", strings.ToLower(tok.Owner.Hex()))
+	}
+
+	privateKey, err := privateKeyFromEncryptedWallet(ctx, s.getWalletUseCase, s.mnemonicFromEncryptedHDWalletUseCase, s.privateKeyFromHDWalletUseCase, sellerAccountAddress, accountWalletPassword)
+	if err != nil {
+		s.logger.Error("failed getting wallet private key",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 3: Create the swap and sign it as the seller.
+	//
+
+	// DEVELOPERS NOTE:
+	// The authority only accepts a transaction if its nonce is exactly one
+	// more then the nonce of the sending account, and a swap only moves the
+	// token if the token nonce increases.
+	txNonce := account.GetNonce()
+	txNonce.Add(txNonce, big.NewInt(1))
+	tokNonce := tok.GetNonce()
+	tokNonce.Add(tokNonce, big.NewInt(1))
+
+	tx := &domain.Transaction{
+		ChainID:          chainID,
+		NonceBytes:       txNonce.Bytes(),
+		From:             sellerAccountAddress,
+		To:               buyer,
+		Value:            price + genesis.Header.TransactionFee, // Buyer pays the transaction fee on top of the price.
+		Data:             []byte{},
+		Type:             domain.TransactionTypeSwap,
+		TokenIDBytes:     tok.IDBytes,
+		TokenMetadataURI: tok.MetadataURI,
+		TokenNonceBytes:  tokNonce.Bytes(),
+		Version:          domain.TransactionVersion,
+	}
+	offer, err := tx.Sign(privateKey)
+	if err != nil {
+		s.logger.Debug("Failed to sign the swap offer",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	// Defensive Coding.
+	if err := offer.ValidateOffer(chainID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Swap offer signed",
+		slog.Any("tx_nonce", offer.GetNonce()),
+		slog.Any("token_id", tokenID),
+		slog.Any("buyer", buyer),
+		slog.Any("price", price))
+
+	return &offer, nil
+}
+
+// TokenSwapAcceptService co-signs the swap offer of a seller as the buyer and
+// submits the swap to the authority's mempool.
+type TokenSwapAcceptService interface {
+	Execute(
+		ctx context.Context,
+		chainID uint16,
+		buyerAccountAddress *common.Address,
+		accountWalletPassword *sstring.SecureString,
+		offer *domain.SignedTransaction,
+	) error
+}
+
+type tokenSwapAcceptServiceImpl struct {
+	logger                                                  *slog.Logger
+	getAccountUseCase                                       uc_account.GetAccountUseCase
+	getWalletUseCase                                        uc_wallet.GetWalletUseCase
+	mnemonicFromEncryptedHDWalletUseCase                    uc_walletutil.MnemonicFromEncryptedHDWalletUseCase
+	privateKeyFromHDWalletUseCase                           uc_walletutil.PrivateKeyFromHDWalletUseCase
+	getTokenUseCase                                         uc_tok.GetTokenUseCase
+	submitMempoolTransactionDTOToBlockchainAuthorityUseCase uc_mempooltxdto.SubmitMempoolTransactionDTOToBlockchainAuthorityUseCase
+}
+
+func NewTokenSwapAcceptService(
+	logger *slog.Logger,
+	uc1 uc_account.GetAccountUseCase,
+	uc2 uc_wallet.GetWalletUseCase,
+	uc3 uc_walletutil.MnemonicFromEncryptedHDWalletUseCase,
+	uc4 uc_walletutil.PrivateKeyFromHDWalletUseCase,
+	uc5 uc_tok.GetTokenUseCase,
+	uc6 uc_mempooltxdto.SubmitMempoolTransactionDTOToBlockchainAuthorityUseCase,
+) TokenSwapAcceptService {
+	return &tokenSwapAcceptServiceImpl{logger, uc1, uc2, uc3, uc4, uc5, uc6}
+}
+
+func (s *tokenSwapAcceptServiceImpl) Execute(
+	ctx context.Context,
+	chainID uint16,
+	buyerAccountAddress *common.Address,
+	accountWalletPassword *sstring.SecureString,
+	offer *domain.SignedTransaction,
+) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if buyerAccountAddress == nil {
+		e["buyer_account_address"] = "missing value"
+	}
+	if accountWalletPassword == nil {
+		e["account_wallet_password"] = "missing value"
+	}
+	if offer == nil {
+		e["offer"] = "missing value"
+	} else {
+		if err := offer.ValidateOffer(chainID); err != nil {
+			e["offer"] = err.Error()
+		} else if strings.ToLower(offer.To.Hex()) != strings.ToLower(buyerAccountAddress.Hex()) {
+			e["offer"] = fmt.Sprintf("offer is for buyer %v", offer.To.Hex())
+		}
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating accept swap offer parameters",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Verify against our local blockchain that the seller still owns the
+	// token and we can afford it.
+	//
+
+	tok, err := s.getTokenUseCase.Execute(ctx, offer.GetTokenID())
+	if err != nil {
+		return fmt.Errorf("failed getting token from database: %s", err)
+	}
+	if tok == nil {
+		return fmt.Errorf("failed getting token from database: %s", "token d.n.e.")
+	}
+	if strings.ToLower(offer.From.Hex()) != strings.ToLower(tok.Owner.Hex()) {
+		return fmt.Errorf("seller does not own the token: %v", strings.ToLower(tok.Owner.Hex()))
+	}
+	if offer.GetTokenNonce().Cmp(tok.GetNonce()) <= 0 {
+		return fmt.Errorf("offer is for an older token nonce: %v", offer.GetTokenNonce())
+	}
+	if offer.TokenMetadataURI != tok.MetadataURI {
+		return fmt.Errorf("offer changes the token metadata uri to: %v", offer.TokenMetadataURI)
+	}
+
+	account, err := s.getAccountUseCase.Execute(ctx, buyerAccountAddress)
+	if err != nil {
+		return fmt.Errorf("failed getting account: %s", err)
+	}
+	if account == nil {
+		return fmt.Errorf("failed getting account: %s", "d.n.e.")
+	}
+	if debit, _, _ := offer.SplitFees(0); account.Balance < debit {
+		s.logger.Warn("insufficient balance in account",
+			slog.Any("account_addr", buyerAccountAddress),
+			slog.Any("account_balance", account.Balance),
+			slog.Any("total", debit))
+		return fmt.Errorf("insufficient balance: %d", account.Balance)
+	}
+
+	//
+	// STEP 3: Co-sign the swap as the buyer.
+	//
+
+	privateKey, err := privateKeyFromEncryptedWallet(ctx, s.getWalletUseCase, s.mnemonicFromEncryptedHDWalletUseCase, s.privateKeyFromHDWalletUseCase, buyerAccountAddress, accountWalletPassword)
+	if err != nil {
+		s.logger.Error("failed getting wallet private key",
+			slog.Any("error", err))
+		return err
+	}
+	stx, err := offer.CoSign(privateKey)
+	if err != nil {
+		s.logger.Debug("Failed to co-sign the swap",
+			slog.Any("error", err))
+		return err
+	}
+
+	mempoolTx := &domain.MempoolTransaction{
+		ID:                primitive.NewObjectID(),
+		SignedTransaction: stx,
+	}
+
+	// Defensive Coding.
+	if err := mempoolTx.Validate(chainID, true); err != nil {
+		s.logger.Debug("Failed to validate signatures of the swap",
+			slog.Any("error", err))
+		return err
+	}
+
+	//
+	// STEP 4
+	// Send the swap to the authority's mempool to wait in a queue to be
+	// processed.
+	//
+
+	// DEVELOPERS NOTE:
+	// The swap uses the nonce of the seller therefore it is not saved as
+	// one of our pending signed transactions.
+	if err := s.submitMempoolTransactionDTOToBlockchainAuthorityUseCase.Execute(ctx, mempoolTx.ToDTO()); err != nil {
+		s.logger.Error("Failed to broadcast to the blockchain authority",
+			slog.Any("error", err))
+		return err
+	}
+
+	s.logger.Info("Swap submitted to the blockchain authority",
+		slog.Any("tx_nonce", stx.GetNonce()),
+		slog.Any("token_id", stx.GetTokenID()),
+		slog.Any("seller", stx.From),
+		slog.Any("buyer", stx.To))
+
+	return nil
+}
+
+// privateKeyFromEncryptedWallet decrypts the wallet of the account and
+// returns its private key.
+func privateKeyFromEncryptedWallet(
+	ctx context.Context,
+	getWalletUseCase uc_wallet.GetWalletUseCase,
+	mnemonicFromEncryptedHDWalletUseCase uc_walletutil.MnemonicFromEncryptedHDWalletUseCase,
+	privateKeyFromHDWalletUseCase uc_walletutil.PrivateKeyFromHDWalletUseCase,
+	accountAddress *common.Address,
+	accountWalletPassword *sstring.SecureString,
+) (*ecdsa.PrivateKey, error) {
+	encryptedWallet, err := getWalletUseCase.Execute(ctx, accountAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting encrypted wallet: %s", err)
+	}
+	if encryptedWallet == nil {
+		return nil, fmt.Errorf("failed getting encrypted wallet: %s", "d.n.e.")
+	}
+	mnemonic, path, err := mnemonicFromEncryptedHDWalletUseCase.Execute(ctx, encryptedWallet.KeystoreBytes, accountWalletPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting wallet and getting mnemonic: %s", err)
+	}
+	privateKey, err := privateKeyFromHDWalletUseCase.Execute(ctx, mnemonic, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting wallet private key: %s", err)
+	}
+	if privateKey == nil {
+		return nil, fmt.Errorf("failed getting wallet private key: %s", "d.n.e.")
+	}
+	return privateKey, nil
+}
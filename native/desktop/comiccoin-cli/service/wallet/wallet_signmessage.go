@@ -0,0 +1,118 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+	sstring "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/security/securestring"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/common/blockchain/signature"
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+	uc_wallet "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/wallet"
+	uc_walletutil "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/walletutil"
+)
+
+// SignMessageService signs a message with the private key of a local wallet
+// so third parties can verify we control the wallet address.
+type SignMessageService interface {
+	// Execute returns the hex signature of the message, see
+	// `signature.SignatureString`.
+	Execute(ctx context.Context, address *common.Address, walletPassword *sstring.SecureString, message string) (string, error)
+}
+
+type signMessageServiceImpl struct {
+	logger                               *slog.Logger
+	getWalletUseCase                     uc_wallet.GetWalletUseCase
+	mnemonicFromEncryptedHDWalletUseCase uc_walletutil.MnemonicFromEncryptedHDWalletUseCase
+	privateKeyFromHDWalletUseCase        uc_walletutil.PrivateKeyFromHDWalletUseCase
+}
+
+func NewSignMessageService(
+	logger *slog.Logger,
+	uc1 uc_wallet.GetWalletUseCase,
+	uc2 uc_walletutil.MnemonicFromEncryptedHDWalletUseCase,
+	uc3 uc_walletutil.PrivateKeyFromHDWalletUseCase,
+) SignMessageService {
+	return &signMessageServiceImpl{logger, uc1, uc2, uc3}
+}
+
+func (s *signMessageServiceImpl) Execute(ctx context.Context, address *common.Address, walletPassword *sstring.SecureString, message string) (string, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if address == nil {
+		e["address"] = "missing value"
+	}
+	if walletPassword == nil {
+		e["wallet_password"] = "missing value"
+	}
+	if strings.TrimSpace(message) == "" {
+		e["message"] = "missing value"
+	}
+	if len(e) != 0 {
+		s.logger.Warn("Failed validating sign message parameters",
+			slog.Any("error", e))
+		return "", httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Decrypt the wallet.
+	//
+
+	encryptedWallet, err := s.getWalletUseCase.Execute(ctx, address)
+	if err != nil {
+		s.logger.Error("failed getting encrypted wallet",
+			slog.Any("error", err))
+		return "", fmt.Errorf("failed getting encrypted wallet: %s", err)
+	}
+	if encryptedWallet == nil {
+		return "", fmt.Errorf("wallet does not exist for address: %v", address.Hex())
+	}
+
+	mnemonic, path, err := s.mnemonicFromEncryptedHDWalletUseCase.Execute(ctx, encryptedWallet.KeystoreBytes, walletPassword)
+	if err != nil {
+		s.logger.Error("failed decrypting wallet and getting mnemonic",
+			slog.Any("error", err))
+		return "", fmt.Errorf("failed decrypting wallet and getting mnemonic: %s", err)
+	}
+
+	privateKey, err := s.privateKeyFromHDWalletUseCase.Execute(ctx, mnemonic, path)
+	if err != nil {
+		s.logger.Error("failed getting wallet private key",
+			slog.Any("error", err))
+		return "", fmt.Errorf("failed getting wallet private key: %s", err)
+	}
+	if privateKey == nil {
+		return "", fmt.Errorf("failed getting wallet private key: %s", "d.n.e.")
+	}
+
+	//
+	// STEP 3:
+	// Sign the message and make sure the signature recovers our address.
+	//
+
+	value := domain.WalletSignedMessage{Message: message}
+	v, r, ss, err := signature.Sign(value, privateKey)
+	if err != nil {
+		s.logger.Error("failed signing message",
+			slog.Any("error", err))
+		return "", err
+	}
+	addressFromSig, err := signature.FromAddress(value, v, r, ss)
+	if err != nil {
+		s.logger.Error("failed getting from address",
+			slog.Any("error", err))
+		return "", err
+	}
+	if !strings.EqualFold(addressFromSig, address.Hex()) {
+		return "", fmt.Errorf("address from signature at %v does not match the wallet address of %v", addressFromSig, address.Hex())
+	}
+
+	return signature.SignatureString(v, r, ss), nil
+}
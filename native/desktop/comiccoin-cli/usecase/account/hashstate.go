@@ -5,10 +5,14 @@ import (
 	"log/slog"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+	"github.com/ethereum/go-ethereum/common"
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 )
 
 type GetAccountsHashStateUseCase interface {
 	Execute(ctx context.Context, chainID uint16) (string, error)
+	ExecuteWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error)
+	ExecuteLegacyWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error)
 }
 
 type getAccountsHashStateUseCaseImpl struct {
@@ -23,3 +27,48 @@ func NewGetAccountsHashStateUseCase(logger *slog.Logger, repo domain.AccountRepo
 func (uc *getAccountsHashStateUseCaseImpl) Execute(ctx context.Context, chainID uint16) (string, error) {
 	return uc.repo.HashStateByChainID(ctx, chainID)
 }
+
+// ExecuteWithChanges returns the hash of the accounts as if the `changed`
+// accounts were saved, so a block can be checked before it is saved.
+func (uc *getAccountsHashStateUseCaseImpl) ExecuteWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	accounts, err := uc.listWithChanges(ctx, chainID, changed)
+	if err != nil {
+		return "", err
+	}
+	return ccdomain.HashAccountsStateTrie(accounts, chainID)
+}
+
+// ExecuteLegacyWithChanges returns the hash of the accounts the way the
+// Authority did before the accounts trie, see
+// `ccdomain.HashAccountsStateLegacy`, as if the `changed` accounts were saved.
+func (uc *getAccountsHashStateUseCaseImpl) ExecuteLegacyWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) (string, error) {
+	accounts, err := uc.listWithChanges(ctx, chainID, changed)
+	if err != nil {
+		return "", err
+	}
+	return ccdomain.HashAccountsStateLegacy(accounts, chainID)
+}
+
+// listWithChanges returns our local accounts with the `changed` accounts
+// replacing or adding to them.
+func (uc *getAccountsHashStateUseCaseImpl) listWithChanges(ctx context.Context, chainID uint16, changed []*domain.Account) ([]*domain.Account, error) {
+	accounts, err := uc.repo.ListByChainID(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) == 0 {
+		return accounts, nil
+	}
+	byAddress := make(map[common.Address]*domain.Account, len(changed))
+	for _, acc := range changed {
+		byAddress[*acc.Address] = acc
+	}
+	result := make([]*domain.Account, 0, len(accounts)+len(changed))
+	for _, acc := range accounts {
+		if _, ok := byAddress[*acc.Address]; ok {
+			continue
+		}
+		result = append(result, acc)
+	}
+	return append(result, changed...), nil
+}
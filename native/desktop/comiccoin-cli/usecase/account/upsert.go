@@ -15,12 +15,16 @@ type UpsertAccountUseCase interface {
 }
 
 type upsertAccountUseCaseImpl struct {
-	logger *slog.Logger
-	repo   domain.AccountRepository
+	logger  *slog.Logger
+	chainID uint16
+	repo    domain.AccountRepository
 }
 
-func NewUpsertAccountUseCase(logger *slog.Logger, repo domain.AccountRepository) UpsertAccountUseCase {
-	return &upsertAccountUseCaseImpl{logger, repo}
+// NewUpsertAccountUseCase constructor takes the `chainID` the accounts belong
+// to, this is required so the hash state of our local accounts matches the
+// state root computed by the Authority.
+func NewUpsertAccountUseCase(logger *slog.Logger, chainID uint16, repo domain.AccountRepository) UpsertAccountUseCase {
+	return &upsertAccountUseCaseImpl{logger, chainID, repo}
 }
 
 func (uc *upsertAccountUseCaseImpl) Execute(ctx context.Context, address *common.Address, balance uint64, nonce *big.Int) error {
@@ -43,6 +47,7 @@ func (uc *upsertAccountUseCaseImpl) Execute(ctx context.Context, address *common
 	//
 
 	account := &domain.Account{
+		ChainID:    uc.chainID,
 		Address:    address,
 		NonceBytes: nonce.Bytes(),
 		Balance:    balance,
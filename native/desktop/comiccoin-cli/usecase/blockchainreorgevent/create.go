@@ -0,0 +1,58 @@
+package blockchainreorgevent
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type CreateBlockchainReorgEventUseCase interface {
+	Execute(ctx context.Context, e *domain.BlockchainReorgEvent) error
+}
+
+type createBlockchainReorgEventUseCaseImpl struct {
+	logger *slog.Logger
+	repo   domain.BlockchainReorgEventRepository
+}
+
+func NewCreateBlockchainReorgEventUseCase(logger *slog.Logger, repo domain.BlockchainReorgEventRepository) CreateBlockchainReorgEventUseCase {
+	return &createBlockchainReorgEventUseCaseImpl{logger, repo}
+}
+
+func (uc *createBlockchainReorgEventUseCaseImpl) Execute(ctx context.Context, e *domain.BlockchainReorgEvent) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	errs := make(map[string]string)
+	if e == nil {
+		errs["event"] = "missing value"
+	} else {
+		if e.ChainID == 0 {
+			errs["chain_id"] = "missing value"
+		}
+		if e.CommonAncestorHash == "" {
+			errs["common_ancestor_hash"] = "missing value"
+		}
+		if e.PreviousLatestHash == "" {
+			errs["previous_latest_hash"] = "missing value"
+		}
+		if e.CreatedAt.IsZero() {
+			errs["created_at"] = "missing value"
+		}
+	}
+	if len(errs) != 0 {
+		uc.logger.Warn("Validation failed for creating blockchain reorg event",
+			slog.Any("error", errs))
+		return httperror.NewForBadRequest(&errs)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Upsert(ctx, e)
+}
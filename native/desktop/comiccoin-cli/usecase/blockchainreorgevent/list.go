@@ -0,0 +1,38 @@
+package blockchainreorgevent
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type ListBlockchainReorgEventsByChainIDUseCase interface {
+	Execute(ctx context.Context, chainID uint16) ([]*domain.BlockchainReorgEvent, error)
+}
+
+type listBlockchainReorgEventsByChainIDUseCaseImpl struct {
+	logger *slog.Logger
+	repo   domain.BlockchainReorgEventRepository
+}
+
+func NewListBlockchainReorgEventsByChainIDUseCase(logger *slog.Logger, repo domain.BlockchainReorgEventRepository) ListBlockchainReorgEventsByChainIDUseCase {
+	return &listBlockchainReorgEventsByChainIDUseCaseImpl{logger, repo}
+}
+
+func (uc *listBlockchainReorgEventsByChainIDUseCaseImpl) Execute(ctx context.Context, chainID uint16) ([]*domain.BlockchainReorgEvent, error) {
+	events, err := uc.repo.ListByChainID(ctx, chainID)
+	if err != nil {
+		uc.logger.Error("failed listing blockchain reorg events",
+			slog.Any("chain_id", chainID),
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	// Most recent reorganization first.
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].CreatedAt.After(events[j].CreatedAt)
+	})
+	return events, nil
+}
@@ -0,0 +1,30 @@
+package blockdata
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type DeleteBlockDataUseCase interface {
+	Execute(ctx context.Context, hash string) error
+}
+
+type deleteBlockDataUseCaseImpl struct {
+	logger *slog.Logger
+	repo   domain.BlockDataRepository
+}
+
+func NewDeleteBlockDataUseCase(logger *slog.Logger, repo domain.BlockDataRepository) DeleteBlockDataUseCase {
+	return &deleteBlockDataUseCaseImpl{logger, repo}
+}
+
+func (uc *deleteBlockDataUseCaseImpl) Execute(ctx context.Context, hash string) error {
+	if hash == "" {
+		return httperror.NewForBadRequestWithSingleField("hash", "missing value")
+	}
+	return uc.repo.DeleteByHash(ctx, hash)
+}
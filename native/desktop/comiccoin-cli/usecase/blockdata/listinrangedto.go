@@ -0,0 +1,54 @@
+package blockdata
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type ListBlockDataDTOInRangeFromBlockchainAuthorityUseCase interface {
+	Execute(ctx context.Context, from, to *big.Int) ([]*ccdomain.BlockDataDTO, error)
+}
+
+type listBlockDataDTOInRangeFromBlockchainAuthorityUseCaseImpl struct {
+	logger *slog.Logger
+	repo   ccdomain.BlockDataRangeDTORepository
+}
+
+func NewListBlockDataDTOInRangeFromBlockchainAuthorityUseCase(
+	logger *slog.Logger,
+	repo ccdomain.BlockDataRangeDTORepository,
+) ListBlockDataDTOInRangeFromBlockchainAuthorityUseCase {
+	return &listBlockDataDTOInRangeFromBlockchainAuthorityUseCaseImpl{logger, repo}
+}
+
+func (uc *listBlockDataDTOInRangeFromBlockchainAuthorityUseCaseImpl) Execute(ctx context.Context, from, to *big.Int) ([]*ccdomain.BlockDataDTO, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if from == nil {
+		e["from"] = "missing value"
+	}
+	if to == nil {
+		e["to"] = "missing value"
+	} else if from != nil && to.Cmp(from) < 0 {
+		e["to"] = "cannot be less than from"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from the Authority.
+	//
+
+	return uc.repo.ListFromBlockchainAuthorityByHeaderNumberRange(ctx, from, to)
+}
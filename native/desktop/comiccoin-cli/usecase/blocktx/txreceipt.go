@@ -0,0 +1,48 @@
+package blocktx
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type GetTransactionReceiptFromBlockchainAuthorityUseCase interface {
+	Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error)
+}
+
+type getTransactionReceiptFromBlockchainAuthorityUseCaseImpl struct {
+	logger *slog.Logger
+	repo   domain.TransactionReceiptDTORepository
+}
+
+func NewGetTransactionReceiptFromBlockchainAuthorityUseCase(
+	logger *slog.Logger,
+	repo domain.TransactionReceiptDTORepository,
+) GetTransactionReceiptFromBlockchainAuthorityUseCase {
+	return &getTransactionReceiptFromBlockchainAuthorityUseCaseImpl{logger, repo}
+}
+
+func (uc *getTransactionReceiptFromBlockchainAuthorityUseCaseImpl) Execute(ctx context.Context, hash string) (*domain.TransactionReceipt, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if hash == "" {
+		e["hash"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from the Authority.
+	//
+
+	return uc.repo.GetFromBlockchainAuthorityByHash(ctx, hash)
+}
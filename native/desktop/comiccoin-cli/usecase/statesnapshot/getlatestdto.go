@@ -0,0 +1,28 @@
+package statesnapshot
+
+import (
+	"context"
+	"log/slog"
+
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type GetLatestStateSnapshotDTOFromBlockchainAuthorityUseCase interface {
+	Execute(ctx context.Context) (*ccdomain.StateSnapshot, error)
+}
+
+type getLatestStateSnapshotDTOFromBlockchainAuthorityUseCaseImpl struct {
+	logger *slog.Logger
+	repo   ccdomain.StateSnapshotDTORepository
+}
+
+func NewGetLatestStateSnapshotDTOFromBlockchainAuthorityUseCase(
+	logger *slog.Logger,
+	repo ccdomain.StateSnapshotDTORepository,
+) GetLatestStateSnapshotDTOFromBlockchainAuthorityUseCase {
+	return &getLatestStateSnapshotDTOFromBlockchainAuthorityUseCaseImpl{logger, repo}
+}
+
+func (uc *getLatestStateSnapshotDTOFromBlockchainAuthorityUseCaseImpl) Execute(ctx context.Context) (*ccdomain.StateSnapshot, error) {
+	return uc.repo.GetLatestFromBlockchainAuthority(ctx)
+}
@@ -15,24 +15,28 @@ type storageTransactionCommitUseCaseImpl struct {
 	logger                       *slog.Logger
 	walletRepo                   domain.WalletRepository
 	accountRepo                  domain.AccountRepository
-	genesisBlockDataRepo         domain.GenesisBlockDataRepository
+	genesisBlockDataRepo         ccdomain.GenesisBlockDataRepository
 	blockchainStateRepo          domain.BlockchainStateRepository
-	blockDataRepo                domain.BlockDataRepository
+	blockDataRepo                ccdomain.BlockDataRepository
 	tokenRepo                    domain.TokenRepository
 	pendingSignedTransactionRepo ccdomain.PendingSignedTransactionRepository
+	blockchainReorgEventRepo     ccdomain.BlockchainReorgEventRepository
+	validatorSetRepo             ccdomain.ValidatorSetRepository
 }
 
 func NewStorageTransactionCommitUseCase(
 	logger *slog.Logger,
 	r1 domain.WalletRepository,
 	r2 domain.AccountRepository,
-	r3 domain.GenesisBlockDataRepository,
+	r3 ccdomain.GenesisBlockDataRepository,
 	r4 domain.BlockchainStateRepository,
-	r5 domain.BlockDataRepository,
+	r5 ccdomain.BlockDataRepository,
 	r6 domain.TokenRepository,
 	r7 ccdomain.PendingSignedTransactionRepository,
+	r8 ccdomain.BlockchainReorgEventRepository,
+	r9 ccdomain.ValidatorSetRepository,
 ) StorageTransactionCommitUseCase {
-	return &storageTransactionCommitUseCaseImpl{logger, r1, r2, r3, r4, r5, r6, r7}
+	return &storageTransactionCommitUseCaseImpl{logger, r1, r2, r3, r4, r5, r6, r7, r8, r9}
 }
 
 func (uc *storageTransactionCommitUseCaseImpl) Execute() error {
@@ -71,5 +75,15 @@ func (uc *storageTransactionCommitUseCaseImpl) Execute() error {
 			slog.Any("error", err))
 		return err
 	}
+	if err := uc.blockchainReorgEventRepo.CommitTransaction(); err != nil {
+		uc.logger.Error("Failed committing transaction for blockchain reorg event",
+			slog.Any("error", err))
+		return err
+	}
+	if err := uc.validatorSetRepo.CommitTransaction(); err != nil {
+		uc.logger.Error("Failed committing transaction for validator set",
+			slog.Any("error", err))
+		return err
+	}
 	return nil
 }
@@ -15,24 +15,28 @@ type storageTransactionDiscardUseCaseImpl struct {
 	logger                       *slog.Logger
 	walletRepo                   domain.WalletRepository
 	accountRepo                  domain.AccountRepository
-	genesisBlockDataRepo         domain.GenesisBlockDataRepository
+	genesisBlockDataRepo         ccdomain.GenesisBlockDataRepository
 	blockchainStateRepo          domain.BlockchainStateRepository
-	blockDataRepo                domain.BlockDataRepository
+	blockDataRepo                ccdomain.BlockDataRepository
 	tokenRepo                    domain.TokenRepository
 	pendingSignedTransactionRepo ccdomain.PendingSignedTransactionRepository
+	blockchainReorgEventRepo     ccdomain.BlockchainReorgEventRepository
+	validatorSetRepo             ccdomain.ValidatorSetRepository
 }
 
 func NewStorageTransactionDiscardUseCase(
 	logger *slog.Logger,
 	r1 domain.WalletRepository,
 	r2 domain.AccountRepository,
-	r3 domain.GenesisBlockDataRepository,
+	r3 ccdomain.GenesisBlockDataRepository,
 	r4 domain.BlockchainStateRepository,
-	r5 domain.BlockDataRepository,
+	r5 ccdomain.BlockDataRepository,
 	r6 domain.TokenRepository,
 	r7 ccdomain.PendingSignedTransactionRepository,
+	r8 ccdomain.BlockchainReorgEventRepository,
+	r9 ccdomain.ValidatorSetRepository,
 ) StorageTransactionDiscardUseCase {
-	return &storageTransactionDiscardUseCaseImpl{logger, r1, r2, r3, r4, r5, r6, r7}
+	return &storageTransactionDiscardUseCaseImpl{logger, r1, r2, r3, r4, r5, r6, r7, r8, r9}
 }
 
 func (uc *storageTransactionDiscardUseCaseImpl) Execute() {
@@ -43,4 +47,6 @@ func (uc *storageTransactionDiscardUseCaseImpl) Execute() {
 	uc.blockDataRepo.DiscardTransaction()
 	uc.tokenRepo.DiscardTransaction()
 	uc.pendingSignedTransactionRepo.DiscardTransaction()
+	uc.blockchainReorgEventRepo.DiscardTransaction()
+	uc.validatorSetRepo.DiscardTransaction()
 }
@@ -0,0 +1,30 @@
+package tok
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/domain"
+)
+
+type DeleteTokenUseCase interface {
+	Execute(ctx context.Context, tokenID *big.Int) error
+}
+
+type deleteTokenUseCaseImpl struct {
+	logger *slog.Logger
+	repo   domain.TokenRepository
+}
+
+func NewDeleteTokenUseCase(logger *slog.Logger, repo domain.TokenRepository) DeleteTokenUseCase {
+	return &deleteTokenUseCaseImpl{logger, repo}
+}
+
+func (uc *deleteTokenUseCaseImpl) Execute(ctx context.Context, tokenID *big.Int) error {
+	if tokenID == nil {
+		return httperror.NewForBadRequestWithSingleField("token_id", "missing value")
+	}
+	return uc.repo.DeleteByID(ctx, tokenID)
+}
@@ -0,0 +1,45 @@
+package validatorset
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type GetValidatorSetUseCase interface {
+	Execute(ctx context.Context, chainID uint16) (*domain.ValidatorSet, error)
+}
+
+type getValidatorSetUseCaseImpl struct {
+	logger *slog.Logger
+	repo   domain.ValidatorSetRepository
+}
+
+func NewGetValidatorSetUseCase(logger *slog.Logger, repo domain.ValidatorSetRepository) GetValidatorSetUseCase {
+	return &getValidatorSetUseCaseImpl{logger, repo}
+}
+
+func (uc *getValidatorSetUseCaseImpl) Execute(ctx context.Context, chainID uint16) (*domain.ValidatorSet, error) {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if chainID == 0 {
+		e["chain_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for getting validator set",
+			slog.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByChainID(ctx, chainID)
+}
@@ -0,0 +1,52 @@
+package validatorset
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+
+	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
+)
+
+type UpsertValidatorSetUseCase interface {
+	Execute(ctx context.Context, validatorSet *domain.ValidatorSet) error
+}
+
+type upsertValidatorSetUseCaseImpl struct {
+	logger *slog.Logger
+	repo   domain.ValidatorSetRepository
+}
+
+func NewUpsertValidatorSetUseCase(logger *slog.Logger, repo domain.ValidatorSetRepository) UpsertValidatorSetUseCase {
+	return &upsertValidatorSetUseCaseImpl{logger, repo}
+}
+
+func (uc *upsertValidatorSetUseCaseImpl) Execute(ctx context.Context, validatorSet *domain.ValidatorSet) error {
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if validatorSet == nil {
+		e["validator_set"] = "missing value"
+	} else {
+		if validatorSet.ChainID == 0 {
+			e["chain_id"] = "missing value"
+		}
+		if len(validatorSet.Validators) == 0 {
+			e["validators"] = "missing value"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for upserting validator set",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Upsert(ctx, validatorSet)
+}
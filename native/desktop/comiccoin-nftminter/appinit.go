@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/httperror"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
@@ -279,3 +280,45 @@ func (a *App) SaveAuthorityStoreConfigVariables(authorityAPIKey string, authorit
 	return nil
 
 }
+
+// SaveCreatorRoyaltyConfigVariables saves the creator whom is paid a royalty
+// on every transfer, made with a coin payment, of the tokens we mint. An empty
+// creator address mints tokens without a royalty.
+func (a *App) SaveCreatorRoyaltyConfigVariables(creatorAddress string, royaltyBasisPoints int) error {
+	//
+	// STEP 1:
+	// Validation.
+	//
+
+	e := make(map[string]string)
+	if creatorAddress != "" && !common.IsHexAddress(creatorAddress) {
+		e["creatorAddress"] = "invalid address"
+	}
+	if royaltyBasisPoints < 0 || royaltyBasisPoints > 10000 {
+		e["royaltyBasisPoints"] = "must be between 0 and 10000"
+	}
+	if len(e) != 0 {
+		// If any fields are invalid, log an error and return a bad request error.
+		a.logger.Warn("Failed validating",
+			slog.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2:
+	// Save to preferences.
+	//
+
+	preferences := PreferencesInstance()
+	if err := preferences.SetRoyalty(creatorAddress, uint16(royaltyBasisPoints)); err != nil {
+		a.logger.Error("Failed setting creator royalty",
+			slog.Any("creator_address", creatorAddress),
+			slog.Any("royalty_basis_points", royaltyBasisPoints),
+			slog.Any("error", err))
+		return err
+	}
+	a.logger.Debug("Creator royalty was set by user",
+		slog.Any("creator_address", creatorAddress),
+		slog.Any("royalty_basis_points", royaltyBasisPoints))
+	return nil
+}
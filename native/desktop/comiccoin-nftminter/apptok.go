@@ -122,6 +122,49 @@ func (a *App) CreateToken(
 	attributes string,
 	backgroundColor string,
 ) (*Token, error) {
+	tokens, err := a.createTokens(walletAddress, "", 1, name, description, image, animation, youtubeURL, externalURL, attributes, backgroundColor)
+	if err != nil {
+		return nil, err
+	}
+	return tokens[0], nil
+}
+
+// CreateTokenEditions creates the next `editions` Tokens of the collection
+// with the given metadata, each edition gets its own metadata file recording
+// its edition number, and mints them all in a single request.
+func (a *App) CreateTokenEditions(
+	walletAddress string,
+	collectionID string,
+	editions int,
+	name string,
+	description string,
+	image string,
+	animation string,
+	youtubeURL string,
+	externalURL string,
+	attributes string,
+	backgroundColor string,
+) ([]*Token, error) {
+	if collectionID == "" {
+		e := map[string]string{"collection_id": "missing value"}
+		return nil, httperror.NewForBadRequest(&e)
+	}
+	return a.createTokens(walletAddress, collectionID, editions, name, description, image, animation, youtubeURL, externalURL, attributes, backgroundColor)
+}
+
+func (a *App) createTokens(
+	walletAddress string,
+	collectionID string,
+	editions int,
+	name string,
+	description string,
+	image string,
+	animation string,
+	youtubeURL string,
+	externalURL string,
+	attributes string,
+	backgroundColor string,
+) ([]*Token, error) {
 	//
 	// STEP 1: Validation.
 	//
@@ -159,6 +202,9 @@ func (a *App) CreateToken(
 			e["background_color"] = "wrong formatting, must be HTML hex formatting"
 		}
 	}
+	if editions < 1 {
+		e["editions"] = "must be at least one"
+	}
 	if len(e) != 0 {
 		// If any fields are missing, log an error and return a bad request error.
 		a.logger.Warn("Failed validating",
@@ -216,14 +262,34 @@ func (a *App) CreateToken(
 	latestTokenID := blockchainState.GetLatestTokenID()
 
 	// Please note that in ComicCoin genesis block, we already have a token set
-	// at zero. Therefore this increment will work well.
-	var tokenID big.Int
-	tokenID.Add(big.NewInt(1), latestTokenID)
-
+	// at zero. Therefore incrementing for every new token will work well.
 	a.logger.Debug("Returned latest token from the Authority",
 		slog.Any("latest_token_id", latestTokenID),
-		slog.String("new_token_id", tokenID.String()),
+		slog.Int("new_tokens", editions),
 	)
+
+	// Editions of a collection are numbered after the editions which were
+	// already minted and may never exceed the maximum supply.
+	var collection *Collection
+	if collectionID != "" {
+		collectionDTORepo := NewCollectionDTORepo(NewTokenMintDTOConfigurationProvider(preferences.AuthorityAddress, preferences.AuthorityAPIKey), a.logger)
+		collection, err = collectionDTORepo.GetFromBlockchainAuthorityByID(a.ctx, collectionID)
+		if err != nil {
+			a.logger.Error("Failed getting collection from the Authority",
+				slog.Any("collection_id", collectionID),
+				slog.Any("error", err))
+			return nil, err
+		}
+		if collection == nil {
+			e := map[string]string{"collection_id": "does not exist"}
+			return nil, httperror.NewForBadRequest(&e)
+		}
+		if collection.Supply+uint64(editions) > collection.MaxSupply {
+			e := map[string]string{"editions": fmt.Sprintf("only %v of %v editions remain", collection.MaxSupply-min(collection.Supply, collection.MaxSupply), collection.MaxSupply)}
+			return nil, httperror.NewForBadRequest(&e)
+		}
+	}
+
 	// Get our data directory from our app preferences.
 	dataDir := preferences.DataDirectory
 
@@ -278,26 +344,114 @@ func (a *App) CreateToken(
 
 	//
 	// STEP 6:
-	// Create token `metadata` file locally.
+	// Create, save and upload the `metadata` of every token.
 	//
 
-	metadata := &TokenMetadata{
-		Image:           fmt.Sprintf("ipfs://%v", imageCID),
-		ExternalURL:     externalURL,
-		Description:     description,
-		Name:            name,
-		Attributes:      attrs,
-		BackgroundColor: backgroundColor,
-		AnimationURL:    fmt.Sprintf("ipfs://%v", animationCID),
-		YoutubeURL:      youtubeURL,
+	tokens := make([]*Token, 0, editions)
+	mintEditions := make([]*TokenMintEdition, 0, editions)
+	for i := 0; i < editions; i++ {
+		tokenID := new(big.Int).Add(latestTokenID, big.NewInt(int64(i+1)))
+
+		metadata := &TokenMetadata{
+			Image:           fmt.Sprintf("ipfs://%v", imageCID),
+			ExternalURL:     externalURL,
+			Description:     description,
+			Name:            name,
+			Attributes:      attrs,
+			BackgroundColor: backgroundColor,
+			AnimationURL:    fmt.Sprintf("ipfs://%v", animationCID),
+			YoutubeURL:      youtubeURL,
+		}
+
+		// Every edition of a collection gets its own metadata which records
+		// which copy of the print run it is, for example "12 of 500".
+		if collection != nil {
+			edition := collection.Supply + uint64(i+1)
+			metadata.Name = fmt.Sprintf("%v #%v", name, edition)
+			metadata.Attributes = append(append(make([]*TokenMetadataAttribute, 0, len(attrs)+1), attrs...), &TokenMetadataAttribute{
+				TraitType: "Edition",
+				Value:     fmt.Sprintf("%v of %v", edition, collection.MaxSupply),
+			})
+		}
+
+		metadataURI, err := a.pinTokenMetadata(dataDir, tokenID, metadata, image, animation)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, &Token{
+			TokenID:     tokenID,
+			MetadataURI: metadataURI,
+			Metadata:    metadata,
+			Timestamp:   uint64(time.Now().UTC().UnixMilli()),
+		})
+		mintEditions = append(mintEditions, &TokenMintEdition{MetadataURI: metadataURI})
 	}
 
+	//
+	// STEP 7:
+	// Send NFT to wallet address via Blockchain Authority.
+	//
+
+	// Get our credentials for the ComicCoin Authority.
+	authorityAddress := preferences.AuthorityAddress
+	authorityAPIKey := preferences.AuthorityAPIKey
+
+	// Setup our API caller.
+	provider := NewTokenMintDTOConfigurationProvider(authorityAddress, authorityAPIKey)
+	tokenMintDTORepo := NewTokenMintDTORepo(provider, a.logger)
+
+	// Setup our API payload.
+	dto := &TokenMintDTO{
+		WalletAddress: walletAddress,
+		MetadataURI:   tokens[0].MetadataURI,
+	}
+	if collection != nil {
+		dto.MetadataURI = ""
+		dto.CollectionID = collectionID
+		dto.Editions = mintEditions
+	}
+	if preferences.CreatorAddress != "" {
+		dto.CreatorAddress = preferences.CreatorAddress
+		dto.RoyaltyBasisPoints = preferences.RoyaltyBasisPoints
+	}
+
+	a.logger.Debug("Submitting to ComicCoin Authority our newly minted NFT.",
+		slog.Any("dto", dto))
+
+	// Submit the token mint to the ComicCoin Authority.
+	if err := tokenMintDTORepo.SubmitToBlockchainAuthority(a.ctx, dto); err != nil {
+		a.logger.Error("Failed submitting to blockchain authority",
+			slog.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 8:
+	// Return our tokens to the GUI.
+	//
+
+	a.logger.Debug("Created tokens",
+		slog.Any("tokens", tokens))
+
+	return tokens, nil
+}
+
+// pinTokenMetadata saves the token `metadata` file with copies of the image
+// and (optional) animation locally under the token ID and uploads the
+// metadata file to IPFS, returning the token's metadata URI.
+func (a *App) pinTokenMetadata(dataDir string, tokenID *big.Int, metadata *TokenMetadata, image string, animation string) (string, error) {
+	//
+	// STEP 1:
+	// Create token `metadata` file locally.
+	//
+
 	metadataBytes, err := json.MarshalIndent(metadata, "", "\t")
 	if err != nil {
 		// If an error occurs, log an error and return an error.
 		a.logger.Error("Failed marshal metadata",
 			slog.Any("error", err))
-		return nil, err
+		return "", err
 	}
 
 	metadataFilepath := filepath.Join(dataDir, "token_assets", fmt.Sprintf("%v", tokenID.String()), "metadata.json")
@@ -307,18 +461,18 @@ func (a *App) CreateToken(
 		// If an error occurs, log an error and return an error.
 		a.logger.Error("Failed create directories",
 			slog.Any("error", err))
-		return nil, err
+		return "", err
 	}
 
 	if err := ioutil.WriteFile(metadataFilepath, metadataBytes, 0644); err != nil {
 		// If an error occurs, log an error and return an error.
 		a.logger.Error("Failed write metadata file",
 			slog.Any("error", err))
-		return nil, err
+		return "", err
 	}
 
 	//
-	// STEP 7
+	// STEP 2:
 	// Copy `image` file so we can consolidate our token assets.
 	//
 
@@ -333,7 +487,7 @@ func (a *App) CreateToken(
 		// If an error occurs, log an error and return an error.
 		a.logger.Error("Failed create directories",
 			slog.Any("error", err))
-		return nil, err
+		return "", err
 	}
 
 	if err := CopyFile(image, consolidatedImage); err != nil {
@@ -348,12 +502,12 @@ func (a *App) CreateToken(
 				slog.Any("dataDir", dataDir),
 				slog.Any("consolidatedImage", consolidatedImage),
 				slog.Any("error", err))
-			return nil, err
+			return "", err
 		}
 	}
 
 	//
-	// STEP 8
+	// STEP 3:
 	// Copy `animation` file so we can consolidate our token assets if it was uploaded.
 	//
 
@@ -376,7 +530,7 @@ func (a *App) CreateToken(
 			// If an error occurs, log an error and return an error.
 			a.logger.Error("Failed create directories",
 				slog.Any("error", err))
-			return nil, err
+			return "", err
 		}
 
 		if err := CopyFile(animation, consolidatedAnimation); err != nil {
@@ -391,13 +545,13 @@ func (a *App) CreateToken(
 					slog.Any("dataDir", dataDir),
 					slog.Any("consolidatedAnimation", consolidatedAnimation),
 					slog.Any("error", err))
-				return nil, err
+				return "", err
 			}
 		}
 	}
 
 	//
-	// STEP 9:
+	// STEP 4:
 	// Upload to IPFs and get the CID.
 	//
 
@@ -408,59 +562,12 @@ func (a *App) CreateToken(
 			slog.String("tokenID", tokenID.String()),
 			slog.Any("filepath", metadataFilepath),
 			slog.Any("error", err))
-		return nil, err
+		return "", err
 	}
 	a.logger.Debug("Metadata uploaded to ipfs.",
 		slog.String("tokenID", tokenID.String()),
 		slog.Any("local", metadataFilepath),
 		slog.Any("cid", metadataCID))
 
-	//
-	// STEP 10:
-	// Send NFT to wallet address via Blockchain Authority.
-	//
-
-	// Define our token's URI.
-	metadataURI := fmt.Sprintf("ipfs://%v", metadataCID)
-
-	// Get our credentials for the ComicCoin Authority.
-	authorityAddress := preferences.AuthorityAddress
-	authorityAPIKey := preferences.AuthorityAPIKey
-
-	// Setup our API caller.
-	provider := NewTokenMintDTOConfigurationProvider(authorityAddress, authorityAPIKey)
-	tokenMintDTORepo := NewTokenMintDTORepo(provider, a.logger)
-
-	// Setup our API payload.
-	dto := &TokenMintDTO{
-		WalletAddress: walletAddress,
-		MetadataURI:   metadataURI,
-	}
-
-	a.logger.Debug("Submitting to ComicCoin Authority our newly minted NFT.",
-		slog.Any("dto", dto))
-
-	// Submit the token mint to the ComicCoin Authority.
-	if err := tokenMintDTORepo.SubmitToBlockchainAuthority(a.ctx, dto); err != nil {
-		a.logger.Error("Failed submitting to blockchain authority",
-			slog.Any("error", err))
-		return nil, err
-	}
-
-	//
-	// STEP 11:
-	// Return our token to the GUI.
-	//
-
-	token := &Token{
-		TokenID:     &tokenID,
-		MetadataURI: metadataURI,
-		Metadata:    metadata,
-		Timestamp:   uint64(time.Now().UTC().UnixMilli()),
-	}
-
-	a.logger.Debug("Created token",
-		slog.Any("token", token))
-
-	return token, nil
+	return fmt.Sprintf("ipfs://%v", metadataCID), nil
 }
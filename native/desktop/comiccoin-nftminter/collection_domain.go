@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+)
+
+// Collection groups the tokens minted as editions of the same work, for
+// example every copy of a print run of a comic, see the Authority's
+// `/authority/api/v1/collections/{id}` endpoint.
+type Collection struct {
+	IDBytes         []byte `json:"id_bytes"`
+	Creator         string `json:"creator"`
+	Name            string `json:"name"`
+	BaseMetadataURI string `json:"base_metadata_uri"`
+	MaxSupply       uint64 `json:"max_supply"`
+	Supply          uint64 `json:"supply"` // The number of editions minted so far.
+}
+
+// CollectionDTORepository is an interface that defines the methods for
+// getting collections from the Authority.
+type CollectionDTORepository interface {
+	GetFromBlockchainAuthorityByID(ctx context.Context, collectionID string) (*Collection, error)
+}
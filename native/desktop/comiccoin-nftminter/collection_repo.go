@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	collectionURL string = "/authority/api/v1/collections/%v"
+)
+
+type CollectionDTORepo struct {
+	config TokenMintDTOConfigurationProvider
+	logger *slog.Logger
+}
+
+func NewCollectionDTORepo(
+	config TokenMintDTOConfigurationProvider,
+	logger *slog.Logger,
+) *CollectionDTORepo {
+
+	return &CollectionDTORepo{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (repo *CollectionDTORepo) GetFromBlockchainAuthorityByID(ctx context.Context, collectionID string) (*Collection, error) {
+	httpEndpoint := fmt.Sprintf("%s%s", repo.config.GetAuthorityAddress(), fmt.Sprintf(collectionURL, url.PathEscape(collectionID)))
+
+	repo.logger.Debug("Fetching from HTTP JSON API",
+		slog.String("url", httpEndpoint),
+		slog.String("method", "GET"))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", httpEndpoint, nil)
+	if err != nil {
+		repo.logger.Error("Request creation error",
+			slog.Any("err", err),
+		)
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		repo.logger.Error("Request error",
+			slog.Any("err", err),
+		)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status getting collection %v: %v", collectionID, resp.StatusCode)
+		repo.logger.Error("Failed getting collection from blockchain authority",
+			slog.Any("err", err),
+		)
+		return nil, err
+	}
+
+	var collection Collection
+	if err := json.NewDecoder(resp.Body).Decode(&collection); err != nil {
+		repo.logger.Error("Failed decoding collection",
+			slog.Any("err", err),
+		)
+		return nil, err
+	}
+	return &collection, nil
+}
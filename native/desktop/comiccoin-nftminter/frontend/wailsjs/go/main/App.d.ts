@@ -4,6 +4,8 @@ import {main} from '../models';
 
 export function CreateToken(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string,arg7:string,arg8:string,arg9:string):Promise<main.Token>;
 
+export function CreateTokenEditions(arg1:string,arg2:string,arg3:number,arg4:string,arg5:string,arg6:string,arg7:string,arg8:string,arg9:string,arg10:string,arg11:string):Promise<Array<main.Token>>;
+
 export function GetDataDirectoryFromDialog():Promise<string>;
 
 export function GetDataDirectoryFromPreferences():Promise<string>;
@@ -32,6 +34,8 @@ export function GetVideoFilePathFromDialog():Promise<string>;
 
 export function SaveAuthorityStoreConfigVariables(arg1:string,arg2:string):Promise<void>;
 
+export function SaveCreatorRoyaltyConfigVariables(arg1:string,arg2:number):Promise<void>;
+
 export function SaveDataDirectory(arg1:string):Promise<void>;
 
 export function SaveNFTStoreConfigVariables(arg1:string,arg2:string):Promise<void>;
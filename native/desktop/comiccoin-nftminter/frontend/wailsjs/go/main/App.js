@@ -6,6 +6,10 @@ export function CreateToken(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9
   return window['go']['main']['App']['CreateToken'](arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9);
 }
 
+export function CreateTokenEditions(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11) {
+  return window['go']['main']['App']['CreateTokenEditions'](arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11);
+}
+
 export function GetDataDirectoryFromDialog() {
   return window['go']['main']['App']['GetDataDirectoryFromDialog']();
 }
@@ -62,6 +66,10 @@ export function SaveAuthorityStoreConfigVariables(arg1, arg2) {
   return window['go']['main']['App']['SaveAuthorityStoreConfigVariables'](arg1, arg2);
 }
 
+export function SaveCreatorRoyaltyConfigVariables(arg1, arg2) {
+  return window['go']['main']['App']['SaveCreatorRoyaltyConfigVariables'](arg1, arg2);
+}
+
 export function SaveDataDirectory(arg1) {
   return window['go']['main']['App']['SaveDataDirectory'](arg1);
 }
@@ -26,6 +26,8 @@ export namespace main {
 	    nft_storage_api_key: string;
 	    authority_address: string;
 	    authority_api_key: string;
+	    creator_address: string;
+	    royalty_basis_points: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new Preferences(source);
@@ -40,6 +42,8 @@ export namespace main {
 	        this.nft_storage_api_key = source["nft_storage_api_key"];
 	        this.authority_address = source["authority_address"];
 	        this.authority_api_key = source["authority_api_key"];
+	        this.creator_address = source["creator_address"];
+	        this.royalty_basis_points = source["royalty_basis_points"];
 	    }
 	}
 	export class TokenMetadataAttribute {
@@ -39,6 +39,14 @@ type Preferences struct {
 	// AuthorityAPIKey holds the API key necessary to make authenticated api
 	// calls such as posting.
 	AuthorityAPIKey string `json:"authority_api_key"`
+
+	// CreatorAddress holds the address of the comic creator whom is paid a
+	// royalty every time a token we minted is transferred with a coin payment.
+	CreatorAddress string `json:"creator_address"`
+
+	// RoyaltyBasisPoints holds the royalty paid to the creator, one basis
+	// point is 0.01% of the coin payment.
+	RoyaltyBasisPoints uint16 `json:"royalty_basis_points"`
 }
 
 var (
@@ -154,6 +162,16 @@ func (pref *Preferences) SetAuthorityAPIKey(apiKey string) error {
 	return ioutil.WriteFile(FilePathPreferences, data, 0666)
 }
 
+func (pref *Preferences) SetRoyalty(creatorAddress string, royaltyBasisPoints uint16) error {
+	pref.CreatorAddress = creatorAddress
+	pref.RoyaltyBasisPoints = royaltyBasisPoints
+	data, err := json.MarshalIndent(pref, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(FilePathPreferences, data, 0666)
+}
+
 func (pref *Preferences) GetFilePathOfPreferencesFile() string {
 	return FilePathPreferences
 }
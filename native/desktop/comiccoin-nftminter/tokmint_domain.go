@@ -10,6 +10,21 @@ import (
 type TokenMint struct {
 	WalletAddress string `json:"wallet_address"`
 	MetadataURI   string `json:"metadata_uri"`
+
+	// Optional: mint the editions into the collection instead of a single
+	// token.
+	CollectionID string              `json:"collection_id,omitempty"`
+	Editions     []*TokenMintEdition `json:"editions,omitempty"`
+
+	// Optional: the creator paid a royalty, in basis points, on every
+	// transfer of the token made with a coin payment.
+	CreatorAddress     string `json:"creator_address,omitempty"`
+	RoyaltyBasisPoints uint16 `json:"royalty_basis_points,omitempty"`
+}
+
+// TokenMintEdition is the metadata of an edition to mint into a collection.
+type TokenMintEdition struct {
+	MetadataURI string `json:"metadata_uri"`
 }
 
 // TokenMint represents the data that can be serialized to disk and over the network.
@@ -19,15 +19,14 @@ import (
 	inmemory "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/common/storage/memory/inmemory"
 	auth_repo "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/repo"
 	uc_blockchainstatedto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockchainstatedto"
-	uc_blockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/blockdatadto"
-	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/genesisblockdatadto"
-	uc_mempooltxdto "github.com/comiccoin-network/monorepo/cloud/comiccoin-authority/usecase/mempooltxdto"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 
+	ccdomain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/domain"
 	"github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/repo"
 	service_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/account"
 	service_blockchain "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchain"
+	service_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchainreorgevent"
 	service_blockchainsyncstatus "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockchainsyncstatus"
 	service_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blockdata"
 	service_blocktx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/blocktx"
@@ -37,15 +36,20 @@ import (
 	service_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/tok"
 	service_wallet "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/service/wallet"
 	uc_account "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/account"
+	uc_blockchainreorgevent "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainreorgevent"
 	uc_blockchainstate "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainstate"
 	uc_blockchainsyncstatus "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockchainsyncstatus"
 	uc_blockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdata"
+	uc_blockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blockdatadto"
 	uc_blocktx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/blocktx"
 	uc_genesisblockdata "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdata"
+	uc_genesisblockdatadto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/genesisblockdatadto"
+	uc_mempooltxdto "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/mempooltxdto"
 	uc_nftok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/nftok"
 	uc_pstx "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/pstx"
 	uc_storagetransaction "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/storagetransaction"
 	uc_tok "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/tok"
+	uc_validatorset "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/validatorset"
 	uc_wallet "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/wallet"
 	uc_walletutil "github.com/comiccoin-network/monorepo/native/desktop/comiccoin-cli/usecase/walletutil"
 )
@@ -68,6 +72,8 @@ type App struct {
 	tokenGetService                                                 service_tok.TokenGetService
 	tokenTransferService                                            service_tok.TokenTransferService
 	tokenBurnService                                                service_tok.TokenBurnService
+	tokenSwapOfferService                                           service_tok.TokenSwapOfferService
+	tokenSwapAcceptService                                          service_tok.TokenSwapAcceptService
 	getOrDownloadNonFungibleTokenService                            service_nftok.GetOrDownloadNonFungibleTokenService
 	listBlockTransactionsByAddressService                           service_blocktx.ListBlockTransactionsByAddressService
 	listWithLimitBlockTransactionsByAddressService                  service_blocktx.ListWithLimitBlockTransactionsByAddressService
@@ -84,6 +90,7 @@ type App struct {
 	importWalletService                                             service_wallet.ImportWalletService
 	walletRecoveryService                                           service_wallet.WalletRecoveryService
 	localNotificationService                                        service_blocktx.AccountLocalNotificationService
+	listBlockchainReorgEventsService                                service_blockchainreorgevent.ListBlockchainReorgEventsService
 }
 
 // NewApp creates a new App application struct
@@ -144,6 +151,8 @@ func (a *App) startup(ctx context.Context) {
 	tokDB := disk.NewDiskStorage(dataDir, "token", logger)
 	nftokDB := disk.NewDiskStorage(dataDir, "non_fungible_token", logger)
 	pstxDB := disk.NewDiskStorage(dataDir, "pending_signed_transaction", logger)
+	blockchainReorgEventDB := disk.NewDiskStorage(dataDir, "blockchain_reorg_event", logger)
+	validatorSetDB := disk.NewDiskStorage(dataDir, "validator_set", logger)
 
 	// ------------ Repo ------------
 
@@ -170,26 +179,32 @@ func (a *App) startup(ctx context.Context) {
 	blockchainStateDTORepo := auth_repo.NewBlockchainStateDTORepo(
 		blockchainStateDTORepoConfig,
 		logger)
-	genesisBlockDataDTORepoConfig := auth_repo.NewGenesisBlockDataDTOConfigurationProvider(authorityAddress)
-	genesisBlockDataDTORepo := auth_repo.NewGenesisBlockDataDTORepo(
+	genesisBlockDataDTORepoConfig := repo.NewGenesisBlockDataDTOConfigurationProvider(authorityAddress)
+	genesisBlockDataDTORepo := repo.NewGenesisBlockDataDTORepo(
 		genesisBlockDataDTORepoConfig,
 		logger)
 	blockDataRepo := repo.NewBlockDataRepo(
 		logger,
 		blockDataDB)
-	blockDataDTORepoConfig := auth_repo.NewBlockDataDTOConfigurationProvider(authorityAddress)
-	blockDataDTORepo := auth_repo.NewBlockDataDTORepo(
+	blockDataDTORepoConfig := repo.NewBlockDataDTOConfigurationProvider(authorityAddress)
+	blockDataDTORepo := repo.NewBlockDataDTORepo(
 		blockDataDTORepoConfig,
 		logger)
+	blockDataRangeDTORepoConfig := repo.NewBlockDataRangeDTOConfigurationProvider(authorityAddress)
+	blockDataRangeDTORepo := repo.NewBlockDataRangeDTORepository(
+		blockDataRangeDTORepoConfig,
+		logger)
 	tokRepo := repo.NewTokenRepo(
 		logger,
 		tokDB)
 	nftokenRepo := repo.NewNonFungibleTokenRepo(logger, nftokDB)
 	nftAssetRepoConfig := repo.NewNFTAssetRepoConfigurationProvider(nftStorageAddress, "")
 	nftAssetRepo := repo.NewNFTAssetRepo(nftAssetRepoConfig, logger)
-	mempoolTxDTORepoConfig := auth_repo.NewMempoolTransactionDTOConfigurationProvider(authorityAddress)
-	mempoolTxDTORepo := auth_repo.NewMempoolTransactionDTORepo(mempoolTxDTORepoConfig, logger)
+	mempoolTxDTORepoConfig := repo.NewMempoolTransactionDTOConfigurationProvider(authorityAddress)
+	mempoolTxDTORepo := repo.NewMempoolTransactionDTORepo(mempoolTxDTORepoConfig, logger)
 	pstxRepo := repo.NewPendingSignedTransactionRepo(logger, pstxDB)
+	blockchainReorgEventRepo := repo.NewBlockchainReorgEventRepo(logger, blockchainReorgEventDB)
+	validatorSetRepo := repo.NewValidatorSetRepo(logger, validatorSetDB)
 	blockchainSyncStatusRepo := repo.NewBlockchainSyncStatusRepo(logger, memDB)
 
 	// DEPRECATED
@@ -209,7 +224,9 @@ func (a *App) startup(ctx context.Context) {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 	storageTransactionCommitUseCase := uc_storagetransaction.NewStorageTransactionCommitUseCase(
 		logger,
 		walletRepo,
@@ -218,7 +235,9 @@ func (a *App) startup(ctx context.Context) {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 	storageTransactionDiscardUseCase := uc_storagetransaction.NewStorageTransactionDiscardUseCase(
 		logger,
 		walletRepo,
@@ -227,7 +246,9 @@ func (a *App) startup(ctx context.Context) {
 		blockchainStateRepo,
 		blockDataRepo,
 		tokRepo,
-		pstxRepo)
+		pstxRepo,
+		blockchainReorgEventRepo,
+		validatorSetRepo)
 
 	// Wallet Utility
 	openHDWalletFromMnemonicUseCase := uc_walletutil.NewOpenHDWalletFromMnemonicUseCase(
@@ -274,12 +295,12 @@ func (a *App) startup(ctx context.Context) {
 		accountRepo)
 	upsertAccountUseCase := uc_account.NewUpsertAccountUseCase(
 		logger,
+		chainID,
 		accountRepo)
 	accountsFilterByAddressesUseCase := uc_account.NewAccountsFilterByAddressesUseCase(
 		logger,
 		accountRepo,
 	)
-	_ = getAccountsHashStateUseCase
 
 	// Blockchain State
 	upsertBlockchainStateUseCase := uc_blockchainstate.NewUpsertBlockchainStateUseCase(
@@ -335,6 +356,9 @@ func (a *App) startup(ctx context.Context) {
 	getBlockDataDTOFromBlockchainAuthorityUseCase := uc_blockdatadto.NewGetBlockDataDTOFromBlockchainAuthorityUseCase(
 		logger,
 		blockDataDTORepo)
+	listBlockDataDTOInRangeFromBlockchainAuthorityUseCase := uc_blockdata.NewListBlockDataDTOInRangeFromBlockchainAuthorityUseCase(
+		logger,
+		blockDataRangeDTORepo)
 
 	// Token
 	getTokUseCase := uc_tok.NewGetTokenUseCase(
@@ -392,6 +416,28 @@ func (a *App) startup(ctx context.Context) {
 		logger,
 		pstxRepo)
 
+	// Validator Set
+	getValidatorSetUseCase := uc_validatorset.NewGetValidatorSetUseCase(
+		logger,
+		validatorSetRepo)
+	upsertValidatorSetUseCase := uc_validatorset.NewUpsertValidatorSetUseCase(
+		logger,
+		validatorSetRepo)
+
+	// Blockchain Reorg Event
+	createBlockchainReorgEventUseCase := uc_blockchainreorgevent.NewCreateBlockchainReorgEventUseCase(
+		logger,
+		blockchainReorgEventRepo)
+	listBlockchainReorgEventsByChainIDUseCase := uc_blockchainreorgevent.NewListBlockchainReorgEventsByChainIDUseCase(
+		logger,
+		blockchainReorgEventRepo)
+	deleteBlockDataUseCase := uc_blockdata.NewDeleteBlockDataUseCase(
+		logger,
+		blockDataRepo)
+	deleteTokenUseCase := uc_tok.NewDeleteTokenUseCase(
+		logger,
+		tokRepo)
+
 	// Blockchain Sync Status
 	setBlockchainSyncStatusUseCase := uc_blockchainsyncstatus.NewSetBlockchainSyncStatusUseCase(
 		logger,
@@ -457,6 +503,24 @@ func (a *App) startup(ctx context.Context) {
 		getTokUseCase,
 		submitMempoolTransactionDTOToBlockchainAuthorityUseCase,
 	)
+	tokenSwapOfferService := service_tok.NewTokenSwapOfferService(
+		logger,
+		getGenesisBlockDataUseCase,
+		getAccountUseCase,
+		getWalletUseCase,
+		mnemonicFromEncryptedHDWalletUseCase,
+		privateKeyFromHDWalletUseCase,
+		getTokUseCase,
+	)
+	tokenSwapAcceptService := service_tok.NewTokenSwapAcceptService(
+		logger,
+		getAccountUseCase,
+		getWalletUseCase,
+		mnemonicFromEncryptedHDWalletUseCase,
+		privateKeyFromHDWalletUseCase,
+		getTokUseCase,
+		submitMempoolTransactionDTOToBlockchainAuthorityUseCase,
+	)
 	tokenBurnService := service_tok.NewTokenBurnService(
 		logger,
 		storageTransactionOpenUseCase,
@@ -490,6 +554,13 @@ func (a *App) startup(ctx context.Context) {
 		upsertAccountUseCase,
 		upsertTokenIfPreviousTokenNonceGTEUseCase,
 		deletePendingSignedTransactionUseCase,
+		getAccountsHashStateUseCase,
+		deleteBlockDataUseCase,
+		deleteTokenUseCase,
+		createBlockchainReorgEventUseCase,
+		listBlockDataDTOInRangeFromBlockchainAuthorityUseCase,
+		getValidatorSetUseCase,
+		upsertValidatorSetUseCase,
 	)
 
 	blockchainSyncWithBlockchainAuthorityViaServerSentEventsService := service_blockchain.NewBlockchainSyncWithBlockchainAuthorityViaServerSentEventsService(
@@ -576,6 +647,10 @@ func (a *App) startup(ctx context.Context) {
 		mnemonicFromEncryptedHDWalletUseCase,
 	)
 	localNotificationService := service_blocktx.NewAccountLocalNotificationService(logger, memDB, getLatestBlockTransactionByAddressServerSentEventsDTOUseCase)
+	listBlockchainReorgEventsService := service_blockchainreorgevent.NewListBlockchainReorgEventsService(
+		logger,
+		listBlockchainReorgEventsByChainIDUseCase,
+	)
 
 	// ------------ Interfaces ------------
 
@@ -587,6 +662,8 @@ func (a *App) startup(ctx context.Context) {
 	a.tokenGetService = tokenGetService
 	a.tokenTransferService = tokenTransferService
 	a.tokenBurnService = tokenBurnService
+	a.tokenSwapOfferService = tokenSwapOfferService
+	a.tokenSwapAcceptService = tokenSwapAcceptService
 	a.blockchainSyncService = blockchainSyncService
 	a.blockchainSyncWithBlockchainAuthorityViaServerSentEventsService = blockchainSyncWithBlockchainAuthorityViaServerSentEventsService
 	a.getOrDownloadNonFungibleTokenService = getOrDownloadNonFungibleTokenService
@@ -603,6 +680,7 @@ func (a *App) startup(ctx context.Context) {
 	a.importWalletService = importWalletService
 	a.walletRecoveryService = walletRecoveryService
 	a.localNotificationService = localNotificationService
+	a.listBlockchainReorgEventsService = listBlockchainReorgEventsService
 
 	//
 	// Execute.
@@ -753,3 +831,20 @@ func (a *App) IsSyncing() bool {
 	}
 	return blockchainSyncStatus.IsSynching
 }
+
+// GetBlockchainReorgEvents returns the reorganizations of the local
+// blockchain, most recent first, so the user can be notified when the
+// Authority rewrote history and their balances were rolled back.
+func (a *App) GetBlockchainReorgEvents() ([]*ccdomain.BlockchainReorgEvent, error) {
+	// Defensive code
+	if a.listBlockchainReorgEventsService == nil {
+		return make([]*ccdomain.BlockchainReorgEvent, 0), nil
+	}
+
+	events, err := a.listBlockchainReorgEventsService.Execute(a.ctx, ComicCoinChainID)
+	if err != nil {
+		a.logger.Error("Failed listing blockchain reorg events", slog.Any("error", err))
+		return nil, err
+	}
+	return events, nil
+}
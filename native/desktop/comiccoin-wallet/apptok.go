@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -64,6 +65,75 @@ func (a *App) TransferToken(
 	return nil
 }
 
+// CreateTokenSwapOffer signs an offer to sell the token to the buyer for the
+// price and returns the offer, which must be handed to the buyer, as JSON.
+func (a *App) CreateTokenSwapOffer(
+	buyerAddress string,
+	tokenID *big.Int,
+	price uint64,
+	sellerAccountAddress string,
+	sellerAccountPassword string,
+) (string, error) {
+	buyerAddr := common.HexToAddress(strings.ToLower(buyerAddress))
+	sellerAddr := common.HexToAddress(strings.ToLower(sellerAccountAddress))
+
+	password, err := sstring.NewSecureString(sellerAccountPassword)
+	if err != nil {
+		a.logger.Error("Failed securing password",
+			slog.Any("error", err))
+		return "", err
+	}
+
+	offer, err := a.tokenSwapOfferService.Execute(
+		a.ctx,
+		preferences.ChainID,
+		&sellerAddr,
+		password,
+		&buyerAddr,
+		tokenID,
+		price,
+	)
+	if err != nil {
+		a.logger.Error("Failed creating token swap offer",
+			slog.Any("error", err))
+		return "", err
+	}
+
+	offerBytes, err := json.Marshal(offer)
+	if err != nil {
+		return "", err
+	}
+	return string(offerBytes), nil
+}
+
+// AcceptTokenSwapOffer co-signs the swap offer given by the seller and
+// submits the swap to buy the token.
+func (a *App) AcceptTokenSwapOffer(
+	offerJSON string,
+	buyerAccountAddress string,
+	buyerAccountPassword string,
+) error {
+	offer := &comic_domain.SignedTransaction{}
+	if err := json.Unmarshal([]byte(offerJSON), offer); err != nil {
+		return fmt.Errorf("failed decoding swap offer: %v", err)
+	}
+	buyerAddr := common.HexToAddress(strings.ToLower(buyerAccountAddress))
+
+	password, err := sstring.NewSecureString(buyerAccountPassword)
+	if err != nil {
+		a.logger.Error("Failed securing password",
+			slog.Any("error", err))
+		return err
+	}
+
+	if err := a.tokenSwapAcceptService.Execute(a.ctx, preferences.ChainID, &buyerAddr, password, offer); err != nil {
+		a.logger.Error("Failed accepting token swap offer",
+			slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
 func (a *App) GetNonFungibleTokensByOwnerAddress(address string) ([]*comic_domain.NonFungibleToken, error) {
 	addr := common.HexToAddress(strings.ToLower(address))
 
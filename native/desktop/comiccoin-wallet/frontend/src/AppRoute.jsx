@@ -35,6 +35,8 @@ import TokenTransferView from "./Components/NFTs/TransferView";
 import TokenTransferSuccessView from "./Components/NFTs/TransferSuccessView";
 import TokenBurnView from "./Components/NFTs/BurnView";
 import TokenBurnSuccessView from "./Components/NFTs/BurnSuccessView";
+import TokenSwapOfferView from "./Components/NFTs/SwapOfferView";
+import TokenSwapAcceptView from "./Components/NFTs/SwapAcceptView";
 import SettingsView from "./Components/More/Settings/View";
 
 function AppRoute() {
@@ -138,6 +140,21 @@ function AppRoute() {
               element={<TokenTransferSuccessView />}
               exact
             />
+            <Route
+              path="/token/:tokenID/swap"
+              element={<TokenSwapOfferView />}
+              exact
+            />
+            <Route
+              path="/nft/:tokenID/swap"
+              element={<TokenSwapOfferView />}
+              exact
+            />
+            <Route
+              path="/nfts/swap-accept"
+              element={<TokenSwapAcceptView />}
+              exact
+            />
             <Route path="/more" element={<MoreView />} exact />
             <Route path="/more/wallets" element={<ListWalletsView />} exact />
             <Route
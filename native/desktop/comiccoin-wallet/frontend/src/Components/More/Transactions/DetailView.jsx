@@ -160,6 +160,12 @@ function TransactionDetailView() {
                                 <InfoRow label="Actual Value" value={(parseFloat(tx.value) - parseFloat(tx.fee)).toString()} />
                                 <InfoRow label="From" value={tx.from} copyable />
                                 <InfoRow label="To" value={tx.to} copyable />
+                                {tx.royalty > 0 && (
+                                    <>
+                                        <InfoRow label="Royalty" value={tx.royalty} />
+                                        <InfoRow label="Royalty Recipient" value={tx.royalty_recipient} copyable />
+                                    </>
+                                )}
 
                                 {showMoreTxInfo && (
                                     <>
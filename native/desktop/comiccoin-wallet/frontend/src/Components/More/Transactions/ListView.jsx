@@ -154,11 +154,19 @@ function ListTransactionsView() {
           ) : (
             <div className="divide-y divide-gray-100">
               {transactions.map((tx) => {
+                const isRoyalty =
+                  !!tx.royalty_recipient &&
+                  tx.royalty_recipient.toLowerCase() ===
+                    currentOpenWalletAtAddress.toLowerCase();
                 const isReceived =
+                  isRoyalty ||
                   tx.to.toLowerCase() ===
-                  currentOpenWalletAtAddress.toLowerCase();
-                const totalValue =
-                  parseFloat(tx.value) - (isReceived ? parseFloat(tx.fee) : 0);
+                    currentOpenWalletAtAddress.toLowerCase();
+                const royalty = tx.royalty ? parseFloat(tx.royalty) : 0;
+                const totalValue = isRoyalty
+                  ? royalty
+                  : parseFloat(tx.value) -
+                    (isReceived ? parseFloat(tx.fee) + royalty : 0);
 
                 return (
                   <button
@@ -188,11 +196,21 @@ function ListTransactionsView() {
                             <span className="px-2 py-1 text-xs font-medium text-gray-600 bg-gray-100 rounded-full">
                               {tx.type.toUpperCase()}
                             </span>
+                            {isRoyalty && (
+                              <span className="px-2 py-1 text-xs font-medium text-purple-600 bg-purple-100 rounded-full">
+                                ROYALTY
+                              </span>
+                            )}
                             {!isReceived && (
                               <span className="text-xs text-gray-500">
                                 (Fee: {tx.fee} CC)
                               </span>
                             )}
+                            {!isReceived && royalty > 0 && (
+                              <span className="text-xs text-gray-500">
+                                (Royalty: {royalty} CC)
+                              </span>
+                            )}
                           </div>
                           <div className="flex flex-col sm:flex-row sm:items-center gap-1 sm:gap-2 mt-1">
                             <p className="text-sm text-gray-600">
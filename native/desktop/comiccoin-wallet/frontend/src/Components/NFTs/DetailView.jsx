@@ -100,6 +100,9 @@ function TokenDetailView() {
                     <Link className="bg-blue-500 text-white px-6 py-2 rounded-lg hover:bg-blue-600" to={`/token/${tokenID}/transfer`}>
                       Transfer to Another Address
                     </Link>
+                    <Link className="bg-purple-500 text-white px-6 py-2 rounded-lg hover:bg-purple-600" to={`/token/${tokenID}/swap`}>
+                      Sell for ComicCoins
+                    </Link>
                   </div>
 
                   <div className="space-y-2">
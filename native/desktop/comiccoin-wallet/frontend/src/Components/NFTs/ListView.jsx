@@ -128,7 +128,12 @@ function ListTokensView() {
   // Show tokens grid
   return (
     <div className="container mx-auto px-4 py-8">
-      <h1 className="text-3xl font-bold mb-6">My NFT Collection</h1>
+      <div className="flex items-center justify-between mb-6">
+        <h1 className="text-3xl font-bold">My NFT Collection</h1>
+        <Link className="bg-purple-500 text-white px-6 py-2 rounded-lg hover:bg-purple-600" to="/nfts/swap-accept">
+          Accept Swap Offer
+        </Link>
+      </div>
 
       <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6">
         {tokens.map((token) => (
@@ -0,0 +1,215 @@
+// src/Components/NFTs/SwapAcceptView.jsx
+import { useState } from "react";
+import { Navigate } from "react-router-dom";
+import { useRecoilState } from "recoil";
+import { AlertCircle, Info, Repeat, ArrowLeft, Loader2, CheckCircle } from "lucide-react";
+
+import { AcceptTokenSwapOffer } from "../../../wailsjs/go/main/App";
+import { currentOpenWalletAtAddressState } from "../../AppState";
+
+/**
+ * TokenSwapAcceptView component lets a buyer accept the swap offer of a seller.
+ *
+ * The offer is verified against the local blockchain, co-signed with the wallet of the buyer and submitted to the
+ * Authority. The buyer only pays if the token is transferred to them in the same transaction.
+ */
+function TokenSwapAcceptView() {
+  ////
+  //// Global State
+  ////
+
+  const [currentOpenWalletAtAddress] = useRecoilState(
+    currentOpenWalletAtAddressState,
+  );
+
+  ////
+  //// Component states.
+  ////
+
+  const [isLoading, setIsLoading] = useState(false);
+  const [forceURL, setForceURL] = useState("");
+  const [errors, setErrors] = useState({});
+  const [isSubmitted, setIsSubmitted] = useState(false);
+  const [formData, setFormData] = useState({
+    offer: "",
+    password: "",
+  });
+
+  ////
+  //// Event handling.
+  ////
+
+  const handleInputChange = (e) => {
+    const { name, value } = e.target;
+    setFormData((prev) => ({ ...prev, [name]: value }));
+  };
+
+  const handleSubmit = (e) => {
+    e.preventDefault();
+    const newErrors = {};
+    if (!formData.offer.trim()) {
+      newErrors.offer = "Offer is required";
+    }
+    if (!formData.password) {
+      newErrors.password = "Password is required to authorize transaction";
+    }
+    setErrors(newErrors);
+    if (Object.keys(newErrors).length > 0) {
+      return;
+    }
+
+    setIsLoading(true);
+    AcceptTokenSwapOffer(
+      formData.offer.trim(),
+      currentOpenWalletAtAddress,
+      formData.password,
+    )
+      .then(() => {
+        setIsSubmitted(true);
+      })
+      .catch((errorJsonString) => {
+        console.log("AcceptTokenSwapOffer: errorJsonString:", errorJsonString);
+        let err = { message: String(errorJsonString) };
+        if (
+          String(errorJsonString)
+            .toLowerCase()
+            .includes("could not decrypt key with given password")
+        ) {
+          err = { password: "Wrong password" };
+        }
+        setErrors(err);
+      })
+      .finally(() => {
+        setIsLoading(false);
+      });
+  };
+
+  ////
+  //// Component rendering.
+  ////
+
+  if (forceURL !== "") {
+    return <Navigate to={forceURL} />;
+  }
+
+  if (isLoading) {
+    return (
+      <div className="min-h-screen flex items-center justify-center">
+        <div className="text-center space-y-4">
+          <Loader2 className="w-12 h-12 text-red-600 animate-spin mx-auto" />
+          <h2 className="text-xl font-semibold text-gray-900">
+            Submitting swap
+          </h2>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div>
+      <main className="max-w-2xl mx-auto px-6 py-12 mb-24">
+        {Object.keys(errors).length > 0 && (
+          <div className="mb-6 bg-red-50 border-l-4 border-red-500 rounded-r-lg p-4 flex items-start gap-3">
+            <AlertCircle className="w-5 h-5 text-red-500 flex-shrink-0 mt-0.5" />
+            <div className="flex-grow">
+              <h3 className="font-semibold text-red-800">
+                Unable to Accept Offer
+              </h3>
+              <div className="text-sm text-red-600 mt-1 space-y-1">
+                {Object.values(errors).map((error, index) => (
+                  <p key={index}>• {error}</p>
+                ))}
+              </div>
+            </div>
+          </div>
+        )}
+
+        <div className="bg-white rounded-xl border-2 border-gray-100">
+          <div className="p-6">
+            <div className="flex items-center gap-3 mb-2">
+              <div className="p-2 bg-purple-100 rounded-xl">
+                <Repeat className="w-5 h-5 text-purple-600" aria-hidden="true" />
+              </div>
+              <h2 className="text-xl font-bold text-gray-900">Buy Token</h2>
+            </div>
+            <p className="text-sm text-gray-500">
+              Paste the offer you received from the seller. You pay the price
+              and the network fee only if the token is transferred to you.
+            </p>
+          </div>
+
+          {isSubmitted ? (
+            <div className="p-6 flex gap-3 items-start">
+              <CheckCircle className="w-5 h-5 text-green-600 flex-shrink-0 mt-0.5" />
+              <p className="text-sm text-gray-700">
+                The swap was submitted to the Authority. The token will appear
+                in your collection once the block is sealed.
+              </p>
+            </div>
+          ) : (
+            <div className="p-6 space-y-6">
+              <label className="block">
+                <span className="text-sm font-medium text-gray-700">
+                  Offer <span className="text-red-500">*</span>
+                </span>
+                <textarea
+                  name="offer"
+                  value={formData.offer}
+                  onChange={handleInputChange}
+                  rows={8}
+                  className={`mt-1 block w-full px-4 py-3 font-mono text-xs bg-white border rounded-lg ${
+                    errors.offer ? "border-red-300 bg-red-50" : "border-gray-200"
+                  }`}
+                  placeholder="Paste the offer of the seller"
+                />
+              </label>
+
+              <label className="block">
+                <span className="text-sm font-medium text-gray-700">
+                  Wallet Password <span className="text-red-500">*</span>
+                </span>
+                <input
+                  type="password"
+                  name="password"
+                  value={formData.password}
+                  onChange={handleInputChange}
+                  className={`mt-1 block w-full px-4 py-3 bg-white border rounded-lg focus:ring-2 focus:ring-red-500 focus:border-transparent transition-colors ${
+                    errors.password ? "border-red-300 bg-red-50" : "border-gray-200"
+                  }`}
+                  placeholder="Enter your wallet password"
+                />
+              </label>
+
+              <div className="bg-blue-50 border border-blue-100 rounded-xl p-4 flex gap-3">
+                <Info className="w-5 h-5 text-blue-600 flex-shrink-0 mt-0.5" />
+                <p className="text-sm text-blue-800">
+                  All transactions are final and cannot be undone. Please verify
+                  the offer with the seller before accepting.
+                </p>
+              </div>
+
+              <div className="flex gap-4 justify-end pt-4">
+                <button
+                  onClick={() => setForceURL("/nfts")}
+                  className="px-6 py-2.5 border border-gray-300 text-gray-700 rounded-lg hover:bg-gray-50 transition-colors inline-flex items-center gap-2"
+                >
+                  <ArrowLeft className="w-4 h-4" />
+                  Cancel
+                </button>
+                <button
+                  onClick={handleSubmit}
+                  className="px-6 py-2.5 bg-purple-600 text-white rounded-lg hover:bg-purple-700 transition-colors inline-flex items-center gap-2"
+                >
+                  Accept Offer
+                  <Repeat className="w-4 h-4" />
+                </button>
+              </div>
+            </div>
+          )}
+        </div>
+      </main>
+    </div>
+  );
+}
+
+export default TokenSwapAcceptView;
@@ -0,0 +1,230 @@
+// src/Components/NFTs/SwapOfferView.jsx
+import { useState } from "react";
+import { useParams, Navigate } from "react-router-dom";
+import { useRecoilState } from "recoil";
+import { AlertCircle, Info, Repeat, ArrowLeft, Loader2, Copy } from "lucide-react";
+
+import { CreateTokenSwapOffer } from "../../../wailsjs/go/main/App";
+import { currentOpenWalletAtAddressState } from "../../AppState";
+
+/**
+ * TokenSwapOfferView component lets the owner of a token sign an offer to sell the token to a buyer for ComicCoins.
+ *
+ * The offer is only signed by the seller and is not submitted, it is displayed so it can be copied and handed to
+ * the buyer who accepts it in their wallet. The token and the coins are exchanged in a single transaction once the
+ * buyer accepts, or not at all.
+ */
+function TokenSwapOfferView() {
+  ////
+  //// URL Parameters.
+  ////
+
+  const { tokenID } = useParams();
+
+  ////
+  //// Global State
+  ////
+
+  const [currentOpenWalletAtAddress] = useRecoilState(
+    currentOpenWalletAtAddressState,
+  );
+
+  ////
+  //// Component states.
+  ////
+
+  const [isLoading, setIsLoading] = useState(false);
+  const [forceURL, setForceURL] = useState("");
+  const [errors, setErrors] = useState({});
+  const [offer, setOffer] = useState("");
+  const [formData, setFormData] = useState({
+    buyer: "",
+    price: "",
+    password: "",
+  });
+
+  ////
+  //// Event handling.
+  ////
+
+  const validateForm = () => {
+    const newErrors = {};
+    if (!/^[A-Za-z0-9]{42}$/.test(formData.buyer)) {
+      newErrors.buyer = "Invalid buyer wallet address format";
+    }
+    if (!(parseInt(formData.price) > 0)) {
+      newErrors.price = "Price must be more than zero";
+    }
+    if (!formData.password) {
+      newErrors.password = "Password is required to sign the offer";
+    }
+    setErrors(newErrors);
+    return Object.keys(newErrors).length === 0;
+  };
+
+  const handleInputChange = (e) => {
+    const { name, value } = e.target;
+    setFormData((prev) => ({ ...prev, [name]: value }));
+  };
+
+  const handleSubmit = (e) => {
+    e.preventDefault();
+    if (!validateForm()) {
+      return;
+    }
+    setIsLoading(true);
+    CreateTokenSwapOffer(
+      formData.buyer,
+      parseInt(tokenID),
+      parseInt(formData.price),
+      currentOpenWalletAtAddress,
+      formData.password,
+    )
+      .then((offerRes) => {
+        setOffer(offerRes);
+      })
+      .catch((errorJsonString) => {
+        console.log("CreateTokenSwapOffer: errorJsonString:", errorJsonString);
+        let err = { message: String(errorJsonString) };
+        if (
+          String(errorJsonString)
+            .toLowerCase()
+            .includes("could not decrypt key with given password")
+        ) {
+          err = { password: "Wrong password" };
+        }
+        setErrors(err);
+      })
+      .finally(() => {
+        setIsLoading(false);
+      });
+  };
+
+  ////
+  //// Component rendering.
+  ////
+
+  if (forceURL !== "") {
+    return <Navigate to={forceURL} />;
+  }
+
+  if (isLoading) {
+    return (
+      <div className="min-h-screen flex items-center justify-center">
+        <div className="text-center space-y-4">
+          <Loader2 className="w-12 h-12 text-red-600 animate-spin mx-auto" />
+          <h2 className="text-xl font-semibold text-gray-900">
+            Signing offer
+          </h2>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div>
+      <main className="max-w-2xl mx-auto px-6 py-12 mb-24">
+        {Object.keys(errors).length > 0 && (
+          <div className="mb-6 bg-red-50 border-l-4 border-red-500 rounded-r-lg p-4 flex items-start gap-3">
+            <AlertCircle className="w-5 h-5 text-red-500 flex-shrink-0 mt-0.5" />
+            <div className="flex-grow">
+              <h3 className="font-semibold text-red-800">
+                Unable to Create Offer
+              </h3>
+              <div className="text-sm text-red-600 mt-1 space-y-1">
+                {Object.values(errors).map((error, index) => (
+                  <p key={index}>• {error}</p>
+                ))}
+              </div>
+            </div>
+          </div>
+        )}
+
+        <div className="bg-white rounded-xl border-2 border-gray-100">
+          <div className="p-6">
+            <div className="flex items-center gap-3 mb-2">
+              <div className="p-2 bg-purple-100 rounded-xl">
+                <Repeat className="w-5 h-5 text-purple-600" aria-hidden="true" />
+              </div>
+              <h2 className="text-xl font-bold text-gray-900">Sell Token</h2>
+            </div>
+            <p className="text-sm text-gray-500">
+              Sign an offer to sell this token for ComicCoins. The buyer pays
+              the network fee on top of the price.
+            </p>
+          </div>
+
+          {offer !== "" ? (
+            <div className="p-6 space-y-4">
+              <div className="bg-blue-50 border border-blue-100 rounded-xl p-4 flex gap-3">
+                <Info className="w-5 h-5 text-blue-600 flex-shrink-0 mt-0.5" />
+                <p className="text-sm text-blue-800">
+                  Send this offer to the buyer. The offer stops being valid as
+                  soon as you send any other transaction.
+                </p>
+              </div>
+              <textarea
+                readOnly
+                value={offer}
+                rows={10}
+                className="block w-full px-4 py-3 font-mono text-xs bg-gray-50 border border-gray-200 rounded-lg"
+              />
+              <div className="flex gap-4 justify-end">
+                <button
+                  onClick={() => navigator.clipboard.writeText(offer)}
+                  className="px-6 py-2.5 bg-purple-600 text-white rounded-lg hover:bg-purple-700 transition-colors inline-flex items-center gap-2"
+                >
+                  Copy Offer
+                  <Copy className="w-4 h-4" />
+                </button>
+              </div>
+            </div>
+          ) : (
+            <div className="p-6 space-y-6">
+              {[
+                { name: "buyer", label: "Buyer Address", type: "text", placeholder: "Enter the address of the buyer" },
+                { name: "price", label: "Price (CC)", type: "number", placeholder: "Enter the price in ComicCoins" },
+                { name: "password", label: "Wallet Password", type: "password", placeholder: "Enter your wallet password" },
+              ].map((field) => (
+                <label key={field.name} className="block">
+                  <span className="text-sm font-medium text-gray-700">
+                    {field.label} <span className="text-red-500">*</span>
+                  </span>
+                  <input
+                    type={field.type}
+                    name={field.name}
+                    value={formData[field.name]}
+                    onChange={handleInputChange}
+                    className={`mt-1 block w-full px-4 py-3 bg-white border rounded-lg focus:ring-2 focus:ring-red-500 focus:border-transparent transition-colors ${
+                      errors[field.name] ? "border-red-300 bg-red-50" : "border-gray-200"
+                    }`}
+                    placeholder={field.placeholder}
+                  />
+                </label>
+              ))}
+
+              <div className="flex gap-4 justify-end pt-4">
+                <button
+                  onClick={() => setForceURL("/token/" + tokenID)}
+                  className="px-6 py-2.5 border border-gray-300 text-gray-700 rounded-lg hover:bg-gray-50 transition-colors inline-flex items-center gap-2"
+                >
+                  <ArrowLeft className="w-4 h-4" />
+                  Cancel
+                </button>
+                <button
+                  onClick={handleSubmit}
+                  className="px-6 py-2.5 bg-purple-600 text-white rounded-lg hover:bg-purple-700 transition-colors inline-flex items-center gap-2"
+                >
+                  Sign Offer
+                  <Repeat className="w-4 h-4" />
+                </button>
+              </div>
+            </div>
+          )}
+        </div>
+      </main>
+    </div>
+  );
+}
+
+export default TokenSwapOfferView;
@@ -4,8 +4,12 @@ import {big} from '../models';
 import {domain} from '../models';
 import {main} from '../models';
 
+export function AcceptTokenSwapOffer(arg1:string,arg2:string,arg3:string):Promise<void>;
+
 export function BurnToken(arg1:big.Int,arg2:string,arg3:string):Promise<void>;
 
+export function CreateTokenSwapOffer(arg1:string,arg2:big.Int,arg3:number,arg4:string,arg5:string):Promise<string>;
+
 export function CreateWallet(arg1:string,arg2:string,arg3:string):Promise<string>;
 
 export function DefaultComicCoinAuthorityAddress():Promise<string>;
@@ -2,10 +2,18 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AcceptTokenSwapOffer(arg1, arg2, arg3) {
+  return window['go']['main']['App']['AcceptTokenSwapOffer'](arg1, arg2, arg3);
+}
+
 export function BurnToken(arg1, arg2, arg3) {
   return window['go']['main']['App']['BurnToken'](arg1, arg2, arg3);
 }
 
+export function CreateTokenSwapOffer(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['CreateTokenSwapOffer'](arg1, arg2, arg3, arg4, arg5);
+}
+
 export function CreateWallet(arg1, arg2, arg3) {
   return window['go']['main']['App']['CreateWallet'](arg1, arg2, arg3);
 }
@@ -22,6 +22,8 @@ import ResetPasswordPage from "./pages/Anonymous/Gateway/ResetPasswordPage";
 import RegisterPage from "./pages/Anonymous/Gateway/RegisterPage";
 import RegistrationSuccessPage from "./pages/Anonymous/Gateway/RegistrationSuccessPage";
 import EmailVerificationPage from "./pages/Anonymous/Gateway/EmailVerificationPage";
+import UnlockAccountPage from "./pages/Anonymous/Gateway/UnlockAccountPage";
+import OAuthAuthorizePage from "./pages/Anonymous/Gateway/OAuthAuthorizePage";
 
 // User
 import VerificationLaunchpadPage from "./pages/Individual/Verification/LaunchpadPage";
@@ -100,6 +102,8 @@ function App() {
             <Route path="/verify" element={<EmailVerificationPage />} />
             <Route path="/forgot-password" element={<ForgotPasswordPage />} />
             <Route path="/reset-password" element={<ResetPasswordPage />} />
+            <Route path="/unlock-account" element={<UnlockAccountPage />} />
+            <Route path="/oauth/authorize" element={<OAuthAuthorizePage />} />
             <Route path="/terms" element={<TermsPage />} />
             <Route path="/privacy" element={<PrivacyPage />} />
 
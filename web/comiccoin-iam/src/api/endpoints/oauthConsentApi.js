@@ -0,0 +1,76 @@
+// monorepo/web/comiccoin-iam/src/api/endpoints/oauthConsentApi.js
+import axios from "axios";
+import axiosClient from "../axiosClient";
+
+/**
+ * Convert an axios error into our `{ message, status }` error shape
+ * @param {Error} error - The error thrown by axios
+ * @param {string} fallback - Message to use when the server sent none
+ */
+const toApiError = (error, fallback) => {
+  if (axios.isAxiosError(error) && error.response?.data) {
+    return {
+      message:
+        error.response.data.auth_id ||
+        error.response.data.message ||
+        fallback,
+      status: error.response.status,
+    };
+  }
+  if (axios.isAxiosError(error)) {
+    return {
+      message: "Network error. Please check your internet connection.",
+      status: error.response?.status || 0,
+    };
+  }
+  return { message: fallback, status: 500 };
+};
+
+/**
+ * Get the app and scopes of a pending "Log in with ComicCoin" request
+ * @param {string} authID - The authorization request id from the URL
+ * @returns {Promise} Promise with `client_name`, `redirect_uri` and `scopes`
+ */
+export const getOAuthConsent = async (authID) => {
+  try {
+    const response = await axiosClient.get(
+      `/oauth/authorize/${encodeURIComponent(authID)}`,
+    );
+    return response.data;
+  } catch (error) {
+    console.error("❌ Get OAuth consent error:", {
+      error,
+      response: error.response?.data,
+      status: error.response?.status,
+    });
+    throw toApiError(error, "The authorization request could not be loaded");
+  }
+};
+
+/**
+ * Approve or deny a pending "Log in with ComicCoin" request
+ * @param {string} authID - The authorization request id from the URL
+ * @param {boolean} approve - Whether the user allows the app access
+ * @returns {Promise} Promise with the `redirect_url` to send the browser to
+ */
+export const decideOAuthConsent = async (authID, approve) => {
+  try {
+    const response = await axiosClient.post(
+      `/oauth/authorize/${encodeURIComponent(authID)}`,
+      { approve },
+    );
+    return response.data;
+  } catch (error) {
+    console.error("❌ OAuth consent decision error:", {
+      error,
+      response: error.response?.data,
+      status: error.response?.status,
+    });
+    throw toApiError(error, "Your decision could not be saved");
+  }
+};
+
+export default {
+  getOAuthConsent,
+  decideOAuthConsent,
+};
@@ -57,6 +57,17 @@ export const useCreatePublicWallet = (options = {}) => {
   });
 };
 
+/**
+ * Custom hook for requesting the challenge message which the user must sign
+ * with the key of the public wallet (for example `comiccoin-cli account
+ * sign-message`) before creating the public wallet or changing its address.
+ */
+export const usePublicWalletChallenge = (options = {}) => {
+  return usePrivateMutation("/public-wallets/challenge", "post", {
+    ...options,
+  });
+};
+
 /**
  * Custom hook for creating a public wallet as an admin
  * This endpoint accepts additional fields: user_id, status, and is_verified
@@ -143,6 +154,8 @@ export function transformPublicWallet(wallet) {
     addressLine2: wallet.address_line2,
     isVerified: wallet.is_verified,
     verifiedOn: wallet.verified_on,
+    isOwnerVerified: wallet.is_owner_verified,
+    ownerVerifiedOn: wallet.owner_verified_on,
     type: wallet.type,
     thumbnailS3Key: wallet.thumbnail_s3_key,
     viewCount: wallet.view_count,
@@ -199,6 +212,7 @@ export function prepareWalletForApi(wallet) {
     user_id: wallet.userId,
     is_verified: wallet.is_verified || wallet.isVerified,
     verified_on: wallet.verified_on || wallet.verifiedOn,
+    signature: wallet.signature,
   };
   // console.log("🚀 prepareWalletForApi: wallet:", wallet);
   // console.log("🚀 prepareWalletForApi: payload:", payload);
@@ -229,6 +243,7 @@ export default {
   getPublicWalletByAddress,
   useListPublicWallets,
   useCreatePublicWallet,
+  usePublicWalletChallenge,
   useCreatePublicWalletByAdmin,
   useUpdatePublicWalletByAddress,
   useDeletePublicWalletByAddress,
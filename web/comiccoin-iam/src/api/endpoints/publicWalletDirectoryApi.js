@@ -49,6 +49,8 @@ export const useListPublicWalletsFromDirectory = (
   if (filters.type !== undefined) queryParams.append("type", filters.type);
   if (filters.isVerified !== undefined)
     queryParams.append("is_verified", filters.isVerified);
+  if (filters.isOwnerVerified !== undefined)
+    queryParams.append("is_owner_verified", filters.isOwnerVerified);
   if (filters.location) queryParams.append("location", filters.location);
 
   // For public endpoints, we may want to filter out non-active wallets by default
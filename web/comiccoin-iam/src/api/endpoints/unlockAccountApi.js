@@ -0,0 +1,59 @@
+// monorepo/web/comiccoin-iam/src/api/endpoints/unlockAccountApi.js
+import axios from "axios";
+import axiosClient, { publicEndpoint } from "../axiosClient";
+
+/**
+ * Unlock an account locked after too many failed logins
+ * @param {string} unlockCode - The unlock code from the email
+ * @returns {Promise} Promise with the unlock result
+ */
+export const unlockAccount = async (unlockCode) => {
+  try {
+    console.log("🔄 Starting account unlock process");
+
+    const response = await axiosClient.post(
+      "/unlock-account",
+      { code: unlockCode },
+      publicEndpoint({}),
+    );
+
+    console.log("✅ Account unlock successful");
+    return response.data;
+  } catch (error) {
+    console.error("❌ Account unlock error:", {
+      error,
+      response: error.response?.data,
+      status: error.response?.status,
+    });
+
+    // Handle axios errors
+    if (axios.isAxiosError(error)) {
+      // If the server returned a response with our standard error format
+      if (error.response?.data) {
+        throw {
+          message:
+            error.response.data.code ||
+            error.response.data.message ||
+            "Unlock failed",
+          status: error.response.status,
+        };
+      }
+
+      // Network errors or other axios errors
+      throw {
+        message: "Network error. Please check your internet connection.",
+        status: error.response?.status || 0,
+      };
+    }
+
+    // For any other unexpected errors
+    throw {
+      message: "An unexpected error occurred while unlocking your account.",
+      status: 500,
+    };
+  }
+};
+
+export default {
+  unlockAccount,
+};
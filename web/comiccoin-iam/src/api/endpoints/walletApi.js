@@ -25,10 +25,11 @@ export function useConnectWallet(options = {}) {
   /**
    * Connect a wallet address to the user's account
    * @param {string} walletAddress - The wallet address to connect
+   * @param {string} signature - The wallet's signature of the challenge message
    * @returns {Promise<boolean>} Promise resolving to a boolean indicating success
    */
   const connectWallet = useCallback(
-    async (walletAddress) => {
+    async (walletAddress, signature) => {
       console.log("🔄 WALLET: Starting wallet connection process", {
         walletAddress: `${walletAddress.slice(0, 6)}...${walletAddress.slice(-4)}`,
       });
@@ -39,6 +40,7 @@ export function useConnectWallet(options = {}) {
         // Make the API call
         const response = await mutateAsync({
           wallet_address: walletAddress,
+          signature: signature,
         });
 
         console.log("✅ WALLET: Wallet connected successfully");
@@ -96,3 +98,41 @@ export function useConnectWallet(options = {}) {
     error: error || apiError,
   };
 }
+
+/**
+ * Custom hook for requesting the challenge message which the user must sign
+ * with their wallet (for example `comiccoin-cli account sign-message`) before
+ * the wallet can be connected to their account.
+ *
+ * @param {Object} options - Optional configuration options
+ * @returns {Object} Object containing request function, loading state, and error state
+ */
+export function useConnectWalletChallenge(options = {}) {
+  const {
+    mutateAsync,
+    isLoading,
+    error,
+  } = usePrivateMutation("/me/connect-wallet/challenge", "post", {
+    ...options,
+  });
+
+  /**
+   * Request a challenge for the wallet address
+   * @param {string} walletAddress - The wallet address to connect
+   * @returns {Promise<Object>} Promise resolving to the `message` to sign and its `expires_at`
+   */
+  const requestChallenge = useCallback(
+    async (walletAddress) => {
+      return await mutateAsync({
+        wallet_address: walletAddress,
+      });
+    },
+    [mutateAsync],
+  );
+
+  return {
+    requestChallenge,
+    isRequesting: isLoading,
+    error,
+  };
+}
@@ -0,0 +1,148 @@
+// monorepo/web/comiccoin-iam/src/components/WalletOwnershipChallenge.jsx
+import React, { useState } from "react";
+import {
+  KeyRound,
+  AlertCircle,
+  Loader,
+  Copy,
+  CheckCircle2,
+} from "lucide-react";
+import { usePublicWalletChallenge } from "../api/endpoints/publicWalletApi";
+
+/**
+ * Lets the user prove they control the key of a public wallet address: the
+ * server issues a challenge message, the user signs it with their wallet
+ * (for example `comiccoin-cli account sign-message`) and pastes the
+ * signature which is submitted with the public wallet.
+ */
+const WalletOwnershipChallenge = ({
+  address,
+  signature,
+  onSignatureChange,
+  error,
+  required = true,
+}) => {
+  const { mutateAsync: requestChallenge, isPending } =
+    usePublicWalletChallenge();
+  const [challenge, setChallenge] = useState(null);
+  const [challengeError, setChallengeError] = useState("");
+  const [copied, setCopied] = useState(false);
+
+  const handleRequestChallenge = async () => {
+    setChallengeError("");
+    setCopied(false);
+    try {
+      const resp = await requestChallenge({ address: address });
+      setChallenge(resp);
+      onSignatureChange("");
+    } catch (err) {
+      console.error("Failed requesting wallet challenge:", err);
+      const data = err.response && err.response.data;
+      setChallengeError(
+        (data && (data.address || data.message)) ||
+          "Failed to request a message to sign, please check the address",
+      );
+    }
+  };
+
+  const handleCopy = () => {
+    navigator.clipboard.writeText(challenge.message);
+    setCopied(true);
+    setTimeout(() => setCopied(false), 2000);
+  };
+
+  return (
+    <div className="bg-indigo-50 border border-indigo-100 rounded-lg p-4 space-y-3">
+      <div className="flex items-start">
+        <KeyRound className="h-5 w-5 text-indigo-600 mr-2 mt-0.5 flex-shrink-0" />
+        <div>
+          <h3 className="text-sm font-medium text-indigo-900">
+            Prove Wallet Ownership{" "}
+            {required && <span className="text-red-500">*</span>}
+          </h3>
+          <p className="text-xs text-indigo-700">
+            Sign the message below with the key of this wallet, for example
+            with <code>comiccoin-cli account sign-message</code>, and paste
+            the signature. Verified wallets show an "Owner Verified" badge in
+            the directory.
+          </p>
+        </div>
+      </div>
+
+      <button
+        type="button"
+        onClick={handleRequestChallenge}
+        disabled={!address || isPending}
+        className="px-3 py-2 text-sm bg-indigo-600 text-white rounded-lg hover:bg-indigo-700 disabled:opacity-50 disabled:cursor-not-allowed flex items-center"
+      >
+        {isPending && <Loader className="h-4 w-4 mr-2 animate-spin" />}
+        {challenge ? "Get New Message" : "Get Message to Sign"}
+      </button>
+      {challengeError && (
+        <p className="text-sm text-red-600 flex items-center">
+          <AlertCircle className="h-4 w-4 mr-1" />
+          {challengeError}
+        </p>
+      )}
+
+      {challenge && (
+        <div>
+          <div className="flex items-center justify-between mb-1">
+            <label className="block text-xs font-medium text-gray-700">
+              Message to sign
+            </label>
+            <button
+              type="button"
+              onClick={handleCopy}
+              className="text-xs text-indigo-600 hover:text-indigo-800 flex items-center"
+            >
+              {copied ? (
+                <CheckCircle2 className="h-3.5 w-3.5 mr-1" />
+              ) : (
+                <Copy className="h-3.5 w-3.5 mr-1" />
+              )}
+              {copied ? "Copied" : "Copy"}
+            </button>
+          </div>
+          <textarea
+            readOnly
+            rows={6}
+            value={challenge.message}
+            className="w-full px-3 py-2 text-xs font-mono border border-gray-300 rounded-lg bg-white"
+          />
+          <p className="mt-1 text-xs text-gray-500">
+            Expires at {new Date(challenge.expires_at).toLocaleTimeString()}
+          </p>
+        </div>
+      )}
+
+      <div>
+        <label
+          htmlFor="signature"
+          className="block text-xs font-medium text-gray-700 mb-1"
+        >
+          Signature
+        </label>
+        <input
+          type="text"
+          id="signature"
+          name="signature"
+          value={signature}
+          onChange={(e) => onSignatureChange(e.target.value)}
+          className={`w-full px-3 py-2 h-10 font-mono text-sm border rounded-lg shadow-sm focus:outline-none focus:ring-2 focus:ring-purple-500 ${
+            error ? "border-red-500 bg-red-50" : "border-gray-300"
+          }`}
+          placeholder="0x..."
+        />
+        {error && (
+          <p className="mt-1 text-sm text-red-600 flex items-center">
+            <AlertCircle className="h-4 w-4 mr-1" />
+            {error}
+          </p>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default WalletOwnershipChallenge;
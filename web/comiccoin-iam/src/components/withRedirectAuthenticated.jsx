@@ -3,6 +3,7 @@ import React, { useEffect } from "react";
 import { useNavigate, useLocation } from "react-router";
 import { useAuth } from "../hooks/useAuth";
 import { USER_ROLE } from "../hooks/useUser"; // Import the USER_ROLE constants
+import { getOAuthReturnTo } from "../utils/oauthReturnTo";
 
 /**
  * Higher-Order Component that redirects authenticated users based on their role
@@ -38,6 +39,13 @@ function withRedirectAuthenticated(Component, options = {}) {
       // 1. Auth check is complete (not loading)
       // 2. User is authenticated
       if (!isLoading && isAuthenticated && user) {
+        // Users logging in for a third-party app go back to its consent page
+        const oauthReturnTo = getOAuthReturnTo();
+        if (oauthReturnTo) {
+          navigate(oauthReturnTo);
+          return;
+        }
+
         // Determine redirect path based on user role
         const redirectTo =
           user.role === USER_ROLE.ROOT ? adminRedirectTo : userRedirectTo;
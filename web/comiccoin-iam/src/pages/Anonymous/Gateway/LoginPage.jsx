@@ -17,6 +17,7 @@ import Header from "../../../components/IndexPage/Header";
 import Footer from "../../../components/IndexPage/Footer";
 import withRedirectAuthenticated from "../../../components/withRedirectAuthenticated";
 import { USER_ROLE } from "../../../hooks/useUser"; // Import USER_ROLE constants
+import { getOAuthReturnTo } from "../../../utils/oauthReturnTo";
 
 function LoginPage() {
   console.log("🚀 LoginPage component initializing");
@@ -50,6 +51,13 @@ function LoginPage() {
 
     // Only redirect if authenticated
     if (isAuthenticated && user) {
+      // Users logging in for a third-party app go back to its consent page
+      const oauthReturnTo = getOAuthReturnTo();
+      if (oauthReturnTo) {
+        navigate(oauthReturnTo);
+        return;
+      }
+
       // Check if user is a root/admin user
       if (user.role === USER_ROLE.ROOT) {
         console.log("👑 Root user detected, redirecting to admin dashboard");
@@ -106,6 +114,10 @@ function LoginPage() {
           console.log("🔍 Field-specific errors detected:", fieldErrors);
           setErrors(fieldErrors);
         }
+        // Failed logins and locked accounts are reported without a field
+        else if (fieldErrors.non_field_error) {
+          setGeneralError(fieldErrors.non_field_error);
+        }
         // If we have a message field, use that as a general error
         else if (fieldErrors.message) {
           setGeneralError(fieldErrors.message);
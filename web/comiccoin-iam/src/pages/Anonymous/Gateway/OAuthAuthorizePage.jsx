@@ -0,0 +1,196 @@
+// monorepo/web/comiccoin-iam/src/pages/Anonymous/Gateway/OAuthAuthorizePage.jsx
+import React, { useState, useEffect } from "react";
+import { Link, useNavigate, useSearchParams } from "react-router";
+import {
+  Coins,
+  ShieldCheck,
+  AlertCircle,
+  Loader,
+  User,
+  Mail,
+} from "lucide-react";
+
+import { useAuth } from "../../../hooks/useAuth";
+import {
+  getOAuthConsent,
+  decideOAuthConsent,
+} from "../../../api/endpoints/oauthConsentApi";
+import {
+  saveOAuthReturnTo,
+  clearOAuthReturnTo,
+} from "../../../utils/oauthReturnTo";
+
+// What each scope lets the app see, shown to the user before they agree.
+const SCOPE_DESCRIPTIONS = {
+  profile: { icon: User, label: "Your first and last name" },
+  email: { icon: Mail, label: "Your email address" },
+};
+
+/**
+ * OAuthAuthorizePage asks the user if a third-party app may "Log in with
+ * ComicCoin". The IAM server sends the browser here from its authorize
+ * endpoint and we send the browser back to the app with the decision.
+ */
+const OAuthAuthorizePage = () => {
+  const navigate = useNavigate();
+  const [searchParams] = useSearchParams();
+  const authID = searchParams.get("auth_id") || "";
+  const { isAuthenticated, isLoading: isAuthLoading } = useAuth();
+
+  const [consent, setConsent] = useState(null);
+  const [error, setError] = useState(
+    authID ? null : "The authorization request is missing its id.",
+  );
+  const [isSubmitting, setIsSubmitting] = useState(false);
+
+  // Logged out users must log in first and then come back here.
+  useEffect(() => {
+    if (!authID || isAuthLoading) {
+      return;
+    }
+    if (!isAuthenticated) {
+      saveOAuthReturnTo(
+        `/oauth/authorize?auth_id=${encodeURIComponent(authID)}`,
+      );
+      navigate("/login", { replace: true });
+      return;
+    }
+    clearOAuthReturnTo();
+
+    getOAuthConsent(authID)
+      .then((data) => setConsent(data))
+      .catch((err) => setError(err.message));
+  }, [authID, isAuthenticated, isAuthLoading, navigate]);
+
+  const handleDecision = async (approve) => {
+    setIsSubmitting(true);
+    try {
+      const response = await decideOAuthConsent(authID, approve);
+      window.location.assign(response.redirect_url);
+    } catch (err) {
+      setError(err.message);
+      setIsSubmitting(false);
+    }
+  };
+
+  if (!error && !consent) {
+    return (
+      <div className="min-h-screen flex items-center justify-center bg-gradient-to-b from-purple-100 to-white">
+        <div className="text-center">
+          <Loader className="h-10 w-10 text-purple-600 animate-spin mx-auto mb-4" />
+          <p className="text-xl text-purple-600">Loading...</p>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div className="min-h-screen flex flex-col bg-gradient-to-b from-purple-100 to-white">
+      <nav className="bg-gradient-to-r from-purple-700 to-indigo-800 text-white p-4">
+        <div className="max-w-7xl mx-auto flex justify-between items-center">
+          <div className="flex items-center space-x-2">
+            <Coins className="h-8 w-8" />
+            <span className="text-2xl font-bold">
+              ComicCoin Digital Identity
+            </span>
+          </div>
+        </div>
+      </nav>
+
+      <main className="flex-grow flex items-center justify-center">
+        <div className="w-full max-w-2xl mx-4">
+          <div
+            className={`bg-white rounded-xl p-8 shadow-lg border-2 ${
+              error ? "border-red-200" : "border-purple-200"
+            }`}
+          >
+            {error ? (
+              <div className="flex flex-col items-center space-y-6">
+                <div className="text-red-500">
+                  <AlertCircle className="h-16 w-16" />
+                </div>
+                <div className="flex flex-col items-center space-y-2">
+                  <h1 className="text-2xl font-bold text-red-600">
+                    Authorization Failed
+                  </h1>
+                  <p className="text-gray-500 text-center">{error}</p>
+                  <p className="text-gray-500 text-center">
+                    Please go back to the app and try logging in again.
+                  </p>
+                </div>
+                <Link
+                  to="/"
+                  className="px-6 py-2 border border-purple-600 text-purple-600 rounded-lg hover:bg-purple-50 transition-colors"
+                >
+                  Back to Home
+                </Link>
+              </div>
+            ) : (
+              <div className="flex flex-col space-y-6">
+                <div className="flex flex-col items-center space-y-2">
+                  <ShieldCheck className="h-16 w-16 text-purple-600" />
+                  <h1 className="text-2xl font-bold text-purple-800 text-center">
+                    {consent.client_name || consent.client_id} wants to use
+                    your ComicCoin account
+                  </h1>
+                </div>
+
+                <div>
+                  <p className="text-gray-700 mb-3">
+                    This will allow it to see:
+                  </p>
+                  <ul className="space-y-2">
+                    {consent.scopes.map((scope) => {
+                      const description = SCOPE_DESCRIPTIONS[scope];
+                      const Icon = description?.icon || ShieldCheck;
+                      return (
+                        <li
+                          key={scope}
+                          className="flex items-center space-x-3 p-3 bg-purple-50 rounded-lg"
+                        >
+                          <Icon className="h-5 w-5 text-purple-600" />
+                          <span className="text-gray-700">
+                            {description?.label || scope}
+                          </span>
+                        </li>
+                      );
+                    })}
+                  </ul>
+                </div>
+
+                <p className="text-sm text-gray-500">
+                  You will be sent to{" "}
+                  <span className="font-mono break-all">
+                    {consent.redirect_uri}
+                  </span>
+                  . The app will never see your password.
+                </p>
+
+                <div className="flex space-x-4 justify-end">
+                  <button
+                    type="button"
+                    onClick={() => handleDecision(false)}
+                    disabled={isSubmitting}
+                    className="px-6 py-2 border border-purple-600 text-purple-600 rounded-lg hover:bg-purple-50 transition-colors disabled:opacity-50"
+                  >
+                    Deny
+                  </button>
+                  <button
+                    type="button"
+                    onClick={() => handleDecision(true)}
+                    disabled={isSubmitting}
+                    className="px-6 py-2 bg-purple-600 text-white rounded-lg hover:bg-purple-700 transition-colors disabled:opacity-50"
+                  >
+                    {isSubmitting ? "Please wait..." : "Allow"}
+                  </button>
+                </div>
+              </div>
+            )}
+          </div>
+        </div>
+      </main>
+    </div>
+  );
+};
+
+export default OAuthAuthorizePage;
@@ -0,0 +1,120 @@
+// monorepo/web/comiccoin-iam/src/pages/Anonymous/Gateway/UnlockAccountPage.jsx
+import React, { useState, useEffect, useRef } from "react";
+import { Link, useSearchParams } from "react-router";
+import { Coins, Unlock, CheckCircle, AlertCircle, Loader } from "lucide-react";
+
+import { unlockAccount } from "../../../api/endpoints/unlockAccountApi";
+
+/**
+ * UnlockAccountPage unlocks an account with the code from the unlock link
+ * we email after too many failed logins
+ */
+const UnlockAccountPage = () => {
+  const [searchParams] = useSearchParams();
+  const unlockCode = searchParams.get("code") || "";
+
+  const [isLoading, setIsLoading] = useState(!!unlockCode);
+  const [message, setMessage] = useState("");
+  const [error, setError] = useState(
+    unlockCode ? null : "The unlock link is missing its code.",
+  );
+
+  // Only submit the code once, even when the effect runs twice
+  const unlockAttempted = useRef(false);
+
+  useEffect(() => {
+    if (!unlockCode || unlockAttempted.current) {
+      return;
+    }
+    unlockAttempted.current = true;
+
+    unlockAccount(unlockCode)
+      .then((response) => setMessage(response.message))
+      .catch((err) =>
+        setError(err.message || "The unlock link is invalid or has expired."),
+      )
+      .finally(() => setIsLoading(false));
+  }, [unlockCode]);
+
+  if (isLoading) {
+    return (
+      <div className="min-h-screen flex items-center justify-center bg-gradient-to-b from-purple-100 to-white">
+        <div className="text-center">
+          <Loader className="h-10 w-10 text-purple-600 animate-spin mx-auto mb-4" />
+          <p className="text-xl text-purple-600">Unlocking your account...</p>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div className="min-h-screen flex flex-col bg-gradient-to-b from-purple-100 to-white">
+      <nav className="bg-gradient-to-r from-purple-700 to-indigo-800 text-white p-4">
+        <div className="max-w-7xl mx-auto flex justify-between items-center">
+          <div className="flex items-center space-x-2">
+            <Coins className="h-8 w-8" />
+            <span className="text-2xl font-bold">
+              ComicCoin Digital Identity
+            </span>
+          </div>
+        </div>
+      </nav>
+
+      <main className="flex-grow flex items-center justify-center">
+        <div className="w-full max-w-2xl mx-4">
+          <div
+            className={`bg-white rounded-xl p-8 shadow-lg border-2 ${
+              error ? "border-red-200" : "border-purple-200"
+            }`}
+          >
+            <div className="flex flex-col items-center space-y-6">
+              {error ? (
+                <>
+                  <div className="text-red-500">
+                    <AlertCircle className="h-16 w-16" />
+                  </div>
+                  <div className="flex flex-col items-center space-y-2">
+                    <h1 className="text-2xl font-bold text-red-600">
+                      Account Unlock Failed
+                    </h1>
+                    <p className="text-gray-500 text-center">{error}</p>
+                  </div>
+                </>
+              ) : (
+                <>
+                  <div className="text-green-500">
+                    <CheckCircle className="h-16 w-16" />
+                  </div>
+                  <div className="flex flex-col items-center space-y-2">
+                    <div className="flex items-center space-x-2 text-purple-600">
+                      <Unlock className="h-8 w-8" />
+                      <h1 className="text-2xl font-bold">Account Unlocked</h1>
+                    </div>
+                    <p className="text-gray-500 text-center">{message}</p>
+                  </div>
+                </>
+              )}
+
+              <div className="flex space-x-4">
+                <Link
+                  to="/login"
+                  className="px-6 py-2 bg-purple-600 text-white rounded-lg hover:bg-purple-700 transition-colors"
+                >
+                  Login
+                </Link>
+                <Link
+                  to="/"
+                  className="px-6 py-2 border border-purple-600 text-purple-600 rounded-lg hover:bg-purple-50 transition-colors"
+                >
+                  Back to Home
+                </Link>
+              </div>
+            </div>
+          </div>
+        </div>
+      </main>
+    </div>
+  );
+};
+
+export default UnlockAccountPage;
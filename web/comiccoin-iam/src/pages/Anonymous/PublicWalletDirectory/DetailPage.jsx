@@ -17,6 +17,7 @@ import {
   EyeIcon,
   Wallet,
   BadgeCheck,
+  KeyRound,
   Copy,
   ExternalLink,
   Share2,
@@ -226,6 +227,15 @@ const PublicWalletDirectoryDetailPage = () => {
                     Verified
                   </div>
                 )}
+                {wallet.isOwnerVerified && (
+                  <div
+                    className="bg-indigo-500 text-white px-3 py-1 rounded-full text-sm font-medium flex items-center"
+                    title="The owner signed a challenge with the key of this wallet"
+                  >
+                    <KeyRound className="h-4 w-4 mr-1" />
+                    Owner Verified
+                  </div>
+                )}
                 <div
                   className={`px-3 py-1 rounded-full text-sm font-medium flex items-center ${
                     entityType === "retailer"
@@ -471,6 +481,33 @@ const PublicWalletDirectoryDetailPage = () => {
                       )}
                     </div>
 
+                    <div className="pt-2 border-t border-gray-200">
+                      <p className="text-sm text-gray-500 mb-2">
+                        Wallet Ownership
+                      </p>
+                      {wallet.isOwnerVerified ? (
+                        <div className="flex items-center text-indigo-700">
+                          <KeyRound className="h-5 w-5 mr-2" />
+                          <div>
+                            <p className="font-medium">Owner Verified</p>
+                            {wallet.ownerVerifiedOn && (
+                              <p className="text-xs text-gray-500">
+                                Signed on{" "}
+                                {new Date(
+                                  wallet.ownerVerifiedOn,
+                                ).toLocaleDateString()}
+                              </p>
+                            )}
+                          </div>
+                        </div>
+                      ) : (
+                        <div className="flex items-center text-amber-700">
+                          <AlertTriangle className="h-5 w-5 mr-2" />
+                          <p className="font-medium">Ownership Not Proven</p>
+                        </div>
+                      )}
+                    </div>
+
                     <div className="pt-2 border-t border-gray-200">
                       <p className="text-sm text-gray-500 mb-2">
                         Registration Details
@@ -25,6 +25,7 @@ import {
   CreditCard,
   Wallet,
   BadgeCheck,
+  KeyRound,
   SlidersHorizontal,
   Users,
   AlertTriangle,
@@ -508,6 +509,15 @@ const PublicWalletDirectoryListPage = () => {
                               Verified
                             </div>
                           )}
+                          {wallet.isOwnerVerified && (
+                            <div
+                              className="bg-indigo-100 text-indigo-700 px-2 py-1 rounded-full text-xs font-medium flex items-center"
+                              title="The owner signed a challenge with the key of this wallet"
+                            >
+                              <KeyRound className="h-3.5 w-3.5 mr-1" />
+                              Owner Verified
+                            </div>
+                          )}
                         </div>
                       </div>
 
@@ -624,6 +634,15 @@ const PublicWalletDirectoryListPage = () => {
                                     Verified
                                   </div>
                                 )}
+                                {wallet.isOwnerVerified && (
+                                  <div
+                                    className="ml-2 bg-indigo-100 text-indigo-700 px-2 py-1 rounded-full text-xs font-medium flex items-center"
+                                    title="The owner signed a challenge with the key of this wallet"
+                                  >
+                                    <KeyRound className="h-3.5 w-3.5 mr-1" />
+                                    Owner Verified
+                                  </div>
+                                )}
                               </div>
                               {wallet.city && (
                                 <p className="text-sm text-gray-500">
@@ -26,6 +26,7 @@ import { toast } from "react-toastify";
 import UserTopNavigation from "../../../components/UserTopNavigation";
 import UserFooter from "../../../components/UserFooter";
 import withProfileVerification from "../../../components/withProfileVerification";
+import WalletOwnershipChallenge from "../../../components/WalletOwnershipChallenge";
 import { usePublicWallet } from "../../../hooks/usePublicWallet";
 import { useGetMe } from "../../../hooks/useGetMe";
 
@@ -48,6 +49,7 @@ const UserPublicWalletAddPage = () => {
     name: "",
     description: "",
     thumbnailS3Key: "",
+    signature: "",
   });
 
   // Create ref for form card to scroll to on error
@@ -394,6 +396,19 @@ const UserPublicWalletAddPage = () => {
                   </p>
                 </div>
 
+                {/* Wallet Ownership */}
+                <WalletOwnershipChallenge
+                  address={formData.address}
+                  signature={formData.signature}
+                  onSignatureChange={(signature) => {
+                    setFormData((prev) => ({ ...prev, signature }));
+                    if (errors.signature) {
+                      setErrors((prev) => ({ ...prev, signature: undefined }));
+                    }
+                  }}
+                  error={errors.signature}
+                />
+
                 {/* Chain ID */}
                 <div>
                   <label
@@ -29,6 +29,7 @@ import {
   Info,
   Activity,
   Users,
+  KeyRound,
 } from "lucide-react";
 import { toast } from "react-toastify";
 import UserTopNavigation from "../../../components/UserTopNavigation";
@@ -269,6 +270,19 @@ const UserPublicWalletDetailsPage = () => {
                         </span>
                       )}
 
+                      {/* Owner Verification Badge */}
+                      {wallet.isOwnerVerified ? (
+                        <span className="inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-indigo-100 text-indigo-800">
+                          <KeyRound className="h-3 w-3 mr-1" />
+                          Owner Verified
+                        </span>
+                      ) : (
+                        <span className="inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-yellow-100 text-yellow-800">
+                          <Info className="h-3 w-3 mr-1" />
+                          Ownership Not Proven
+                        </span>
+                      )}
+
                       {/* Wallet Type Badge */}
                       <span className="inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-purple-100 text-purple-800">
                         {wallet.type === 2 ? (
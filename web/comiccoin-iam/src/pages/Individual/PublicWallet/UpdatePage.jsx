@@ -19,6 +19,7 @@ import { toast } from "react-toastify";
 import UserTopNavigation from "../../../components/UserTopNavigation";
 import UserFooter from "../../../components/UserFooter";
 import withProfileVerification from "../../../components/withProfileVerification";
+import WalletOwnershipChallenge from "../../../components/WalletOwnershipChallenge";
 import { usePublicWallet } from "../../../hooks/usePublicWallet";
 import { useGetMe } from "../../../hooks/useGetMe";
 
@@ -61,8 +62,12 @@ const UserPublicWalletUpdatePage = () => {
     status: 1, // Default to active
     type: 3, // Default to individual
     websiteURL: "", // Added website URL
+    signature: "",
   });
 
+  // Whether the owner already proved they control the wallet address
+  const [isOwnerVerified, setIsOwnerVerified] = useState(false);
+
   // Combine loading states
   const isLoading = isLoadingOperation || isUpdating || isLoadingWallet;
   const error = operationError;
@@ -111,7 +116,9 @@ const UserPublicWalletUpdatePage = () => {
             type: walletData.type || 3,
             websiteURL: walletData.websiteURL || "",
             viewCount: walletData.viewCount || 0,
+            signature: "",
           });
+          setIsOwnerVerified(!!walletData.isOwnerVerified);
         } else {
           console.warn("No wallet data found");
           setGeneralError("Wallet not found");
@@ -428,6 +435,22 @@ const UserPublicWalletUpdatePage = () => {
                 </p>
               </div>
 
+              {/* Wallet Ownership (optional, for wallets created before signatures were required) */}
+              {!isOwnerVerified && (
+                <WalletOwnershipChallenge
+                  address={formData.address}
+                  signature={formData.signature}
+                  onSignatureChange={(signature) => {
+                    setFormData((prev) => ({ ...prev, signature }));
+                    if (errors.signature) {
+                      setErrors((prev) => ({ ...prev, signature: undefined }));
+                    }
+                  }}
+                  error={errors.signature}
+                  required={false}
+                />
+              )}
+
               {/* Chain ID */}
               <div>
                 <label
@@ -0,0 +1,29 @@
+// monorepo/web/comiccoin-iam/src/utils/oauthReturnTo.js
+
+// When a third-party app sends a logged out user to us we need to bring
+// them back to the consent page after they log in. The consent page clears
+// the saved path once it is shown, so every post-login redirect sees the
+// same value until then.
+const OAUTH_RETURN_TO_KEY = "oauth_return_to";
+
+export const saveOAuthReturnTo = (path) => {
+  sessionStorage.setItem(OAUTH_RETURN_TO_KEY, path);
+};
+
+/**
+ * Return the saved consent page path, if any
+ * @returns {string|null}
+ */
+export const getOAuthReturnTo = () => {
+  const path = sessionStorage.getItem(OAUTH_RETURN_TO_KEY);
+
+  // Only ever send the user back to the consent page.
+  if (!path || !path.startsWith("/oauth/authorize?")) {
+    return null;
+  }
+  return path;
+};
+
+export const clearOAuthReturnTo = () => {
+  sessionStorage.removeItem(OAUTH_RETURN_TO_KEY);
+};
@@ -298,6 +298,31 @@ function TransactionDetailPage() {
                                     </p>
                                 </div>
 
+                                {/* Royalty paid to the creator of the token */}
+                                {currentTransaction.royalty > 0 && (
+                                    <>
+                                        <div className="space-y-2">
+                                            <h3 className="text-sm font-medium text-gray-500">Creator Royalty</h3>
+                                            <p className="text-lg font-bold text-gray-900 bg-gray-50 rounded-lg p-2 md:p-3">
+                                                {currentTransaction.royalty} CC
+                                            </p>
+                                        </div>
+
+                                        <div className="space-y-2">
+                                            <h3 className="text-sm font-medium text-gray-500">Royalty Recipient</h3>
+                                            <div className="flex items-center gap-2 bg-gray-50 rounded-lg p-2 md:p-3">
+                                                <code className="text-sm flex-1 break-all">{currentTransaction.royalty_recipient}</code>
+                                                <button
+                                                    onClick={() => copyToClipboard(currentTransaction.royalty_recipient)}
+                                                    className="p-2 text-gray-500 hover:text-gray-700 hover:bg-gray-100 rounded-lg transition-colors touch-manipulation"
+                                                >
+                                                    <Copy className="w-4 h-4" />
+                                                </button>
+                                            </div>
+                                        </div>
+                                    </>
+                                )}
+
                                 {/* Chain ID and Timestamp */}
                                 <div className="space-y-2">
                                     <h3 className="text-sm font-medium text-gray-500">Chain ID</h3>